@@ -5,21 +5,40 @@ import (
 	"database/sql"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 	"time"
 
+	"github.com/voidarchive/ntx/internal/convert"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
 	"github.com/voidarchive/ntx/internal/nepse"
+	"github.com/voidarchive/ntx/internal/worker"
 )
 
 const maxConcurrency = 5
 
 func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Client, opts backfillOptions) error {
+	progress, err := startBackfillProgress(ctx, queries)
+	if err != nil {
+		return fmt.Errorf("record backfill run: %w", err)
+	}
+	err = backfillPhases(ctx, queries, client, opts, progress)
+	progress.finish(err)
+	return err
+}
+
+func backfillPhases(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	client *nepse.Client,
+	opts backfillOptions,
+	progress *backfillProgress,
+) error {
 	start := time.Now()
 
 	if opts.companies {
 		slog.Info("syncing companies...")
-		if err := syncCompanies(ctx, queries, client); err != nil {
+		if err := syncCompanies(ctx, queries, client, progress); err != nil {
 			return fmt.Errorf("sync companies: %w", err)
 		}
 		slog.Info("companies synced")
@@ -27,7 +46,7 @@ func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Clien
 
 	if opts.fundamentals {
 		slog.Info("syncing fundamentals...", "concurrency", maxConcurrency)
-		if err := syncFundamentalsConcurrent(ctx, queries, client); err != nil {
+		if err := syncFundamentalsConcurrent(ctx, queries, client, opts, progress); err != nil {
 			return fmt.Errorf("sync fundamentals: %w", err)
 		}
 		slog.Info("fundamentals synced")
@@ -35,7 +54,7 @@ func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Clien
 
 	if opts.prices {
 		slog.Info("syncing price history...", "concurrency", maxConcurrency)
-		if err := syncPriceHistoryConcurrent(ctx, queries, client); err != nil {
+		if err := syncPriceHistoryConcurrent(ctx, queries, client, opts, progress); err != nil {
 			return fmt.Errorf("sync price history: %w", err)
 		}
 		slog.Info("price history synced")
@@ -43,7 +62,7 @@ func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Clien
 
 	if opts.ownership {
 		slog.Info("syncing ownership...", "concurrency", maxConcurrency)
-		if err := syncOwnershipConcurrent(ctx, queries, client); err != nil {
+		if err := syncOwnershipConcurrent(ctx, queries, client, opts, progress); err != nil {
 			return fmt.Errorf("sync ownership: %w", err)
 		}
 		slog.Info("ownership synced")
@@ -51,7 +70,7 @@ func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Clien
 
 	if opts.corporateActions {
 		slog.Info("syncing dividends...", "concurrency", maxConcurrency)
-		if err := syncDividendsConcurrent(ctx, queries, client); err != nil {
+		if err := syncDividendsConcurrent(ctx, queries, client, opts, progress); err != nil {
 			return fmt.Errorf("sync dividends: %w", err)
 		}
 		slog.Info("dividends synced")
@@ -61,12 +80,42 @@ func runBackfill(ctx context.Context, queries *sqlc.Queries, client *nepse.Clien
 	return nil
 }
 
-func syncCompanies(ctx context.Context, queries *sqlc.Queries, client *nepse.Client) error {
+// backfillCompanies lists the companies to sync: all of them, or only the
+// given symbols. An unknown symbol is an error rather than a silent no-op.
+func backfillCompanies(ctx context.Context, queries *sqlc.Queries, symbols []string) ([]sqlc.ListCompaniesRow, error) {
+	companies, err := queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
+		Limit:  1000,
+		Offset: 0,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(symbols) == 0 {
+		return companies, nil
+	}
+
+	bySymbol := make(map[string]sqlc.ListCompaniesRow, len(companies))
+	for _, c := range companies {
+		bySymbol[c.Symbol] = c
+	}
+	selected := make([]sqlc.ListCompaniesRow, 0, len(symbols))
+	for _, symbol := range symbols {
+		c, ok := bySymbol[symbol]
+		if !ok {
+			return nil, fmt.Errorf("unknown symbol %s", symbol)
+		}
+		selected = append(selected, c)
+	}
+	return selected, nil
+}
+
+func syncCompanies(ctx context.Context, queries *sqlc.Queries, client *nepse.Client, progress *backfillProgress) error {
 	companies, err := client.Companies(ctx)
 	if err != nil {
 		return err
 	}
 
+	progress.startPhase(ctx, "companies", len(companies))
 	for _, c := range companies {
 		params := sqlc.UpsertCompanyParams{
 			ID:             c.ID,
@@ -81,19 +130,28 @@ func syncCompanies(ctx context.Context, queries *sqlc.Queries, client *nepse.Cli
 		if err := queries.UpsertCompany(ctx, params); err != nil {
 			return fmt.Errorf("upsert %s: %w", c.Symbol, err)
 		}
+		if err := worker.RecordListingStatus(ctx, queries, c.ID, c.Status, worker.BusinessDate(time.Now())); err != nil {
+			return fmt.Errorf("listing status %s: %w", c.Symbol, err)
+		}
+		progress.companyDone(ctx, c.Symbol, 1)
 	}
 	return nil
 }
 
-func syncFundamentalsConcurrent(ctx context.Context, queries *sqlc.Queries, client *nepse.Client) error {
-	companies, err := queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
-		Limit:  1000,
-		Offset: 0,
-	})
+func syncFundamentalsConcurrent(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	client *nepse.Client,
+	opts backfillOptions,
+	progress *backfillProgress,
+) error {
+	companies, err := backfillCompanies(ctx, queries, opts.symbols)
 	if err != nil {
 		return err
 	}
 
+	progress.startPhase(ctx, "fundamentals", len(companies))
+
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, maxConcurrency)
 	errChan := make(chan error, len(companies))
@@ -106,9 +164,11 @@ func syncFundamentalsConcurrent(ctx context.Context, queries *sqlc.Queries, clie
 			defer func() { <-sem }() // release
 
 			company := rowToCompany(row)
-			if err := syncCompanyFundamentals(ctx, queries, client, company); err != nil {
+			rows, err := syncCompanyFundamentals(ctx, queries, client, company)
+			if err != nil {
 				slog.Warn("skip fundamentals", "symbol", company.Symbol, "error", err)
 			}
+			progress.companyDone(ctx, company.Symbol, rows)
 		}(r)
 	}
 
@@ -129,10 +189,10 @@ func syncCompanyFundamentals(
 	queries *sqlc.Queries,
 	client *nepse.Client,
 	company sqlc.Company,
-) error {
-	fundamentals, err := client.Fundamentals(ctx, safeInt32(company.ID))
+) (int, error) {
+	fundamentals, err := client.Fundamentals(ctx, convert.SafeInt32(company.ID))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	for _, f := range fundamentals {
@@ -147,21 +207,26 @@ func syncCompanyFundamentals(
 			ProfitAmount:  nullFloat64(f.ProfitAmount),
 		}
 		if err := queries.UpsertFundamental(ctx, params); err != nil {
-			return fmt.Errorf("upsert fundamental: %w", err)
+			return 0, fmt.Errorf("upsert fundamental: %w", err)
 		}
 	}
-	return nil
+	return len(fundamentals), nil
 }
 
-func syncOwnershipConcurrent(ctx context.Context, queries *sqlc.Queries, client *nepse.Client) error {
-	companies, err := queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
-		Limit:  1000,
-		Offset: 0,
-	})
+func syncOwnershipConcurrent(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	client *nepse.Client,
+	opts backfillOptions,
+	progress *backfillProgress,
+) error {
+	companies, err := backfillCompanies(ctx, queries, opts.symbols)
 	if err != nil {
 		return err
 	}
 
+	progress.startPhase(ctx, "ownership", len(companies))
+
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, maxConcurrency)
 
@@ -173,9 +238,11 @@ func syncOwnershipConcurrent(ctx context.Context, queries *sqlc.Queries, client
 			defer func() { <-sem }()
 
 			company := rowToCompany(row)
-			if err := syncCompanyOwnership(ctx, queries, client, company); err != nil {
+			rows, err := syncCompanyOwnership(ctx, queries, client, company)
+			if err != nil {
 				slog.Warn("skip ownership", "symbol", company.Symbol, "error", err)
 			}
+			progress.companyDone(ctx, company.Symbol, rows)
 		}(r)
 	}
 
@@ -188,10 +255,10 @@ func syncCompanyOwnership(
 	queries *sqlc.Queries,
 	client *nepse.Client,
 	company sqlc.Company,
-) error {
-	ownership, err := client.SecurityDetail(ctx, safeInt32(company.ID))
+) (int, error) {
+	ownership, err := client.SecurityDetail(ctx, convert.SafeInt32(company.ID))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	params := sqlc.UpsertOwnershipParams{
@@ -202,18 +269,26 @@ func syncCompanyOwnership(
 		PromoterShares:  nullInt64(ownership.PromoterShares),
 		PromoterPercent: nullFloat64(ownership.PromoterPercent),
 	}
-	return queries.UpsertOwnership(ctx, params)
+	if err := queries.UpsertOwnership(ctx, params); err != nil {
+		return 0, err
+	}
+	return 1, nil
 }
 
-func syncDividendsConcurrent(ctx context.Context, queries *sqlc.Queries, client *nepse.Client) error {
-	companies, err := queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
-		Limit:  1000,
-		Offset: 0,
-	})
+func syncDividendsConcurrent(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	client *nepse.Client,
+	opts backfillOptions,
+	progress *backfillProgress,
+) error {
+	companies, err := backfillCompanies(ctx, queries, opts.symbols)
 	if err != nil {
 		return err
 	}
 
+	progress.startPhase(ctx, "corporate-actions", len(companies))
+
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, maxConcurrency)
 
@@ -225,9 +300,11 @@ func syncDividendsConcurrent(ctx context.Context, queries *sqlc.Queries, client
 			defer func() { <-sem }()
 
 			company := rowToCompany(row)
-			if err := syncCompanyDividends(ctx, queries, client, company); err != nil {
+			rows, err := syncCompanyDividends(ctx, queries, client, company)
+			if err != nil {
 				slog.Warn("skip dividends", "symbol", company.Symbol, "error", err)
 			}
+			progress.companyDone(ctx, company.Symbol, rows)
 		}(r)
 	}
 
@@ -240,10 +317,10 @@ func syncCompanyDividends(
 	queries *sqlc.Queries,
 	client *nepse.Client,
 	company sqlc.Company,
-) error {
-	dividends, err := client.Dividends(ctx, safeInt32(company.ID))
+) (int, error) {
+	dividends, err := client.Dividends(ctx, convert.SafeInt32(company.ID))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	for _, d := range dividends {
@@ -256,28 +333,45 @@ func syncCompanyDividends(
 			SubmittedDate:   nullString(d.ModifiedDate),
 		}
 		if err := queries.UpsertCorporateAction(ctx, params); err != nil {
-			return fmt.Errorf("upsert dividend: %w", err)
+			return 0, fmt.Errorf("upsert dividend: %w", err)
 		}
 	}
-	return nil
+	return len(dividends), nil
 }
 
-func syncPriceHistoryConcurrent(ctx context.Context, queries *sqlc.Queries, client *nepse.Client) error {
-	companies, err := queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
-		Limit:  1000,
-		Offset: 0,
-	})
+func syncPriceHistoryConcurrent(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	client *nepse.Client,
+	opts backfillOptions,
+	progress *backfillProgress,
+) error {
+	companies, err := backfillCompanies(ctx, queries, opts.symbols)
 	if err != nil {
 		return err
 	}
+	// Delisted companies have no new history to fetch, unless asked for by name
+	if len(opts.symbols) == 0 {
+		companies = slices.DeleteFunc(companies, func(c sqlc.ListCompaniesRow) bool {
+			return c.Status == worker.StatusDelisted
+		})
+	}
 
-	// Calculate 1 year date range
+	// Default to the last year
 	loc, _ := time.LoadLocation("Asia/Kathmandu")
 	endDate := time.Now().In(loc)
 	startDate := endDate.AddDate(-1, 0, 0)
 
 	startStr := startDate.Format("2006-01-02")
 	endStr := endDate.Format("2006-01-02")
+	if opts.from != "" {
+		startStr = opts.from
+	}
+	if opts.to != "" {
+		endStr = opts.to
+	}
+
+	progress.startPhase(ctx, "prices", len(companies))
 
 	var wg sync.WaitGroup
 	sem := make(chan struct{}, maxConcurrency)
@@ -290,9 +384,11 @@ func syncPriceHistoryConcurrent(ctx context.Context, queries *sqlc.Queries, clie
 			defer func() { <-sem }()
 
 			company := rowToCompany(row)
-			if err := syncCompanyPriceHistory(ctx, queries, client, company, startStr, endStr); err != nil {
+			rows, err := syncCompanyPriceHistory(ctx, queries, client, company, startStr, endStr)
+			if err != nil {
 				slog.Warn("skip price history", "symbol", company.Symbol, "error", err)
 			}
+			progress.companyDone(ctx, company.Symbol, rows)
 		}(r)
 	}
 
@@ -306,10 +402,10 @@ func syncCompanyPriceHistory(
 	client *nepse.Client,
 	company sqlc.Company,
 	startDate, endDate string,
-) error {
-	history, err := client.PriceHistory(ctx, safeInt32(company.ID), startDate, endDate)
+) (int, error) {
+	history, err := client.PriceHistory(ctx, convert.SafeInt32(company.ID), startDate, endDate)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	slog.Debug("price history received", "symbol", company.Symbol, "count", len(history))
@@ -326,10 +422,10 @@ func syncCompanyPriceHistory(
 			Trades:       nullInt64(int64(h.Trades)),
 		}
 		if err := queries.UpsertPrice(ctx, params); err != nil {
-			return fmt.Errorf("upsert price: %w", err)
+			return 0, fmt.Errorf("upsert price: %w", err)
 		}
 	}
-	return nil
+	return len(history), nil
 }
 
 func nullString(s string) sql.NullString {
@@ -360,14 +456,6 @@ func nullFloat64Ptr(f *float64) sql.NullFloat64 {
 	return sql.NullFloat64{Float64: *f, Valid: true}
 }
 
-func safeInt32(v int64) int32 {
-	const maxInt32 = 1<<31 - 1
-	if v > maxInt32 {
-		return maxInt32
-	}
-	return int32(v) //nolint:gosec // bounds checked above
-}
-
 func rowToCompany(r sqlc.ListCompaniesRow) sqlc.Company {
 	return sqlc.Company{
 		ID:             r.ID,
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// progressInterval throttles progress writes; phase changes always save.
+const progressInterval = 2 * time.Second
+
+// backfillProgress records a run in backfill_runs so GetBackfillStatus can
+// report it, and logs the same figures as structured progress events.
+type backfillProgress struct {
+	queries      *sqlc.Queries
+	runID        int64
+	mu           sync.Mutex
+	phase        string
+	phaseStarted time.Time
+	symbol       string
+	done, total  int64
+	rows         int64
+	lastSaved    time.Time
+}
+
+func startBackfillProgress(ctx context.Context, queries *sqlc.Queries) (*backfillProgress, error) {
+	now := time.Now()
+	id, err := queries.CreateBackfillRun(ctx, sqlc.CreateBackfillRunParams{StartedAt: now, UpdatedAt: now})
+	if err != nil {
+		return nil, err
+	}
+	return &backfillProgress{queries: queries, runID: id}, nil
+}
+
+// startPhase resets the per-company counters for a phase over total companies.
+func (p *backfillProgress) startPhase(ctx context.Context, phase string, total int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.phase = phase
+	p.phaseStarted = time.Now()
+	p.symbol = ""
+	p.done = 0
+	p.total = int64(total)
+	p.save(ctx, true)
+}
+
+// companyDone counts a finished company, whether or not its sync succeeded.
+func (p *backfillProgress) companyDone(ctx context.Context, symbol string, rows int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.symbol = symbol
+	p.done++
+	p.rows += int64(rows)
+	p.save(ctx, p.done == p.total)
+}
+
+// save must be called with mu held.
+func (p *backfillProgress) save(ctx context.Context, force bool) {
+	now := time.Now()
+	if !force && now.Sub(p.lastSaved) < progressInterval {
+		return
+	}
+	p.lastSaved = now
+
+	var eta time.Duration
+	if p.done > 0 {
+		eta = now.Sub(p.phaseStarted) / time.Duration(p.done) * time.Duration(p.total-p.done)
+	}
+	slog.Info("backfill progress",
+		"phase", p.phase,
+		"symbol", p.symbol,
+		"done", p.done,
+		"total", p.total,
+		"rows", p.rows,
+		"eta", eta.Round(time.Second),
+	)
+
+	err := p.queries.UpdateBackfillProgress(ctx, sqlc.UpdateBackfillProgressParams{
+		Phase:          p.phase,
+		PhaseStartedAt: sql.NullTime{Time: p.phaseStarted, Valid: true},
+		Symbol:         p.symbol,
+		CompaniesDone:  p.done,
+		CompaniesTotal: p.total,
+		RowsFetched:    p.rows,
+		UpdatedAt:      now,
+		ID:             p.runID,
+	})
+	if err != nil {
+		slog.Warn("save backfill progress", "error", err)
+	}
+}
+
+// finish marks the run succeeded or failed. It uses a fresh context since the
+// run's own may be what failed.
+func (p *backfillProgress) finish(runErr error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p.save(ctx, true)
+	now := time.Now()
+	params := sqlc.FinishBackfillRunParams{
+		FinishedAt: sql.NullTime{Time: now, Valid: true},
+		Status:     "succeeded",
+		UpdatedAt:  now,
+		ID:         p.runID,
+	}
+	if runErr != nil {
+		params.Status = "failed"
+		params.Error = runErr.Error()
+	}
+	if err := p.queries.FinishBackfillRun(ctx, params); err != nil {
+		slog.Warn("save backfill result", "error", err)
+	}
+}
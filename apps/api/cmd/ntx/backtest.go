@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/backtest"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// strategyFile is the JSON read by `ntx backtest`, for example:
+//
+//	{"symbols": ["NABIL"], "from": "2025-01-01", "capital": 100000,
+//	 "rule": "sma_cross", "fast": 20, "slow": 50}
+type strategyFile struct {
+	Symbols []string `json:"symbols"`
+	backtest.Strategy
+}
+
+func runBacktestCmd(args []string) {
+	fs := flag.NewFlagSet("backtest", flag.ExitOnError)
+	asJSON := fs.Bool("json", false, "print results as JSON")
+	_ = fs.Parse(args)
+	if fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "usage: ntx backtest [--json] strategy.json")
+		os.Exit(1)
+	}
+
+	strategy, err := readStrategy(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	db, queries := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	results, err := runBacktest(ctx, queries, strategy)
+	if err != nil {
+		slog.Error("backtest failed", "error", err)
+		os.Exit(1)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		_ = enc.Encode(results)
+		return
+	}
+	printBacktest(os.Stdout, strategy.Strategy, results)
+}
+
+func readStrategy(path string) (strategyFile, error) {
+	var s strategyFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(s.Symbols) == 0 {
+		return s, errors.New("strategy needs at least one symbol")
+	}
+	for _, d := range []string{s.From, s.To} {
+		if _, err := time.Parse("2006-01-02", d); d != "" && err != nil {
+			return s, fmt.Errorf("invalid date %q, want YYYY-MM-DD", d)
+		}
+	}
+	return s, s.Validate()
+}
+
+// runBacktest replays each symbol's stored history with NEPSE broker fees
+// applied to every fill.
+func runBacktest(ctx context.Context, queries *sqlc.Queries, s strategyFile) ([]backtest.Result, error) {
+	results := make([]backtest.Result, 0, len(s.Symbols))
+	for _, symbol := range s.Symbols {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		history, err := loadHistory(ctx, queries, symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		bars := make([]backtest.Bar, len(history))
+		for i, p := range history {
+			bars[i] = backtest.Bar{Date: p.BusinessDate, Open: p.OpenPrice.Float64, Close: closeOf(p)}
+		}
+		results = append(results, backtest.Run(s.Strategy, symbol, bars, portfolio.TradeFees))
+	}
+	return results, nil
+}
+
+func printBacktest(w io.Writer, s backtest.Strategy, results []backtest.Result) {
+	for _, r := range results {
+		fmt.Fprintf(w, "%s  %s\n", r.Symbol, describeStrategy(s))
+		for _, t := range r.Trades {
+			fmt.Fprintf(w, "  %s  %-4s %6d @ %s  fees %s\n",
+				t.Date, t.Side, t.Quantity, formatFloat(t.Price), formatFloat(t.Fees))
+		}
+		if len(r.Trades) == 0 {
+			fmt.Fprintln(w, "  no trades")
+		}
+		fmt.Fprintf(w, "  end value %s  return %s%%  buy & hold %s%%  max drawdown %s%%\n",
+			formatFloat(r.EndValue), formatFloat(r.ReturnPercent),
+			formatFloat(r.BuyHoldPercent), formatFloat(r.MaxDrawdownPercent))
+		fmt.Fprintf(w, "  round trips %d (%d won)  fees paid %s\n\n",
+			r.RoundTrips, r.Wins, formatFloat(r.FeesPaid))
+	}
+}
+
+func describeStrategy(s backtest.Strategy) string {
+	if s.Rule == backtest.SMACross {
+		return fmt.Sprintf("sma_cross(%d/%d)", s.Fast, s.Slow)
+	}
+	return fmt.Sprintf("rsi(%d) buy<%s sell>%s", s.Period, formatFloat(s.BuyBelow), formatFloat(s.SellAbove))
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/bcrypt"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// Demo login, printed at startup so the web app can sign in.
+const (
+	demoEmail    = "demo@ntx.local"
+	demoPassword = "demo1234"
+)
+
+// demoDays is the number of trading days of generated price history.
+const demoDays = 250
+
+type demoCompany struct {
+	id     int64
+	symbol string
+	name   string
+	sector string
+	price  float64
+}
+
+var demoCompanies = []demoCompany{
+	{131, "NABIL", "Nabil Bank Limited", "Commercial Banks", 520},
+	{139, "NICA", "NIC Asia Bank Ltd.", "Commercial Banks", 410},
+	{2792, "GBIME", "Global IME Bank Limited", "Commercial Banks", 230},
+	{2791, "UPPER", "Upper Tamakoshi Hydropower Ltd", "Hydro Power", 190},
+	{357, "CHCL", "Chilime Hydropower Company Limited", "Hydro Power", 520},
+	{174, "NLIC", "Nepal Life Insurance Co. Ltd.", "Life Insurance", 760},
+	{236, "HDL", "Himalayan Distillery Limited", "Manufacturing And Processing", 1350},
+	{2875, "SHIVM", "Shivam Cements Ltd", "Manufacturing And Processing", 560},
+	{397, "NTC", "Nepal Doorsanchar Comapany Limited", "Others", 880},
+	{227, "CIT", "Citizen Investment Trust", "Others", 2100},
+}
+
+// openDemoDatabase opens an in-memory database filled with generated
+// companies, price history and a sample portfolio. Nothing is written to disk.
+func openDemoDatabase() (*sql.DB, *sqlc.Queries) {
+	db, err := database.OpenDB("file::memory:")
+	if err != nil {
+		slog.Error("failed to open demo database", "error", err)
+		os.Exit(1)
+	}
+	if err := database.AutoMigrate(db); err != nil {
+		slog.Error("failed to run migrations", "error", err)
+		os.Exit(1)
+	}
+	queries := sqlc.New(db)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if err := seedDemo(ctx, db, queries); err != nil {
+		slog.Error("failed to seed demo data", "error", err)
+		os.Exit(1)
+	}
+
+	slog.Info("demo database seeded", "email", demoEmail, "password", demoPassword)
+	return db, queries
+}
+
+// seedDemo generates the demo data. The generator is seeded with a constant
+// so every run, screenshot and test sees the same market.
+func seedDemo(ctx context.Context, db *sql.DB, queries *sqlc.Queries) error {
+	rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec // demo data, not security sensitive
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(demoPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+	user, err := queries.CreateUser(ctx, sqlc.CreateUserParams{
+		Email:        demoEmail,
+		PasswordHash: string(hash),
+	})
+	if err != nil {
+		return fmt.Errorf("create user: %w", err)
+	}
+
+	dates := demoTradingDays(time.Now(), demoDays)
+	closes := make(map[string][]float64, len(demoCompanies))
+	for _, c := range demoCompanies {
+		err := queries.UpsertCompany(ctx, sqlc.UpsertCompanyParams{
+			ID:             c.id,
+			Name:           c.name,
+			Symbol:         c.symbol,
+			Status:         "A",
+			Sector:         c.sector,
+			InstrumentType: "Equity",
+		})
+		if err != nil {
+			return fmt.Errorf("create company %s: %w", c.symbol, err)
+		}
+
+		series, err := seedDemoPrices(ctx, queries, rng, c, dates)
+		if err != nil {
+			return fmt.Errorf("seed prices %s: %w", c.symbol, err)
+		}
+		closes[c.symbol] = series
+	}
+
+	return seedDemoPortfolio(ctx, portfolio.NewPortfolioService(db, queries), rng, user.ID, dates, closes)
+}
+
+// seedDemoPrices writes a random walk of daily prices for one company,
+// bounded by NEPSE's 10% circuit, and returns the closes.
+func seedDemoPrices(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	rng *rand.Rand,
+	c demoCompany,
+	dates []string,
+) ([]float64, error) {
+	closes := make([]float64, len(dates))
+	prev := c.price
+	for i, date := range dates {
+		change := max(-0.1, min(0.1, rng.NormFloat64()*0.018+0.0004))
+		closePrice := roundTick(prev * (1 + change))
+		openPrice := roundTick(prev * (1 + (rng.Float64()-0.5)*0.01))
+		high := roundTick(max(openPrice, closePrice) * (1 + rng.Float64()*0.015))
+		low := roundTick(min(openPrice, closePrice) * (1 - rng.Float64()*0.015))
+		volume := int64(2000 + rng.IntN(60000))
+
+		err := queries.UpsertPrice(ctx, sqlc.UpsertPriceParams{
+			CompanyID:       c.id,
+			BusinessDate:    date,
+			OpenPrice:       sql.NullFloat64{Float64: openPrice, Valid: true},
+			HighPrice:       sql.NullFloat64{Float64: high, Valid: true},
+			LowPrice:        sql.NullFloat64{Float64: low, Valid: true},
+			ClosePrice:      sql.NullFloat64{Float64: closePrice, Valid: true},
+			LastTradedPrice: sql.NullFloat64{Float64: closePrice, Valid: true},
+			PreviousClose:   sql.NullFloat64{Float64: prev, Valid: true},
+			ChangeAmount:    sql.NullFloat64{Float64: closePrice - prev, Valid: true},
+			ChangePercent:   sql.NullFloat64{Float64: (closePrice - prev) / prev * 100, Valid: true},
+			Volume:          sql.NullInt64{Int64: volume, Valid: true},
+			Turnover:        sql.NullFloat64{Float64: float64(volume) * closePrice, Valid: true},
+			Trades:          sql.NullInt64{Int64: volume / 40, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+		closes[i] = closePrice
+		prev = closePrice
+	}
+	return closes, nil
+}
+
+// seedDemoPortfolio books a couple of buys per company at the generated
+// closes, and partly sells every third position so realized gains show up.
+func seedDemoPortfolio(
+	ctx context.Context,
+	portfolios *portfolio.PortfolioService,
+	rng *rand.Rand,
+	userID int64,
+	dates []string,
+	closes map[string][]float64,
+) error {
+	ctx = context.WithValue(ctx, portfolio.UserIDKey, userID)
+
+	created, err := portfolios.CreatePortfolio(ctx, connect.NewRequest(&ntxv1.CreatePortfolioRequest{Name: "Demo"}))
+	if err != nil {
+		return fmt.Errorf("create portfolio: %w", err)
+	}
+	portfolioID := created.Msg.Portfolio.Id
+
+	add := func(symbol string, txType ntxv1.TransactionType, quantity int64, day int) error {
+		_, err := portfolios.AddTransaction(ctx, connect.NewRequest(&ntxv1.AddTransactionRequest{
+			PortfolioId:     portfolioID,
+			StockSymbol:     symbol,
+			TransactionType: txType,
+			Quantity:        quantity,
+			UnitPrice:       closes[symbol][day],
+			TransactionDate: dates[day],
+		}))
+		if err != nil {
+			return fmt.Errorf("add %s %s: %w", txType, symbol, err)
+		}
+		return nil
+	}
+
+	for i, c := range demoCompanies {
+		first := rng.IntN(len(dates) / 2)
+		second := first + 1 + rng.IntN(len(dates)/3)
+		bought := int64(10 * (1 + rng.IntN(15)))
+		if err := add(c.symbol, ntxv1.TransactionType_TRANSACTION_TYPE_BUY, bought, first); err != nil {
+			return err
+		}
+		more := int64(10 * (1 + rng.IntN(10)))
+		if err := add(c.symbol, ntxv1.TransactionType_TRANSACTION_TYPE_BUY, more, second); err != nil {
+			return err
+		}
+
+		if i%3 != 0 {
+			continue
+		}
+		sellDay := second + 1 + rng.IntN(len(dates)-second-1)
+		if err := add(c.symbol, ntxv1.TransactionType_TRANSACTION_TYPE_SELL, (bought+more)/2, sellDay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// demoTradingDays returns the last n NEPSE trading days up to and including
+// end, oldest first. NEPSE trades Sunday to Thursday.
+func demoTradingDays(end time.Time, n int) []string {
+	dates := make([]string, n)
+	day := end
+	for i := n - 1; i >= 0; {
+		if day.Weekday() != time.Friday && day.Weekday() != time.Saturday {
+			dates[i] = day.Format("2006-01-02")
+			i--
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return dates
+}
+
+// roundTick rounds a price to NEPSE's 0.1 tick.
+func roundTick(price float64) float64 {
+	return math.Round(price*10) / 10
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// doctorIssue is a broken invariant with a suggestion the user can act on.
+type doctorIssue struct {
+	check   string
+	problem string
+	fix     string
+}
+
+type doctorCheck struct {
+	name string
+	run  func(ctx context.Context, queries *sqlc.Queries) ([]doctorIssue, error)
+}
+
+var doctorChecks = []doctorCheck{
+	{"holdings", checkHoldings},
+	{"symbols", checkOrphanSymbols},
+	{"prices", checkStalePrices},
+	{"money", checkPaisaPrecision},
+}
+
+// runDoctorCmd validates database invariants and exits 1 if any fail, so it
+// can gate deploys and cron scripts.
+func runDoctorCmd() {
+	db, queries := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	var issues []doctorIssue
+	for _, c := range doctorChecks {
+		found, err := c.run(ctx, queries)
+		if err != nil {
+			slog.Error("doctor check failed", "check", c.name, "error", err)
+			os.Exit(2)
+		}
+		issues = append(issues, found...)
+	}
+
+	if len(issues) == 0 {
+		fmt.Println("ok: no problems found")
+		return
+	}
+
+	for _, i := range issues {
+		fmt.Printf("[%s] %s\n    fix: %s\n", i.check, i.problem, i.fix)
+	}
+	fmt.Printf("%d problem(s) found\n", len(issues))
+	os.Exit(1)
+}
+
+// checkHoldings compares the holdings table against holdings recomputed from
+// transactions and flags positions that sold more than was bought.
+func checkHoldings(ctx context.Context, queries *sqlc.Queries) ([]doctorIssue, error) {
+	holdings, err := queries.ListAllHoldings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transactions, err := queries.ListAllTransactionsChronological(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		portfolioID int64
+		symbol      string
+	}
+	stored := make(map[key]sqlc.Holding, len(holdings))
+	for _, h := range holdings {
+		stored[key{h.PortfolioID, h.StockSymbol}] = h
+	}
+	net := make(map[key]int64)
+	for _, tx := range transactions {
+		k := key{tx.PortfolioID, tx.StockSymbol}
+		if tx.TransactionType == "BUY" {
+			net[k] += tx.Quantity
+		} else {
+			net[k] -= tx.Quantity
+		}
+	}
+
+	var issues []doctorIssue
+	for _, t := range portfolio.ComputeHoldings(transactions) {
+		k := key{t.PortfolioID, t.StockSymbol}
+		h, ok := stored[k]
+		delete(stored, k)
+
+		if n := net[k]; n < 0 {
+			issues = append(issues, doctorIssue{
+				check:   "holdings",
+				problem: fmt.Sprintf("portfolio %d sells %d more %s than it bought", t.PortfolioID, -n, t.StockSymbol),
+				fix:     "add the missing BUY or delete the oversold SELL",
+			})
+		}
+
+		if ok && h.Quantity == t.Quantity && math.Abs(h.TotalBuyCost-t.TotalBuyCost) < 0.01 {
+			continue
+		}
+		issues = append(issues, doctorIssue{
+			check:   "holdings",
+			problem: fmt.Sprintf("portfolio %d %s holding is %d, transactions give %d", t.PortfolioID, t.StockSymbol, h.Quantity, t.Quantity),
+			fix:     "run `ntx rebuild-holdings`",
+		})
+	}
+
+	for _, h := range stored {
+		issues = append(issues, doctorIssue{
+			check:   "holdings",
+			problem: fmt.Sprintf("portfolio %d %s holding has no transactions", h.PortfolioID, h.StockSymbol),
+			fix:     "run `ntx rebuild-holdings`",
+		})
+	}
+
+	return issues, nil
+}
+
+// checkOrphanSymbols finds transactions for symbols missing from companies,
+// which get no prices, sectors or fundamentals.
+func checkOrphanSymbols(ctx context.Context, queries *sqlc.Queries) ([]doctorIssue, error) {
+	symbols, err := queries.ListOrphanTransactionSymbols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]doctorIssue, len(symbols))
+	for i, s := range symbols {
+		issues[i] = doctorIssue{
+			check:   "symbols",
+			problem: fmt.Sprintf("%s has transactions but is not a known company", s),
+			fix:     "run `ntx backfill -companies`, or correct the symbol if it was mistyped",
+		}
+	}
+	return issues, nil
+}
+
+// checkStalePrices finds held symbols whose price history stops before the
+// latest trading day in the database.
+func checkStalePrices(ctx context.Context, queries *sqlc.Queries) ([]doctorIssue, error) {
+	rows, err := queries.ListStaleHeldPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]doctorIssue, len(rows))
+	for i, r := range rows {
+		issues[i] = doctorIssue{
+			check:   "prices",
+			problem: fmt.Sprintf("%s has no price after %s", r.Symbol, r.LastPriceDate),
+			fix:     "run `ntx backfill -prices`; if it persists the stock may be suspended",
+		}
+	}
+	return issues, nil
+}
+
+// checkPaisaPrecision finds prices that can't be expressed in whole paisa,
+// which usually means a typo or a float import artefact.
+func checkPaisaPrecision(ctx context.Context, queries *sqlc.Queries) ([]doctorIssue, error) {
+	txs, err := queries.ListOffPaisaTransactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]doctorIssue, len(txs))
+	for i, tx := range txs {
+		issues[i] = doctorIssue{
+			check:   "money",
+			problem: fmt.Sprintf("transaction %d (%s) has unit price %v, finer than one paisa", tx.ID, tx.StockSymbol, tx.UnitPrice),
+			fix:     "re-enter the transaction with at most two decimal places",
+		}
+	}
+	return issues, nil
+}
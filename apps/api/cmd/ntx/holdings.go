@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// runRebuildHoldingsCmd recomputes the holdings table from transactions and
+// exits non-zero if the incremental updates had drifted.
+func runRebuildHoldingsCmd() {
+	db, _ := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	drift, err := portfolio.RebuildHoldings(ctx, db)
+	if err != nil {
+		slog.Error("rebuild holdings failed", "error", err)
+		os.Exit(1)
+	}
+
+	for _, d := range drift {
+		slog.Warn("holding drift corrected",
+			"portfolio_id", d.PortfolioID,
+			"symbol", d.Symbol,
+			"stored_quantity", d.Stored,
+			"computed_quantity", d.Computed,
+		)
+	}
+
+	slog.Info("holdings rebuilt", "drifted", len(drift))
+	if len(drift) > 0 {
+		os.Exit(1)
+	}
+}
@@ -7,18 +7,33 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/voidarchive/ntx/internal/alert"
 	"github.com/voidarchive/ntx/internal/database"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/digest"
+	"github.com/voidarchive/ntx/internal/flags"
+	"github.com/voidarchive/ntx/internal/logging"
+	"github.com/voidarchive/ntx/internal/meroshare"
 	"github.com/voidarchive/ntx/internal/nepse"
+	"github.com/voidarchive/ntx/internal/notify"
+	"github.com/voidarchive/ntx/internal/order"
+	"github.com/voidarchive/ntx/internal/portfolio"
 	"github.com/voidarchive/ntx/internal/server"
 	"github.com/voidarchive/ntx/internal/worker"
 )
 
 func main() {
-	// Configure slog to write to stdout (Railway treats stderr as errors)
-	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	if err := logging.Setup(); err != nil {
+		fmt.Fprintf(os.Stderr, "logging: %v\n", err)
+		os.Exit(1)
+	}
+	if err := flags.Load(); err != nil {
+		slog.Error("invalid NTX_FEATURES", "error", err)
+		os.Exit(1)
+	}
 
 	if len(os.Args) > 1 {
 		switch os.Args[1] {
@@ -26,17 +41,38 @@ func main() {
 			runBackfillCmd()
 			return
 		case "serve":
-			runServer()
+			runServer(os.Args[2:])
+			return
+		case "rebuild-holdings":
+			runRebuildHoldingsCmd()
+			return
+		case "doctor":
+			runDoctorCmd()
+			return
+		case "market":
+			runMarketCmd(os.Args[2:])
+			return
+		case "backtest":
+			runBacktestCmd(os.Args[2:])
+			return
+		case "snapshot":
+			runSnapshotCmd(os.Args[2:])
+			return
+		case "secret":
+			runSecretCmd(os.Args[2:])
+			return
+		case "merge":
+			runMergeCmd(os.Args[2:])
 			return
 		default:
 			fmt.Fprintf(os.Stderr, "unknown command: %s\n", os.Args[1])
-			fmt.Fprintln(os.Stderr, "usage: ntx [backfill|serve]")
+			fmt.Fprintln(os.Stderr, "usage: ntx [backfill|serve [--demo]|rebuild-holdings|doctor|market export|backtest|snapshot|secret|merge]")
 			os.Exit(1)
 		}
 	}
 
 	// Default: run server
-	runServer()
+	runServer(nil)
 }
 
 type backfillOptions struct {
@@ -45,6 +81,8 @@ type backfillOptions struct {
 	prices           bool
 	ownership        bool
 	corporateActions bool
+	symbols          []string // empty for every company
+	from, to         string   // price history range, YYYY-MM-DD
 }
 
 func runBackfillCmd() {
@@ -55,8 +93,27 @@ func runBackfillCmd() {
 	fs.BoolVar(&opts.prices, "prices", false, "sync price history")
 	fs.BoolVar(&opts.ownership, "ownership", false, "sync ownership data")
 	fs.BoolVar(&opts.corporateActions, "corporate-actions", false, "sync corporate actions")
+	symbols := fs.String("symbols", "", "comma separated symbols to sync instead of every company")
+	fs.StringVar(&opts.from, "from", "", "first price history date (YYYY-MM-DD, default a year ago)")
+	fs.StringVar(&opts.to, "to", "", "last price history date (YYYY-MM-DD, default today)")
 	_ = fs.Parse(os.Args[2:])
 
+	for s := range strings.SplitSeq(*symbols, ",") {
+		if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+			opts.symbols = append(opts.symbols, s)
+		}
+	}
+	for _, d := range []string{opts.from, opts.to} {
+		if _, err := time.Parse("2006-01-02", d); d != "" && err != nil {
+			fmt.Fprintf(os.Stderr, "invalid date %q, want YYYY-MM-DD\n", d)
+			os.Exit(1)
+		}
+	}
+	if opts.from != "" && opts.to != "" && opts.to < opts.from {
+		fmt.Fprintln(os.Stderr, "--to is before --from")
+		os.Exit(1)
+	}
+
 	// If no flags specified, sync everything
 	if !opts.companies && !opts.fundamentals && !opts.prices && !opts.ownership && !opts.corporateActions {
 		opts.companies = true
@@ -79,28 +136,111 @@ func runBackfillCmd() {
 	}
 }
 
-func runServer() {
-	db, queries, client := setup()
+func runServer(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	demo := fs.Bool("demo", false, "serve generated sample data from an in-memory database")
+	_ = fs.Parse(args)
+
+	open := openDatabase
+	if *demo {
+		open = openDemoDatabase
+	}
+	db, queries := open()
 	defer db.Close()
 
-	w := worker.New(client, queries)
+	var enabled []string
+	for _, f := range flags.All() {
+		if f.Enabled() {
+			enabled = append(enabled, f.Name)
+		}
+	}
+	slog.Info("features", "enabled", enabled)
+
+	w := worker.New(newNEPSEClient(), queries)
+	// Demo data is fixed; syncing live prices or sending reminders would mix
+	// real market data into it.
+	// One service for the API and the scheduler, so their holding refreshes
+	// share the same per-symbol locks
+	portfolios := portfolio.NewPortfolioService(db, queries)
+	if !*demo {
+		startScheduler(w, db, queries, portfolios)
+	}
+
+	srv := server.NewServer(db, queries, w, portfolios)
+	if err := srv.Start(); err != nil {
+		slog.Error("server error", "error", err)
+		os.Exit(1)
+	}
+}
+
+// startScheduler runs the market syncs and the after-close jobs in the background.
+func startScheduler(w *worker.Worker, db *sql.DB, queries *sqlc.Queries, portfolios *portfolio.PortfolioService) {
 	sched, err := worker.NewScheduler(w)
 	if err != nil {
 		slog.Error("scheduler init failed", "error", err)
 		os.Exit(1)
 	}
+	// Imports run first so alerts and the digest see the synced holdings
+	addMeroShareSync(sched, queries, portfolios)
+	sched.AfterClose("alert evaluation", alert.NewEvaluator(queries, portfolios).Run)
+	notifier := notify.FromEnv()
+	sched.AfterClose("order reminders", order.NewReminder(queries, notifier).Run)
+	reports := digest.New(queries, portfolios, notifier)
+	sched.AfterClose("daily digest", reports.Run)
+	if flags.WeeklyRecap.Enabled() {
+		sched.Weekly("weekly recap", reports.RunWeekly)
+	}
+	sched.Weekly("database maintenance", func(ctx context.Context) error {
+		if err := worker.Prune(ctx, queries, time.Now()); err != nil {
+			return err
+		}
+		return database.Compact(ctx, db)
+	})
 	go func() {
 		_ = sched.Start(context.Background())
 	}()
+}
 
-	srv := server.NewServer(queries)
-	if err := srv.Start(); err != nil {
-		slog.Error("server error", "error", err)
-		os.Exit(1)
+// addMeroShareSync schedules the Mero Share statement sync when an account
+// is configured.
+func addMeroShareSync(sched *worker.Scheduler, queries *sqlc.Queries, portfolios *portfolio.PortfolioService) {
+	cfg, ok, err := meroshare.ConfigFromEnv()
+	if !ok {
+		return
+	}
+	if err != nil {
+		slog.Error("meroshare sync disabled", "error", err)
+		return
 	}
+	p, err := snapshotPortfolio(context.Background(), queries, cfg.PortfolioID)
+	if err != nil {
+		slog.Error("meroshare sync disabled, set MEROSHARE_PORTFOLIO_ID", "error", err)
+		return
+	}
+	if p.Paper {
+		slog.Error("meroshare sync disabled", "error", "paper portfolios don't accept imports")
+		return
+	}
+	cfg.PortfolioID = p.ID
+	sched.AfterClose("meroshare sync", meroshare.NewSyncer(cfg, queries, portfolios).Run)
 }
 
 func setup() (*sql.DB, *sqlc.Queries, *nepse.Client) {
+	db, queries := openDatabase()
+	return db, queries, newNEPSEClient()
+}
+
+func newNEPSEClient() *nepse.Client {
+	nepseClient, err := nepse.NewClient()
+	if err != nil {
+		slog.Error("nepse client", "error", err)
+		os.Exit(1)
+	}
+	return nepseClient
+}
+
+// openDatabase opens and migrates the database for commands that don't talk to NEPSE.
+func openDatabase() (*sql.DB, *sqlc.Queries) {
 	dbPath := database.DefaultPath()
 	db, err := database.OpenDB(dbPath)
 	if err != nil {
@@ -117,13 +257,5 @@ func setup() (*sql.DB, *sqlc.Queries, *nepse.Client) {
 
 	slog.Info("database initialized")
 
-	queries := sqlc.New(db)
-
-	nepseClient, err := nepse.NewClient()
-	if err != nil {
-		slog.Error("nepse client", "error", err)
-		os.Exit(1)
-	}
-
-	return db, queries, nepseClient
+	return db, sqlc.New(db)
 }
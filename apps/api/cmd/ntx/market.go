@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/indicator"
+)
+
+// maxHistoryDays bounds a price history lookup; NEPSE has far fewer trading days.
+const maxHistoryDays = 100000
+
+var exportColumns = []string{
+	"symbol", "date", "open", "high", "low", "close", "volume", "turnover",
+	"sma_20", "sma_50", "rsi_14",
+}
+
+func runMarketCmd(args []string) {
+	if len(args) == 0 || args[0] != "export" {
+		fmt.Fprintln(os.Stderr,
+			"usage: ntx market export --symbol NABIL[,NICA] [--from YYYY-MM-DD] [--to YYYY-MM-DD] [--out file.csv]")
+		os.Exit(1)
+	}
+
+	fs := flag.NewFlagSet("market export", flag.ExitOnError)
+	symbols := fs.String("symbol", "", "comma separated symbols to export")
+	from := fs.String("from", "", "first date to include (YYYY-MM-DD)")
+	to := fs.String("to", "", "last date to include (YYYY-MM-DD)")
+	out := fs.String("out", "", "write to this file instead of stdout")
+	_ = fs.Parse(args[1:])
+
+	if *symbols == "" {
+		fmt.Fprintln(os.Stderr, "--symbol is required")
+		os.Exit(1)
+	}
+	for _, d := range []string{*from, *to} {
+		if _, err := time.Parse("2006-01-02", d); d != "" && err != nil {
+			fmt.Fprintf(os.Stderr, "invalid date %q, want YYYY-MM-DD\n", d)
+			os.Exit(1)
+		}
+	}
+
+	db, queries := openDatabase()
+	defer db.Close()
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			slog.Error("create output", "error", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	if err := exportMarket(ctx, queries, w, strings.Split(*symbols, ","), *from, *to); err != nil {
+		slog.Error("market export failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// exportMarket writes one tidy CSV row per symbol and trading day. The
+// indicators use the full stored history, so the first rows of a range
+// already carry values.
+func exportMarket(ctx context.Context, queries *sqlc.Queries, w io.Writer, symbols []string, from, to string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportColumns); err != nil {
+		return err
+	}
+
+	for _, symbol := range symbols {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		history, err := loadHistory(ctx, queries, symbol)
+		if err != nil {
+			return err
+		}
+
+		closes := make([]float64, len(history))
+		for i, p := range history {
+			closes[i] = closeOf(p)
+		}
+		sma20 := indicator.SMA(closes, 20)
+		sma50 := indicator.SMA(closes, 50)
+		rsi14 := indicator.RSI(closes, 14)
+
+		for i, p := range history {
+			if (from != "" && p.BusinessDate < from) || (to != "" && p.BusinessDate > to) {
+				continue
+			}
+			err := cw.Write([]string{
+				symbol,
+				p.BusinessDate,
+				formatNull(p.OpenPrice),
+				formatNull(p.HighPrice),
+				formatNull(p.LowPrice),
+				formatFloat(closes[i]),
+				formatCount(p.Volume),
+				formatNull(p.Turnover),
+				formatFloat(sma20[i]),
+				formatFloat(sma50[i]),
+				formatFloat(rsi14[i]),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// loadHistory returns a symbol's stored daily prices, oldest first.
+func loadHistory(ctx context.Context, queries *sqlc.Queries, symbol string) ([]sqlc.Price, error) {
+	company, err := queries.GetCompany(ctx, symbol)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("unknown symbol %s", symbol)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := queries.ListPricesByCompany(ctx, sqlc.ListPricesByCompanyParams{
+		CompanyID: company.ID,
+		Limit:     maxHistoryDays,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("price history %s: %w", symbol, err)
+	}
+	slices.Reverse(history)
+	return history, nil
+}
+
+// closeOf falls back to the last traded price for days synced live.
+func closeOf(p sqlc.Price) float64 {
+	if p.ClosePrice.Valid {
+		return p.ClosePrice.Float64
+	}
+	return p.LastTradedPrice.Float64
+}
+
+// formatFloat rounds to 4 decimals, enough for paisa prices and indicators
+// without float noise.
+func formatFloat(f float64) string {
+	if math.IsNaN(f) {
+		return ""
+	}
+	return strconv.FormatFloat(math.Round(f*1e4)/1e4, 'f', -1, 64)
+}
+
+func formatNull(f sql.NullFloat64) string {
+	if !f.Valid {
+		return ""
+	}
+	return formatFloat(f.Float64)
+}
+
+func formatCount(n sql.NullInt64) string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.FormatInt(n.Int64, 10)
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+const mergeUsage = "usage: ntx merge --out merged.db [--portfolio ID] [--from ID] [--resolve ask|a|b|both] other.db"
+
+// mergeKind says how a transaction from the other database relates to ours.
+type mergeKind int
+
+const (
+	mergeNew      mergeKind = iota // only in the other database
+	mergeSame                      // already here at the same price
+	mergeConflict                  // here with a different price
+)
+
+// Resolutions for a conflict: keep this database's entry, take the other
+// database's, or keep both.
+const (
+	resolveA    = "a"
+	resolveB    = "b"
+	resolveBoth = "both"
+)
+
+// mergeItem is one transaction from the other database and, when it matched,
+// the one here it matched.
+type mergeItem struct {
+	kind       mergeKind
+	theirs     sqlc.Transaction
+	ours       sqlc.Transaction
+	resolution string
+}
+
+func runMergeCmd(args []string) {
+	fs := flag.NewFlagSet("merge", flag.ExitOnError)
+	out := fs.String("out", "", "write the merged database here; required, this database is left as is")
+	portfolioID := fs.Int64("portfolio", 0, "portfolio to merge into, required when there is more than one")
+	fromID := fs.Int64("from", 0, "portfolio in the other database, required when it has more than one")
+	resolve := fs.String("resolve", "ask", "how to settle conflicts: ask, a (keep this database), b (take other.db) or both")
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 || *out == "" {
+		fmt.Fprintln(os.Stderr, mergeUsage)
+		os.Exit(1)
+	}
+	if !slices.Contains([]string{"ask", resolveA, resolveB, resolveBoth}, *resolve) {
+		fmt.Fprintf(os.Stderr, "--resolve must be ask, a, b or both, got %q\n", *resolve)
+		os.Exit(1)
+	}
+	if _, err := os.Stat(*out); err == nil {
+		fmt.Fprintf(os.Stderr, "%s already exists\n", *out)
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	if err := runMerge(ctx, fs.Arg(0), *out, *portfolioID, *fromID, *resolve); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		_ = os.Remove(*out)
+		os.Exit(1)
+	}
+}
+
+// runMerge copies this database to out and merges the other database's
+// transactions into the copy, recording every decision as a journal note.
+func runMerge(ctx context.Context, otherPath, out string, portfolioID, fromID int64, resolve string) error {
+	db, _ := openDatabase()
+	defer db.Close()
+	if err := database.CopyTo(ctx, db, out); err != nil {
+		return err
+	}
+
+	merged, err := database.OpenDB(out)
+	if err != nil {
+		return err
+	}
+	defer merged.Close()
+	queries := sqlc.New(merged)
+
+	if _, err := os.Stat(otherPath); err != nil {
+		return err
+	}
+	other, err := database.OpenDB(otherPath)
+	if err != nil {
+		return err
+	}
+	defer other.Close()
+	otherQueries := sqlc.New(other)
+
+	target, err := snapshotPortfolio(ctx, queries, portfolioID)
+	if err != nil {
+		return fmt.Errorf("this database: %w", err)
+	}
+	if target.Paper {
+		return errors.New("paper portfolios don't accept imports")
+	}
+	source, err := snapshotPortfolio(ctx, otherQueries, fromID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", otherPath, err)
+	}
+
+	ours, err := queries.ListTransactionsChronological(ctx, target.ID)
+	if err != nil {
+		return err
+	}
+	theirs, err := otherQueries.ListTransactionsChronological(ctx, source.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w (run ntx against it once to migrate it)", otherPath, err)
+	}
+
+	items := planMerge(ours, theirs)
+	in := bufio.NewReader(os.Stdin)
+	for i := range items {
+		if items[i].kind != mergeConflict {
+			continue
+		}
+		items[i].resolution = resolve
+		if resolve == "ask" {
+			items[i].resolution, err = askResolution(os.Stdout, in, items[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// The handlers check ownership against the signed-in user
+	ctx = context.WithValue(ctx, portfolio.UserIDKey, target.UserID)
+	portfolios := portfolio.NewPortfolioService(merged, queries)
+	for _, item := range items {
+		if item.resolution != resolveB {
+			continue
+		}
+		req := &ntxv1.DeleteTransactionRequest{TransactionId: item.ours.ID}
+		if _, err := portfolios.DeleteTransaction(ctx, connect.NewRequest(req)); err != nil {
+			return fmt.Errorf("replace transaction %d: %w", item.ours.ID, err)
+		}
+	}
+	if _, err := portfolios.Import(ctx, target.ID, mergeFile(items), ntxv1.ConflictStrategy_CONFLICT_STRATEGY_KEEP_BOTH); err != nil {
+		return fmt.Errorf("add transactions: %w", err)
+	}
+
+	title := "Merged " + filepath.Base(otherPath)
+	body := mergeReport(source.Name, items)
+	_, err = queries.CreateNote(ctx, sqlc.CreateNoteParams{
+		UserID:   target.UserID,
+		NoteDate: time.Now().Format("2006-01-02"),
+		Title:    title,
+		Body:     body,
+	})
+	if err != nil {
+		return fmt.Errorf("record merge note: %w", err)
+	}
+
+	fmt.Printf("%s into %q, written to %s\n\n%s", title, target.Name, out, body)
+	return nil
+}
+
+// planMerge pairs each of their transactions with one of ours for the same
+// symbol, type, date and quantity. Each of ours pairs at most once, so two
+// identical fills on one side and one on the other leave one new.
+func planMerge(ours, theirs []sqlc.Transaction) []mergeItem {
+	type key struct {
+		symbol, txType, date string
+		quantity             int64
+	}
+	keyOf := func(tx sqlc.Transaction) key {
+		return key{tx.StockSymbol, tx.TransactionType, tx.TransactionDate.Format("2006-01-02"), tx.Quantity}
+	}
+	byKey := make(map[key][]sqlc.Transaction)
+	for _, tx := range ours {
+		byKey[keyOf(tx)] = append(byKey[keyOf(tx)], tx)
+	}
+
+	items := make([]mergeItem, 0, len(theirs))
+	for _, tx := range theirs {
+		k := keyOf(tx)
+		matches := byKey[k]
+		if len(matches) == 0 {
+			items = append(items, mergeItem{kind: mergeNew, theirs: tx})
+			continue
+		}
+		// Prefer an exact price match so a duplicate fill isn't reported as a conflict
+		i := 0
+		for j, m := range matches {
+			if samePrice(m.UnitPrice, tx.UnitPrice) {
+				i = j
+				break
+			}
+		}
+		item := mergeItem{kind: mergeConflict, theirs: tx, ours: matches[i]}
+		if samePrice(matches[i].UnitPrice, tx.UnitPrice) {
+			item.kind = mergeSame
+		}
+		byKey[k] = append(matches[:i:i], matches[i+1:]...)
+		items = append(items, item)
+	}
+	return items
+}
+
+func samePrice(a, b float64) bool {
+	return math.Abs(a-b) < 0.005
+}
+
+// askResolution shows a conflict and reads which side to keep.
+func askResolution(w io.Writer, r *bufio.Reader, item mergeItem) (string, error) {
+	fmt.Fprintf(w, "%s %s %d on %s: a has Rs.%.2f, b has Rs.%.2f\n",
+		item.theirs.StockSymbol, item.theirs.TransactionType, item.theirs.Quantity,
+		item.theirs.TransactionDate.Format("2006-01-02"), item.ours.UnitPrice, item.theirs.UnitPrice)
+	for {
+		fmt.Fprint(w, "keep [a] this database, [b] the other or [both]? ")
+		line, err := r.ReadString('\n')
+		answer := strings.ToLower(strings.TrimSpace(line))
+		if answer == resolveA || answer == resolveB || answer == resolveBoth {
+			return answer, nil
+		}
+		if err != nil {
+			return "", errors.New("no answer for a conflict; pass --resolve to settle them without asking")
+		}
+	}
+}
+
+// mergeFile builds the import file of their transactions that go in: new
+// ones, and conflicts settled as b or both.
+func mergeFile(items []mergeItem) []byte {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"symbol", "type", "quantity", "price", "date"})
+	for _, item := range items {
+		if item.kind == mergeSame || item.resolution == resolveA {
+			continue
+		}
+		_ = w.Write([]string{
+			item.theirs.StockSymbol,
+			item.theirs.TransactionType,
+			strconv.FormatInt(item.theirs.Quantity, 10),
+			strconv.FormatFloat(item.theirs.UnitPrice, 'f', -1, 64),
+			item.theirs.TransactionDate.Format("2006-01-02"),
+		})
+	}
+	w.Flush()
+	return buf.Bytes()
+}
+
+// mergeReport is the audit trail saved with the merge: one line for every
+// transaction that changed and a count of the ones already present.
+func mergeReport(source string, items []mergeItem) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From portfolio %q.\n\n", source)
+	same := 0
+	for _, item := range items {
+		tx := item.theirs
+		desc := fmt.Sprintf("%s %s %d on %s", tx.StockSymbol, tx.TransactionType, tx.Quantity, tx.TransactionDate.Format("2006-01-02"))
+		switch {
+		case item.kind == mergeSame:
+			same++
+		case item.kind == mergeNew:
+			fmt.Fprintf(&b, "- Added %s at Rs.%.2f\n", desc, tx.UnitPrice)
+		case item.resolution == resolveA:
+			fmt.Fprintf(&b, "- Kept %s at Rs.%.2f over Rs.%.2f\n", desc, item.ours.UnitPrice, tx.UnitPrice)
+		case item.resolution == resolveB:
+			fmt.Fprintf(&b, "- Replaced %s at Rs.%.2f with Rs.%.2f\n", desc, item.ours.UnitPrice, tx.UnitPrice)
+		case item.resolution == resolveBoth:
+			fmt.Fprintf(&b, "- Kept both %s at Rs.%.2f and Rs.%.2f\n", desc, item.ours.UnitPrice, tx.UnitPrice)
+		}
+	}
+	fmt.Fprintf(&b, "\n%d transactions were already present.\n", same)
+	return b.String()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/voidarchive/ntx/internal/secrets"
+)
+
+const secretUsage = "usage: ntx secret set NAME (value on stdin) | ntx secret get NAME"
+
+// runSecretCmd stores or reads a credential in the OS keyring. The value to
+// set is read from stdin so it stays out of shell history.
+func runSecretCmd(args []string) {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, secretUsage)
+		os.Exit(1)
+	}
+	name := strings.ToUpper(strings.TrimSpace(args[1]))
+
+	switch args[0] {
+	case "set":
+		if fi, err := os.Stdin.Stat(); err == nil && fi.Mode()&os.ModeCharDevice != 0 {
+			fmt.Fprintf(os.Stderr, "%s: ", name)
+		}
+		// A value without a trailing newline ends at EOF, which isn't an error here
+		value, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+		value = strings.TrimRight(value, "\r\n")
+		if value == "" {
+			fmt.Fprintln(os.Stderr, "no value given")
+			os.Exit(1)
+		}
+		if err := secrets.Set(name, value); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	case "get":
+		value, err := secrets.Get(name)
+		if errors.Is(err, secrets.ErrNotFound) {
+			fmt.Fprintf(os.Stderr, "%s is not in the keyring\n", name)
+			os.Exit(1)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Println(value)
+	default:
+		fmt.Fprintln(os.Stderr, secretUsage)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/flags"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+func runSnapshotCmd(args []string) {
+	fs := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	portfolioID := fs.Int64("portfolio", 0, "portfolio ID, required when there is more than one")
+	redact := fs.Bool("redact", flags.PrivateReports.Enabled(), "hide rupee amounts and quantities, showing percentages only")
+	out := fs.String("out", "", "write to this file instead of stdout")
+	_ = fs.Parse(args)
+
+	db, queries := openDatabase()
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	p, err := snapshotPortfolio(ctx, queries, *portfolioID)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	holdings, err := portfolio.NewPortfolioService(db, queries).Holdings(ctx, p.ID)
+	if err != nil {
+		slog.Error("snapshot failed", "error", err)
+		os.Exit(1)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			slog.Error("create output", "error", err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := printSnapshot(w, p.Name, holdings, *redact, time.Now()); err != nil {
+		slog.Error("write snapshot", "error", err)
+		os.Exit(1)
+	}
+}
+
+// snapshotPortfolio finds the portfolio to render. Without an ID it picks the
+// only real portfolio, since the CLI has no signed-in user to narrow by.
+func snapshotPortfolio(ctx context.Context, queries *sqlc.Queries, id int64) (sqlc.Portfolio, error) {
+	users, err := queries.ListUsers(ctx)
+	if err != nil {
+		return sqlc.Portfolio{}, err
+	}
+
+	var candidates []sqlc.Portfolio
+	for _, u := range users {
+		list, err := queries.ListPortfoliosByUser(ctx, u.ID)
+		if err != nil {
+			return sqlc.Portfolio{}, err
+		}
+		for _, p := range list {
+			if p.ID == id {
+				return p, nil
+			}
+			if !p.Paper {
+				candidates = append(candidates, p)
+			}
+		}
+	}
+
+	if id != 0 {
+		return sqlc.Portfolio{}, fmt.Errorf("portfolio %d not found", id)
+	}
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	if len(candidates) == 0 {
+		return sqlc.Portfolio{}, errors.New("no portfolios to snapshot")
+	}
+	msg := "more than one portfolio, pick one with --portfolio:"
+	for _, p := range candidates {
+		msg += fmt.Sprintf("\n  %d  %s", p.ID, p.Name)
+	}
+	return sqlc.Portfolio{}, errors.New(msg)
+}
+
+// printSnapshot renders the dashboard as plain text, largest holding first.
+// Redacted snapshots keep weights and percent changes, which say how the
+// portfolio is doing without saying how big it is.
+func printSnapshot(w io.Writer, name string, holdings []*ntxv1.Holding, redact bool, now time.Time) error {
+	var value, dayChange, invested float64
+	for _, h := range holdings {
+		value += h.TotalValue
+		dayChange += h.DayChangeValue
+		invested += h.TotalValue - h.ProfitLoss
+	}
+	var dayPercent, plPercent float64
+	if previous := value - dayChange; previous > 0 {
+		dayPercent = dayChange / previous * 100
+	}
+	if invested > 0 {
+		plPercent = (value - invested) / invested * 100
+	}
+
+	totals := fmt.Sprintf("Value Rs.%.2f  Today %+.2f (%+.2f%%)  Total P&L %+.2f (%+.2f%%)",
+		value, dayChange, dayPercent, value-invested, plPercent)
+	header := "Symbol\tQty\tLTP\tValue\tWeight\tToday\tP&L\t"
+	if redact {
+		totals = fmt.Sprintf("Today %+.2f%%  Total P&L %+.2f%%", dayPercent, plPercent)
+		header = "Symbol\tWeight\tToday\tP&L\t"
+	}
+	fmt.Fprintf(w, "%s  %s\n%s\n\n", name, now.Format("2006-01-02 15:04"), totals)
+	if len(holdings) == 0 {
+		_, err := fmt.Fprintln(w, "no holdings")
+		return err
+	}
+
+	slices.SortFunc(holdings, func(a, b *ntxv1.Holding) int {
+		return cmp.Or(cmp.Compare(b.TotalValue, a.TotalValue), cmp.Compare(a.StockSymbol, b.StockSymbol))
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, header)
+	for _, h := range holdings {
+		if redact {
+			fmt.Fprintf(tw, "%s\t%.1f%%\t%+.2f%%\t%+.2f%%\t\n",
+				h.StockSymbol, h.WeightPercent, h.DayChangePercent, h.ProfitLossPercent)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.2f\t%.1f%%\t%+.2f%%\t%+.2f%%\t\n",
+			h.StockSymbol, h.Quantity, h.CurrentPrice, h.TotalValue,
+			h.WeightPercent, h.DayChangePercent, h.ProfitLossPercent)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ntx/v1/alert.proto
+
+package ntxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AlertType int32
+
+const (
+	AlertType_ALERT_TYPE_UNSPECIFIED AlertType = 0
+	AlertType_ALERT_TYPE_PRICE_ABOVE AlertType = 1 // stock_symbol, threshold in Rs.
+	AlertType_ALERT_TYPE_PRICE_BELOW AlertType = 2 // stock_symbol, threshold in Rs.
+	AlertType_ALERT_TYPE_DAY_LOSS    AlertType = 3 // portfolio_id, threshold in Rs.
+	AlertType_ALERT_TYPE_DRAWDOWN    AlertType = 4 // portfolio_id, threshold in % from peak
+	// portfolio_id, threshold in percentage points behind the NEPSE index
+	// over window_days
+	AlertType_ALERT_TYPE_INDEX_UNDERPERFORMANCE AlertType = 5
+	// stock_symbol, threshold as a multiple of the average volume over the
+	// previous window_days trading days
+	AlertType_ALERT_TYPE_VOLUME_SPIKE   AlertType = 6
+	AlertType_ALERT_TYPE_TURNOVER_ABOVE AlertType = 7 // stock_symbol, threshold in Rs.
+	// portfolio_id and stock_symbol, threshold in % relative to the weighted
+	// average cost of the holding
+	AlertType_ALERT_TYPE_ABOVE_WAC AlertType = 8
+	AlertType_ALERT_TYPE_BELOW_WAC AlertType = 9
+	// stock_symbol, threshold in % below the highest close since creation
+	AlertType_ALERT_TYPE_TRAILING_STOP AlertType = 10
+)
+
+// Enum value maps for AlertType.
+var (
+	AlertType_name = map[int32]string{
+		0:  "ALERT_TYPE_UNSPECIFIED",
+		1:  "ALERT_TYPE_PRICE_ABOVE",
+		2:  "ALERT_TYPE_PRICE_BELOW",
+		3:  "ALERT_TYPE_DAY_LOSS",
+		4:  "ALERT_TYPE_DRAWDOWN",
+		5:  "ALERT_TYPE_INDEX_UNDERPERFORMANCE",
+		6:  "ALERT_TYPE_VOLUME_SPIKE",
+		7:  "ALERT_TYPE_TURNOVER_ABOVE",
+		8:  "ALERT_TYPE_ABOVE_WAC",
+		9:  "ALERT_TYPE_BELOW_WAC",
+		10: "ALERT_TYPE_TRAILING_STOP",
+	}
+	AlertType_value = map[string]int32{
+		"ALERT_TYPE_UNSPECIFIED":            0,
+		"ALERT_TYPE_PRICE_ABOVE":            1,
+		"ALERT_TYPE_PRICE_BELOW":            2,
+		"ALERT_TYPE_DAY_LOSS":               3,
+		"ALERT_TYPE_DRAWDOWN":               4,
+		"ALERT_TYPE_INDEX_UNDERPERFORMANCE": 5,
+		"ALERT_TYPE_VOLUME_SPIKE":           6,
+		"ALERT_TYPE_TURNOVER_ABOVE":         7,
+		"ALERT_TYPE_ABOVE_WAC":              8,
+		"ALERT_TYPE_BELOW_WAC":              9,
+		"ALERT_TYPE_TRAILING_STOP":          10,
+	}
+)
+
+func (x AlertType) Enum() *AlertType {
+	p := new(AlertType)
+	*p = x
+	return p
+}
+
+func (x AlertType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_alert_proto_enumTypes[0].Descriptor()
+}
+
+func (AlertType) Type() protoreflect.EnumType {
+	return &file_ntx_v1_alert_proto_enumTypes[0]
+}
+
+func (x AlertType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertType.Descriptor instead.
+func (AlertType) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{0}
+}
+
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          AlertType              `protobuf:"varint,2,opt,name=type,proto3,enum=ntx.v1.AlertType" json:"type,omitempty"`
+	PortfolioId   *int64                 `protobuf:"varint,3,opt,name=portfolio_id,json=portfolioId,proto3,oneof" json:"portfolio_id,omitempty"`
+	StockSymbol   *string                `protobuf:"bytes,4,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowDays    int32                  `protobuf:"varint,6,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	TriggeredAt   string                 `protobuf:"bytes,8,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"` // empty if never triggered
+	LastMessage   string                 `protobuf:"bytes,9,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PeakPrice     *float64               `protobuf:"fixed64,11,opt,name=peak_price,json=peakPrice,proto3,oneof" json:"peak_price,omitempty"` // highest close seen, for trailing stops
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Alert) GetType() AlertType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertType_ALERT_TYPE_UNSPECIFIED
+}
+
+func (x *Alert) GetPortfolioId() int64 {
+	if x != nil && x.PortfolioId != nil {
+		return *x.PortfolioId
+	}
+	return 0
+}
+
+func (x *Alert) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *Alert) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *Alert) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *Alert) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Alert) GetTriggeredAt() string {
+	if x != nil {
+		return x.TriggeredAt
+	}
+	return ""
+}
+
+func (x *Alert) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *Alert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Alert) GetPeakPrice() float64 {
+	if x != nil && x.PeakPrice != nil {
+		return *x.PeakPrice
+	}
+	return 0
+}
+
+type CreateAlertRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Type        AlertType              `protobuf:"varint,1,opt,name=type,proto3,enum=ntx.v1.AlertType" json:"type,omitempty"`
+	PortfolioId *int64                 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3,oneof" json:"portfolio_id,omitempty"`
+	StockSymbol *string                `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	Threshold   float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// defaults to 30 for index underperformance and 20 for volume spikes
+	WindowDays    int32 `protobuf:"varint,5,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertRequest) Reset() {
+	*x = CreateAlertRequest{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertRequest) ProtoMessage() {}
+
+func (x *CreateAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreateAlertRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAlertRequest) GetType() AlertType {
+	if x != nil {
+		return x.Type
+	}
+	return AlertType_ALERT_TYPE_UNSPECIFIED
+}
+
+func (x *CreateAlertRequest) GetPortfolioId() int64 {
+	if x != nil && x.PortfolioId != nil {
+		return *x.PortfolioId
+	}
+	return 0
+}
+
+func (x *CreateAlertRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CreateAlertRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *CreateAlertRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+type CreateAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAlertResponse) Reset() {
+	*x = CreateAlertResponse{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAlertResponse) ProtoMessage() {}
+
+func (x *CreateAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAlertResponse.ProtoReflect.Descriptor instead.
+func (*CreateAlertResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAlertResponse) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+type ListAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsRequest) Reset() {
+	*x = ListAlertsRequest{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsRequest) ProtoMessage() {}
+
+func (x *ListAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{3}
+}
+
+type ListAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertsResponse) Reset() {
+	*x = ListAlertsResponse{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertsResponse) ProtoMessage() {}
+
+func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAlertsResponse) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type DeleteAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertId       int64                  `protobuf:"varint,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRequest) Reset() {
+	*x = DeleteAlertRequest{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAlertRequest) GetAlertId() int64 {
+	if x != nil {
+		return x.AlertId
+	}
+	return 0
+}
+
+type DeleteAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertResponse) Reset() {
+	*x = DeleteAlertResponse{}
+	mi := &file_ntx_v1_alert_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertResponse) ProtoMessage() {}
+
+func (x *DeleteAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_alert_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_alert_proto_rawDescGZIP(), []int{6}
+}
+
+var File_ntx_v1_alert_proto protoreflect.FileDescriptor
+
+const file_ntx_v1_alert_proto_rawDesc = "" +
+	"\n" +
+	"\x12ntx/v1/alert.proto\x12\x06ntx.v1\"\x9f\x03\n" +
+	"\x05Alert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x11.ntx.v1.AlertTypeR\x04type\x12&\n" +
+	"\fportfolio_id\x18\x03 \x01(\x03H\x00R\vportfolioId\x88\x01\x01\x12&\n" +
+	"\fstock_symbol\x18\x04 \x01(\tH\x01R\vstockSymbol\x88\x01\x01\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\x12\x1f\n" +
+	"\vwindow_days\x18\x06 \x01(\x05R\n" +
+	"windowDays\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\x12!\n" +
+	"\ftriggered_at\x18\b \x01(\tR\vtriggeredAt\x12!\n" +
+	"\flast_message\x18\t \x01(\tR\vlastMessage\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"peak_price\x18\v \x01(\x01H\x02R\tpeakPrice\x88\x01\x01B\x0f\n" +
+	"\r_portfolio_idB\x0f\n" +
+	"\r_stock_symbolB\r\n" +
+	"\v_peak_price\"\xec\x01\n" +
+	"\x12CreateAlertRequest\x12%\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x11.ntx.v1.AlertTypeR\x04type\x12&\n" +
+	"\fportfolio_id\x18\x02 \x01(\x03H\x00R\vportfolioId\x88\x01\x01\x12&\n" +
+	"\fstock_symbol\x18\x03 \x01(\tH\x01R\vstockSymbol\x88\x01\x01\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x1f\n" +
+	"\vwindow_days\x18\x05 \x01(\x05R\n" +
+	"windowDaysB\x0f\n" +
+	"\r_portfolio_idB\x0f\n" +
+	"\r_stock_symbol\":\n" +
+	"\x13CreateAlertResponse\x12#\n" +
+	"\x05alert\x18\x01 \x01(\v2\r.ntx.v1.AlertR\x05alert\"\x13\n" +
+	"\x11ListAlertsRequest\";\n" +
+	"\x12ListAlertsResponse\x12%\n" +
+	"\x06alerts\x18\x01 \x03(\v2\r.ntx.v1.AlertR\x06alerts\"/\n" +
+	"\x12DeleteAlertRequest\x12\x19\n" +
+	"\balert_id\x18\x01 \x01(\x03R\aalertId\"\x15\n" +
+	"\x13DeleteAlertResponse*\xc6\x02\n" +
+	"\tAlertType\x12\x1a\n" +
+	"\x16ALERT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16ALERT_TYPE_PRICE_ABOVE\x10\x01\x12\x1a\n" +
+	"\x16ALERT_TYPE_PRICE_BELOW\x10\x02\x12\x17\n" +
+	"\x13ALERT_TYPE_DAY_LOSS\x10\x03\x12\x17\n" +
+	"\x13ALERT_TYPE_DRAWDOWN\x10\x04\x12%\n" +
+	"!ALERT_TYPE_INDEX_UNDERPERFORMANCE\x10\x05\x12\x1b\n" +
+	"\x17ALERT_TYPE_VOLUME_SPIKE\x10\x06\x12\x1d\n" +
+	"\x19ALERT_TYPE_TURNOVER_ABOVE\x10\a\x12\x18\n" +
+	"\x14ALERT_TYPE_ABOVE_WAC\x10\b\x12\x18\n" +
+	"\x14ALERT_TYPE_BELOW_WAC\x10\t\x12\x1c\n" +
+	"\x18ALERT_TYPE_TRAILING_STOP\x10\n" +
+	"2\xe3\x01\n" +
+	"\fAlertService\x12F\n" +
+	"\vCreateAlert\x12\x1a.ntx.v1.CreateAlertRequest\x1a\x1b.ntx.v1.CreateAlertResponse\x12C\n" +
+	"\n" +
+	"ListAlerts\x12\x19.ntx.v1.ListAlertsRequest\x1a\x1a.ntx.v1.ListAlertsResponse\x12F\n" +
+	"\vDeleteAlert\x12\x1a.ntx.v1.DeleteAlertRequest\x1a\x1b.ntx.v1.DeleteAlertResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+
+var (
+	file_ntx_v1_alert_proto_rawDescOnce sync.Once
+	file_ntx_v1_alert_proto_rawDescData []byte
+)
+
+func file_ntx_v1_alert_proto_rawDescGZIP() []byte {
+	file_ntx_v1_alert_proto_rawDescOnce.Do(func() {
+		file_ntx_v1_alert_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ntx_v1_alert_proto_rawDesc), len(file_ntx_v1_alert_proto_rawDesc)))
+	})
+	return file_ntx_v1_alert_proto_rawDescData
+}
+
+var file_ntx_v1_alert_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ntx_v1_alert_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_ntx_v1_alert_proto_goTypes = []any{
+	(AlertType)(0),              // 0: ntx.v1.AlertType
+	(*Alert)(nil),               // 1: ntx.v1.Alert
+	(*CreateAlertRequest)(nil),  // 2: ntx.v1.CreateAlertRequest
+	(*CreateAlertResponse)(nil), // 3: ntx.v1.CreateAlertResponse
+	(*ListAlertsRequest)(nil),   // 4: ntx.v1.ListAlertsRequest
+	(*ListAlertsResponse)(nil),  // 5: ntx.v1.ListAlertsResponse
+	(*DeleteAlertRequest)(nil),  // 6: ntx.v1.DeleteAlertRequest
+	(*DeleteAlertResponse)(nil), // 7: ntx.v1.DeleteAlertResponse
+}
+var file_ntx_v1_alert_proto_depIdxs = []int32{
+	0, // 0: ntx.v1.Alert.type:type_name -> ntx.v1.AlertType
+	0, // 1: ntx.v1.CreateAlertRequest.type:type_name -> ntx.v1.AlertType
+	1, // 2: ntx.v1.CreateAlertResponse.alert:type_name -> ntx.v1.Alert
+	1, // 3: ntx.v1.ListAlertsResponse.alerts:type_name -> ntx.v1.Alert
+	2, // 4: ntx.v1.AlertService.CreateAlert:input_type -> ntx.v1.CreateAlertRequest
+	4, // 5: ntx.v1.AlertService.ListAlerts:input_type -> ntx.v1.ListAlertsRequest
+	6, // 6: ntx.v1.AlertService.DeleteAlert:input_type -> ntx.v1.DeleteAlertRequest
+	3, // 7: ntx.v1.AlertService.CreateAlert:output_type -> ntx.v1.CreateAlertResponse
+	5, // 8: ntx.v1.AlertService.ListAlerts:output_type -> ntx.v1.ListAlertsResponse
+	7, // 9: ntx.v1.AlertService.DeleteAlert:output_type -> ntx.v1.DeleteAlertResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ntx_v1_alert_proto_init() }
+func file_ntx_v1_alert_proto_init() {
+	if File_ntx_v1_alert_proto != nil {
+		return
+	}
+	file_ntx_v1_alert_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ntx_v1_alert_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_alert_proto_rawDesc), len(file_ntx_v1_alert_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ntx_v1_alert_proto_goTypes,
+		DependencyIndexes: file_ntx_v1_alert_proto_depIdxs,
+		EnumInfos:         file_ntx_v1_alert_proto_enumTypes,
+		MessageInfos:      file_ntx_v1_alert_proto_msgTypes,
+	}.Build()
+	File_ntx_v1_alert_proto = out.File
+	file_ntx_v1_alert_proto_goTypes = nil
+	file_ntx_v1_alert_proto_depIdxs = nil
+}
@@ -0,0 +1,1357 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ntx/v1/application.proto
+
+package ntxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type IssueType int32
+
+const (
+	IssueType_ISSUE_TYPE_UNSPECIFIED IssueType = 0
+	IssueType_ISSUE_TYPE_IPO         IssueType = 1
+	IssueType_ISSUE_TYPE_FPO         IssueType = 2
+	IssueType_ISSUE_TYPE_RIGHT       IssueType = 3
+)
+
+// Enum value maps for IssueType.
+var (
+	IssueType_name = map[int32]string{
+		0: "ISSUE_TYPE_UNSPECIFIED",
+		1: "ISSUE_TYPE_IPO",
+		2: "ISSUE_TYPE_FPO",
+		3: "ISSUE_TYPE_RIGHT",
+	}
+	IssueType_value = map[string]int32{
+		"ISSUE_TYPE_UNSPECIFIED": 0,
+		"ISSUE_TYPE_IPO":         1,
+		"ISSUE_TYPE_FPO":         2,
+		"ISSUE_TYPE_RIGHT":       3,
+	}
+)
+
+func (x IssueType) Enum() *IssueType {
+	p := new(IssueType)
+	*p = x
+	return p
+}
+
+func (x IssueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_application_proto_enumTypes[0].Descriptor()
+}
+
+func (IssueType) Type() protoreflect.EnumType {
+	return &file_ntx_v1_application_proto_enumTypes[0]
+}
+
+func (x IssueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueType.Descriptor instead.
+func (IssueType) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{0}
+}
+
+type ApplicationStatus int32
+
+const (
+	ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED  ApplicationStatus = 0
+	ApplicationStatus_APPLICATION_STATUS_PENDING      ApplicationStatus = 1 // amount still blocked
+	ApplicationStatus_APPLICATION_STATUS_ALLOTTED     ApplicationStatus = 2
+	ApplicationStatus_APPLICATION_STATUS_NOT_ALLOTTED ApplicationStatus = 3
+	ApplicationStatus_APPLICATION_STATUS_WITHDRAWN    ApplicationStatus = 4
+)
+
+// Enum value maps for ApplicationStatus.
+var (
+	ApplicationStatus_name = map[int32]string{
+		0: "APPLICATION_STATUS_UNSPECIFIED",
+		1: "APPLICATION_STATUS_PENDING",
+		2: "APPLICATION_STATUS_ALLOTTED",
+		3: "APPLICATION_STATUS_NOT_ALLOTTED",
+		4: "APPLICATION_STATUS_WITHDRAWN",
+	}
+	ApplicationStatus_value = map[string]int32{
+		"APPLICATION_STATUS_UNSPECIFIED":  0,
+		"APPLICATION_STATUS_PENDING":      1,
+		"APPLICATION_STATUS_ALLOTTED":     2,
+		"APPLICATION_STATUS_NOT_ALLOTTED": 3,
+		"APPLICATION_STATUS_WITHDRAWN":    4,
+	}
+)
+
+func (x ApplicationStatus) Enum() *ApplicationStatus {
+	p := new(ApplicationStatus)
+	*p = x
+	return p
+}
+
+func (x ApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_application_proto_enumTypes[1].Descriptor()
+}
+
+func (ApplicationStatus) Type() protoreflect.EnumType {
+	return &file_ntx_v1_application_proto_enumTypes[1]
+}
+
+func (x ApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApplicationStatus.Descriptor instead.
+func (ApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{1}
+}
+
+type ShareApplication struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PortfolioId         int64                  `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol         string                 `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	IssueType           IssueType              `protobuf:"varint,4,opt,name=issue_type,json=issueType,proto3,enum=ntx.v1.IssueType" json:"issue_type,omitempty"`
+	Units               int64                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	PricePerUnit        float64                `protobuf:"fixed64,6,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"`
+	AmountBlocked       float64                `protobuf:"fixed64,7,opt,name=amount_blocked,json=amountBlocked,proto3" json:"amount_blocked,omitempty"`                   // units times price while pending, else 0
+	AppliedOn           string                 `protobuf:"bytes,8,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`                                 // YYYY-MM-DD
+	ExpectedAllotmentOn string                 `protobuf:"bytes,9,opt,name=expected_allotment_on,json=expectedAllotmentOn,proto3" json:"expected_allotment_on,omitempty"` // YYYY-MM-DD, empty if not announced
+	Status              ApplicationStatus      `protobuf:"varint,10,opt,name=status,proto3,enum=ntx.v1.ApplicationStatus" json:"status,omitempty"`
+	AllottedUnits       int64                  `protobuf:"varint,11,opt,name=allotted_units,json=allottedUnits,proto3" json:"allotted_units,omitempty"`
+	AmountReleased      float64                `protobuf:"fixed64,12,opt,name=amount_released,json=amountReleased,proto3" json:"amount_released,omitempty"`   // unblocked after allotment or withdrawal
+	TransactionId       *int64                 `protobuf:"varint,13,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"` // the booked allotment
+	Note                string                 `protobuf:"bytes,14,opt,name=note,proto3" json:"note,omitempty"`
+	ClosedAt            string                 `protobuf:"bytes,15,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ShareApplication) Reset() {
+	*x = ShareApplication{}
+	mi := &file_ntx_v1_application_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareApplication) ProtoMessage() {}
+
+func (x *ShareApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareApplication.ProtoReflect.Descriptor instead.
+func (*ShareApplication) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShareApplication) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *ShareApplication) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *ShareApplication) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetPricePerUnit() float64 {
+	if x != nil {
+		return x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetAmountBlocked() float64 {
+	if x != nil {
+		return x.AmountBlocked
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetAppliedOn() string {
+	if x != nil {
+		return x.AppliedOn
+	}
+	return ""
+}
+
+func (x *ShareApplication) GetExpectedAllotmentOn() string {
+	if x != nil {
+		return x.ExpectedAllotmentOn
+	}
+	return ""
+}
+
+func (x *ShareApplication) GetStatus() ApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *ShareApplication) GetAllottedUnits() int64 {
+	if x != nil {
+		return x.AllottedUnits
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetAmountReleased() float64 {
+	if x != nil {
+		return x.AmountReleased
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *ShareApplication) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShareApplication) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *ShareApplication) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateApplicationRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId         int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol         string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	IssueType           IssueType              `protobuf:"varint,3,opt,name=issue_type,json=issueType,proto3,enum=ntx.v1.IssueType" json:"issue_type,omitempty"`
+	Units               int64                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	PricePerUnit        float64                `protobuf:"fixed64,5,opt,name=price_per_unit,json=pricePerUnit,proto3" json:"price_per_unit,omitempty"` // defaults to the Rs.100 face value
+	AppliedOn           string                 `protobuf:"bytes,6,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`              // YYYY-MM-DD, defaults to today
+	ExpectedAllotmentOn string                 `protobuf:"bytes,7,opt,name=expected_allotment_on,json=expectedAllotmentOn,proto3" json:"expected_allotment_on,omitempty"`
+	Note                string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateApplicationRequest) Reset() {
+	*x = CreateApplicationRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationRequest) ProtoMessage() {}
+
+func (x *CreateApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateApplicationRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *CreateApplicationRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateApplicationRequest) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *CreateApplicationRequest) GetPricePerUnit() float64 {
+	if x != nil {
+		return x.PricePerUnit
+	}
+	return 0
+}
+
+func (x *CreateApplicationRequest) GetAppliedOn() string {
+	if x != nil {
+		return x.AppliedOn
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetExpectedAllotmentOn() string {
+	if x != nil {
+		return x.ExpectedAllotmentOn
+	}
+	return ""
+}
+
+func (x *CreateApplicationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *ShareApplication      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApplicationResponse) Reset() {
+	*x = CreateApplicationResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApplicationResponse) ProtoMessage() {}
+
+func (x *CreateApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateApplicationResponse) GetApplication() *ShareApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+type ListApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ApplicationStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=ntx.v1.ApplicationStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsRequest) Reset() {
+	*x = ListApplicationsRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsRequest) ProtoMessage() {}
+
+func (x *ListApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListApplicationsRequest) GetStatus() ApplicationStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+type ListApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applications  []*ShareApplication    `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	TotalBlocked  float64                `protobuf:"fixed64,2,opt,name=total_blocked,json=totalBlocked,proto3" json:"total_blocked,omitempty"` // across every pending application
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApplicationsResponse) Reset() {
+	*x = ListApplicationsResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApplicationsResponse) ProtoMessage() {}
+
+func (x *ListApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListApplicationsResponse) GetApplications() []*ShareApplication {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListApplicationsResponse) GetTotalBlocked() float64 {
+	if x != nil {
+		return x.TotalBlocked
+	}
+	return 0
+}
+
+type WithdrawApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawApplicationRequest) Reset() {
+	*x = WithdrawApplicationRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawApplicationRequest) ProtoMessage() {}
+
+func (x *WithdrawApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawApplicationRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WithdrawApplicationRequest) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+type WithdrawApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *ShareApplication      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawApplicationResponse) Reset() {
+	*x = WithdrawApplicationResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawApplicationResponse) ProtoMessage() {}
+
+func (x *WithdrawApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawApplicationResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WithdrawApplicationResponse) GetApplication() *ShareApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// AllotApplicationRequest records the allotment result. Allotted units are
+// booked as a buy at the issue price; zero closes the application as not
+// allotted.
+type AllotApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId int64                  `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	AllottedUnits int64                  `protobuf:"varint,2,opt,name=allotted_units,json=allottedUnits,proto3" json:"allotted_units,omitempty"`
+	AllottedOn    string                 `protobuf:"bytes,3,opt,name=allotted_on,json=allottedOn,proto3" json:"allotted_on,omitempty"` // YYYY-MM-DD, defaults to today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllotApplicationRequest) Reset() {
+	*x = AllotApplicationRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllotApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllotApplicationRequest) ProtoMessage() {}
+
+func (x *AllotApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllotApplicationRequest.ProtoReflect.Descriptor instead.
+func (*AllotApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AllotApplicationRequest) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *AllotApplicationRequest) GetAllottedUnits() int64 {
+	if x != nil {
+		return x.AllottedUnits
+	}
+	return 0
+}
+
+func (x *AllotApplicationRequest) GetAllottedOn() string {
+	if x != nil {
+		return x.AllottedOn
+	}
+	return ""
+}
+
+type AllotApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Application   *ShareApplication      `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"` // unset when nothing was allotted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllotApplicationResponse) Reset() {
+	*x = AllotApplicationResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllotApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllotApplicationResponse) ProtoMessage() {}
+
+func (x *AllotApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllotApplicationResponse.ProtoReflect.Descriptor instead.
+func (*AllotApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AllotApplicationResponse) GetApplication() *ShareApplication {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+func (x *AllotApplicationResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// RightRenunciation is rights entitlement given up instead of subscribed.
+// Unsubscribed rights are auctioned and the premium paid to the holder;
+// renounced units carry no cost basis, so the proceeds are realized gain.
+type RightRenunciation struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PortfolioId        int64                  `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol        string                 `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Units              int64                  `protobuf:"varint,4,opt,name=units,proto3" json:"units,omitempty"`
+	RenouncedOn        string                 `protobuf:"bytes,5,opt,name=renounced_on,json=renouncedOn,proto3" json:"renounced_on,omitempty"` // YYYY-MM-DD
+	Proceeds           float64                `protobuf:"fixed64,6,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	ProceedsReceivedOn string                 `protobuf:"bytes,7,opt,name=proceeds_received_on,json=proceedsReceivedOn,proto3" json:"proceeds_received_on,omitempty"` // empty until the auction settles
+	Note               string                 `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RightRenunciation) Reset() {
+	*x = RightRenunciation{}
+	mi := &file_ntx_v1_application_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RightRenunciation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RightRenunciation) ProtoMessage() {}
+
+func (x *RightRenunciation) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RightRenunciation.ProtoReflect.Descriptor instead.
+func (*RightRenunciation) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RightRenunciation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RightRenunciation) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *RightRenunciation) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *RightRenunciation) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *RightRenunciation) GetRenouncedOn() string {
+	if x != nil {
+		return x.RenouncedOn
+	}
+	return ""
+}
+
+func (x *RightRenunciation) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *RightRenunciation) GetProceedsReceivedOn() string {
+	if x != nil {
+		return x.ProceedsReceivedOn
+	}
+	return ""
+}
+
+func (x *RightRenunciation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *RightRenunciation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// RenounceRightsRequest records units of a rights issue not subscribed. For
+// a partial subscription, apply for the rest with CreateApplication.
+type RenounceRightsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Units         int64                  `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	RenouncedOn   string                 `protobuf:"bytes,4,opt,name=renounced_on,json=renouncedOn,proto3" json:"renounced_on,omitempty"` // YYYY-MM-DD, defaults to today
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenounceRightsRequest) Reset() {
+	*x = RenounceRightsRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceRightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceRightsRequest) ProtoMessage() {}
+
+func (x *RenounceRightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceRightsRequest.ProtoReflect.Descriptor instead.
+func (*RenounceRightsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RenounceRightsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *RenounceRightsRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *RenounceRightsRequest) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *RenounceRightsRequest) GetRenouncedOn() string {
+	if x != nil {
+		return x.RenouncedOn
+	}
+	return ""
+}
+
+func (x *RenounceRightsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type RenounceRightsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Renunciation  *RightRenunciation     `protobuf:"bytes,1,opt,name=renunciation,proto3" json:"renunciation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenounceRightsResponse) Reset() {
+	*x = RenounceRightsResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenounceRightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenounceRightsResponse) ProtoMessage() {}
+
+func (x *RenounceRightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenounceRightsResponse.ProtoReflect.Descriptor instead.
+func (*RenounceRightsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RenounceRightsResponse) GetRenunciation() *RightRenunciation {
+	if x != nil {
+		return x.Renunciation
+	}
+	return nil
+}
+
+type ListRenunciationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRenunciationsRequest) Reset() {
+	*x = ListRenunciationsRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRenunciationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRenunciationsRequest) ProtoMessage() {}
+
+func (x *ListRenunciationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRenunciationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRenunciationsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{12}
+}
+
+type ListRenunciationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Renunciations []*RightRenunciation   `protobuf:"bytes,1,rep,name=renunciations,proto3" json:"renunciations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRenunciationsResponse) Reset() {
+	*x = ListRenunciationsResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRenunciationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRenunciationsResponse) ProtoMessage() {}
+
+func (x *ListRenunciationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRenunciationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRenunciationsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListRenunciationsResponse) GetRenunciations() []*RightRenunciation {
+	if x != nil {
+		return x.Renunciations
+	}
+	return nil
+}
+
+type SetRenunciationProceedsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RenunciationId int64                  `protobuf:"varint,1,opt,name=renunciation_id,json=renunciationId,proto3" json:"renunciation_id,omitempty"`
+	Proceeds       float64                `protobuf:"fixed64,2,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	ReceivedOn     string                 `protobuf:"bytes,3,opt,name=received_on,json=receivedOn,proto3" json:"received_on,omitempty"` // YYYY-MM-DD, defaults to today
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetRenunciationProceedsRequest) Reset() {
+	*x = SetRenunciationProceedsRequest{}
+	mi := &file_ntx_v1_application_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRenunciationProceedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRenunciationProceedsRequest) ProtoMessage() {}
+
+func (x *SetRenunciationProceedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRenunciationProceedsRequest.ProtoReflect.Descriptor instead.
+func (*SetRenunciationProceedsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetRenunciationProceedsRequest) GetRenunciationId() int64 {
+	if x != nil {
+		return x.RenunciationId
+	}
+	return 0
+}
+
+func (x *SetRenunciationProceedsRequest) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *SetRenunciationProceedsRequest) GetReceivedOn() string {
+	if x != nil {
+		return x.ReceivedOn
+	}
+	return ""
+}
+
+type SetRenunciationProceedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Renunciation  *RightRenunciation     `protobuf:"bytes,1,opt,name=renunciation,proto3" json:"renunciation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRenunciationProceedsResponse) Reset() {
+	*x = SetRenunciationProceedsResponse{}
+	mi := &file_ntx_v1_application_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRenunciationProceedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRenunciationProceedsResponse) ProtoMessage() {}
+
+func (x *SetRenunciationProceedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_application_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRenunciationProceedsResponse.ProtoReflect.Descriptor instead.
+func (*SetRenunciationProceedsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_application_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetRenunciationProceedsResponse) GetRenunciation() *RightRenunciation {
+	if x != nil {
+		return x.Renunciation
+	}
+	return nil
+}
+
+var File_ntx_v1_application_proto protoreflect.FileDescriptor
+
+const file_ntx_v1_application_proto_rawDesc = "" +
+	"\n" +
+	"\x18ntx/v1/application.proto\x12\x06ntx.v1\x1a\x16ntx/v1/portfolio.proto\"\xe2\x04\n" +
+	"\x10ShareApplication\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fportfolio_id\x18\x02 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x120\n" +
+	"\n" +
+	"issue_type\x18\x04 \x01(\x0e2\x11.ntx.v1.IssueTypeR\tissueType\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\x03R\x05units\x12$\n" +
+	"\x0eprice_per_unit\x18\x06 \x01(\x01R\fpricePerUnit\x12%\n" +
+	"\x0eamount_blocked\x18\a \x01(\x01R\ramountBlocked\x12\x1d\n" +
+	"\n" +
+	"applied_on\x18\b \x01(\tR\tappliedOn\x122\n" +
+	"\x15expected_allotment_on\x18\t \x01(\tR\x13expectedAllotmentOn\x121\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x19.ntx.v1.ApplicationStatusR\x06status\x12%\n" +
+	"\x0eallotted_units\x18\v \x01(\x03R\rallottedUnits\x12'\n" +
+	"\x0famount_released\x18\f \x01(\x01R\x0eamountReleased\x12*\n" +
+	"\x0etransaction_id\x18\r \x01(\x03H\x00R\rtransactionId\x88\x01\x01\x12\x12\n" +
+	"\x04note\x18\x0e \x01(\tR\x04note\x12\x1b\n" +
+	"\tclosed_at\x18\x0f \x01(\tR\bclosedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAtB\x11\n" +
+	"\x0f_transaction_id\"\xb5\x02\n" +
+	"\x18CreateApplicationRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x120\n" +
+	"\n" +
+	"issue_type\x18\x03 \x01(\x0e2\x11.ntx.v1.IssueTypeR\tissueType\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x03R\x05units\x12$\n" +
+	"\x0eprice_per_unit\x18\x05 \x01(\x01R\fpricePerUnit\x12\x1d\n" +
+	"\n" +
+	"applied_on\x18\x06 \x01(\tR\tappliedOn\x122\n" +
+	"\x15expected_allotment_on\x18\a \x01(\tR\x13expectedAllotmentOn\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\"W\n" +
+	"\x19CreateApplicationResponse\x12:\n" +
+	"\vapplication\x18\x01 \x01(\v2\x18.ntx.v1.ShareApplicationR\vapplication\"\\\n" +
+	"\x17ListApplicationsRequest\x126\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x19.ntx.v1.ApplicationStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\"}\n" +
+	"\x18ListApplicationsResponse\x12<\n" +
+	"\fapplications\x18\x01 \x03(\v2\x18.ntx.v1.ShareApplicationR\fapplications\x12#\n" +
+	"\rtotal_blocked\x18\x02 \x01(\x01R\ftotalBlocked\"C\n" +
+	"\x1aWithdrawApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x03R\rapplicationId\"Y\n" +
+	"\x1bWithdrawApplicationResponse\x12:\n" +
+	"\vapplication\x18\x01 \x01(\v2\x18.ntx.v1.ShareApplicationR\vapplication\"\x88\x01\n" +
+	"\x17AllotApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x03R\rapplicationId\x12%\n" +
+	"\x0eallotted_units\x18\x02 \x01(\x03R\rallottedUnits\x12\x1f\n" +
+	"\vallotted_on\x18\x03 \x01(\tR\n" +
+	"allottedOn\"\x8d\x01\n" +
+	"\x18AllotApplicationResponse\x12:\n" +
+	"\vapplication\x18\x01 \x01(\v2\x18.ntx.v1.ShareApplicationR\vapplication\x125\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x13.ntx.v1.TransactionR\vtransaction\"\xa3\x02\n" +
+	"\x11RightRenunciation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fportfolio_id\x18\x02 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\x03R\x05units\x12!\n" +
+	"\frenounced_on\x18\x05 \x01(\tR\vrenouncedOn\x12\x1a\n" +
+	"\bproceeds\x18\x06 \x01(\x01R\bproceeds\x120\n" +
+	"\x14proceeds_received_on\x18\a \x01(\tR\x12proceedsReceivedOn\x12\x12\n" +
+	"\x04note\x18\b \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xaa\x01\n" +
+	"\x15RenounceRightsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12\x14\n" +
+	"\x05units\x18\x03 \x01(\x03R\x05units\x12!\n" +
+	"\frenounced_on\x18\x04 \x01(\tR\vrenouncedOn\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"W\n" +
+	"\x16RenounceRightsResponse\x12=\n" +
+	"\frenunciation\x18\x01 \x01(\v2\x19.ntx.v1.RightRenunciationR\frenunciation\"\x1a\n" +
+	"\x18ListRenunciationsRequest\"\\\n" +
+	"\x19ListRenunciationsResponse\x12?\n" +
+	"\rrenunciations\x18\x01 \x03(\v2\x19.ntx.v1.RightRenunciationR\rrenunciations\"\x86\x01\n" +
+	"\x1eSetRenunciationProceedsRequest\x12'\n" +
+	"\x0frenunciation_id\x18\x01 \x01(\x03R\x0erenunciationId\x12\x1a\n" +
+	"\bproceeds\x18\x02 \x01(\x01R\bproceeds\x12\x1f\n" +
+	"\vreceived_on\x18\x03 \x01(\tR\n" +
+	"receivedOn\"`\n" +
+	"\x1fSetRenunciationProceedsResponse\x12=\n" +
+	"\frenunciation\x18\x01 \x01(\v2\x19.ntx.v1.RightRenunciationR\frenunciation*e\n" +
+	"\tIssueType\x12\x1a\n" +
+	"\x16ISSUE_TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eISSUE_TYPE_IPO\x10\x01\x12\x12\n" +
+	"\x0eISSUE_TYPE_FPO\x10\x02\x12\x14\n" +
+	"\x10ISSUE_TYPE_RIGHT\x10\x03*\xbf\x01\n" +
+	"\x11ApplicationStatus\x12\"\n" +
+	"\x1eAPPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aAPPLICATION_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bAPPLICATION_STATUS_ALLOTTED\x10\x02\x12#\n" +
+	"\x1fAPPLICATION_STATUS_NOT_ALLOTTED\x10\x03\x12 \n" +
+	"\x1cAPPLICATION_STATUS_WITHDRAWN\x10\x042\x93\x05\n" +
+	"\x12ApplicationService\x12X\n" +
+	"\x11CreateApplication\x12 .ntx.v1.CreateApplicationRequest\x1a!.ntx.v1.CreateApplicationResponse\x12U\n" +
+	"\x10ListApplications\x12\x1f.ntx.v1.ListApplicationsRequest\x1a .ntx.v1.ListApplicationsResponse\x12^\n" +
+	"\x13WithdrawApplication\x12\".ntx.v1.WithdrawApplicationRequest\x1a#.ntx.v1.WithdrawApplicationResponse\x12U\n" +
+	"\x10AllotApplication\x12\x1f.ntx.v1.AllotApplicationRequest\x1a .ntx.v1.AllotApplicationResponse\x12O\n" +
+	"\x0eRenounceRights\x12\x1d.ntx.v1.RenounceRightsRequest\x1a\x1e.ntx.v1.RenounceRightsResponse\x12X\n" +
+	"\x11ListRenunciations\x12 .ntx.v1.ListRenunciationsRequest\x1a!.ntx.v1.ListRenunciationsResponse\x12j\n" +
+	"\x17SetRenunciationProceeds\x12&.ntx.v1.SetRenunciationProceedsRequest\x1a'.ntx.v1.SetRenunciationProceedsResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+
+var (
+	file_ntx_v1_application_proto_rawDescOnce sync.Once
+	file_ntx_v1_application_proto_rawDescData []byte
+)
+
+func file_ntx_v1_application_proto_rawDescGZIP() []byte {
+	file_ntx_v1_application_proto_rawDescOnce.Do(func() {
+		file_ntx_v1_application_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ntx_v1_application_proto_rawDesc), len(file_ntx_v1_application_proto_rawDesc)))
+	})
+	return file_ntx_v1_application_proto_rawDescData
+}
+
+var file_ntx_v1_application_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ntx_v1_application_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_ntx_v1_application_proto_goTypes = []any{
+	(IssueType)(0),                          // 0: ntx.v1.IssueType
+	(ApplicationStatus)(0),                  // 1: ntx.v1.ApplicationStatus
+	(*ShareApplication)(nil),                // 2: ntx.v1.ShareApplication
+	(*CreateApplicationRequest)(nil),        // 3: ntx.v1.CreateApplicationRequest
+	(*CreateApplicationResponse)(nil),       // 4: ntx.v1.CreateApplicationResponse
+	(*ListApplicationsRequest)(nil),         // 5: ntx.v1.ListApplicationsRequest
+	(*ListApplicationsResponse)(nil),        // 6: ntx.v1.ListApplicationsResponse
+	(*WithdrawApplicationRequest)(nil),      // 7: ntx.v1.WithdrawApplicationRequest
+	(*WithdrawApplicationResponse)(nil),     // 8: ntx.v1.WithdrawApplicationResponse
+	(*AllotApplicationRequest)(nil),         // 9: ntx.v1.AllotApplicationRequest
+	(*AllotApplicationResponse)(nil),        // 10: ntx.v1.AllotApplicationResponse
+	(*RightRenunciation)(nil),               // 11: ntx.v1.RightRenunciation
+	(*RenounceRightsRequest)(nil),           // 12: ntx.v1.RenounceRightsRequest
+	(*RenounceRightsResponse)(nil),          // 13: ntx.v1.RenounceRightsResponse
+	(*ListRenunciationsRequest)(nil),        // 14: ntx.v1.ListRenunciationsRequest
+	(*ListRenunciationsResponse)(nil),       // 15: ntx.v1.ListRenunciationsResponse
+	(*SetRenunciationProceedsRequest)(nil),  // 16: ntx.v1.SetRenunciationProceedsRequest
+	(*SetRenunciationProceedsResponse)(nil), // 17: ntx.v1.SetRenunciationProceedsResponse
+	(*Transaction)(nil),                     // 18: ntx.v1.Transaction
+}
+var file_ntx_v1_application_proto_depIdxs = []int32{
+	0,  // 0: ntx.v1.ShareApplication.issue_type:type_name -> ntx.v1.IssueType
+	1,  // 1: ntx.v1.ShareApplication.status:type_name -> ntx.v1.ApplicationStatus
+	0,  // 2: ntx.v1.CreateApplicationRequest.issue_type:type_name -> ntx.v1.IssueType
+	2,  // 3: ntx.v1.CreateApplicationResponse.application:type_name -> ntx.v1.ShareApplication
+	1,  // 4: ntx.v1.ListApplicationsRequest.status:type_name -> ntx.v1.ApplicationStatus
+	2,  // 5: ntx.v1.ListApplicationsResponse.applications:type_name -> ntx.v1.ShareApplication
+	2,  // 6: ntx.v1.WithdrawApplicationResponse.application:type_name -> ntx.v1.ShareApplication
+	2,  // 7: ntx.v1.AllotApplicationResponse.application:type_name -> ntx.v1.ShareApplication
+	18, // 8: ntx.v1.AllotApplicationResponse.transaction:type_name -> ntx.v1.Transaction
+	11, // 9: ntx.v1.RenounceRightsResponse.renunciation:type_name -> ntx.v1.RightRenunciation
+	11, // 10: ntx.v1.ListRenunciationsResponse.renunciations:type_name -> ntx.v1.RightRenunciation
+	11, // 11: ntx.v1.SetRenunciationProceedsResponse.renunciation:type_name -> ntx.v1.RightRenunciation
+	3,  // 12: ntx.v1.ApplicationService.CreateApplication:input_type -> ntx.v1.CreateApplicationRequest
+	5,  // 13: ntx.v1.ApplicationService.ListApplications:input_type -> ntx.v1.ListApplicationsRequest
+	7,  // 14: ntx.v1.ApplicationService.WithdrawApplication:input_type -> ntx.v1.WithdrawApplicationRequest
+	9,  // 15: ntx.v1.ApplicationService.AllotApplication:input_type -> ntx.v1.AllotApplicationRequest
+	12, // 16: ntx.v1.ApplicationService.RenounceRights:input_type -> ntx.v1.RenounceRightsRequest
+	14, // 17: ntx.v1.ApplicationService.ListRenunciations:input_type -> ntx.v1.ListRenunciationsRequest
+	16, // 18: ntx.v1.ApplicationService.SetRenunciationProceeds:input_type -> ntx.v1.SetRenunciationProceedsRequest
+	4,  // 19: ntx.v1.ApplicationService.CreateApplication:output_type -> ntx.v1.CreateApplicationResponse
+	6,  // 20: ntx.v1.ApplicationService.ListApplications:output_type -> ntx.v1.ListApplicationsResponse
+	8,  // 21: ntx.v1.ApplicationService.WithdrawApplication:output_type -> ntx.v1.WithdrawApplicationResponse
+	10, // 22: ntx.v1.ApplicationService.AllotApplication:output_type -> ntx.v1.AllotApplicationResponse
+	13, // 23: ntx.v1.ApplicationService.RenounceRights:output_type -> ntx.v1.RenounceRightsResponse
+	15, // 24: ntx.v1.ApplicationService.ListRenunciations:output_type -> ntx.v1.ListRenunciationsResponse
+	17, // 25: ntx.v1.ApplicationService.SetRenunciationProceeds:output_type -> ntx.v1.SetRenunciationProceedsResponse
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_ntx_v1_application_proto_init() }
+func file_ntx_v1_application_proto_init() {
+	if File_ntx_v1_application_proto != nil {
+		return
+	}
+	file_ntx_v1_portfolio_proto_init()
+	file_ntx_v1_application_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ntx_v1_application_proto_msgTypes[3].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_application_proto_rawDesc), len(file_ntx_v1_application_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ntx_v1_application_proto_goTypes,
+		DependencyIndexes: file_ntx_v1_application_proto_depIdxs,
+		EnumInfos:         file_ntx_v1_application_proto_enumTypes,
+		MessageInfos:      file_ntx_v1_application_proto_msgTypes,
+	}.Build()
+	File_ntx_v1_application_proto = out.File
+	file_ntx_v1_application_proto_goTypes = nil
+	file_ntx_v1_application_proto_depIdxs = nil
+}
@@ -155,6 +155,60 @@ func (Sector) EnumDescriptor() ([]byte, []int) {
 	return file_ntx_v1_common_proto_rawDescGZIP(), []int{1}
 }
 
+// Trading status on NEPSE. Suspended scrips can't be traded until the
+// suspension lifts; delisted ones no longer trade at all.
+type ListingStatus int32
+
+const (
+	ListingStatus_LISTING_STATUS_UNSPECIFIED ListingStatus = 0
+	ListingStatus_LISTING_STATUS_ACTIVE      ListingStatus = 1
+	ListingStatus_LISTING_STATUS_SUSPENDED   ListingStatus = 2
+	ListingStatus_LISTING_STATUS_DELISTED    ListingStatus = 3
+)
+
+// Enum value maps for ListingStatus.
+var (
+	ListingStatus_name = map[int32]string{
+		0: "LISTING_STATUS_UNSPECIFIED",
+		1: "LISTING_STATUS_ACTIVE",
+		2: "LISTING_STATUS_SUSPENDED",
+		3: "LISTING_STATUS_DELISTED",
+	}
+	ListingStatus_value = map[string]int32{
+		"LISTING_STATUS_UNSPECIFIED": 0,
+		"LISTING_STATUS_ACTIVE":      1,
+		"LISTING_STATUS_SUSPENDED":   2,
+		"LISTING_STATUS_DELISTED":    3,
+	}
+)
+
+func (x ListingStatus) Enum() *ListingStatus {
+	p := new(ListingStatus)
+	*p = x
+	return p
+}
+
+func (x ListingStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListingStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_common_proto_enumTypes[2].Descriptor()
+}
+
+func (ListingStatus) Type() protoreflect.EnumType {
+	return &file_ntx_v1_common_proto_enumTypes[2]
+}
+
+func (x ListingStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListingStatus.Descriptor instead.
+func (ListingStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_common_proto_rawDescGZIP(), []int{2}
+}
+
 type InstrumentType int32
 
 const (
@@ -191,11 +245,11 @@ func (x InstrumentType) String() string {
 }
 
 func (InstrumentType) Descriptor() protoreflect.EnumDescriptor {
-	return file_ntx_v1_common_proto_enumTypes[2].Descriptor()
+	return file_ntx_v1_common_proto_enumTypes[3].Descriptor()
 }
 
 func (InstrumentType) Type() protoreflect.EnumType {
-	return &file_ntx_v1_common_proto_enumTypes[2]
+	return &file_ntx_v1_common_proto_enumTypes[3]
 }
 
 func (x InstrumentType) Number() protoreflect.EnumNumber {
@@ -204,7 +258,7 @@ func (x InstrumentType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InstrumentType.Descriptor instead.
 func (InstrumentType) EnumDescriptor() ([]byte, []int) {
-	return file_ntx_v1_common_proto_rawDescGZIP(), []int{2}
+	return file_ntx_v1_common_proto_rawDescGZIP(), []int{3}
 }
 
 type Company struct {
@@ -865,7 +919,12 @@ const file_ntx_v1_common_proto_rawDesc = "" +
 	"\x12\x15\n" +
 	"\x11SECTOR_INVESTMENT\x10\v\x12\x16\n" +
 	"\x12SECTOR_MUTUAL_FUND\x10\f\x12\x11\n" +
-	"\rSECTOR_OTHERS\x10\r*\x88\x01\n" +
+	"\rSECTOR_OTHERS\x10\r*\x85\x01\n" +
+	"\rListingStatus\x12\x1e\n" +
+	"\x1aLISTING_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15LISTING_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
+	"\x18LISTING_STATUS_SUSPENDED\x10\x02\x12\x1b\n" +
+	"\x17LISTING_STATUS_DELISTED\x10\x03*\x88\x01\n" +
 	"\x0eInstrumentType\x12\x1f\n" +
 	"\x1bINSTRUMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16INSTRUMENT_TYPE_EQUITY\x10\x01\x12\x18\n" +
@@ -884,22 +943,23 @@ func file_ntx_v1_common_proto_rawDescGZIP() []byte {
 	return file_ntx_v1_common_proto_rawDescData
 }
 
-var file_ntx_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ntx_v1_common_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
 var file_ntx_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_ntx_v1_common_proto_goTypes = []any{
 	(CompanyStatus)(0),      // 0: ntx.v1.CompanyStatus
 	(Sector)(0),             // 1: ntx.v1.Sector
-	(InstrumentType)(0),     // 2: ntx.v1.InstrumentType
-	(*Company)(nil),         // 3: ntx.v1.Company
-	(*Fundamental)(nil),     // 4: ntx.v1.Fundamental
-	(*Price)(nil),           // 5: ntx.v1.Price
-	(*Ownership)(nil),       // 6: ntx.v1.Ownership
-	(*CorporateAction)(nil), // 7: ntx.v1.CorporateAction
+	(ListingStatus)(0),      // 2: ntx.v1.ListingStatus
+	(InstrumentType)(0),     // 3: ntx.v1.InstrumentType
+	(*Company)(nil),         // 4: ntx.v1.Company
+	(*Fundamental)(nil),     // 5: ntx.v1.Fundamental
+	(*Price)(nil),           // 6: ntx.v1.Price
+	(*Ownership)(nil),       // 7: ntx.v1.Ownership
+	(*CorporateAction)(nil), // 8: ntx.v1.CorporateAction
 }
 var file_ntx_v1_common_proto_depIdxs = []int32{
 	0, // 0: ntx.v1.Company.status:type_name -> ntx.v1.CompanyStatus
 	1, // 1: ntx.v1.Company.sector:type_name -> ntx.v1.Sector
-	2, // 2: ntx.v1.Company.instrument_type:type_name -> ntx.v1.InstrumentType
+	3, // 2: ntx.v1.Company.instrument_type:type_name -> ntx.v1.InstrumentType
 	3, // [3:3] is the sub-list for method output_type
 	3, // [3:3] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
@@ -921,7 +981,7 @@ func file_ntx_v1_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_common_proto_rawDesc), len(file_ntx_v1_common_proto_rawDesc)),
-			NumEnums:      3,
+			NumEnums:      4,
 			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
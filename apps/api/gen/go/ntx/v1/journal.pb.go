@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ntx/v1/journal.proto
+
+package ntxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"` // markdown
+	StockSymbol   *string                `protobuf:"bytes,5,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Note) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Note) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Note) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Note) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Note) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *Note) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Note) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Note) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Date        string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD, defaults to today
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body        string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	StockSymbol *string                `protobuf:"bytes,4,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	// Linking a transaction also links its symbol
+	TransactionId *int64 `protobuf:"varint,5,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateNoteRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type CreateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteResponse) Reset() {
+	*x = CreateNoteResponse{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteResponse) ProtoMessage() {}
+
+func (x *CreateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteResponse.ProtoReflect.Descriptor instead.
+func (*CreateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// UpdateNoteRequest replaces every field of the note.
+type UpdateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	StockSymbol   *string                `protobuf:"bytes,5,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,6,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteRequest) Reset() {
+	*x = UpdateNoteRequest{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteRequest) ProtoMessage() {}
+
+func (x *UpdateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateNoteRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+func (x *UpdateNoteRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *UpdateNoteRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+type UpdateNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *Note                  `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNoteResponse) Reset() {
+	*x = UpdateNoteResponse{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNoteResponse) ProtoMessage() {}
+
+func (x *UpdateNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNoteResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNoteResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateNoteResponse) GetNote() *Note {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+type ListNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol   *string                `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                       // case-insensitive match on title and body
+	FromDate      string                 `protobuf:"bytes,4,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // YYYY-MM-DD
+	ToDate        string                 `protobuf:"bytes,5,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesRequest) Reset() {
+	*x = ListNotesRequest{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesRequest) ProtoMessage() {}
+
+func (x *ListNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListNotesRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListNotesRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *ListNotesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListNotesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type ListNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*Note                `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotesResponse) Reset() {
+	*x = ListNotesResponse{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotesResponse) ProtoMessage() {}
+
+func (x *ListNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotesResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListNotesResponse) GetNotes() []*Note {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type DeleteNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        int64                  `protobuf:"varint,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteRequest) Reset() {
+	*x = DeleteNoteRequest{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteRequest) ProtoMessage() {}
+
+func (x *DeleteNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNoteRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteNoteRequest) GetNoteId() int64 {
+	if x != nil {
+		return x.NoteId
+	}
+	return 0
+}
+
+type DeleteNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNoteResponse) Reset() {
+	*x = DeleteNoteResponse{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNoteResponse) ProtoMessage() {}
+
+func (x *DeleteNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNoteResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{8}
+}
+
+type ExportNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesRequest) Reset() {
+	*x = ExportNotesRequest{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesRequest) ProtoMessage() {}
+
+func (x *ExportNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesRequest.ProtoReflect.Descriptor instead.
+func (*ExportNotesRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{9}
+}
+
+// ExportNotesResponse is every note as one markdown document, oldest first.
+type ExportNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Markdown      []byte                 `protobuf:"bytes,2,opt,name=markdown,proto3" json:"markdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportNotesResponse) Reset() {
+	*x = ExportNotesResponse{}
+	mi := &file_ntx_v1_journal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportNotesResponse) ProtoMessage() {}
+
+func (x *ExportNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_journal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportNotesResponse.ProtoReflect.Descriptor instead.
+func (*ExportNotesResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_journal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ExportNotesResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportNotesResponse) GetMarkdown() []byte {
+	if x != nil {
+		return x.Markdown
+	}
+	return nil
+}
+
+var File_ntx_v1_journal_proto protoreflect.FileDescriptor
+
+const file_ntx_v1_journal_proto_rawDesc = "" +
+	"\n" +
+	"\x14ntx/v1/journal.proto\x12\x06ntx.v1\"\x8a\x02\n" +
+	"\x04Note\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12&\n" +
+	"\fstock_symbol\x18\x05 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x06 \x01(\x03H\x01R\rtransactionId\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAtB\x0f\n" +
+	"\r_stock_symbolB\x11\n" +
+	"\x0f_transaction_id\"\xc9\x01\n" +
+	"\x11CreateNoteRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12&\n" +
+	"\fstock_symbol\x18\x04 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x05 \x01(\x03H\x01R\rtransactionId\x88\x01\x01B\x0f\n" +
+	"\r_stock_symbolB\x11\n" +
+	"\x0f_transaction_id\"6\n" +
+	"\x12CreateNoteResponse\x12 \n" +
+	"\x04note\x18\x01 \x01(\v2\f.ntx.v1.NoteR\x04note\"\xe2\x01\n" +
+	"\x11UpdateNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12&\n" +
+	"\fstock_symbol\x18\x05 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x06 \x01(\x03H\x01R\rtransactionId\x88\x01\x01B\x0f\n" +
+	"\r_stock_symbolB\x11\n" +
+	"\x0f_transaction_id\"6\n" +
+	"\x12UpdateNoteResponse\x12 \n" +
+	"\x04note\x18\x01 \x01(\v2\f.ntx.v1.NoteR\x04note\"\xd6\x01\n" +
+	"\x10ListNotesRequest\x12&\n" +
+	"\fstock_symbol\x18\x01 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12*\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03H\x01R\rtransactionId\x88\x01\x01\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1b\n" +
+	"\tfrom_date\x18\x04 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x05 \x01(\tR\x06toDateB\x0f\n" +
+	"\r_stock_symbolB\x11\n" +
+	"\x0f_transaction_id\"7\n" +
+	"\x11ListNotesResponse\x12\"\n" +
+	"\x05notes\x18\x01 \x03(\v2\f.ntx.v1.NoteR\x05notes\",\n" +
+	"\x11DeleteNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\x03R\x06noteId\"\x14\n" +
+	"\x12DeleteNoteResponse\"\x14\n" +
+	"\x12ExportNotesRequest\"M\n" +
+	"\x13ExportNotesResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1a\n" +
+	"\bmarkdown\x18\x02 \x01(\fR\bmarkdown2\xe9\x02\n" +
+	"\x0eJournalService\x12C\n" +
+	"\n" +
+	"CreateNote\x12\x19.ntx.v1.CreateNoteRequest\x1a\x1a.ntx.v1.CreateNoteResponse\x12C\n" +
+	"\n" +
+	"UpdateNote\x12\x19.ntx.v1.UpdateNoteRequest\x1a\x1a.ntx.v1.UpdateNoteResponse\x12@\n" +
+	"\tListNotes\x12\x18.ntx.v1.ListNotesRequest\x1a\x19.ntx.v1.ListNotesResponse\x12C\n" +
+	"\n" +
+	"DeleteNote\x12\x19.ntx.v1.DeleteNoteRequest\x1a\x1a.ntx.v1.DeleteNoteResponse\x12F\n" +
+	"\vExportNotes\x12\x1a.ntx.v1.ExportNotesRequest\x1a\x1b.ntx.v1.ExportNotesResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+
+var (
+	file_ntx_v1_journal_proto_rawDescOnce sync.Once
+	file_ntx_v1_journal_proto_rawDescData []byte
+)
+
+func file_ntx_v1_journal_proto_rawDescGZIP() []byte {
+	file_ntx_v1_journal_proto_rawDescOnce.Do(func() {
+		file_ntx_v1_journal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ntx_v1_journal_proto_rawDesc), len(file_ntx_v1_journal_proto_rawDesc)))
+	})
+	return file_ntx_v1_journal_proto_rawDescData
+}
+
+var file_ntx_v1_journal_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_ntx_v1_journal_proto_goTypes = []any{
+	(*Note)(nil),                // 0: ntx.v1.Note
+	(*CreateNoteRequest)(nil),   // 1: ntx.v1.CreateNoteRequest
+	(*CreateNoteResponse)(nil),  // 2: ntx.v1.CreateNoteResponse
+	(*UpdateNoteRequest)(nil),   // 3: ntx.v1.UpdateNoteRequest
+	(*UpdateNoteResponse)(nil),  // 4: ntx.v1.UpdateNoteResponse
+	(*ListNotesRequest)(nil),    // 5: ntx.v1.ListNotesRequest
+	(*ListNotesResponse)(nil),   // 6: ntx.v1.ListNotesResponse
+	(*DeleteNoteRequest)(nil),   // 7: ntx.v1.DeleteNoteRequest
+	(*DeleteNoteResponse)(nil),  // 8: ntx.v1.DeleteNoteResponse
+	(*ExportNotesRequest)(nil),  // 9: ntx.v1.ExportNotesRequest
+	(*ExportNotesResponse)(nil), // 10: ntx.v1.ExportNotesResponse
+}
+var file_ntx_v1_journal_proto_depIdxs = []int32{
+	0,  // 0: ntx.v1.CreateNoteResponse.note:type_name -> ntx.v1.Note
+	0,  // 1: ntx.v1.UpdateNoteResponse.note:type_name -> ntx.v1.Note
+	0,  // 2: ntx.v1.ListNotesResponse.notes:type_name -> ntx.v1.Note
+	1,  // 3: ntx.v1.JournalService.CreateNote:input_type -> ntx.v1.CreateNoteRequest
+	3,  // 4: ntx.v1.JournalService.UpdateNote:input_type -> ntx.v1.UpdateNoteRequest
+	5,  // 5: ntx.v1.JournalService.ListNotes:input_type -> ntx.v1.ListNotesRequest
+	7,  // 6: ntx.v1.JournalService.DeleteNote:input_type -> ntx.v1.DeleteNoteRequest
+	9,  // 7: ntx.v1.JournalService.ExportNotes:input_type -> ntx.v1.ExportNotesRequest
+	2,  // 8: ntx.v1.JournalService.CreateNote:output_type -> ntx.v1.CreateNoteResponse
+	4,  // 9: ntx.v1.JournalService.UpdateNote:output_type -> ntx.v1.UpdateNoteResponse
+	6,  // 10: ntx.v1.JournalService.ListNotes:output_type -> ntx.v1.ListNotesResponse
+	8,  // 11: ntx.v1.JournalService.DeleteNote:output_type -> ntx.v1.DeleteNoteResponse
+	10, // 12: ntx.v1.JournalService.ExportNotes:output_type -> ntx.v1.ExportNotesResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_ntx_v1_journal_proto_init() }
+func file_ntx_v1_journal_proto_init() {
+	if File_ntx_v1_journal_proto != nil {
+		return
+	}
+	file_ntx_v1_journal_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ntx_v1_journal_proto_msgTypes[1].OneofWrappers = []any{}
+	file_ntx_v1_journal_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ntx_v1_journal_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_journal_proto_rawDesc), len(file_ntx_v1_journal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ntx_v1_journal_proto_goTypes,
+		DependencyIndexes: file_ntx_v1_journal_proto_depIdxs,
+		MessageInfos:      file_ntx_v1_journal_proto_msgTypes,
+	}.Build()
+	File_ntx_v1_journal_proto = out.File
+	file_ntx_v1_journal_proto_goTypes = nil
+	file_ntx_v1_journal_proto_depIdxs = nil
+}
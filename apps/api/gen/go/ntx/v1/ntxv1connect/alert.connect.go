@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ntx/v1/alert.proto
+
+package ntxv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AlertServiceName is the fully-qualified name of the AlertService service.
+	AlertServiceName = "ntx.v1.AlertService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AlertServiceCreateAlertProcedure is the fully-qualified name of the AlertService's CreateAlert
+	// RPC.
+	AlertServiceCreateAlertProcedure = "/ntx.v1.AlertService/CreateAlert"
+	// AlertServiceListAlertsProcedure is the fully-qualified name of the AlertService's ListAlerts RPC.
+	AlertServiceListAlertsProcedure = "/ntx.v1.AlertService/ListAlerts"
+	// AlertServiceDeleteAlertProcedure is the fully-qualified name of the AlertService's DeleteAlert
+	// RPC.
+	AlertServiceDeleteAlertProcedure = "/ntx.v1.AlertService/DeleteAlert"
+)
+
+// AlertServiceClient is a client for the ntx.v1.AlertService service.
+type AlertServiceClient interface {
+	CreateAlert(context.Context, *connect.Request[v1.CreateAlertRequest]) (*connect.Response[v1.CreateAlertResponse], error)
+	ListAlerts(context.Context, *connect.Request[v1.ListAlertsRequest]) (*connect.Response[v1.ListAlertsResponse], error)
+	DeleteAlert(context.Context, *connect.Request[v1.DeleteAlertRequest]) (*connect.Response[v1.DeleteAlertResponse], error)
+}
+
+// NewAlertServiceClient constructs a client for the ntx.v1.AlertService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAlertServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AlertServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	alertServiceMethods := v1.File_ntx_v1_alert_proto.Services().ByName("AlertService").Methods()
+	return &alertServiceClient{
+		createAlert: connect.NewClient[v1.CreateAlertRequest, v1.CreateAlertResponse](
+			httpClient,
+			baseURL+AlertServiceCreateAlertProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("CreateAlert")),
+			connect.WithClientOptions(opts...),
+		),
+		listAlerts: connect.NewClient[v1.ListAlertsRequest, v1.ListAlertsResponse](
+			httpClient,
+			baseURL+AlertServiceListAlertsProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("ListAlerts")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAlert: connect.NewClient[v1.DeleteAlertRequest, v1.DeleteAlertResponse](
+			httpClient,
+			baseURL+AlertServiceDeleteAlertProcedure,
+			connect.WithSchema(alertServiceMethods.ByName("DeleteAlert")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// alertServiceClient implements AlertServiceClient.
+type alertServiceClient struct {
+	createAlert *connect.Client[v1.CreateAlertRequest, v1.CreateAlertResponse]
+	listAlerts  *connect.Client[v1.ListAlertsRequest, v1.ListAlertsResponse]
+	deleteAlert *connect.Client[v1.DeleteAlertRequest, v1.DeleteAlertResponse]
+}
+
+// CreateAlert calls ntx.v1.AlertService.CreateAlert.
+func (c *alertServiceClient) CreateAlert(ctx context.Context, req *connect.Request[v1.CreateAlertRequest]) (*connect.Response[v1.CreateAlertResponse], error) {
+	return c.createAlert.CallUnary(ctx, req)
+}
+
+// ListAlerts calls ntx.v1.AlertService.ListAlerts.
+func (c *alertServiceClient) ListAlerts(ctx context.Context, req *connect.Request[v1.ListAlertsRequest]) (*connect.Response[v1.ListAlertsResponse], error) {
+	return c.listAlerts.CallUnary(ctx, req)
+}
+
+// DeleteAlert calls ntx.v1.AlertService.DeleteAlert.
+func (c *alertServiceClient) DeleteAlert(ctx context.Context, req *connect.Request[v1.DeleteAlertRequest]) (*connect.Response[v1.DeleteAlertResponse], error) {
+	return c.deleteAlert.CallUnary(ctx, req)
+}
+
+// AlertServiceHandler is an implementation of the ntx.v1.AlertService service.
+type AlertServiceHandler interface {
+	CreateAlert(context.Context, *connect.Request[v1.CreateAlertRequest]) (*connect.Response[v1.CreateAlertResponse], error)
+	ListAlerts(context.Context, *connect.Request[v1.ListAlertsRequest]) (*connect.Response[v1.ListAlertsResponse], error)
+	DeleteAlert(context.Context, *connect.Request[v1.DeleteAlertRequest]) (*connect.Response[v1.DeleteAlertResponse], error)
+}
+
+// NewAlertServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAlertServiceHandler(svc AlertServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	alertServiceMethods := v1.File_ntx_v1_alert_proto.Services().ByName("AlertService").Methods()
+	alertServiceCreateAlertHandler := connect.NewUnaryHandler(
+		AlertServiceCreateAlertProcedure,
+		svc.CreateAlert,
+		connect.WithSchema(alertServiceMethods.ByName("CreateAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceListAlertsHandler := connect.NewUnaryHandler(
+		AlertServiceListAlertsProcedure,
+		svc.ListAlerts,
+		connect.WithSchema(alertServiceMethods.ByName("ListAlerts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	alertServiceDeleteAlertHandler := connect.NewUnaryHandler(
+		AlertServiceDeleteAlertProcedure,
+		svc.DeleteAlert,
+		connect.WithSchema(alertServiceMethods.ByName("DeleteAlert")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ntx.v1.AlertService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AlertServiceCreateAlertProcedure:
+			alertServiceCreateAlertHandler.ServeHTTP(w, r)
+		case AlertServiceListAlertsProcedure:
+			alertServiceListAlertsHandler.ServeHTTP(w, r)
+		case AlertServiceDeleteAlertProcedure:
+			alertServiceDeleteAlertHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAlertServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAlertServiceHandler struct{}
+
+func (UnimplementedAlertServiceHandler) CreateAlert(context.Context, *connect.Request[v1.CreateAlertRequest]) (*connect.Response[v1.CreateAlertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AlertService.CreateAlert is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) ListAlerts(context.Context, *connect.Request[v1.ListAlertsRequest]) (*connect.Response[v1.ListAlertsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AlertService.ListAlerts is not implemented"))
+}
+
+func (UnimplementedAlertServiceHandler) DeleteAlert(context.Context, *connect.Request[v1.DeleteAlertRequest]) (*connect.Response[v1.DeleteAlertResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AlertService.DeleteAlert is not implemented"))
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ntx/v1/application.proto
+
+package ntxv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ApplicationServiceName is the fully-qualified name of the ApplicationService service.
+	ApplicationServiceName = "ntx.v1.ApplicationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ApplicationServiceCreateApplicationProcedure is the fully-qualified name of the
+	// ApplicationService's CreateApplication RPC.
+	ApplicationServiceCreateApplicationProcedure = "/ntx.v1.ApplicationService/CreateApplication"
+	// ApplicationServiceListApplicationsProcedure is the fully-qualified name of the
+	// ApplicationService's ListApplications RPC.
+	ApplicationServiceListApplicationsProcedure = "/ntx.v1.ApplicationService/ListApplications"
+	// ApplicationServiceWithdrawApplicationProcedure is the fully-qualified name of the
+	// ApplicationService's WithdrawApplication RPC.
+	ApplicationServiceWithdrawApplicationProcedure = "/ntx.v1.ApplicationService/WithdrawApplication"
+	// ApplicationServiceAllotApplicationProcedure is the fully-qualified name of the
+	// ApplicationService's AllotApplication RPC.
+	ApplicationServiceAllotApplicationProcedure = "/ntx.v1.ApplicationService/AllotApplication"
+	// ApplicationServiceRenounceRightsProcedure is the fully-qualified name of the ApplicationService's
+	// RenounceRights RPC.
+	ApplicationServiceRenounceRightsProcedure = "/ntx.v1.ApplicationService/RenounceRights"
+	// ApplicationServiceListRenunciationsProcedure is the fully-qualified name of the
+	// ApplicationService's ListRenunciations RPC.
+	ApplicationServiceListRenunciationsProcedure = "/ntx.v1.ApplicationService/ListRenunciations"
+	// ApplicationServiceSetRenunciationProceedsProcedure is the fully-qualified name of the
+	// ApplicationService's SetRenunciationProceeds RPC.
+	ApplicationServiceSetRenunciationProceedsProcedure = "/ntx.v1.ApplicationService/SetRenunciationProceeds"
+)
+
+// ApplicationServiceClient is a client for the ntx.v1.ApplicationService service.
+type ApplicationServiceClient interface {
+	CreateApplication(context.Context, *connect.Request[v1.CreateApplicationRequest]) (*connect.Response[v1.CreateApplicationResponse], error)
+	ListApplications(context.Context, *connect.Request[v1.ListApplicationsRequest]) (*connect.Response[v1.ListApplicationsResponse], error)
+	WithdrawApplication(context.Context, *connect.Request[v1.WithdrawApplicationRequest]) (*connect.Response[v1.WithdrawApplicationResponse], error)
+	AllotApplication(context.Context, *connect.Request[v1.AllotApplicationRequest]) (*connect.Response[v1.AllotApplicationResponse], error)
+	RenounceRights(context.Context, *connect.Request[v1.RenounceRightsRequest]) (*connect.Response[v1.RenounceRightsResponse], error)
+	ListRenunciations(context.Context, *connect.Request[v1.ListRenunciationsRequest]) (*connect.Response[v1.ListRenunciationsResponse], error)
+	SetRenunciationProceeds(context.Context, *connect.Request[v1.SetRenunciationProceedsRequest]) (*connect.Response[v1.SetRenunciationProceedsResponse], error)
+}
+
+// NewApplicationServiceClient constructs a client for the ntx.v1.ApplicationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewApplicationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ApplicationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	applicationServiceMethods := v1.File_ntx_v1_application_proto.Services().ByName("ApplicationService").Methods()
+	return &applicationServiceClient{
+		createApplication: connect.NewClient[v1.CreateApplicationRequest, v1.CreateApplicationResponse](
+			httpClient,
+			baseURL+ApplicationServiceCreateApplicationProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("CreateApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		listApplications: connect.NewClient[v1.ListApplicationsRequest, v1.ListApplicationsResponse](
+			httpClient,
+			baseURL+ApplicationServiceListApplicationsProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("ListApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		withdrawApplication: connect.NewClient[v1.WithdrawApplicationRequest, v1.WithdrawApplicationResponse](
+			httpClient,
+			baseURL+ApplicationServiceWithdrawApplicationProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("WithdrawApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		allotApplication: connect.NewClient[v1.AllotApplicationRequest, v1.AllotApplicationResponse](
+			httpClient,
+			baseURL+ApplicationServiceAllotApplicationProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("AllotApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		renounceRights: connect.NewClient[v1.RenounceRightsRequest, v1.RenounceRightsResponse](
+			httpClient,
+			baseURL+ApplicationServiceRenounceRightsProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("RenounceRights")),
+			connect.WithClientOptions(opts...),
+		),
+		listRenunciations: connect.NewClient[v1.ListRenunciationsRequest, v1.ListRenunciationsResponse](
+			httpClient,
+			baseURL+ApplicationServiceListRenunciationsProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("ListRenunciations")),
+			connect.WithClientOptions(opts...),
+		),
+		setRenunciationProceeds: connect.NewClient[v1.SetRenunciationProceedsRequest, v1.SetRenunciationProceedsResponse](
+			httpClient,
+			baseURL+ApplicationServiceSetRenunciationProceedsProcedure,
+			connect.WithSchema(applicationServiceMethods.ByName("SetRenunciationProceeds")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// applicationServiceClient implements ApplicationServiceClient.
+type applicationServiceClient struct {
+	createApplication       *connect.Client[v1.CreateApplicationRequest, v1.CreateApplicationResponse]
+	listApplications        *connect.Client[v1.ListApplicationsRequest, v1.ListApplicationsResponse]
+	withdrawApplication     *connect.Client[v1.WithdrawApplicationRequest, v1.WithdrawApplicationResponse]
+	allotApplication        *connect.Client[v1.AllotApplicationRequest, v1.AllotApplicationResponse]
+	renounceRights          *connect.Client[v1.RenounceRightsRequest, v1.RenounceRightsResponse]
+	listRenunciations       *connect.Client[v1.ListRenunciationsRequest, v1.ListRenunciationsResponse]
+	setRenunciationProceeds *connect.Client[v1.SetRenunciationProceedsRequest, v1.SetRenunciationProceedsResponse]
+}
+
+// CreateApplication calls ntx.v1.ApplicationService.CreateApplication.
+func (c *applicationServiceClient) CreateApplication(ctx context.Context, req *connect.Request[v1.CreateApplicationRequest]) (*connect.Response[v1.CreateApplicationResponse], error) {
+	return c.createApplication.CallUnary(ctx, req)
+}
+
+// ListApplications calls ntx.v1.ApplicationService.ListApplications.
+func (c *applicationServiceClient) ListApplications(ctx context.Context, req *connect.Request[v1.ListApplicationsRequest]) (*connect.Response[v1.ListApplicationsResponse], error) {
+	return c.listApplications.CallUnary(ctx, req)
+}
+
+// WithdrawApplication calls ntx.v1.ApplicationService.WithdrawApplication.
+func (c *applicationServiceClient) WithdrawApplication(ctx context.Context, req *connect.Request[v1.WithdrawApplicationRequest]) (*connect.Response[v1.WithdrawApplicationResponse], error) {
+	return c.withdrawApplication.CallUnary(ctx, req)
+}
+
+// AllotApplication calls ntx.v1.ApplicationService.AllotApplication.
+func (c *applicationServiceClient) AllotApplication(ctx context.Context, req *connect.Request[v1.AllotApplicationRequest]) (*connect.Response[v1.AllotApplicationResponse], error) {
+	return c.allotApplication.CallUnary(ctx, req)
+}
+
+// RenounceRights calls ntx.v1.ApplicationService.RenounceRights.
+func (c *applicationServiceClient) RenounceRights(ctx context.Context, req *connect.Request[v1.RenounceRightsRequest]) (*connect.Response[v1.RenounceRightsResponse], error) {
+	return c.renounceRights.CallUnary(ctx, req)
+}
+
+// ListRenunciations calls ntx.v1.ApplicationService.ListRenunciations.
+func (c *applicationServiceClient) ListRenunciations(ctx context.Context, req *connect.Request[v1.ListRenunciationsRequest]) (*connect.Response[v1.ListRenunciationsResponse], error) {
+	return c.listRenunciations.CallUnary(ctx, req)
+}
+
+// SetRenunciationProceeds calls ntx.v1.ApplicationService.SetRenunciationProceeds.
+func (c *applicationServiceClient) SetRenunciationProceeds(ctx context.Context, req *connect.Request[v1.SetRenunciationProceedsRequest]) (*connect.Response[v1.SetRenunciationProceedsResponse], error) {
+	return c.setRenunciationProceeds.CallUnary(ctx, req)
+}
+
+// ApplicationServiceHandler is an implementation of the ntx.v1.ApplicationService service.
+type ApplicationServiceHandler interface {
+	CreateApplication(context.Context, *connect.Request[v1.CreateApplicationRequest]) (*connect.Response[v1.CreateApplicationResponse], error)
+	ListApplications(context.Context, *connect.Request[v1.ListApplicationsRequest]) (*connect.Response[v1.ListApplicationsResponse], error)
+	WithdrawApplication(context.Context, *connect.Request[v1.WithdrawApplicationRequest]) (*connect.Response[v1.WithdrawApplicationResponse], error)
+	AllotApplication(context.Context, *connect.Request[v1.AllotApplicationRequest]) (*connect.Response[v1.AllotApplicationResponse], error)
+	RenounceRights(context.Context, *connect.Request[v1.RenounceRightsRequest]) (*connect.Response[v1.RenounceRightsResponse], error)
+	ListRenunciations(context.Context, *connect.Request[v1.ListRenunciationsRequest]) (*connect.Response[v1.ListRenunciationsResponse], error)
+	SetRenunciationProceeds(context.Context, *connect.Request[v1.SetRenunciationProceedsRequest]) (*connect.Response[v1.SetRenunciationProceedsResponse], error)
+}
+
+// NewApplicationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewApplicationServiceHandler(svc ApplicationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	applicationServiceMethods := v1.File_ntx_v1_application_proto.Services().ByName("ApplicationService").Methods()
+	applicationServiceCreateApplicationHandler := connect.NewUnaryHandler(
+		ApplicationServiceCreateApplicationProcedure,
+		svc.CreateApplication,
+		connect.WithSchema(applicationServiceMethods.ByName("CreateApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceListApplicationsHandler := connect.NewUnaryHandler(
+		ApplicationServiceListApplicationsProcedure,
+		svc.ListApplications,
+		connect.WithSchema(applicationServiceMethods.ByName("ListApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceWithdrawApplicationHandler := connect.NewUnaryHandler(
+		ApplicationServiceWithdrawApplicationProcedure,
+		svc.WithdrawApplication,
+		connect.WithSchema(applicationServiceMethods.ByName("WithdrawApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceAllotApplicationHandler := connect.NewUnaryHandler(
+		ApplicationServiceAllotApplicationProcedure,
+		svc.AllotApplication,
+		connect.WithSchema(applicationServiceMethods.ByName("AllotApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceRenounceRightsHandler := connect.NewUnaryHandler(
+		ApplicationServiceRenounceRightsProcedure,
+		svc.RenounceRights,
+		connect.WithSchema(applicationServiceMethods.ByName("RenounceRights")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceListRenunciationsHandler := connect.NewUnaryHandler(
+		ApplicationServiceListRenunciationsProcedure,
+		svc.ListRenunciations,
+		connect.WithSchema(applicationServiceMethods.ByName("ListRenunciations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	applicationServiceSetRenunciationProceedsHandler := connect.NewUnaryHandler(
+		ApplicationServiceSetRenunciationProceedsProcedure,
+		svc.SetRenunciationProceeds,
+		connect.WithSchema(applicationServiceMethods.ByName("SetRenunciationProceeds")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ntx.v1.ApplicationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ApplicationServiceCreateApplicationProcedure:
+			applicationServiceCreateApplicationHandler.ServeHTTP(w, r)
+		case ApplicationServiceListApplicationsProcedure:
+			applicationServiceListApplicationsHandler.ServeHTTP(w, r)
+		case ApplicationServiceWithdrawApplicationProcedure:
+			applicationServiceWithdrawApplicationHandler.ServeHTTP(w, r)
+		case ApplicationServiceAllotApplicationProcedure:
+			applicationServiceAllotApplicationHandler.ServeHTTP(w, r)
+		case ApplicationServiceRenounceRightsProcedure:
+			applicationServiceRenounceRightsHandler.ServeHTTP(w, r)
+		case ApplicationServiceListRenunciationsProcedure:
+			applicationServiceListRenunciationsHandler.ServeHTTP(w, r)
+		case ApplicationServiceSetRenunciationProceedsProcedure:
+			applicationServiceSetRenunciationProceedsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedApplicationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedApplicationServiceHandler struct{}
+
+func (UnimplementedApplicationServiceHandler) CreateApplication(context.Context, *connect.Request[v1.CreateApplicationRequest]) (*connect.Response[v1.CreateApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.CreateApplication is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) ListApplications(context.Context, *connect.Request[v1.ListApplicationsRequest]) (*connect.Response[v1.ListApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.ListApplications is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) WithdrawApplication(context.Context, *connect.Request[v1.WithdrawApplicationRequest]) (*connect.Response[v1.WithdrawApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.WithdrawApplication is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) AllotApplication(context.Context, *connect.Request[v1.AllotApplicationRequest]) (*connect.Response[v1.AllotApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.AllotApplication is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) RenounceRights(context.Context, *connect.Request[v1.RenounceRightsRequest]) (*connect.Response[v1.RenounceRightsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.RenounceRights is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) ListRenunciations(context.Context, *connect.Request[v1.ListRenunciationsRequest]) (*connect.Response[v1.ListRenunciationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.ListRenunciations is not implemented"))
+}
+
+func (UnimplementedApplicationServiceHandler) SetRenunciationProceeds(context.Context, *connect.Request[v1.SetRenunciationProceedsRequest]) (*connect.Response[v1.SetRenunciationProceedsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.ApplicationService.SetRenunciationProceeds is not implemented"))
+}
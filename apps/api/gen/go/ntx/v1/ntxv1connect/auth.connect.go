@@ -37,12 +37,39 @@ const (
 	AuthServiceLoginProcedure = "/ntx.v1.AuthService/Login"
 	// AuthServiceRegisterProcedure is the fully-qualified name of the AuthService's Register RPC.
 	AuthServiceRegisterProcedure = "/ntx.v1.AuthService/Register"
+	// AuthServiceCreateWidgetTokenProcedure is the fully-qualified name of the AuthService's
+	// CreateWidgetToken RPC.
+	AuthServiceCreateWidgetTokenProcedure = "/ntx.v1.AuthService/CreateWidgetToken"
+	// AuthServiceRevokeWidgetTokenProcedure is the fully-qualified name of the AuthService's
+	// RevokeWidgetToken RPC.
+	AuthServiceRevokeWidgetTokenProcedure = "/ntx.v1.AuthService/RevokeWidgetToken"
+	// AuthServiceListSessionsProcedure is the fully-qualified name of the AuthService's ListSessions
+	// RPC.
+	AuthServiceListSessionsProcedure = "/ntx.v1.AuthService/ListSessions"
+	// AuthServiceRevokeSessionProcedure is the fully-qualified name of the AuthService's RevokeSession
+	// RPC.
+	AuthServiceRevokeSessionProcedure = "/ntx.v1.AuthService/RevokeSession"
+	// AuthServiceBeginTotpEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// BeginTotpEnrollment RPC.
+	AuthServiceBeginTotpEnrollmentProcedure = "/ntx.v1.AuthService/BeginTotpEnrollment"
+	// AuthServiceConfirmTotpEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// ConfirmTotpEnrollment RPC.
+	AuthServiceConfirmTotpEnrollmentProcedure = "/ntx.v1.AuthService/ConfirmTotpEnrollment"
+	// AuthServiceDisableTotpProcedure is the fully-qualified name of the AuthService's DisableTotp RPC.
+	AuthServiceDisableTotpProcedure = "/ntx.v1.AuthService/DisableTotp"
 )
 
 // AuthServiceClient is a client for the ntx.v1.AuthService service.
 type AuthServiceClient interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	CreateWidgetToken(context.Context, *connect.Request[v1.CreateWidgetTokenRequest]) (*connect.Response[v1.CreateWidgetTokenResponse], error)
+	RevokeWidgetToken(context.Context, *connect.Request[v1.RevokeWidgetTokenRequest]) (*connect.Response[v1.RevokeWidgetTokenResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the ntx.v1.AuthService service. By default, it uses
@@ -68,13 +95,62 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("Register")),
 			connect.WithClientOptions(opts...),
 		),
+		createWidgetToken: connect.NewClient[v1.CreateWidgetTokenRequest, v1.CreateWidgetTokenResponse](
+			httpClient,
+			baseURL+AuthServiceCreateWidgetTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CreateWidgetToken")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeWidgetToken: connect.NewClient[v1.RevokeWidgetTokenRequest, v1.RevokeWidgetTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeWidgetTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeWidgetToken")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceListSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTotpEnrollment: connect.NewClient[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceBeginTotpEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTotpEnrollment: connect.NewClient[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmTotpEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTotp: connect.NewClient[v1.DisableTotpRequest, v1.DisableTotpResponse](
+			httpClient,
+			baseURL+AuthServiceDisableTotpProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DisableTotp")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	login    *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	register *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login                 *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	register              *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	createWidgetToken     *connect.Client[v1.CreateWidgetTokenRequest, v1.CreateWidgetTokenResponse]
+	revokeWidgetToken     *connect.Client[v1.RevokeWidgetTokenRequest, v1.RevokeWidgetTokenResponse]
+	listSessions          *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession         *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	beginTotpEnrollment   *connect.Client[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse]
+	confirmTotpEnrollment *connect.Client[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse]
+	disableTotp           *connect.Client[v1.DisableTotpRequest, v1.DisableTotpResponse]
 }
 
 // Login calls ntx.v1.AuthService.Login.
@@ -87,10 +163,52 @@ func (c *authServiceClient) Register(ctx context.Context, req *connect.Request[v
 	return c.register.CallUnary(ctx, req)
 }
 
+// CreateWidgetToken calls ntx.v1.AuthService.CreateWidgetToken.
+func (c *authServiceClient) CreateWidgetToken(ctx context.Context, req *connect.Request[v1.CreateWidgetTokenRequest]) (*connect.Response[v1.CreateWidgetTokenResponse], error) {
+	return c.createWidgetToken.CallUnary(ctx, req)
+}
+
+// RevokeWidgetToken calls ntx.v1.AuthService.RevokeWidgetToken.
+func (c *authServiceClient) RevokeWidgetToken(ctx context.Context, req *connect.Request[v1.RevokeWidgetTokenRequest]) (*connect.Response[v1.RevokeWidgetTokenResponse], error) {
+	return c.revokeWidgetToken.CallUnary(ctx, req)
+}
+
+// ListSessions calls ntx.v1.AuthService.ListSessions.
+func (c *authServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls ntx.v1.AuthService.RevokeSession.
+func (c *authServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// BeginTotpEnrollment calls ntx.v1.AuthService.BeginTotpEnrollment.
+func (c *authServiceClient) BeginTotpEnrollment(ctx context.Context, req *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return c.beginTotpEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTotpEnrollment calls ntx.v1.AuthService.ConfirmTotpEnrollment.
+func (c *authServiceClient) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return c.confirmTotpEnrollment.CallUnary(ctx, req)
+}
+
+// DisableTotp calls ntx.v1.AuthService.DisableTotp.
+func (c *authServiceClient) DisableTotp(ctx context.Context, req *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return c.disableTotp.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the ntx.v1.AuthService service.
 type AuthServiceHandler interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error)
+	CreateWidgetToken(context.Context, *connect.Request[v1.CreateWidgetTokenRequest]) (*connect.Response[v1.CreateWidgetTokenResponse], error)
+	RevokeWidgetToken(context.Context, *connect.Request[v1.RevokeWidgetTokenRequest]) (*connect.Response[v1.RevokeWidgetTokenResponse], error)
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -112,12 +230,68 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("Register")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceCreateWidgetTokenHandler := connect.NewUnaryHandler(
+		AuthServiceCreateWidgetTokenProcedure,
+		svc.CreateWidgetToken,
+		connect.WithSchema(authServiceMethods.ByName("CreateWidgetToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeWidgetTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeWidgetTokenProcedure,
+		svc.RevokeWidgetToken,
+		connect.WithSchema(authServiceMethods.ByName("RevokeWidgetToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceBeginTotpEnrollmentProcedure,
+		svc.BeginTotpEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("BeginTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmTotpEnrollmentProcedure,
+		svc.ConfirmTotpEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDisableTotpHandler := connect.NewUnaryHandler(
+		AuthServiceDisableTotpProcedure,
+		svc.DisableTotp,
+		connect.WithSchema(authServiceMethods.ByName("DisableTotp")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ntx.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthServiceLoginProcedure:
 			authServiceLoginHandler.ServeHTTP(w, r)
 		case AuthServiceRegisterProcedure:
 			authServiceRegisterHandler.ServeHTTP(w, r)
+		case AuthServiceCreateWidgetTokenProcedure:
+			authServiceCreateWidgetTokenHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeWidgetTokenProcedure:
+			authServiceRevokeWidgetTokenHandler.ServeHTTP(w, r)
+		case AuthServiceListSessionsProcedure:
+			authServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeSessionProcedure:
+			authServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case AuthServiceBeginTotpEnrollmentProcedure:
+			authServiceBeginTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmTotpEnrollmentProcedure:
+			authServiceConfirmTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceDisableTotpProcedure:
+			authServiceDisableTotpHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -134,3 +308,31 @@ func (UnimplementedAuthServiceHandler) Login(context.Context, *connect.Request[v
 func (UnimplementedAuthServiceHandler) Register(context.Context, *connect.Request[v1.RegisterRequest]) (*connect.Response[v1.RegisterResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.Register is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) CreateWidgetToken(context.Context, *connect.Request[v1.CreateWidgetTokenRequest]) (*connect.Response[v1.CreateWidgetTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.CreateWidgetToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeWidgetToken(context.Context, *connect.Request[v1.RevokeWidgetTokenRequest]) (*connect.Response[v1.RevokeWidgetTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.RevokeWidgetToken is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.BeginTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.ConfirmTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.AuthService.DisableTotp is not implemented"))
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ntx/v1/journal.proto
+
+package ntxv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JournalServiceName is the fully-qualified name of the JournalService service.
+	JournalServiceName = "ntx.v1.JournalService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JournalServiceCreateNoteProcedure is the fully-qualified name of the JournalService's CreateNote
+	// RPC.
+	JournalServiceCreateNoteProcedure = "/ntx.v1.JournalService/CreateNote"
+	// JournalServiceUpdateNoteProcedure is the fully-qualified name of the JournalService's UpdateNote
+	// RPC.
+	JournalServiceUpdateNoteProcedure = "/ntx.v1.JournalService/UpdateNote"
+	// JournalServiceListNotesProcedure is the fully-qualified name of the JournalService's ListNotes
+	// RPC.
+	JournalServiceListNotesProcedure = "/ntx.v1.JournalService/ListNotes"
+	// JournalServiceDeleteNoteProcedure is the fully-qualified name of the JournalService's DeleteNote
+	// RPC.
+	JournalServiceDeleteNoteProcedure = "/ntx.v1.JournalService/DeleteNote"
+	// JournalServiceExportNotesProcedure is the fully-qualified name of the JournalService's
+	// ExportNotes RPC.
+	JournalServiceExportNotesProcedure = "/ntx.v1.JournalService/ExportNotes"
+)
+
+// JournalServiceClient is a client for the ntx.v1.JournalService service.
+type JournalServiceClient interface {
+	CreateNote(context.Context, *connect.Request[v1.CreateNoteRequest]) (*connect.Response[v1.CreateNoteResponse], error)
+	UpdateNote(context.Context, *connect.Request[v1.UpdateNoteRequest]) (*connect.Response[v1.UpdateNoteResponse], error)
+	ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error)
+	DeleteNote(context.Context, *connect.Request[v1.DeleteNoteRequest]) (*connect.Response[v1.DeleteNoteResponse], error)
+	ExportNotes(context.Context, *connect.Request[v1.ExportNotesRequest]) (*connect.Response[v1.ExportNotesResponse], error)
+}
+
+// NewJournalServiceClient constructs a client for the ntx.v1.JournalService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJournalServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JournalServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	journalServiceMethods := v1.File_ntx_v1_journal_proto.Services().ByName("JournalService").Methods()
+	return &journalServiceClient{
+		createNote: connect.NewClient[v1.CreateNoteRequest, v1.CreateNoteResponse](
+			httpClient,
+			baseURL+JournalServiceCreateNoteProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("CreateNote")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNote: connect.NewClient[v1.UpdateNoteRequest, v1.UpdateNoteResponse](
+			httpClient,
+			baseURL+JournalServiceUpdateNoteProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("UpdateNote")),
+			connect.WithClientOptions(opts...),
+		),
+		listNotes: connect.NewClient[v1.ListNotesRequest, v1.ListNotesResponse](
+			httpClient,
+			baseURL+JournalServiceListNotesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ListNotes")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNote: connect.NewClient[v1.DeleteNoteRequest, v1.DeleteNoteResponse](
+			httpClient,
+			baseURL+JournalServiceDeleteNoteProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("DeleteNote")),
+			connect.WithClientOptions(opts...),
+		),
+		exportNotes: connect.NewClient[v1.ExportNotesRequest, v1.ExportNotesResponse](
+			httpClient,
+			baseURL+JournalServiceExportNotesProcedure,
+			connect.WithSchema(journalServiceMethods.ByName("ExportNotes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// journalServiceClient implements JournalServiceClient.
+type journalServiceClient struct {
+	createNote  *connect.Client[v1.CreateNoteRequest, v1.CreateNoteResponse]
+	updateNote  *connect.Client[v1.UpdateNoteRequest, v1.UpdateNoteResponse]
+	listNotes   *connect.Client[v1.ListNotesRequest, v1.ListNotesResponse]
+	deleteNote  *connect.Client[v1.DeleteNoteRequest, v1.DeleteNoteResponse]
+	exportNotes *connect.Client[v1.ExportNotesRequest, v1.ExportNotesResponse]
+}
+
+// CreateNote calls ntx.v1.JournalService.CreateNote.
+func (c *journalServiceClient) CreateNote(ctx context.Context, req *connect.Request[v1.CreateNoteRequest]) (*connect.Response[v1.CreateNoteResponse], error) {
+	return c.createNote.CallUnary(ctx, req)
+}
+
+// UpdateNote calls ntx.v1.JournalService.UpdateNote.
+func (c *journalServiceClient) UpdateNote(ctx context.Context, req *connect.Request[v1.UpdateNoteRequest]) (*connect.Response[v1.UpdateNoteResponse], error) {
+	return c.updateNote.CallUnary(ctx, req)
+}
+
+// ListNotes calls ntx.v1.JournalService.ListNotes.
+func (c *journalServiceClient) ListNotes(ctx context.Context, req *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error) {
+	return c.listNotes.CallUnary(ctx, req)
+}
+
+// DeleteNote calls ntx.v1.JournalService.DeleteNote.
+func (c *journalServiceClient) DeleteNote(ctx context.Context, req *connect.Request[v1.DeleteNoteRequest]) (*connect.Response[v1.DeleteNoteResponse], error) {
+	return c.deleteNote.CallUnary(ctx, req)
+}
+
+// ExportNotes calls ntx.v1.JournalService.ExportNotes.
+func (c *journalServiceClient) ExportNotes(ctx context.Context, req *connect.Request[v1.ExportNotesRequest]) (*connect.Response[v1.ExportNotesResponse], error) {
+	return c.exportNotes.CallUnary(ctx, req)
+}
+
+// JournalServiceHandler is an implementation of the ntx.v1.JournalService service.
+type JournalServiceHandler interface {
+	CreateNote(context.Context, *connect.Request[v1.CreateNoteRequest]) (*connect.Response[v1.CreateNoteResponse], error)
+	UpdateNote(context.Context, *connect.Request[v1.UpdateNoteRequest]) (*connect.Response[v1.UpdateNoteResponse], error)
+	ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error)
+	DeleteNote(context.Context, *connect.Request[v1.DeleteNoteRequest]) (*connect.Response[v1.DeleteNoteResponse], error)
+	ExportNotes(context.Context, *connect.Request[v1.ExportNotesRequest]) (*connect.Response[v1.ExportNotesResponse], error)
+}
+
+// NewJournalServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJournalServiceHandler(svc JournalServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	journalServiceMethods := v1.File_ntx_v1_journal_proto.Services().ByName("JournalService").Methods()
+	journalServiceCreateNoteHandler := connect.NewUnaryHandler(
+		JournalServiceCreateNoteProcedure,
+		svc.CreateNote,
+		connect.WithSchema(journalServiceMethods.ByName("CreateNote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceUpdateNoteHandler := connect.NewUnaryHandler(
+		JournalServiceUpdateNoteProcedure,
+		svc.UpdateNote,
+		connect.WithSchema(journalServiceMethods.ByName("UpdateNote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceListNotesHandler := connect.NewUnaryHandler(
+		JournalServiceListNotesProcedure,
+		svc.ListNotes,
+		connect.WithSchema(journalServiceMethods.ByName("ListNotes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceDeleteNoteHandler := connect.NewUnaryHandler(
+		JournalServiceDeleteNoteProcedure,
+		svc.DeleteNote,
+		connect.WithSchema(journalServiceMethods.ByName("DeleteNote")),
+		connect.WithHandlerOptions(opts...),
+	)
+	journalServiceExportNotesHandler := connect.NewUnaryHandler(
+		JournalServiceExportNotesProcedure,
+		svc.ExportNotes,
+		connect.WithSchema(journalServiceMethods.ByName("ExportNotes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ntx.v1.JournalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JournalServiceCreateNoteProcedure:
+			journalServiceCreateNoteHandler.ServeHTTP(w, r)
+		case JournalServiceUpdateNoteProcedure:
+			journalServiceUpdateNoteHandler.ServeHTTP(w, r)
+		case JournalServiceListNotesProcedure:
+			journalServiceListNotesHandler.ServeHTTP(w, r)
+		case JournalServiceDeleteNoteProcedure:
+			journalServiceDeleteNoteHandler.ServeHTTP(w, r)
+		case JournalServiceExportNotesProcedure:
+			journalServiceExportNotesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJournalServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJournalServiceHandler struct{}
+
+func (UnimplementedJournalServiceHandler) CreateNote(context.Context, *connect.Request[v1.CreateNoteRequest]) (*connect.Response[v1.CreateNoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.JournalService.CreateNote is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) UpdateNote(context.Context, *connect.Request[v1.UpdateNoteRequest]) (*connect.Response[v1.UpdateNoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.JournalService.UpdateNote is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ListNotes(context.Context, *connect.Request[v1.ListNotesRequest]) (*connect.Response[v1.ListNotesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.JournalService.ListNotes is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) DeleteNote(context.Context, *connect.Request[v1.DeleteNoteRequest]) (*connect.Response[v1.DeleteNoteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.JournalService.DeleteNote is not implemented"))
+}
+
+func (UnimplementedJournalServiceHandler) ExportNotes(context.Context, *connect.Request[v1.ExportNotesRequest]) (*connect.Response[v1.ExportNotesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.JournalService.ExportNotes is not implemented"))
+}
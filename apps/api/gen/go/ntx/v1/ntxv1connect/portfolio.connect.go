@@ -39,6 +39,9 @@ const (
 	// PortfolioServiceCreatePortfolioProcedure is the fully-qualified name of the PortfolioService's
 	// CreatePortfolio RPC.
 	PortfolioServiceCreatePortfolioProcedure = "/ntx.v1.PortfolioService/CreatePortfolio"
+	// PortfolioServiceDeletePortfolioProcedure is the fully-qualified name of the PortfolioService's
+	// DeletePortfolio RPC.
+	PortfolioServiceDeletePortfolioProcedure = "/ntx.v1.PortfolioService/DeletePortfolio"
 	// PortfolioServiceAddTransactionProcedure is the fully-qualified name of the PortfolioService's
 	// AddTransaction RPC.
 	PortfolioServiceAddTransactionProcedure = "/ntx.v1.PortfolioService/AddTransaction"
@@ -51,16 +54,189 @@ const (
 	// PortfolioServiceGetPortfolioSummaryProcedure is the fully-qualified name of the
 	// PortfolioService's GetPortfolioSummary RPC.
 	PortfolioServiceGetPortfolioSummaryProcedure = "/ntx.v1.PortfolioService/GetPortfolioSummary"
+	// PortfolioServiceListHoldingsProcedure is the fully-qualified name of the PortfolioService's
+	// ListHoldings RPC.
+	PortfolioServiceListHoldingsProcedure = "/ntx.v1.PortfolioService/ListHoldings"
+	// PortfolioServiceGetPortfolioHistoryProcedure is the fully-qualified name of the
+	// PortfolioService's GetPortfolioHistory RPC.
+	PortfolioServiceGetPortfolioHistoryProcedure = "/ntx.v1.PortfolioService/GetPortfolioHistory"
+	// PortfolioServiceGetConsolidatedSummaryProcedure is the fully-qualified name of the
+	// PortfolioService's GetConsolidatedSummary RPC.
+	PortfolioServiceGetConsolidatedSummaryProcedure = "/ntx.v1.PortfolioService/GetConsolidatedSummary"
+	// PortfolioServiceListLotsProcedure is the fully-qualified name of the PortfolioService's ListLots
+	// RPC.
+	PortfolioServiceListLotsProcedure = "/ntx.v1.PortfolioService/ListLots"
+	// PortfolioServiceImportTransactionsProcedure is the fully-qualified name of the PortfolioService's
+	// ImportTransactions RPC.
+	PortfolioServiceImportTransactionsProcedure = "/ntx.v1.PortfolioService/ImportTransactions"
+	// PortfolioServiceExportTransactionsProcedure is the fully-qualified name of the PortfolioService's
+	// ExportTransactions RPC.
+	PortfolioServiceExportTransactionsProcedure = "/ntx.v1.PortfolioService/ExportTransactions"
+	// PortfolioServiceGetAttributionProcedure is the fully-qualified name of the PortfolioService's
+	// GetAttribution RPC.
+	PortfolioServiceGetAttributionProcedure = "/ntx.v1.PortfolioService/GetAttribution"
+	// PortfolioServiceProjectPortfolioProcedure is the fully-qualified name of the PortfolioService's
+	// ProjectPortfolio RPC.
+	PortfolioServiceProjectPortfolioProcedure = "/ntx.v1.PortfolioService/ProjectPortfolio"
+	// PortfolioServiceRunScenarioProcedure is the fully-qualified name of the PortfolioService's
+	// RunScenario RPC.
+	PortfolioServiceRunScenarioProcedure = "/ntx.v1.PortfolioService/RunScenario"
+	// PortfolioServiceCalculatePositionSizeProcedure is the fully-qualified name of the
+	// PortfolioService's CalculatePositionSize RPC.
+	PortfolioServiceCalculatePositionSizeProcedure = "/ntx.v1.PortfolioService/CalculatePositionSize"
+	// PortfolioServiceCreateTagProcedure is the fully-qualified name of the PortfolioService's
+	// CreateTag RPC.
+	PortfolioServiceCreateTagProcedure = "/ntx.v1.PortfolioService/CreateTag"
+	// PortfolioServiceListTagsProcedure is the fully-qualified name of the PortfolioService's ListTags
+	// RPC.
+	PortfolioServiceListTagsProcedure = "/ntx.v1.PortfolioService/ListTags"
+	// PortfolioServiceRenameTagProcedure is the fully-qualified name of the PortfolioService's
+	// RenameTag RPC.
+	PortfolioServiceRenameTagProcedure = "/ntx.v1.PortfolioService/RenameTag"
+	// PortfolioServiceDeleteTagProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteTag RPC.
+	PortfolioServiceDeleteTagProcedure = "/ntx.v1.PortfolioService/DeleteTag"
+	// PortfolioServiceSetTransactionTagsProcedure is the fully-qualified name of the PortfolioService's
+	// SetTransactionTags RPC.
+	PortfolioServiceSetTransactionTagsProcedure = "/ntx.v1.PortfolioService/SetTransactionTags"
+	// PortfolioServiceGetTagPerformanceProcedure is the fully-qualified name of the PortfolioService's
+	// GetTagPerformance RPC.
+	PortfolioServiceGetTagPerformanceProcedure = "/ntx.v1.PortfolioService/GetTagPerformance"
+	// PortfolioServiceCreateBrokerAccountProcedure is the fully-qualified name of the
+	// PortfolioService's CreateBrokerAccount RPC.
+	PortfolioServiceCreateBrokerAccountProcedure = "/ntx.v1.PortfolioService/CreateBrokerAccount"
+	// PortfolioServiceListBrokerAccountsProcedure is the fully-qualified name of the PortfolioService's
+	// ListBrokerAccounts RPC.
+	PortfolioServiceListBrokerAccountsProcedure = "/ntx.v1.PortfolioService/ListBrokerAccounts"
+	// PortfolioServiceDeleteBrokerAccountProcedure is the fully-qualified name of the
+	// PortfolioService's DeleteBrokerAccount RPC.
+	PortfolioServiceDeleteBrokerAccountProcedure = "/ntx.v1.PortfolioService/DeleteBrokerAccount"
+	// PortfolioServiceSetTransactionBrokerProcedure is the fully-qualified name of the
+	// PortfolioService's SetTransactionBroker RPC.
+	PortfolioServiceSetTransactionBrokerProcedure = "/ntx.v1.PortfolioService/SetTransactionBroker"
+	// PortfolioServiceGetBrokerCommissionsProcedure is the fully-qualified name of the
+	// PortfolioService's GetBrokerCommissions RPC.
+	PortfolioServiceGetBrokerCommissionsProcedure = "/ntx.v1.PortfolioService/GetBrokerCommissions"
+	// PortfolioServiceCreateProfileProcedure is the fully-qualified name of the PortfolioService's
+	// CreateProfile RPC.
+	PortfolioServiceCreateProfileProcedure = "/ntx.v1.PortfolioService/CreateProfile"
+	// PortfolioServiceListProfilesProcedure is the fully-qualified name of the PortfolioService's
+	// ListProfiles RPC.
+	PortfolioServiceListProfilesProcedure = "/ntx.v1.PortfolioService/ListProfiles"
+	// PortfolioServiceDeleteProfileProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteProfile RPC.
+	PortfolioServiceDeleteProfileProcedure = "/ntx.v1.PortfolioService/DeleteProfile"
+	// PortfolioServiceSetPortfolioProfileProcedure is the fully-qualified name of the
+	// PortfolioService's SetPortfolioProfile RPC.
+	PortfolioServiceSetPortfolioProfileProcedure = "/ntx.v1.PortfolioService/SetPortfolioProfile"
+	// PortfolioServiceSetHoldingCostProcedure is the fully-qualified name of the PortfolioService's
+	// SetHoldingCost RPC.
+	PortfolioServiceSetHoldingCostProcedure = "/ntx.v1.PortfolioService/SetHoldingCost"
+	// PortfolioServiceClearHoldingCostProcedure is the fully-qualified name of the PortfolioService's
+	// ClearHoldingCost RPC.
+	PortfolioServiceClearHoldingCostProcedure = "/ntx.v1.PortfolioService/ClearHoldingCost"
+	// PortfolioServiceGetCostReconciliationProcedure is the fully-qualified name of the
+	// PortfolioService's GetCostReconciliation RPC.
+	PortfolioServiceGetCostReconciliationProcedure = "/ntx.v1.PortfolioService/GetCostReconciliation"
+	// PortfolioServiceExportCostBasisProcedure is the fully-qualified name of the PortfolioService's
+	// ExportCostBasis RPC.
+	PortfolioServiceExportCostBasisProcedure = "/ntx.v1.PortfolioService/ExportCostBasis"
+	// PortfolioServiceGetBonusExpectationsProcedure is the fully-qualified name of the
+	// PortfolioService's GetBonusExpectations RPC.
+	PortfolioServiceGetBonusExpectationsProcedure = "/ntx.v1.PortfolioService/GetBonusExpectations"
+	// PortfolioServiceGetIncomeSummaryProcedure is the fully-qualified name of the PortfolioService's
+	// GetIncomeSummary RPC.
+	PortfolioServiceGetIncomeSummaryProcedure = "/ntx.v1.PortfolioService/GetIncomeSummary"
+	// PortfolioServiceSetBondTermsProcedure is the fully-qualified name of the PortfolioService's
+	// SetBondTerms RPC.
+	PortfolioServiceSetBondTermsProcedure = "/ntx.v1.PortfolioService/SetBondTerms"
+	// PortfolioServiceClearBondTermsProcedure is the fully-qualified name of the PortfolioService's
+	// ClearBondTerms RPC.
+	PortfolioServiceClearBondTermsProcedure = "/ntx.v1.PortfolioService/ClearBondTerms"
+	// PortfolioServiceGetBondScheduleProcedure is the fully-qualified name of the PortfolioService's
+	// GetBondSchedule RPC.
+	PortfolioServiceGetBondScheduleProcedure = "/ntx.v1.PortfolioService/GetBondSchedule"
+	// PortfolioServiceCreateHoldingGroupProcedure is the fully-qualified name of the PortfolioService's
+	// CreateHoldingGroup RPC.
+	PortfolioServiceCreateHoldingGroupProcedure = "/ntx.v1.PortfolioService/CreateHoldingGroup"
+	// PortfolioServiceListHoldingGroupsProcedure is the fully-qualified name of the PortfolioService's
+	// ListHoldingGroups RPC.
+	PortfolioServiceListHoldingGroupsProcedure = "/ntx.v1.PortfolioService/ListHoldingGroups"
+	// PortfolioServiceUpdateHoldingGroupProcedure is the fully-qualified name of the PortfolioService's
+	// UpdateHoldingGroup RPC.
+	PortfolioServiceUpdateHoldingGroupProcedure = "/ntx.v1.PortfolioService/UpdateHoldingGroup"
+	// PortfolioServiceDeleteHoldingGroupProcedure is the fully-qualified name of the PortfolioService's
+	// DeleteHoldingGroup RPC.
+	PortfolioServiceDeleteHoldingGroupProcedure = "/ntx.v1.PortfolioService/DeleteHoldingGroup"
+	// PortfolioServiceGroupHoldingsProcedure is the fully-qualified name of the PortfolioService's
+	// GroupHoldings RPC.
+	PortfolioServiceGroupHoldingsProcedure = "/ntx.v1.PortfolioService/GroupHoldings"
+	// PortfolioServiceGetTimelineProcedure is the fully-qualified name of the PortfolioService's
+	// GetTimeline RPC.
+	PortfolioServiceGetTimelineProcedure = "/ntx.v1.PortfolioService/GetTimeline"
+	// PortfolioServiceGetYearComparisonProcedure is the fully-qualified name of the PortfolioService's
+	// GetYearComparison RPC.
+	PortfolioServiceGetYearComparisonProcedure = "/ntx.v1.PortfolioService/GetYearComparison"
+	// PortfolioServiceGetGrowthBreakdownProcedure is the fully-qualified name of the PortfolioService's
+	// GetGrowthBreakdown RPC.
+	PortfolioServiceGetGrowthBreakdownProcedure = "/ntx.v1.PortfolioService/GetGrowthBreakdown"
+	// PortfolioServiceGetTaxReportProcedure is the fully-qualified name of the PortfolioService's
+	// GetTaxReport RPC.
+	PortfolioServiceGetTaxReportProcedure = "/ntx.v1.PortfolioService/GetTaxReport"
 )
 
 // PortfolioServiceClient is a client for the ntx.v1.PortfolioService service.
 type PortfolioServiceClient interface {
 	ListPortfolios(context.Context, *connect.Request[v1.ListPortfoliosRequest]) (*connect.Response[v1.ListPortfoliosResponse], error)
 	CreatePortfolio(context.Context, *connect.Request[v1.CreatePortfolioRequest]) (*connect.Response[v1.CreatePortfolioResponse], error)
+	DeletePortfolio(context.Context, *connect.Request[v1.DeletePortfolioRequest]) (*connect.Response[v1.DeletePortfolioResponse], error)
 	AddTransaction(context.Context, *connect.Request[v1.AddTransactionRequest]) (*connect.Response[v1.AddTransactionResponse], error)
 	ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error)
 	DeleteTransaction(context.Context, *connect.Request[v1.DeleteTransactionRequest]) (*connect.Response[v1.DeleteTransactionResponse], error)
 	GetPortfolioSummary(context.Context, *connect.Request[v1.GetPortfolioSummaryRequest]) (*connect.Response[v1.GetPortfolioSummaryResponse], error)
+	ListHoldings(context.Context, *connect.Request[v1.ListHoldingsRequest]) (*connect.Response[v1.ListHoldingsResponse], error)
+	GetPortfolioHistory(context.Context, *connect.Request[v1.GetPortfolioHistoryRequest]) (*connect.Response[v1.GetPortfolioHistoryResponse], error)
+	GetConsolidatedSummary(context.Context, *connect.Request[v1.GetConsolidatedSummaryRequest]) (*connect.Response[v1.GetConsolidatedSummaryResponse], error)
+	ListLots(context.Context, *connect.Request[v1.ListLotsRequest]) (*connect.Response[v1.ListLotsResponse], error)
+	ImportTransactions(context.Context, *connect.Request[v1.ImportTransactionsRequest]) (*connect.Response[v1.ImportTransactionsResponse], error)
+	ExportTransactions(context.Context, *connect.Request[v1.ExportTransactionsRequest]) (*connect.Response[v1.ExportTransactionsResponse], error)
+	GetAttribution(context.Context, *connect.Request[v1.GetAttributionRequest]) (*connect.Response[v1.GetAttributionResponse], error)
+	ProjectPortfolio(context.Context, *connect.Request[v1.ProjectPortfolioRequest]) (*connect.Response[v1.ProjectPortfolioResponse], error)
+	RunScenario(context.Context, *connect.Request[v1.RunScenarioRequest]) (*connect.Response[v1.RunScenarioResponse], error)
+	CalculatePositionSize(context.Context, *connect.Request[v1.CalculatePositionSizeRequest]) (*connect.Response[v1.CalculatePositionSizeResponse], error)
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	SetTransactionTags(context.Context, *connect.Request[v1.SetTransactionTagsRequest]) (*connect.Response[v1.SetTransactionTagsResponse], error)
+	GetTagPerformance(context.Context, *connect.Request[v1.GetTagPerformanceRequest]) (*connect.Response[v1.GetTagPerformanceResponse], error)
+	CreateBrokerAccount(context.Context, *connect.Request[v1.CreateBrokerAccountRequest]) (*connect.Response[v1.CreateBrokerAccountResponse], error)
+	ListBrokerAccounts(context.Context, *connect.Request[v1.ListBrokerAccountsRequest]) (*connect.Response[v1.ListBrokerAccountsResponse], error)
+	DeleteBrokerAccount(context.Context, *connect.Request[v1.DeleteBrokerAccountRequest]) (*connect.Response[v1.DeleteBrokerAccountResponse], error)
+	SetTransactionBroker(context.Context, *connect.Request[v1.SetTransactionBrokerRequest]) (*connect.Response[v1.SetTransactionBrokerResponse], error)
+	GetBrokerCommissions(context.Context, *connect.Request[v1.GetBrokerCommissionsRequest]) (*connect.Response[v1.GetBrokerCommissionsResponse], error)
+	CreateProfile(context.Context, *connect.Request[v1.CreateProfileRequest]) (*connect.Response[v1.CreateProfileResponse], error)
+	ListProfiles(context.Context, *connect.Request[v1.ListProfilesRequest]) (*connect.Response[v1.ListProfilesResponse], error)
+	DeleteProfile(context.Context, *connect.Request[v1.DeleteProfileRequest]) (*connect.Response[v1.DeleteProfileResponse], error)
+	SetPortfolioProfile(context.Context, *connect.Request[v1.SetPortfolioProfileRequest]) (*connect.Response[v1.SetPortfolioProfileResponse], error)
+	SetHoldingCost(context.Context, *connect.Request[v1.SetHoldingCostRequest]) (*connect.Response[v1.SetHoldingCostResponse], error)
+	ClearHoldingCost(context.Context, *connect.Request[v1.ClearHoldingCostRequest]) (*connect.Response[v1.ClearHoldingCostResponse], error)
+	GetCostReconciliation(context.Context, *connect.Request[v1.GetCostReconciliationRequest]) (*connect.Response[v1.GetCostReconciliationResponse], error)
+	ExportCostBasis(context.Context, *connect.Request[v1.ExportCostBasisRequest]) (*connect.Response[v1.ExportCostBasisResponse], error)
+	GetBonusExpectations(context.Context, *connect.Request[v1.GetBonusExpectationsRequest]) (*connect.Response[v1.GetBonusExpectationsResponse], error)
+	GetIncomeSummary(context.Context, *connect.Request[v1.GetIncomeSummaryRequest]) (*connect.Response[v1.GetIncomeSummaryResponse], error)
+	SetBondTerms(context.Context, *connect.Request[v1.SetBondTermsRequest]) (*connect.Response[v1.SetBondTermsResponse], error)
+	ClearBondTerms(context.Context, *connect.Request[v1.ClearBondTermsRequest]) (*connect.Response[v1.ClearBondTermsResponse], error)
+	GetBondSchedule(context.Context, *connect.Request[v1.GetBondScheduleRequest]) (*connect.Response[v1.GetBondScheduleResponse], error)
+	CreateHoldingGroup(context.Context, *connect.Request[v1.CreateHoldingGroupRequest]) (*connect.Response[v1.CreateHoldingGroupResponse], error)
+	ListHoldingGroups(context.Context, *connect.Request[v1.ListHoldingGroupsRequest]) (*connect.Response[v1.ListHoldingGroupsResponse], error)
+	UpdateHoldingGroup(context.Context, *connect.Request[v1.UpdateHoldingGroupRequest]) (*connect.Response[v1.UpdateHoldingGroupResponse], error)
+	DeleteHoldingGroup(context.Context, *connect.Request[v1.DeleteHoldingGroupRequest]) (*connect.Response[v1.DeleteHoldingGroupResponse], error)
+	GroupHoldings(context.Context, *connect.Request[v1.GroupHoldingsRequest]) (*connect.Response[v1.GroupHoldingsResponse], error)
+	GetTimeline(context.Context, *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error)
+	GetYearComparison(context.Context, *connect.Request[v1.GetYearComparisonRequest]) (*connect.Response[v1.GetYearComparisonResponse], error)
+	GetGrowthBreakdown(context.Context, *connect.Request[v1.GetGrowthBreakdownRequest]) (*connect.Response[v1.GetGrowthBreakdownResponse], error)
+	GetTaxReport(context.Context, *connect.Request[v1.GetTaxReportRequest]) (*connect.Response[v1.GetTaxReportResponse], error)
 }
 
 // NewPortfolioServiceClient constructs a client for the ntx.v1.PortfolioService service. By
@@ -86,6 +262,12 @@ func NewPortfolioServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(portfolioServiceMethods.ByName("CreatePortfolio")),
 			connect.WithClientOptions(opts...),
 		),
+		deletePortfolio: connect.NewClient[v1.DeletePortfolioRequest, v1.DeletePortfolioResponse](
+			httpClient,
+			baseURL+PortfolioServiceDeletePortfolioProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("DeletePortfolio")),
+			connect.WithClientOptions(opts...),
+		),
 		addTransaction: connect.NewClient[v1.AddTransactionRequest, v1.AddTransactionResponse](
 			httpClient,
 			baseURL+PortfolioServiceAddTransactionProcedure,
@@ -110,17 +292,319 @@ func NewPortfolioServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(portfolioServiceMethods.ByName("GetPortfolioSummary")),
 			connect.WithClientOptions(opts...),
 		),
+		listHoldings: connect.NewClient[v1.ListHoldingsRequest, v1.ListHoldingsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListHoldingsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListHoldings")),
+			connect.WithClientOptions(opts...),
+		),
+		getPortfolioHistory: connect.NewClient[v1.GetPortfolioHistoryRequest, v1.GetPortfolioHistoryResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetPortfolioHistoryProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetPortfolioHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getConsolidatedSummary: connect.NewClient[v1.GetConsolidatedSummaryRequest, v1.GetConsolidatedSummaryResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetConsolidatedSummaryProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetConsolidatedSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		listLots: connect.NewClient[v1.ListLotsRequest, v1.ListLotsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListLotsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListLots")),
+			connect.WithClientOptions(opts...),
+		),
+		importTransactions: connect.NewClient[v1.ImportTransactionsRequest, v1.ImportTransactionsResponse](
+			httpClient,
+			baseURL+PortfolioServiceImportTransactionsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ImportTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		exportTransactions: connect.NewClient[v1.ExportTransactionsRequest, v1.ExportTransactionsResponse](
+			httpClient,
+			baseURL+PortfolioServiceExportTransactionsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ExportTransactions")),
+			connect.WithClientOptions(opts...),
+		),
+		getAttribution: connect.NewClient[v1.GetAttributionRequest, v1.GetAttributionResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetAttributionProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetAttribution")),
+			connect.WithClientOptions(opts...),
+		),
+		projectPortfolio: connect.NewClient[v1.ProjectPortfolioRequest, v1.ProjectPortfolioResponse](
+			httpClient,
+			baseURL+PortfolioServiceProjectPortfolioProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ProjectPortfolio")),
+			connect.WithClientOptions(opts...),
+		),
+		runScenario: connect.NewClient[v1.RunScenarioRequest, v1.RunScenarioResponse](
+			httpClient,
+			baseURL+PortfolioServiceRunScenarioProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("RunScenario")),
+			connect.WithClientOptions(opts...),
+		),
+		calculatePositionSize: connect.NewClient[v1.CalculatePositionSizeRequest, v1.CalculatePositionSizeResponse](
+			httpClient,
+			baseURL+PortfolioServiceCalculatePositionSizeProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("CalculatePositionSize")),
+			connect.WithClientOptions(opts...),
+		),
+		createTag: connect.NewClient[v1.CreateTagRequest, v1.CreateTagResponse](
+			httpClient,
+			baseURL+PortfolioServiceCreateTagProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("CreateTag")),
+			connect.WithClientOptions(opts...),
+		),
+		listTags: connect.NewClient[v1.ListTagsRequest, v1.ListTagsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListTagsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListTags")),
+			connect.WithClientOptions(opts...),
+		),
+		renameTag: connect.NewClient[v1.RenameTagRequest, v1.RenameTagResponse](
+			httpClient,
+			baseURL+PortfolioServiceRenameTagProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("RenameTag")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTag: connect.NewClient[v1.DeleteTagRequest, v1.DeleteTagResponse](
+			httpClient,
+			baseURL+PortfolioServiceDeleteTagProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("DeleteTag")),
+			connect.WithClientOptions(opts...),
+		),
+		setTransactionTags: connect.NewClient[v1.SetTransactionTagsRequest, v1.SetTransactionTagsResponse](
+			httpClient,
+			baseURL+PortfolioServiceSetTransactionTagsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("SetTransactionTags")),
+			connect.WithClientOptions(opts...),
+		),
+		getTagPerformance: connect.NewClient[v1.GetTagPerformanceRequest, v1.GetTagPerformanceResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetTagPerformanceProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetTagPerformance")),
+			connect.WithClientOptions(opts...),
+		),
+		createBrokerAccount: connect.NewClient[v1.CreateBrokerAccountRequest, v1.CreateBrokerAccountResponse](
+			httpClient,
+			baseURL+PortfolioServiceCreateBrokerAccountProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("CreateBrokerAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		listBrokerAccounts: connect.NewClient[v1.ListBrokerAccountsRequest, v1.ListBrokerAccountsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListBrokerAccountsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListBrokerAccounts")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteBrokerAccount: connect.NewClient[v1.DeleteBrokerAccountRequest, v1.DeleteBrokerAccountResponse](
+			httpClient,
+			baseURL+PortfolioServiceDeleteBrokerAccountProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("DeleteBrokerAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		setTransactionBroker: connect.NewClient[v1.SetTransactionBrokerRequest, v1.SetTransactionBrokerResponse](
+			httpClient,
+			baseURL+PortfolioServiceSetTransactionBrokerProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("SetTransactionBroker")),
+			connect.WithClientOptions(opts...),
+		),
+		getBrokerCommissions: connect.NewClient[v1.GetBrokerCommissionsRequest, v1.GetBrokerCommissionsResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetBrokerCommissionsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetBrokerCommissions")),
+			connect.WithClientOptions(opts...),
+		),
+		createProfile: connect.NewClient[v1.CreateProfileRequest, v1.CreateProfileResponse](
+			httpClient,
+			baseURL+PortfolioServiceCreateProfileProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("CreateProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		listProfiles: connect.NewClient[v1.ListProfilesRequest, v1.ListProfilesResponse](
+			httpClient,
+			baseURL+PortfolioServiceListProfilesProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListProfiles")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteProfile: connect.NewClient[v1.DeleteProfileRequest, v1.DeleteProfileResponse](
+			httpClient,
+			baseURL+PortfolioServiceDeleteProfileProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("DeleteProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		setPortfolioProfile: connect.NewClient[v1.SetPortfolioProfileRequest, v1.SetPortfolioProfileResponse](
+			httpClient,
+			baseURL+PortfolioServiceSetPortfolioProfileProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("SetPortfolioProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		setHoldingCost: connect.NewClient[v1.SetHoldingCostRequest, v1.SetHoldingCostResponse](
+			httpClient,
+			baseURL+PortfolioServiceSetHoldingCostProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("SetHoldingCost")),
+			connect.WithClientOptions(opts...),
+		),
+		clearHoldingCost: connect.NewClient[v1.ClearHoldingCostRequest, v1.ClearHoldingCostResponse](
+			httpClient,
+			baseURL+PortfolioServiceClearHoldingCostProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ClearHoldingCost")),
+			connect.WithClientOptions(opts...),
+		),
+		getCostReconciliation: connect.NewClient[v1.GetCostReconciliationRequest, v1.GetCostReconciliationResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetCostReconciliationProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetCostReconciliation")),
+			connect.WithClientOptions(opts...),
+		),
+		exportCostBasis: connect.NewClient[v1.ExportCostBasisRequest, v1.ExportCostBasisResponse](
+			httpClient,
+			baseURL+PortfolioServiceExportCostBasisProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ExportCostBasis")),
+			connect.WithClientOptions(opts...),
+		),
+		getBonusExpectations: connect.NewClient[v1.GetBonusExpectationsRequest, v1.GetBonusExpectationsResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetBonusExpectationsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetBonusExpectations")),
+			connect.WithClientOptions(opts...),
+		),
+		getIncomeSummary: connect.NewClient[v1.GetIncomeSummaryRequest, v1.GetIncomeSummaryResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetIncomeSummaryProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetIncomeSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		setBondTerms: connect.NewClient[v1.SetBondTermsRequest, v1.SetBondTermsResponse](
+			httpClient,
+			baseURL+PortfolioServiceSetBondTermsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("SetBondTerms")),
+			connect.WithClientOptions(opts...),
+		),
+		clearBondTerms: connect.NewClient[v1.ClearBondTermsRequest, v1.ClearBondTermsResponse](
+			httpClient,
+			baseURL+PortfolioServiceClearBondTermsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ClearBondTerms")),
+			connect.WithClientOptions(opts...),
+		),
+		getBondSchedule: connect.NewClient[v1.GetBondScheduleRequest, v1.GetBondScheduleResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetBondScheduleProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetBondSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		createHoldingGroup: connect.NewClient[v1.CreateHoldingGroupRequest, v1.CreateHoldingGroupResponse](
+			httpClient,
+			baseURL+PortfolioServiceCreateHoldingGroupProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("CreateHoldingGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		listHoldingGroups: connect.NewClient[v1.ListHoldingGroupsRequest, v1.ListHoldingGroupsResponse](
+			httpClient,
+			baseURL+PortfolioServiceListHoldingGroupsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("ListHoldingGroups")),
+			connect.WithClientOptions(opts...),
+		),
+		updateHoldingGroup: connect.NewClient[v1.UpdateHoldingGroupRequest, v1.UpdateHoldingGroupResponse](
+			httpClient,
+			baseURL+PortfolioServiceUpdateHoldingGroupProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("UpdateHoldingGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteHoldingGroup: connect.NewClient[v1.DeleteHoldingGroupRequest, v1.DeleteHoldingGroupResponse](
+			httpClient,
+			baseURL+PortfolioServiceDeleteHoldingGroupProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("DeleteHoldingGroup")),
+			connect.WithClientOptions(opts...),
+		),
+		groupHoldings: connect.NewClient[v1.GroupHoldingsRequest, v1.GroupHoldingsResponse](
+			httpClient,
+			baseURL+PortfolioServiceGroupHoldingsProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GroupHoldings")),
+			connect.WithClientOptions(opts...),
+		),
+		getTimeline: connect.NewClient[v1.GetTimelineRequest, v1.GetTimelineResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetTimelineProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetTimeline")),
+			connect.WithClientOptions(opts...),
+		),
+		getYearComparison: connect.NewClient[v1.GetYearComparisonRequest, v1.GetYearComparisonResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetYearComparisonProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetYearComparison")),
+			connect.WithClientOptions(opts...),
+		),
+		getGrowthBreakdown: connect.NewClient[v1.GetGrowthBreakdownRequest, v1.GetGrowthBreakdownResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetGrowthBreakdownProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetGrowthBreakdown")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaxReport: connect.NewClient[v1.GetTaxReportRequest, v1.GetTaxReportResponse](
+			httpClient,
+			baseURL+PortfolioServiceGetTaxReportProcedure,
+			connect.WithSchema(portfolioServiceMethods.ByName("GetTaxReport")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // portfolioServiceClient implements PortfolioServiceClient.
 type portfolioServiceClient struct {
-	listPortfolios      *connect.Client[v1.ListPortfoliosRequest, v1.ListPortfoliosResponse]
-	createPortfolio     *connect.Client[v1.CreatePortfolioRequest, v1.CreatePortfolioResponse]
-	addTransaction      *connect.Client[v1.AddTransactionRequest, v1.AddTransactionResponse]
-	listTransactions    *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
-	deleteTransaction   *connect.Client[v1.DeleteTransactionRequest, v1.DeleteTransactionResponse]
-	getPortfolioSummary *connect.Client[v1.GetPortfolioSummaryRequest, v1.GetPortfolioSummaryResponse]
+	listPortfolios         *connect.Client[v1.ListPortfoliosRequest, v1.ListPortfoliosResponse]
+	createPortfolio        *connect.Client[v1.CreatePortfolioRequest, v1.CreatePortfolioResponse]
+	deletePortfolio        *connect.Client[v1.DeletePortfolioRequest, v1.DeletePortfolioResponse]
+	addTransaction         *connect.Client[v1.AddTransactionRequest, v1.AddTransactionResponse]
+	listTransactions       *connect.Client[v1.ListTransactionsRequest, v1.ListTransactionsResponse]
+	deleteTransaction      *connect.Client[v1.DeleteTransactionRequest, v1.DeleteTransactionResponse]
+	getPortfolioSummary    *connect.Client[v1.GetPortfolioSummaryRequest, v1.GetPortfolioSummaryResponse]
+	listHoldings           *connect.Client[v1.ListHoldingsRequest, v1.ListHoldingsResponse]
+	getPortfolioHistory    *connect.Client[v1.GetPortfolioHistoryRequest, v1.GetPortfolioHistoryResponse]
+	getConsolidatedSummary *connect.Client[v1.GetConsolidatedSummaryRequest, v1.GetConsolidatedSummaryResponse]
+	listLots               *connect.Client[v1.ListLotsRequest, v1.ListLotsResponse]
+	importTransactions     *connect.Client[v1.ImportTransactionsRequest, v1.ImportTransactionsResponse]
+	exportTransactions     *connect.Client[v1.ExportTransactionsRequest, v1.ExportTransactionsResponse]
+	getAttribution         *connect.Client[v1.GetAttributionRequest, v1.GetAttributionResponse]
+	projectPortfolio       *connect.Client[v1.ProjectPortfolioRequest, v1.ProjectPortfolioResponse]
+	runScenario            *connect.Client[v1.RunScenarioRequest, v1.RunScenarioResponse]
+	calculatePositionSize  *connect.Client[v1.CalculatePositionSizeRequest, v1.CalculatePositionSizeResponse]
+	createTag              *connect.Client[v1.CreateTagRequest, v1.CreateTagResponse]
+	listTags               *connect.Client[v1.ListTagsRequest, v1.ListTagsResponse]
+	renameTag              *connect.Client[v1.RenameTagRequest, v1.RenameTagResponse]
+	deleteTag              *connect.Client[v1.DeleteTagRequest, v1.DeleteTagResponse]
+	setTransactionTags     *connect.Client[v1.SetTransactionTagsRequest, v1.SetTransactionTagsResponse]
+	getTagPerformance      *connect.Client[v1.GetTagPerformanceRequest, v1.GetTagPerformanceResponse]
+	createBrokerAccount    *connect.Client[v1.CreateBrokerAccountRequest, v1.CreateBrokerAccountResponse]
+	listBrokerAccounts     *connect.Client[v1.ListBrokerAccountsRequest, v1.ListBrokerAccountsResponse]
+	deleteBrokerAccount    *connect.Client[v1.DeleteBrokerAccountRequest, v1.DeleteBrokerAccountResponse]
+	setTransactionBroker   *connect.Client[v1.SetTransactionBrokerRequest, v1.SetTransactionBrokerResponse]
+	getBrokerCommissions   *connect.Client[v1.GetBrokerCommissionsRequest, v1.GetBrokerCommissionsResponse]
+	createProfile          *connect.Client[v1.CreateProfileRequest, v1.CreateProfileResponse]
+	listProfiles           *connect.Client[v1.ListProfilesRequest, v1.ListProfilesResponse]
+	deleteProfile          *connect.Client[v1.DeleteProfileRequest, v1.DeleteProfileResponse]
+	setPortfolioProfile    *connect.Client[v1.SetPortfolioProfileRequest, v1.SetPortfolioProfileResponse]
+	setHoldingCost         *connect.Client[v1.SetHoldingCostRequest, v1.SetHoldingCostResponse]
+	clearHoldingCost       *connect.Client[v1.ClearHoldingCostRequest, v1.ClearHoldingCostResponse]
+	getCostReconciliation  *connect.Client[v1.GetCostReconciliationRequest, v1.GetCostReconciliationResponse]
+	exportCostBasis        *connect.Client[v1.ExportCostBasisRequest, v1.ExportCostBasisResponse]
+	getBonusExpectations   *connect.Client[v1.GetBonusExpectationsRequest, v1.GetBonusExpectationsResponse]
+	getIncomeSummary       *connect.Client[v1.GetIncomeSummaryRequest, v1.GetIncomeSummaryResponse]
+	setBondTerms           *connect.Client[v1.SetBondTermsRequest, v1.SetBondTermsResponse]
+	clearBondTerms         *connect.Client[v1.ClearBondTermsRequest, v1.ClearBondTermsResponse]
+	getBondSchedule        *connect.Client[v1.GetBondScheduleRequest, v1.GetBondScheduleResponse]
+	createHoldingGroup     *connect.Client[v1.CreateHoldingGroupRequest, v1.CreateHoldingGroupResponse]
+	listHoldingGroups      *connect.Client[v1.ListHoldingGroupsRequest, v1.ListHoldingGroupsResponse]
+	updateHoldingGroup     *connect.Client[v1.UpdateHoldingGroupRequest, v1.UpdateHoldingGroupResponse]
+	deleteHoldingGroup     *connect.Client[v1.DeleteHoldingGroupRequest, v1.DeleteHoldingGroupResponse]
+	groupHoldings          *connect.Client[v1.GroupHoldingsRequest, v1.GroupHoldingsResponse]
+	getTimeline            *connect.Client[v1.GetTimelineRequest, v1.GetTimelineResponse]
+	getYearComparison      *connect.Client[v1.GetYearComparisonRequest, v1.GetYearComparisonResponse]
+	getGrowthBreakdown     *connect.Client[v1.GetGrowthBreakdownRequest, v1.GetGrowthBreakdownResponse]
+	getTaxReport           *connect.Client[v1.GetTaxReportRequest, v1.GetTaxReportResponse]
 }
 
 // ListPortfolios calls ntx.v1.PortfolioService.ListPortfolios.
@@ -133,6 +617,11 @@ func (c *portfolioServiceClient) CreatePortfolio(ctx context.Context, req *conne
 	return c.createPortfolio.CallUnary(ctx, req)
 }
 
+// DeletePortfolio calls ntx.v1.PortfolioService.DeletePortfolio.
+func (c *portfolioServiceClient) DeletePortfolio(ctx context.Context, req *connect.Request[v1.DeletePortfolioRequest]) (*connect.Response[v1.DeletePortfolioResponse], error) {
+	return c.deletePortfolio.CallUnary(ctx, req)
+}
+
 // AddTransaction calls ntx.v1.PortfolioService.AddTransaction.
 func (c *portfolioServiceClient) AddTransaction(ctx context.Context, req *connect.Request[v1.AddTransactionRequest]) (*connect.Response[v1.AddTransactionResponse], error) {
 	return c.addTransaction.CallUnary(ctx, req)
@@ -153,14 +642,273 @@ func (c *portfolioServiceClient) GetPortfolioSummary(ctx context.Context, req *c
 	return c.getPortfolioSummary.CallUnary(ctx, req)
 }
 
+// ListHoldings calls ntx.v1.PortfolioService.ListHoldings.
+func (c *portfolioServiceClient) ListHoldings(ctx context.Context, req *connect.Request[v1.ListHoldingsRequest]) (*connect.Response[v1.ListHoldingsResponse], error) {
+	return c.listHoldings.CallUnary(ctx, req)
+}
+
+// GetPortfolioHistory calls ntx.v1.PortfolioService.GetPortfolioHistory.
+func (c *portfolioServiceClient) GetPortfolioHistory(ctx context.Context, req *connect.Request[v1.GetPortfolioHistoryRequest]) (*connect.Response[v1.GetPortfolioHistoryResponse], error) {
+	return c.getPortfolioHistory.CallUnary(ctx, req)
+}
+
+// GetConsolidatedSummary calls ntx.v1.PortfolioService.GetConsolidatedSummary.
+func (c *portfolioServiceClient) GetConsolidatedSummary(ctx context.Context, req *connect.Request[v1.GetConsolidatedSummaryRequest]) (*connect.Response[v1.GetConsolidatedSummaryResponse], error) {
+	return c.getConsolidatedSummary.CallUnary(ctx, req)
+}
+
+// ListLots calls ntx.v1.PortfolioService.ListLots.
+func (c *portfolioServiceClient) ListLots(ctx context.Context, req *connect.Request[v1.ListLotsRequest]) (*connect.Response[v1.ListLotsResponse], error) {
+	return c.listLots.CallUnary(ctx, req)
+}
+
+// ImportTransactions calls ntx.v1.PortfolioService.ImportTransactions.
+func (c *portfolioServiceClient) ImportTransactions(ctx context.Context, req *connect.Request[v1.ImportTransactionsRequest]) (*connect.Response[v1.ImportTransactionsResponse], error) {
+	return c.importTransactions.CallUnary(ctx, req)
+}
+
+// ExportTransactions calls ntx.v1.PortfolioService.ExportTransactions.
+func (c *portfolioServiceClient) ExportTransactions(ctx context.Context, req *connect.Request[v1.ExportTransactionsRequest]) (*connect.Response[v1.ExportTransactionsResponse], error) {
+	return c.exportTransactions.CallUnary(ctx, req)
+}
+
+// GetAttribution calls ntx.v1.PortfolioService.GetAttribution.
+func (c *portfolioServiceClient) GetAttribution(ctx context.Context, req *connect.Request[v1.GetAttributionRequest]) (*connect.Response[v1.GetAttributionResponse], error) {
+	return c.getAttribution.CallUnary(ctx, req)
+}
+
+// ProjectPortfolio calls ntx.v1.PortfolioService.ProjectPortfolio.
+func (c *portfolioServiceClient) ProjectPortfolio(ctx context.Context, req *connect.Request[v1.ProjectPortfolioRequest]) (*connect.Response[v1.ProjectPortfolioResponse], error) {
+	return c.projectPortfolio.CallUnary(ctx, req)
+}
+
+// RunScenario calls ntx.v1.PortfolioService.RunScenario.
+func (c *portfolioServiceClient) RunScenario(ctx context.Context, req *connect.Request[v1.RunScenarioRequest]) (*connect.Response[v1.RunScenarioResponse], error) {
+	return c.runScenario.CallUnary(ctx, req)
+}
+
+// CalculatePositionSize calls ntx.v1.PortfolioService.CalculatePositionSize.
+func (c *portfolioServiceClient) CalculatePositionSize(ctx context.Context, req *connect.Request[v1.CalculatePositionSizeRequest]) (*connect.Response[v1.CalculatePositionSizeResponse], error) {
+	return c.calculatePositionSize.CallUnary(ctx, req)
+}
+
+// CreateTag calls ntx.v1.PortfolioService.CreateTag.
+func (c *portfolioServiceClient) CreateTag(ctx context.Context, req *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return c.createTag.CallUnary(ctx, req)
+}
+
+// ListTags calls ntx.v1.PortfolioService.ListTags.
+func (c *portfolioServiceClient) ListTags(ctx context.Context, req *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return c.listTags.CallUnary(ctx, req)
+}
+
+// RenameTag calls ntx.v1.PortfolioService.RenameTag.
+func (c *portfolioServiceClient) RenameTag(ctx context.Context, req *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return c.renameTag.CallUnary(ctx, req)
+}
+
+// DeleteTag calls ntx.v1.PortfolioService.DeleteTag.
+func (c *portfolioServiceClient) DeleteTag(ctx context.Context, req *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return c.deleteTag.CallUnary(ctx, req)
+}
+
+// SetTransactionTags calls ntx.v1.PortfolioService.SetTransactionTags.
+func (c *portfolioServiceClient) SetTransactionTags(ctx context.Context, req *connect.Request[v1.SetTransactionTagsRequest]) (*connect.Response[v1.SetTransactionTagsResponse], error) {
+	return c.setTransactionTags.CallUnary(ctx, req)
+}
+
+// GetTagPerformance calls ntx.v1.PortfolioService.GetTagPerformance.
+func (c *portfolioServiceClient) GetTagPerformance(ctx context.Context, req *connect.Request[v1.GetTagPerformanceRequest]) (*connect.Response[v1.GetTagPerformanceResponse], error) {
+	return c.getTagPerformance.CallUnary(ctx, req)
+}
+
+// CreateBrokerAccount calls ntx.v1.PortfolioService.CreateBrokerAccount.
+func (c *portfolioServiceClient) CreateBrokerAccount(ctx context.Context, req *connect.Request[v1.CreateBrokerAccountRequest]) (*connect.Response[v1.CreateBrokerAccountResponse], error) {
+	return c.createBrokerAccount.CallUnary(ctx, req)
+}
+
+// ListBrokerAccounts calls ntx.v1.PortfolioService.ListBrokerAccounts.
+func (c *portfolioServiceClient) ListBrokerAccounts(ctx context.Context, req *connect.Request[v1.ListBrokerAccountsRequest]) (*connect.Response[v1.ListBrokerAccountsResponse], error) {
+	return c.listBrokerAccounts.CallUnary(ctx, req)
+}
+
+// DeleteBrokerAccount calls ntx.v1.PortfolioService.DeleteBrokerAccount.
+func (c *portfolioServiceClient) DeleteBrokerAccount(ctx context.Context, req *connect.Request[v1.DeleteBrokerAccountRequest]) (*connect.Response[v1.DeleteBrokerAccountResponse], error) {
+	return c.deleteBrokerAccount.CallUnary(ctx, req)
+}
+
+// SetTransactionBroker calls ntx.v1.PortfolioService.SetTransactionBroker.
+func (c *portfolioServiceClient) SetTransactionBroker(ctx context.Context, req *connect.Request[v1.SetTransactionBrokerRequest]) (*connect.Response[v1.SetTransactionBrokerResponse], error) {
+	return c.setTransactionBroker.CallUnary(ctx, req)
+}
+
+// GetBrokerCommissions calls ntx.v1.PortfolioService.GetBrokerCommissions.
+func (c *portfolioServiceClient) GetBrokerCommissions(ctx context.Context, req *connect.Request[v1.GetBrokerCommissionsRequest]) (*connect.Response[v1.GetBrokerCommissionsResponse], error) {
+	return c.getBrokerCommissions.CallUnary(ctx, req)
+}
+
+// CreateProfile calls ntx.v1.PortfolioService.CreateProfile.
+func (c *portfolioServiceClient) CreateProfile(ctx context.Context, req *connect.Request[v1.CreateProfileRequest]) (*connect.Response[v1.CreateProfileResponse], error) {
+	return c.createProfile.CallUnary(ctx, req)
+}
+
+// ListProfiles calls ntx.v1.PortfolioService.ListProfiles.
+func (c *portfolioServiceClient) ListProfiles(ctx context.Context, req *connect.Request[v1.ListProfilesRequest]) (*connect.Response[v1.ListProfilesResponse], error) {
+	return c.listProfiles.CallUnary(ctx, req)
+}
+
+// DeleteProfile calls ntx.v1.PortfolioService.DeleteProfile.
+func (c *portfolioServiceClient) DeleteProfile(ctx context.Context, req *connect.Request[v1.DeleteProfileRequest]) (*connect.Response[v1.DeleteProfileResponse], error) {
+	return c.deleteProfile.CallUnary(ctx, req)
+}
+
+// SetPortfolioProfile calls ntx.v1.PortfolioService.SetPortfolioProfile.
+func (c *portfolioServiceClient) SetPortfolioProfile(ctx context.Context, req *connect.Request[v1.SetPortfolioProfileRequest]) (*connect.Response[v1.SetPortfolioProfileResponse], error) {
+	return c.setPortfolioProfile.CallUnary(ctx, req)
+}
+
+// SetHoldingCost calls ntx.v1.PortfolioService.SetHoldingCost.
+func (c *portfolioServiceClient) SetHoldingCost(ctx context.Context, req *connect.Request[v1.SetHoldingCostRequest]) (*connect.Response[v1.SetHoldingCostResponse], error) {
+	return c.setHoldingCost.CallUnary(ctx, req)
+}
+
+// ClearHoldingCost calls ntx.v1.PortfolioService.ClearHoldingCost.
+func (c *portfolioServiceClient) ClearHoldingCost(ctx context.Context, req *connect.Request[v1.ClearHoldingCostRequest]) (*connect.Response[v1.ClearHoldingCostResponse], error) {
+	return c.clearHoldingCost.CallUnary(ctx, req)
+}
+
+// GetCostReconciliation calls ntx.v1.PortfolioService.GetCostReconciliation.
+func (c *portfolioServiceClient) GetCostReconciliation(ctx context.Context, req *connect.Request[v1.GetCostReconciliationRequest]) (*connect.Response[v1.GetCostReconciliationResponse], error) {
+	return c.getCostReconciliation.CallUnary(ctx, req)
+}
+
+// ExportCostBasis calls ntx.v1.PortfolioService.ExportCostBasis.
+func (c *portfolioServiceClient) ExportCostBasis(ctx context.Context, req *connect.Request[v1.ExportCostBasisRequest]) (*connect.Response[v1.ExportCostBasisResponse], error) {
+	return c.exportCostBasis.CallUnary(ctx, req)
+}
+
+// GetBonusExpectations calls ntx.v1.PortfolioService.GetBonusExpectations.
+func (c *portfolioServiceClient) GetBonusExpectations(ctx context.Context, req *connect.Request[v1.GetBonusExpectationsRequest]) (*connect.Response[v1.GetBonusExpectationsResponse], error) {
+	return c.getBonusExpectations.CallUnary(ctx, req)
+}
+
+// GetIncomeSummary calls ntx.v1.PortfolioService.GetIncomeSummary.
+func (c *portfolioServiceClient) GetIncomeSummary(ctx context.Context, req *connect.Request[v1.GetIncomeSummaryRequest]) (*connect.Response[v1.GetIncomeSummaryResponse], error) {
+	return c.getIncomeSummary.CallUnary(ctx, req)
+}
+
+// SetBondTerms calls ntx.v1.PortfolioService.SetBondTerms.
+func (c *portfolioServiceClient) SetBondTerms(ctx context.Context, req *connect.Request[v1.SetBondTermsRequest]) (*connect.Response[v1.SetBondTermsResponse], error) {
+	return c.setBondTerms.CallUnary(ctx, req)
+}
+
+// ClearBondTerms calls ntx.v1.PortfolioService.ClearBondTerms.
+func (c *portfolioServiceClient) ClearBondTerms(ctx context.Context, req *connect.Request[v1.ClearBondTermsRequest]) (*connect.Response[v1.ClearBondTermsResponse], error) {
+	return c.clearBondTerms.CallUnary(ctx, req)
+}
+
+// GetBondSchedule calls ntx.v1.PortfolioService.GetBondSchedule.
+func (c *portfolioServiceClient) GetBondSchedule(ctx context.Context, req *connect.Request[v1.GetBondScheduleRequest]) (*connect.Response[v1.GetBondScheduleResponse], error) {
+	return c.getBondSchedule.CallUnary(ctx, req)
+}
+
+// CreateHoldingGroup calls ntx.v1.PortfolioService.CreateHoldingGroup.
+func (c *portfolioServiceClient) CreateHoldingGroup(ctx context.Context, req *connect.Request[v1.CreateHoldingGroupRequest]) (*connect.Response[v1.CreateHoldingGroupResponse], error) {
+	return c.createHoldingGroup.CallUnary(ctx, req)
+}
+
+// ListHoldingGroups calls ntx.v1.PortfolioService.ListHoldingGroups.
+func (c *portfolioServiceClient) ListHoldingGroups(ctx context.Context, req *connect.Request[v1.ListHoldingGroupsRequest]) (*connect.Response[v1.ListHoldingGroupsResponse], error) {
+	return c.listHoldingGroups.CallUnary(ctx, req)
+}
+
+// UpdateHoldingGroup calls ntx.v1.PortfolioService.UpdateHoldingGroup.
+func (c *portfolioServiceClient) UpdateHoldingGroup(ctx context.Context, req *connect.Request[v1.UpdateHoldingGroupRequest]) (*connect.Response[v1.UpdateHoldingGroupResponse], error) {
+	return c.updateHoldingGroup.CallUnary(ctx, req)
+}
+
+// DeleteHoldingGroup calls ntx.v1.PortfolioService.DeleteHoldingGroup.
+func (c *portfolioServiceClient) DeleteHoldingGroup(ctx context.Context, req *connect.Request[v1.DeleteHoldingGroupRequest]) (*connect.Response[v1.DeleteHoldingGroupResponse], error) {
+	return c.deleteHoldingGroup.CallUnary(ctx, req)
+}
+
+// GroupHoldings calls ntx.v1.PortfolioService.GroupHoldings.
+func (c *portfolioServiceClient) GroupHoldings(ctx context.Context, req *connect.Request[v1.GroupHoldingsRequest]) (*connect.Response[v1.GroupHoldingsResponse], error) {
+	return c.groupHoldings.CallUnary(ctx, req)
+}
+
+// GetTimeline calls ntx.v1.PortfolioService.GetTimeline.
+func (c *portfolioServiceClient) GetTimeline(ctx context.Context, req *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error) {
+	return c.getTimeline.CallUnary(ctx, req)
+}
+
+// GetYearComparison calls ntx.v1.PortfolioService.GetYearComparison.
+func (c *portfolioServiceClient) GetYearComparison(ctx context.Context, req *connect.Request[v1.GetYearComparisonRequest]) (*connect.Response[v1.GetYearComparisonResponse], error) {
+	return c.getYearComparison.CallUnary(ctx, req)
+}
+
+// GetGrowthBreakdown calls ntx.v1.PortfolioService.GetGrowthBreakdown.
+func (c *portfolioServiceClient) GetGrowthBreakdown(ctx context.Context, req *connect.Request[v1.GetGrowthBreakdownRequest]) (*connect.Response[v1.GetGrowthBreakdownResponse], error) {
+	return c.getGrowthBreakdown.CallUnary(ctx, req)
+}
+
+// GetTaxReport calls ntx.v1.PortfolioService.GetTaxReport.
+func (c *portfolioServiceClient) GetTaxReport(ctx context.Context, req *connect.Request[v1.GetTaxReportRequest]) (*connect.Response[v1.GetTaxReportResponse], error) {
+	return c.getTaxReport.CallUnary(ctx, req)
+}
+
 // PortfolioServiceHandler is an implementation of the ntx.v1.PortfolioService service.
 type PortfolioServiceHandler interface {
 	ListPortfolios(context.Context, *connect.Request[v1.ListPortfoliosRequest]) (*connect.Response[v1.ListPortfoliosResponse], error)
 	CreatePortfolio(context.Context, *connect.Request[v1.CreatePortfolioRequest]) (*connect.Response[v1.CreatePortfolioResponse], error)
+	DeletePortfolio(context.Context, *connect.Request[v1.DeletePortfolioRequest]) (*connect.Response[v1.DeletePortfolioResponse], error)
 	AddTransaction(context.Context, *connect.Request[v1.AddTransactionRequest]) (*connect.Response[v1.AddTransactionResponse], error)
 	ListTransactions(context.Context, *connect.Request[v1.ListTransactionsRequest]) (*connect.Response[v1.ListTransactionsResponse], error)
 	DeleteTransaction(context.Context, *connect.Request[v1.DeleteTransactionRequest]) (*connect.Response[v1.DeleteTransactionResponse], error)
 	GetPortfolioSummary(context.Context, *connect.Request[v1.GetPortfolioSummaryRequest]) (*connect.Response[v1.GetPortfolioSummaryResponse], error)
+	ListHoldings(context.Context, *connect.Request[v1.ListHoldingsRequest]) (*connect.Response[v1.ListHoldingsResponse], error)
+	GetPortfolioHistory(context.Context, *connect.Request[v1.GetPortfolioHistoryRequest]) (*connect.Response[v1.GetPortfolioHistoryResponse], error)
+	GetConsolidatedSummary(context.Context, *connect.Request[v1.GetConsolidatedSummaryRequest]) (*connect.Response[v1.GetConsolidatedSummaryResponse], error)
+	ListLots(context.Context, *connect.Request[v1.ListLotsRequest]) (*connect.Response[v1.ListLotsResponse], error)
+	ImportTransactions(context.Context, *connect.Request[v1.ImportTransactionsRequest]) (*connect.Response[v1.ImportTransactionsResponse], error)
+	ExportTransactions(context.Context, *connect.Request[v1.ExportTransactionsRequest]) (*connect.Response[v1.ExportTransactionsResponse], error)
+	GetAttribution(context.Context, *connect.Request[v1.GetAttributionRequest]) (*connect.Response[v1.GetAttributionResponse], error)
+	ProjectPortfolio(context.Context, *connect.Request[v1.ProjectPortfolioRequest]) (*connect.Response[v1.ProjectPortfolioResponse], error)
+	RunScenario(context.Context, *connect.Request[v1.RunScenarioRequest]) (*connect.Response[v1.RunScenarioResponse], error)
+	CalculatePositionSize(context.Context, *connect.Request[v1.CalculatePositionSizeRequest]) (*connect.Response[v1.CalculatePositionSizeResponse], error)
+	CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error)
+	ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error)
+	RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error)
+	DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error)
+	SetTransactionTags(context.Context, *connect.Request[v1.SetTransactionTagsRequest]) (*connect.Response[v1.SetTransactionTagsResponse], error)
+	GetTagPerformance(context.Context, *connect.Request[v1.GetTagPerformanceRequest]) (*connect.Response[v1.GetTagPerformanceResponse], error)
+	CreateBrokerAccount(context.Context, *connect.Request[v1.CreateBrokerAccountRequest]) (*connect.Response[v1.CreateBrokerAccountResponse], error)
+	ListBrokerAccounts(context.Context, *connect.Request[v1.ListBrokerAccountsRequest]) (*connect.Response[v1.ListBrokerAccountsResponse], error)
+	DeleteBrokerAccount(context.Context, *connect.Request[v1.DeleteBrokerAccountRequest]) (*connect.Response[v1.DeleteBrokerAccountResponse], error)
+	SetTransactionBroker(context.Context, *connect.Request[v1.SetTransactionBrokerRequest]) (*connect.Response[v1.SetTransactionBrokerResponse], error)
+	GetBrokerCommissions(context.Context, *connect.Request[v1.GetBrokerCommissionsRequest]) (*connect.Response[v1.GetBrokerCommissionsResponse], error)
+	CreateProfile(context.Context, *connect.Request[v1.CreateProfileRequest]) (*connect.Response[v1.CreateProfileResponse], error)
+	ListProfiles(context.Context, *connect.Request[v1.ListProfilesRequest]) (*connect.Response[v1.ListProfilesResponse], error)
+	DeleteProfile(context.Context, *connect.Request[v1.DeleteProfileRequest]) (*connect.Response[v1.DeleteProfileResponse], error)
+	SetPortfolioProfile(context.Context, *connect.Request[v1.SetPortfolioProfileRequest]) (*connect.Response[v1.SetPortfolioProfileResponse], error)
+	SetHoldingCost(context.Context, *connect.Request[v1.SetHoldingCostRequest]) (*connect.Response[v1.SetHoldingCostResponse], error)
+	ClearHoldingCost(context.Context, *connect.Request[v1.ClearHoldingCostRequest]) (*connect.Response[v1.ClearHoldingCostResponse], error)
+	GetCostReconciliation(context.Context, *connect.Request[v1.GetCostReconciliationRequest]) (*connect.Response[v1.GetCostReconciliationResponse], error)
+	ExportCostBasis(context.Context, *connect.Request[v1.ExportCostBasisRequest]) (*connect.Response[v1.ExportCostBasisResponse], error)
+	GetBonusExpectations(context.Context, *connect.Request[v1.GetBonusExpectationsRequest]) (*connect.Response[v1.GetBonusExpectationsResponse], error)
+	GetIncomeSummary(context.Context, *connect.Request[v1.GetIncomeSummaryRequest]) (*connect.Response[v1.GetIncomeSummaryResponse], error)
+	SetBondTerms(context.Context, *connect.Request[v1.SetBondTermsRequest]) (*connect.Response[v1.SetBondTermsResponse], error)
+	ClearBondTerms(context.Context, *connect.Request[v1.ClearBondTermsRequest]) (*connect.Response[v1.ClearBondTermsResponse], error)
+	GetBondSchedule(context.Context, *connect.Request[v1.GetBondScheduleRequest]) (*connect.Response[v1.GetBondScheduleResponse], error)
+	CreateHoldingGroup(context.Context, *connect.Request[v1.CreateHoldingGroupRequest]) (*connect.Response[v1.CreateHoldingGroupResponse], error)
+	ListHoldingGroups(context.Context, *connect.Request[v1.ListHoldingGroupsRequest]) (*connect.Response[v1.ListHoldingGroupsResponse], error)
+	UpdateHoldingGroup(context.Context, *connect.Request[v1.UpdateHoldingGroupRequest]) (*connect.Response[v1.UpdateHoldingGroupResponse], error)
+	DeleteHoldingGroup(context.Context, *connect.Request[v1.DeleteHoldingGroupRequest]) (*connect.Response[v1.DeleteHoldingGroupResponse], error)
+	GroupHoldings(context.Context, *connect.Request[v1.GroupHoldingsRequest]) (*connect.Response[v1.GroupHoldingsResponse], error)
+	GetTimeline(context.Context, *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error)
+	GetYearComparison(context.Context, *connect.Request[v1.GetYearComparisonRequest]) (*connect.Response[v1.GetYearComparisonResponse], error)
+	GetGrowthBreakdown(context.Context, *connect.Request[v1.GetGrowthBreakdownRequest]) (*connect.Response[v1.GetGrowthBreakdownResponse], error)
+	GetTaxReport(context.Context, *connect.Request[v1.GetTaxReportRequest]) (*connect.Response[v1.GetTaxReportResponse], error)
 }
 
 // NewPortfolioServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -182,6 +930,12 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 		connect.WithSchema(portfolioServiceMethods.ByName("CreatePortfolio")),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceDeletePortfolioHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeletePortfolioProcedure,
+		svc.DeletePortfolio,
+		connect.WithSchema(portfolioServiceMethods.ByName("DeletePortfolio")),
+		connect.WithHandlerOptions(opts...),
+	)
 	portfolioServiceAddTransactionHandler := connect.NewUnaryHandler(
 		PortfolioServiceAddTransactionProcedure,
 		svc.AddTransaction,
@@ -206,12 +960,272 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 		connect.WithSchema(portfolioServiceMethods.ByName("GetPortfolioSummary")),
 		connect.WithHandlerOptions(opts...),
 	)
+	portfolioServiceListHoldingsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListHoldingsProcedure,
+		svc.ListHoldings,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListHoldings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetPortfolioHistoryHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetPortfolioHistoryProcedure,
+		svc.GetPortfolioHistory,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetPortfolioHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetConsolidatedSummaryHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetConsolidatedSummaryProcedure,
+		svc.GetConsolidatedSummary,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetConsolidatedSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListLotsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListLotsProcedure,
+		svc.ListLots,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListLots")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceImportTransactionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceImportTransactionsProcedure,
+		svc.ImportTransactions,
+		connect.WithSchema(portfolioServiceMethods.ByName("ImportTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceExportTransactionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceExportTransactionsProcedure,
+		svc.ExportTransactions,
+		connect.WithSchema(portfolioServiceMethods.ByName("ExportTransactions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetAttributionHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetAttributionProcedure,
+		svc.GetAttribution,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetAttribution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceProjectPortfolioHandler := connect.NewUnaryHandler(
+		PortfolioServiceProjectPortfolioProcedure,
+		svc.ProjectPortfolio,
+		connect.WithSchema(portfolioServiceMethods.ByName("ProjectPortfolio")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceRunScenarioHandler := connect.NewUnaryHandler(
+		PortfolioServiceRunScenarioProcedure,
+		svc.RunScenario,
+		connect.WithSchema(portfolioServiceMethods.ByName("RunScenario")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCalculatePositionSizeHandler := connect.NewUnaryHandler(
+		PortfolioServiceCalculatePositionSizeProcedure,
+		svc.CalculatePositionSize,
+		connect.WithSchema(portfolioServiceMethods.ByName("CalculatePositionSize")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreateTagHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateTagProcedure,
+		svc.CreateTag,
+		connect.WithSchema(portfolioServiceMethods.ByName("CreateTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListTagsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListTagsProcedure,
+		svc.ListTags,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceRenameTagHandler := connect.NewUnaryHandler(
+		PortfolioServiceRenameTagProcedure,
+		svc.RenameTag,
+		connect.WithSchema(portfolioServiceMethods.ByName("RenameTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteTagHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteTagProcedure,
+		svc.DeleteTag,
+		connect.WithSchema(portfolioServiceMethods.ByName("DeleteTag")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSetTransactionTagsHandler := connect.NewUnaryHandler(
+		PortfolioServiceSetTransactionTagsProcedure,
+		svc.SetTransactionTags,
+		connect.WithSchema(portfolioServiceMethods.ByName("SetTransactionTags")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetTagPerformanceHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetTagPerformanceProcedure,
+		svc.GetTagPerformance,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetTagPerformance")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreateBrokerAccountHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateBrokerAccountProcedure,
+		svc.CreateBrokerAccount,
+		connect.WithSchema(portfolioServiceMethods.ByName("CreateBrokerAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListBrokerAccountsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListBrokerAccountsProcedure,
+		svc.ListBrokerAccounts,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListBrokerAccounts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteBrokerAccountHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteBrokerAccountProcedure,
+		svc.DeleteBrokerAccount,
+		connect.WithSchema(portfolioServiceMethods.ByName("DeleteBrokerAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSetTransactionBrokerHandler := connect.NewUnaryHandler(
+		PortfolioServiceSetTransactionBrokerProcedure,
+		svc.SetTransactionBroker,
+		connect.WithSchema(portfolioServiceMethods.ByName("SetTransactionBroker")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetBrokerCommissionsHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetBrokerCommissionsProcedure,
+		svc.GetBrokerCommissions,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetBrokerCommissions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreateProfileHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateProfileProcedure,
+		svc.CreateProfile,
+		connect.WithSchema(portfolioServiceMethods.ByName("CreateProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListProfilesHandler := connect.NewUnaryHandler(
+		PortfolioServiceListProfilesProcedure,
+		svc.ListProfiles,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListProfiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteProfileHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteProfileProcedure,
+		svc.DeleteProfile,
+		connect.WithSchema(portfolioServiceMethods.ByName("DeleteProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSetPortfolioProfileHandler := connect.NewUnaryHandler(
+		PortfolioServiceSetPortfolioProfileProcedure,
+		svc.SetPortfolioProfile,
+		connect.WithSchema(portfolioServiceMethods.ByName("SetPortfolioProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSetHoldingCostHandler := connect.NewUnaryHandler(
+		PortfolioServiceSetHoldingCostProcedure,
+		svc.SetHoldingCost,
+		connect.WithSchema(portfolioServiceMethods.ByName("SetHoldingCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceClearHoldingCostHandler := connect.NewUnaryHandler(
+		PortfolioServiceClearHoldingCostProcedure,
+		svc.ClearHoldingCost,
+		connect.WithSchema(portfolioServiceMethods.ByName("ClearHoldingCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetCostReconciliationHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetCostReconciliationProcedure,
+		svc.GetCostReconciliation,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetCostReconciliation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceExportCostBasisHandler := connect.NewUnaryHandler(
+		PortfolioServiceExportCostBasisProcedure,
+		svc.ExportCostBasis,
+		connect.WithSchema(portfolioServiceMethods.ByName("ExportCostBasis")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetBonusExpectationsHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetBonusExpectationsProcedure,
+		svc.GetBonusExpectations,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetBonusExpectations")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetIncomeSummaryHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetIncomeSummaryProcedure,
+		svc.GetIncomeSummary,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetIncomeSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceSetBondTermsHandler := connect.NewUnaryHandler(
+		PortfolioServiceSetBondTermsProcedure,
+		svc.SetBondTerms,
+		connect.WithSchema(portfolioServiceMethods.ByName("SetBondTerms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceClearBondTermsHandler := connect.NewUnaryHandler(
+		PortfolioServiceClearBondTermsProcedure,
+		svc.ClearBondTerms,
+		connect.WithSchema(portfolioServiceMethods.ByName("ClearBondTerms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetBondScheduleHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetBondScheduleProcedure,
+		svc.GetBondSchedule,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetBondSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceCreateHoldingGroupHandler := connect.NewUnaryHandler(
+		PortfolioServiceCreateHoldingGroupProcedure,
+		svc.CreateHoldingGroup,
+		connect.WithSchema(portfolioServiceMethods.ByName("CreateHoldingGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceListHoldingGroupsHandler := connect.NewUnaryHandler(
+		PortfolioServiceListHoldingGroupsProcedure,
+		svc.ListHoldingGroups,
+		connect.WithSchema(portfolioServiceMethods.ByName("ListHoldingGroups")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceUpdateHoldingGroupHandler := connect.NewUnaryHandler(
+		PortfolioServiceUpdateHoldingGroupProcedure,
+		svc.UpdateHoldingGroup,
+		connect.WithSchema(portfolioServiceMethods.ByName("UpdateHoldingGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceDeleteHoldingGroupHandler := connect.NewUnaryHandler(
+		PortfolioServiceDeleteHoldingGroupProcedure,
+		svc.DeleteHoldingGroup,
+		connect.WithSchema(portfolioServiceMethods.ByName("DeleteHoldingGroup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGroupHoldingsHandler := connect.NewUnaryHandler(
+		PortfolioServiceGroupHoldingsProcedure,
+		svc.GroupHoldings,
+		connect.WithSchema(portfolioServiceMethods.ByName("GroupHoldings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetTimelineHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetTimelineProcedure,
+		svc.GetTimeline,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetYearComparisonHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetYearComparisonProcedure,
+		svc.GetYearComparison,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetYearComparison")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetGrowthBreakdownHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetGrowthBreakdownProcedure,
+		svc.GetGrowthBreakdown,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetGrowthBreakdown")),
+		connect.WithHandlerOptions(opts...),
+	)
+	portfolioServiceGetTaxReportHandler := connect.NewUnaryHandler(
+		PortfolioServiceGetTaxReportProcedure,
+		svc.GetTaxReport,
+		connect.WithSchema(portfolioServiceMethods.ByName("GetTaxReport")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ntx.v1.PortfolioService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PortfolioServiceListPortfoliosProcedure:
 			portfolioServiceListPortfoliosHandler.ServeHTTP(w, r)
 		case PortfolioServiceCreatePortfolioProcedure:
 			portfolioServiceCreatePortfolioHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeletePortfolioProcedure:
+			portfolioServiceDeletePortfolioHandler.ServeHTTP(w, r)
 		case PortfolioServiceAddTransactionProcedure:
 			portfolioServiceAddTransactionHandler.ServeHTTP(w, r)
 		case PortfolioServiceListTransactionsProcedure:
@@ -220,6 +1234,92 @@ func NewPortfolioServiceHandler(svc PortfolioServiceHandler, opts ...connect.Han
 			portfolioServiceDeleteTransactionHandler.ServeHTTP(w, r)
 		case PortfolioServiceGetPortfolioSummaryProcedure:
 			portfolioServiceGetPortfolioSummaryHandler.ServeHTTP(w, r)
+		case PortfolioServiceListHoldingsProcedure:
+			portfolioServiceListHoldingsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetPortfolioHistoryProcedure:
+			portfolioServiceGetPortfolioHistoryHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetConsolidatedSummaryProcedure:
+			portfolioServiceGetConsolidatedSummaryHandler.ServeHTTP(w, r)
+		case PortfolioServiceListLotsProcedure:
+			portfolioServiceListLotsHandler.ServeHTTP(w, r)
+		case PortfolioServiceImportTransactionsProcedure:
+			portfolioServiceImportTransactionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceExportTransactionsProcedure:
+			portfolioServiceExportTransactionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetAttributionProcedure:
+			portfolioServiceGetAttributionHandler.ServeHTTP(w, r)
+		case PortfolioServiceProjectPortfolioProcedure:
+			portfolioServiceProjectPortfolioHandler.ServeHTTP(w, r)
+		case PortfolioServiceRunScenarioProcedure:
+			portfolioServiceRunScenarioHandler.ServeHTTP(w, r)
+		case PortfolioServiceCalculatePositionSizeProcedure:
+			portfolioServiceCalculatePositionSizeHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateTagProcedure:
+			portfolioServiceCreateTagHandler.ServeHTTP(w, r)
+		case PortfolioServiceListTagsProcedure:
+			portfolioServiceListTagsHandler.ServeHTTP(w, r)
+		case PortfolioServiceRenameTagProcedure:
+			portfolioServiceRenameTagHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteTagProcedure:
+			portfolioServiceDeleteTagHandler.ServeHTTP(w, r)
+		case PortfolioServiceSetTransactionTagsProcedure:
+			portfolioServiceSetTransactionTagsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetTagPerformanceProcedure:
+			portfolioServiceGetTagPerformanceHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateBrokerAccountProcedure:
+			portfolioServiceCreateBrokerAccountHandler.ServeHTTP(w, r)
+		case PortfolioServiceListBrokerAccountsProcedure:
+			portfolioServiceListBrokerAccountsHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteBrokerAccountProcedure:
+			portfolioServiceDeleteBrokerAccountHandler.ServeHTTP(w, r)
+		case PortfolioServiceSetTransactionBrokerProcedure:
+			portfolioServiceSetTransactionBrokerHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetBrokerCommissionsProcedure:
+			portfolioServiceGetBrokerCommissionsHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateProfileProcedure:
+			portfolioServiceCreateProfileHandler.ServeHTTP(w, r)
+		case PortfolioServiceListProfilesProcedure:
+			portfolioServiceListProfilesHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteProfileProcedure:
+			portfolioServiceDeleteProfileHandler.ServeHTTP(w, r)
+		case PortfolioServiceSetPortfolioProfileProcedure:
+			portfolioServiceSetPortfolioProfileHandler.ServeHTTP(w, r)
+		case PortfolioServiceSetHoldingCostProcedure:
+			portfolioServiceSetHoldingCostHandler.ServeHTTP(w, r)
+		case PortfolioServiceClearHoldingCostProcedure:
+			portfolioServiceClearHoldingCostHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetCostReconciliationProcedure:
+			portfolioServiceGetCostReconciliationHandler.ServeHTTP(w, r)
+		case PortfolioServiceExportCostBasisProcedure:
+			portfolioServiceExportCostBasisHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetBonusExpectationsProcedure:
+			portfolioServiceGetBonusExpectationsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetIncomeSummaryProcedure:
+			portfolioServiceGetIncomeSummaryHandler.ServeHTTP(w, r)
+		case PortfolioServiceSetBondTermsProcedure:
+			portfolioServiceSetBondTermsHandler.ServeHTTP(w, r)
+		case PortfolioServiceClearBondTermsProcedure:
+			portfolioServiceClearBondTermsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetBondScheduleProcedure:
+			portfolioServiceGetBondScheduleHandler.ServeHTTP(w, r)
+		case PortfolioServiceCreateHoldingGroupProcedure:
+			portfolioServiceCreateHoldingGroupHandler.ServeHTTP(w, r)
+		case PortfolioServiceListHoldingGroupsProcedure:
+			portfolioServiceListHoldingGroupsHandler.ServeHTTP(w, r)
+		case PortfolioServiceUpdateHoldingGroupProcedure:
+			portfolioServiceUpdateHoldingGroupHandler.ServeHTTP(w, r)
+		case PortfolioServiceDeleteHoldingGroupProcedure:
+			portfolioServiceDeleteHoldingGroupHandler.ServeHTTP(w, r)
+		case PortfolioServiceGroupHoldingsProcedure:
+			portfolioServiceGroupHoldingsHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetTimelineProcedure:
+			portfolioServiceGetTimelineHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetYearComparisonProcedure:
+			portfolioServiceGetYearComparisonHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetGrowthBreakdownProcedure:
+			portfolioServiceGetGrowthBreakdownHandler.ServeHTTP(w, r)
+		case PortfolioServiceGetTaxReportProcedure:
+			portfolioServiceGetTaxReportHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -237,6 +1337,10 @@ func (UnimplementedPortfolioServiceHandler) CreatePortfolio(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CreatePortfolio is not implemented"))
 }
 
+func (UnimplementedPortfolioServiceHandler) DeletePortfolio(context.Context, *connect.Request[v1.DeletePortfolioRequest]) (*connect.Response[v1.DeletePortfolioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.DeletePortfolio is not implemented"))
+}
+
 func (UnimplementedPortfolioServiceHandler) AddTransaction(context.Context, *connect.Request[v1.AddTransactionRequest]) (*connect.Response[v1.AddTransactionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.AddTransaction is not implemented"))
 }
@@ -252,3 +1356,175 @@ func (UnimplementedPortfolioServiceHandler) DeleteTransaction(context.Context, *
 func (UnimplementedPortfolioServiceHandler) GetPortfolioSummary(context.Context, *connect.Request[v1.GetPortfolioSummaryRequest]) (*connect.Response[v1.GetPortfolioSummaryResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetPortfolioSummary is not implemented"))
 }
+
+func (UnimplementedPortfolioServiceHandler) ListHoldings(context.Context, *connect.Request[v1.ListHoldingsRequest]) (*connect.Response[v1.ListHoldingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListHoldings is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetPortfolioHistory(context.Context, *connect.Request[v1.GetPortfolioHistoryRequest]) (*connect.Response[v1.GetPortfolioHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetPortfolioHistory is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetConsolidatedSummary(context.Context, *connect.Request[v1.GetConsolidatedSummaryRequest]) (*connect.Response[v1.GetConsolidatedSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetConsolidatedSummary is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListLots(context.Context, *connect.Request[v1.ListLotsRequest]) (*connect.Response[v1.ListLotsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListLots is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ImportTransactions(context.Context, *connect.Request[v1.ImportTransactionsRequest]) (*connect.Response[v1.ImportTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ImportTransactions is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ExportTransactions(context.Context, *connect.Request[v1.ExportTransactionsRequest]) (*connect.Response[v1.ExportTransactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ExportTransactions is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetAttribution(context.Context, *connect.Request[v1.GetAttributionRequest]) (*connect.Response[v1.GetAttributionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetAttribution is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ProjectPortfolio(context.Context, *connect.Request[v1.ProjectPortfolioRequest]) (*connect.Response[v1.ProjectPortfolioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ProjectPortfolio is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) RunScenario(context.Context, *connect.Request[v1.RunScenarioRequest]) (*connect.Response[v1.RunScenarioResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.RunScenario is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CalculatePositionSize(context.Context, *connect.Request[v1.CalculatePositionSizeRequest]) (*connect.Response[v1.CalculatePositionSizeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CalculatePositionSize is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreateTag(context.Context, *connect.Request[v1.CreateTagRequest]) (*connect.Response[v1.CreateTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CreateTag is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListTags(context.Context, *connect.Request[v1.ListTagsRequest]) (*connect.Response[v1.ListTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListTags is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) RenameTag(context.Context, *connect.Request[v1.RenameTagRequest]) (*connect.Response[v1.RenameTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.RenameTag is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteTag(context.Context, *connect.Request[v1.DeleteTagRequest]) (*connect.Response[v1.DeleteTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.DeleteTag is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SetTransactionTags(context.Context, *connect.Request[v1.SetTransactionTagsRequest]) (*connect.Response[v1.SetTransactionTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.SetTransactionTags is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetTagPerformance(context.Context, *connect.Request[v1.GetTagPerformanceRequest]) (*connect.Response[v1.GetTagPerformanceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetTagPerformance is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreateBrokerAccount(context.Context, *connect.Request[v1.CreateBrokerAccountRequest]) (*connect.Response[v1.CreateBrokerAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CreateBrokerAccount is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListBrokerAccounts(context.Context, *connect.Request[v1.ListBrokerAccountsRequest]) (*connect.Response[v1.ListBrokerAccountsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListBrokerAccounts is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteBrokerAccount(context.Context, *connect.Request[v1.DeleteBrokerAccountRequest]) (*connect.Response[v1.DeleteBrokerAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.DeleteBrokerAccount is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SetTransactionBroker(context.Context, *connect.Request[v1.SetTransactionBrokerRequest]) (*connect.Response[v1.SetTransactionBrokerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.SetTransactionBroker is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetBrokerCommissions(context.Context, *connect.Request[v1.GetBrokerCommissionsRequest]) (*connect.Response[v1.GetBrokerCommissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetBrokerCommissions is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreateProfile(context.Context, *connect.Request[v1.CreateProfileRequest]) (*connect.Response[v1.CreateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CreateProfile is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListProfiles(context.Context, *connect.Request[v1.ListProfilesRequest]) (*connect.Response[v1.ListProfilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListProfiles is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteProfile(context.Context, *connect.Request[v1.DeleteProfileRequest]) (*connect.Response[v1.DeleteProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.DeleteProfile is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SetPortfolioProfile(context.Context, *connect.Request[v1.SetPortfolioProfileRequest]) (*connect.Response[v1.SetPortfolioProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.SetPortfolioProfile is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SetHoldingCost(context.Context, *connect.Request[v1.SetHoldingCostRequest]) (*connect.Response[v1.SetHoldingCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.SetHoldingCost is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ClearHoldingCost(context.Context, *connect.Request[v1.ClearHoldingCostRequest]) (*connect.Response[v1.ClearHoldingCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ClearHoldingCost is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetCostReconciliation(context.Context, *connect.Request[v1.GetCostReconciliationRequest]) (*connect.Response[v1.GetCostReconciliationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetCostReconciliation is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ExportCostBasis(context.Context, *connect.Request[v1.ExportCostBasisRequest]) (*connect.Response[v1.ExportCostBasisResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ExportCostBasis is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetBonusExpectations(context.Context, *connect.Request[v1.GetBonusExpectationsRequest]) (*connect.Response[v1.GetBonusExpectationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetBonusExpectations is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetIncomeSummary(context.Context, *connect.Request[v1.GetIncomeSummaryRequest]) (*connect.Response[v1.GetIncomeSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetIncomeSummary is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) SetBondTerms(context.Context, *connect.Request[v1.SetBondTermsRequest]) (*connect.Response[v1.SetBondTermsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.SetBondTerms is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ClearBondTerms(context.Context, *connect.Request[v1.ClearBondTermsRequest]) (*connect.Response[v1.ClearBondTermsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ClearBondTerms is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetBondSchedule(context.Context, *connect.Request[v1.GetBondScheduleRequest]) (*connect.Response[v1.GetBondScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetBondSchedule is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) CreateHoldingGroup(context.Context, *connect.Request[v1.CreateHoldingGroupRequest]) (*connect.Response[v1.CreateHoldingGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.CreateHoldingGroup is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) ListHoldingGroups(context.Context, *connect.Request[v1.ListHoldingGroupsRequest]) (*connect.Response[v1.ListHoldingGroupsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.ListHoldingGroups is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) UpdateHoldingGroup(context.Context, *connect.Request[v1.UpdateHoldingGroupRequest]) (*connect.Response[v1.UpdateHoldingGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.UpdateHoldingGroup is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) DeleteHoldingGroup(context.Context, *connect.Request[v1.DeleteHoldingGroupRequest]) (*connect.Response[v1.DeleteHoldingGroupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.DeleteHoldingGroup is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GroupHoldings(context.Context, *connect.Request[v1.GroupHoldingsRequest]) (*connect.Response[v1.GroupHoldingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GroupHoldings is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetTimeline(context.Context, *connect.Request[v1.GetTimelineRequest]) (*connect.Response[v1.GetTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetTimeline is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetYearComparison(context.Context, *connect.Request[v1.GetYearComparisonRequest]) (*connect.Response[v1.GetYearComparisonResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetYearComparison is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetGrowthBreakdown(context.Context, *connect.Request[v1.GetGrowthBreakdownRequest]) (*connect.Response[v1.GetGrowthBreakdownResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetGrowthBreakdown is not implemented"))
+}
+
+func (UnimplementedPortfolioServiceHandler) GetTaxReport(context.Context, *connect.Request[v1.GetTaxReportRequest]) (*connect.Response[v1.GetTaxReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PortfolioService.GetTaxReport is not implemented"))
+}
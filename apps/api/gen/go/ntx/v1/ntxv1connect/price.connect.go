@@ -41,6 +41,11 @@ const (
 	// PriceServiceListLatestPricesProcedure is the fully-qualified name of the PriceService's
 	// ListLatestPrices RPC.
 	PriceServiceListLatestPricesProcedure = "/ntx.v1.PriceService/ListLatestPrices"
+	// PriceServiceSyncPricesProcedure is the fully-qualified name of the PriceService's SyncPrices RPC.
+	PriceServiceSyncPricesProcedure = "/ntx.v1.PriceService/SyncPrices"
+	// PriceServiceGetBackfillStatusProcedure is the fully-qualified name of the PriceService's
+	// GetBackfillStatus RPC.
+	PriceServiceGetBackfillStatusProcedure = "/ntx.v1.PriceService/GetBackfillStatus"
 )
 
 // PriceServiceClient is a client for the ntx.v1.PriceService service.
@@ -48,6 +53,8 @@ type PriceServiceClient interface {
 	GetPrice(context.Context, *connect.Request[v1.GetPriceRequest]) (*connect.Response[v1.GetPriceResponse], error)
 	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
 	ListLatestPrices(context.Context, *connect.Request[v1.ListLatestPricesRequest]) (*connect.Response[v1.ListLatestPricesResponse], error)
+	SyncPrices(context.Context, *connect.Request[v1.SyncPricesRequest]) (*connect.Response[v1.SyncPricesResponse], error)
+	GetBackfillStatus(context.Context, *connect.Request[v1.GetBackfillStatusRequest]) (*connect.Response[v1.GetBackfillStatusResponse], error)
 }
 
 // NewPriceServiceClient constructs a client for the ntx.v1.PriceService service. By default, it
@@ -79,14 +86,28 @@ func NewPriceServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(priceServiceMethods.ByName("ListLatestPrices")),
 			connect.WithClientOptions(opts...),
 		),
+		syncPrices: connect.NewClient[v1.SyncPricesRequest, v1.SyncPricesResponse](
+			httpClient,
+			baseURL+PriceServiceSyncPricesProcedure,
+			connect.WithSchema(priceServiceMethods.ByName("SyncPrices")),
+			connect.WithClientOptions(opts...),
+		),
+		getBackfillStatus: connect.NewClient[v1.GetBackfillStatusRequest, v1.GetBackfillStatusResponse](
+			httpClient,
+			baseURL+PriceServiceGetBackfillStatusProcedure,
+			connect.WithSchema(priceServiceMethods.ByName("GetBackfillStatus")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // priceServiceClient implements PriceServiceClient.
 type priceServiceClient struct {
-	getPrice         *connect.Client[v1.GetPriceRequest, v1.GetPriceResponse]
-	getPriceHistory  *connect.Client[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse]
-	listLatestPrices *connect.Client[v1.ListLatestPricesRequest, v1.ListLatestPricesResponse]
+	getPrice          *connect.Client[v1.GetPriceRequest, v1.GetPriceResponse]
+	getPriceHistory   *connect.Client[v1.GetPriceHistoryRequest, v1.GetPriceHistoryResponse]
+	listLatestPrices  *connect.Client[v1.ListLatestPricesRequest, v1.ListLatestPricesResponse]
+	syncPrices        *connect.Client[v1.SyncPricesRequest, v1.SyncPricesResponse]
+	getBackfillStatus *connect.Client[v1.GetBackfillStatusRequest, v1.GetBackfillStatusResponse]
 }
 
 // GetPrice calls ntx.v1.PriceService.GetPrice.
@@ -104,11 +125,23 @@ func (c *priceServiceClient) ListLatestPrices(ctx context.Context, req *connect.
 	return c.listLatestPrices.CallUnary(ctx, req)
 }
 
+// SyncPrices calls ntx.v1.PriceService.SyncPrices.
+func (c *priceServiceClient) SyncPrices(ctx context.Context, req *connect.Request[v1.SyncPricesRequest]) (*connect.Response[v1.SyncPricesResponse], error) {
+	return c.syncPrices.CallUnary(ctx, req)
+}
+
+// GetBackfillStatus calls ntx.v1.PriceService.GetBackfillStatus.
+func (c *priceServiceClient) GetBackfillStatus(ctx context.Context, req *connect.Request[v1.GetBackfillStatusRequest]) (*connect.Response[v1.GetBackfillStatusResponse], error) {
+	return c.getBackfillStatus.CallUnary(ctx, req)
+}
+
 // PriceServiceHandler is an implementation of the ntx.v1.PriceService service.
 type PriceServiceHandler interface {
 	GetPrice(context.Context, *connect.Request[v1.GetPriceRequest]) (*connect.Response[v1.GetPriceResponse], error)
 	GetPriceHistory(context.Context, *connect.Request[v1.GetPriceHistoryRequest]) (*connect.Response[v1.GetPriceHistoryResponse], error)
 	ListLatestPrices(context.Context, *connect.Request[v1.ListLatestPricesRequest]) (*connect.Response[v1.ListLatestPricesResponse], error)
+	SyncPrices(context.Context, *connect.Request[v1.SyncPricesRequest]) (*connect.Response[v1.SyncPricesResponse], error)
+	GetBackfillStatus(context.Context, *connect.Request[v1.GetBackfillStatusRequest]) (*connect.Response[v1.GetBackfillStatusResponse], error)
 }
 
 // NewPriceServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -136,6 +169,18 @@ func NewPriceServiceHandler(svc PriceServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(priceServiceMethods.ByName("ListLatestPrices")),
 		connect.WithHandlerOptions(opts...),
 	)
+	priceServiceSyncPricesHandler := connect.NewUnaryHandler(
+		PriceServiceSyncPricesProcedure,
+		svc.SyncPrices,
+		connect.WithSchema(priceServiceMethods.ByName("SyncPrices")),
+		connect.WithHandlerOptions(opts...),
+	)
+	priceServiceGetBackfillStatusHandler := connect.NewUnaryHandler(
+		PriceServiceGetBackfillStatusProcedure,
+		svc.GetBackfillStatus,
+		connect.WithSchema(priceServiceMethods.ByName("GetBackfillStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ntx.v1.PriceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PriceServiceGetPriceProcedure:
@@ -144,6 +189,10 @@ func NewPriceServiceHandler(svc PriceServiceHandler, opts ...connect.HandlerOpti
 			priceServiceGetPriceHistoryHandler.ServeHTTP(w, r)
 		case PriceServiceListLatestPricesProcedure:
 			priceServiceListLatestPricesHandler.ServeHTTP(w, r)
+		case PriceServiceSyncPricesProcedure:
+			priceServiceSyncPricesHandler.ServeHTTP(w, r)
+		case PriceServiceGetBackfillStatusProcedure:
+			priceServiceGetBackfillStatusHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -164,3 +213,11 @@ func (UnimplementedPriceServiceHandler) GetPriceHistory(context.Context, *connec
 func (UnimplementedPriceServiceHandler) ListLatestPrices(context.Context, *connect.Request[v1.ListLatestPricesRequest]) (*connect.Response[v1.ListLatestPricesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PriceService.ListLatestPrices is not implemented"))
 }
+
+func (UnimplementedPriceServiceHandler) SyncPrices(context.Context, *connect.Request[v1.SyncPricesRequest]) (*connect.Response[v1.SyncPricesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PriceService.SyncPrices is not implemented"))
+}
+
+func (UnimplementedPriceServiceHandler) GetBackfillStatus(context.Context, *connect.Request[v1.GetBackfillStatusRequest]) (*connect.Response[v1.GetBackfillStatusResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ntx.v1.PriceService.GetBackfillStatus is not implemented"))
+}
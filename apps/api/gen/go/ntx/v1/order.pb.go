@@ -0,0 +1,793 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: ntx/v1/order.proto
+
+package ntxv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNSPECIFIED OrderStatus = 0
+	OrderStatus_ORDER_STATUS_OPEN        OrderStatus = 1
+	OrderStatus_ORDER_STATUS_EXECUTED    OrderStatus = 2
+	OrderStatus_ORDER_STATUS_CANCELLED   OrderStatus = 3
+	OrderStatus_ORDER_STATUS_EXPIRED     OrderStatus = 4
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNSPECIFIED",
+		1: "ORDER_STATUS_OPEN",
+		2: "ORDER_STATUS_EXECUTED",
+		3: "ORDER_STATUS_CANCELLED",
+		4: "ORDER_STATUS_EXPIRED",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNSPECIFIED": 0,
+		"ORDER_STATUS_OPEN":        1,
+		"ORDER_STATUS_EXECUTED":    2,
+		"ORDER_STATUS_CANCELLED":   3,
+		"ORDER_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_order_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_ntx_v1_order_proto_enumTypes[0]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{0}
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PortfolioId   int64                  `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Side          TransactionType        `protobuf:"varint,4,opt,name=side,proto3,enum=ntx.v1.TransactionType" json:"side,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LimitPrice    float64                `protobuf:"fixed64,6,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExpiresOn     string                 `protobuf:"bytes,7,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"` // YYYY-MM-DD, empty for good-till-cancelled
+	Status        OrderStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=ntx.v1.OrderStatus" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	TransactionId *int64                 `protobuf:"varint,10,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"` // set once executed
+	ClosedAt      string                 `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_ntx_v1_order_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *Order) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *Order) GetSide() TransactionType {
+	if x != nil {
+		return x.Side
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *Order) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetLimitPrice() float64 {
+	if x != nil {
+		return x.LimitPrice
+	}
+	return 0
+}
+
+func (x *Order) GetExpiresOn() string {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func (x *Order) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Order) GetTransactionId() int64 {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return 0
+}
+
+func (x *Order) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *Order) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Side          TransactionType        `protobuf:"varint,3,opt,name=side,proto3,enum=ntx.v1.TransactionType" json:"side,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	LimitPrice    float64                `protobuf:"fixed64,5,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	ExpiresOn     string                 `protobuf:"bytes,6,opt,name=expires_on,json=expiresOn,proto3" json:"expires_on,omitempty"` // YYYY-MM-DD, empty for good-till-cancelled
+	Note          string                 `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderRequest) Reset() {
+	*x = CreateOrderRequest{}
+	mi := &file_ntx_v1_order_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderRequest) ProtoMessage() {}
+
+func (x *CreateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateOrderRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetSide() TransactionType {
+	if x != nil {
+		return x.Side
+	}
+	return TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CreateOrderRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetLimitPrice() float64 {
+	if x != nil {
+		return x.LimitPrice
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetExpiresOn() string {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CreateOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrderResponse) Reset() {
+	*x = CreateOrderResponse{}
+	mi := &file_ntx_v1_order_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrderResponse) ProtoMessage() {}
+
+func (x *CreateOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *OrderStatus           `protobuf:"varint,1,opt,name=status,proto3,enum=ntx.v1.OrderStatus,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_ntx_v1_order_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListOrdersRequest) GetStatus() OrderStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_ntx_v1_order_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_ntx_v1_order_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_ntx_v1_order_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// ExecuteOrderRequest books an open order as a transaction. Fields left
+// unset default to the order's quantity and limit price, and today.
+type ExecuteOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderId         int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity        *int64                 `protobuf:"varint,2,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	UnitPrice       *float64               `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3,oneof" json:"unit_price,omitempty"`
+	TransactionDate string                 `protobuf:"bytes,4,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"` // YYYY-MM-DD
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ExecuteOrderRequest) Reset() {
+	*x = ExecuteOrderRequest{}
+	mi := &file_ntx_v1_order_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteOrderRequest) ProtoMessage() {}
+
+func (x *ExecuteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteOrderRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExecuteOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ExecuteOrderRequest) GetQuantity() int64 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+func (x *ExecuteOrderRequest) GetUnitPrice() float64 {
+	if x != nil && x.UnitPrice != nil {
+		return *x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ExecuteOrderRequest) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+type ExecuteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteOrderResponse) Reset() {
+	*x = ExecuteOrderResponse{}
+	mi := &file_ntx_v1_order_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteOrderResponse) ProtoMessage() {}
+
+func (x *ExecuteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_order_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteOrderResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_order_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExecuteOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *ExecuteOrderResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+var File_ntx_v1_order_proto protoreflect.FileDescriptor
+
+const file_ntx_v1_order_proto_rawDesc = "" +
+	"\n" +
+	"\x12ntx/v1/order.proto\x12\x06ntx.v1\x1a\x16ntx/v1/portfolio.proto\"\xa2\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fportfolio_id\x18\x02 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x12+\n" +
+	"\x04side\x18\x04 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vlimit_price\x18\x06 \x01(\x01R\n" +
+	"limitPrice\x12\x1d\n" +
+	"\n" +
+	"expires_on\x18\a \x01(\tR\texpiresOn\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.ntx.v1.OrderStatusR\x06status\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x12*\n" +
+	"\x0etransaction_id\x18\n" +
+	" \x01(\x03H\x00R\rtransactionId\x88\x01\x01\x12\x1b\n" +
+	"\tclosed_at\x18\v \x01(\tR\bclosedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAtB\x11\n" +
+	"\x0f_transaction_id\"\xf7\x01\n" +
+	"\x12CreateOrderRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12+\n" +
+	"\x04side\x18\x03 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x04side\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vlimit_price\x18\x05 \x01(\x01R\n" +
+	"limitPrice\x12\x1d\n" +
+	"\n" +
+	"expires_on\x18\x06 \x01(\tR\texpiresOn\x12\x12\n" +
+	"\x04note\x18\a \x01(\tR\x04note\":\n" +
+	"\x13CreateOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.ntx.v1.OrderR\x05order\"P\n" +
+	"\x11ListOrdersRequest\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x13.ntx.v1.OrderStatusH\x00R\x06status\x88\x01\x01B\t\n" +
+	"\a_status\";\n" +
+	"\x12ListOrdersResponse\x12%\n" +
+	"\x06orders\x18\x01 \x03(\v2\r.ntx.v1.OrderR\x06orders\"/\n" +
+	"\x12CancelOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\":\n" +
+	"\x13CancelOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.ntx.v1.OrderR\x05order\"\xbc\x01\n" +
+	"\x13ExecuteOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1f\n" +
+	"\bquantity\x18\x02 \x01(\x03H\x00R\bquantity\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01H\x01R\tunitPrice\x88\x01\x01\x12)\n" +
+	"\x10transaction_date\x18\x04 \x01(\tR\x0ftransactionDateB\v\n" +
+	"\t_quantityB\r\n" +
+	"\v_unit_price\"r\n" +
+	"\x14ExecuteOrderResponse\x12#\n" +
+	"\x05order\x18\x01 \x01(\v2\r.ntx.v1.OrderR\x05order\x125\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x13.ntx.v1.TransactionR\vtransaction*\x93\x01\n" +
+	"\vOrderStatus\x12\x1c\n" +
+	"\x18ORDER_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ORDER_STATUS_OPEN\x10\x01\x12\x19\n" +
+	"\x15ORDER_STATUS_EXECUTED\x10\x02\x12\x1a\n" +
+	"\x16ORDER_STATUS_CANCELLED\x10\x03\x12\x18\n" +
+	"\x14ORDER_STATUS_EXPIRED\x10\x042\xae\x02\n" +
+	"\fOrderService\x12F\n" +
+	"\vCreateOrder\x12\x1a.ntx.v1.CreateOrderRequest\x1a\x1b.ntx.v1.CreateOrderResponse\x12C\n" +
+	"\n" +
+	"ListOrders\x12\x19.ntx.v1.ListOrdersRequest\x1a\x1a.ntx.v1.ListOrdersResponse\x12F\n" +
+	"\vCancelOrder\x12\x1a.ntx.v1.CancelOrderRequest\x1a\x1b.ntx.v1.CancelOrderResponse\x12I\n" +
+	"\fExecuteOrder\x12\x1b.ntx.v1.ExecuteOrderRequest\x1a\x1c.ntx.v1.ExecuteOrderResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+
+var (
+	file_ntx_v1_order_proto_rawDescOnce sync.Once
+	file_ntx_v1_order_proto_rawDescData []byte
+)
+
+func file_ntx_v1_order_proto_rawDescGZIP() []byte {
+	file_ntx_v1_order_proto_rawDescOnce.Do(func() {
+		file_ntx_v1_order_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ntx_v1_order_proto_rawDesc), len(file_ntx_v1_order_proto_rawDesc)))
+	})
+	return file_ntx_v1_order_proto_rawDescData
+}
+
+var file_ntx_v1_order_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ntx_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ntx_v1_order_proto_goTypes = []any{
+	(OrderStatus)(0),             // 0: ntx.v1.OrderStatus
+	(*Order)(nil),                // 1: ntx.v1.Order
+	(*CreateOrderRequest)(nil),   // 2: ntx.v1.CreateOrderRequest
+	(*CreateOrderResponse)(nil),  // 3: ntx.v1.CreateOrderResponse
+	(*ListOrdersRequest)(nil),    // 4: ntx.v1.ListOrdersRequest
+	(*ListOrdersResponse)(nil),   // 5: ntx.v1.ListOrdersResponse
+	(*CancelOrderRequest)(nil),   // 6: ntx.v1.CancelOrderRequest
+	(*CancelOrderResponse)(nil),  // 7: ntx.v1.CancelOrderResponse
+	(*ExecuteOrderRequest)(nil),  // 8: ntx.v1.ExecuteOrderRequest
+	(*ExecuteOrderResponse)(nil), // 9: ntx.v1.ExecuteOrderResponse
+	(TransactionType)(0),         // 10: ntx.v1.TransactionType
+	(*Transaction)(nil),          // 11: ntx.v1.Transaction
+}
+var file_ntx_v1_order_proto_depIdxs = []int32{
+	10, // 0: ntx.v1.Order.side:type_name -> ntx.v1.TransactionType
+	0,  // 1: ntx.v1.Order.status:type_name -> ntx.v1.OrderStatus
+	10, // 2: ntx.v1.CreateOrderRequest.side:type_name -> ntx.v1.TransactionType
+	1,  // 3: ntx.v1.CreateOrderResponse.order:type_name -> ntx.v1.Order
+	0,  // 4: ntx.v1.ListOrdersRequest.status:type_name -> ntx.v1.OrderStatus
+	1,  // 5: ntx.v1.ListOrdersResponse.orders:type_name -> ntx.v1.Order
+	1,  // 6: ntx.v1.CancelOrderResponse.order:type_name -> ntx.v1.Order
+	1,  // 7: ntx.v1.ExecuteOrderResponse.order:type_name -> ntx.v1.Order
+	11, // 8: ntx.v1.ExecuteOrderResponse.transaction:type_name -> ntx.v1.Transaction
+	2,  // 9: ntx.v1.OrderService.CreateOrder:input_type -> ntx.v1.CreateOrderRequest
+	4,  // 10: ntx.v1.OrderService.ListOrders:input_type -> ntx.v1.ListOrdersRequest
+	6,  // 11: ntx.v1.OrderService.CancelOrder:input_type -> ntx.v1.CancelOrderRequest
+	8,  // 12: ntx.v1.OrderService.ExecuteOrder:input_type -> ntx.v1.ExecuteOrderRequest
+	3,  // 13: ntx.v1.OrderService.CreateOrder:output_type -> ntx.v1.CreateOrderResponse
+	5,  // 14: ntx.v1.OrderService.ListOrders:output_type -> ntx.v1.ListOrdersResponse
+	7,  // 15: ntx.v1.OrderService.CancelOrder:output_type -> ntx.v1.CancelOrderResponse
+	9,  // 16: ntx.v1.OrderService.ExecuteOrder:output_type -> ntx.v1.ExecuteOrderResponse
+	13, // [13:17] is the sub-list for method output_type
+	9,  // [9:13] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_ntx_v1_order_proto_init() }
+func file_ntx_v1_order_proto_init() {
+	if File_ntx_v1_order_proto != nil {
+		return
+	}
+	file_ntx_v1_portfolio_proto_init()
+	file_ntx_v1_order_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ntx_v1_order_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ntx_v1_order_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_order_proto_rawDesc), len(file_ntx_v1_order_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ntx_v1_order_proto_goTypes,
+		DependencyIndexes: file_ntx_v1_order_proto_depIdxs,
+		EnumInfos:         file_ntx_v1_order_proto_enumTypes,
+		MessageInfos:      file_ntx_v1_order_proto_msgTypes,
+	}.Build()
+	File_ntx_v1_order_proto = out.File
+	file_ntx_v1_order_proto_goTypes = nil
+	file_ntx_v1_order_proto_depIdxs = nil
+}
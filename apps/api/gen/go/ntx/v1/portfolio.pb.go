@@ -70,11 +70,291 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{0}
 }
 
+type HoldingSortField int32
+
+const (
+	HoldingSortField_HOLDING_SORT_FIELD_UNSPECIFIED HoldingSortField = 0 // sorts by symbol
+	HoldingSortField_HOLDING_SORT_FIELD_SYMBOL      HoldingSortField = 1
+	HoldingSortField_HOLDING_SORT_FIELD_VALUE       HoldingSortField = 2
+	HoldingSortField_HOLDING_SORT_FIELD_PNL         HoldingSortField = 3
+	HoldingSortField_HOLDING_SORT_FIELD_PNL_PERCENT HoldingSortField = 4
+	HoldingSortField_HOLDING_SORT_FIELD_DAY_CHANGE  HoldingSortField = 5
+	HoldingSortField_HOLDING_SORT_FIELD_WEIGHT      HoldingSortField = 6
+)
+
+// Enum value maps for HoldingSortField.
+var (
+	HoldingSortField_name = map[int32]string{
+		0: "HOLDING_SORT_FIELD_UNSPECIFIED",
+		1: "HOLDING_SORT_FIELD_SYMBOL",
+		2: "HOLDING_SORT_FIELD_VALUE",
+		3: "HOLDING_SORT_FIELD_PNL",
+		4: "HOLDING_SORT_FIELD_PNL_PERCENT",
+		5: "HOLDING_SORT_FIELD_DAY_CHANGE",
+		6: "HOLDING_SORT_FIELD_WEIGHT",
+	}
+	HoldingSortField_value = map[string]int32{
+		"HOLDING_SORT_FIELD_UNSPECIFIED": 0,
+		"HOLDING_SORT_FIELD_SYMBOL":      1,
+		"HOLDING_SORT_FIELD_VALUE":       2,
+		"HOLDING_SORT_FIELD_PNL":         3,
+		"HOLDING_SORT_FIELD_PNL_PERCENT": 4,
+		"HOLDING_SORT_FIELD_DAY_CHANGE":  5,
+		"HOLDING_SORT_FIELD_WEIGHT":      6,
+	}
+)
+
+func (x HoldingSortField) Enum() *HoldingSortField {
+	p := new(HoldingSortField)
+	*p = x
+	return p
+}
+
+func (x HoldingSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HoldingSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_portfolio_proto_enumTypes[1].Descriptor()
+}
+
+func (HoldingSortField) Type() protoreflect.EnumType {
+	return &file_ntx_v1_portfolio_proto_enumTypes[1]
+}
+
+func (x HoldingSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HoldingSortField.Descriptor instead.
+func (HoldingSortField) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{1}
+}
+
+type ConflictStrategy int32
+
+const (
+	ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED ConflictStrategy = 0
+	ConflictStrategy_CONFLICT_STRATEGY_SKIP        ConflictStrategy = 1
+	ConflictStrategy_CONFLICT_STRATEGY_REPLACE     ConflictStrategy = 2
+	ConflictStrategy_CONFLICT_STRATEGY_KEEP_BOTH   ConflictStrategy = 3
+)
+
+// Enum value maps for ConflictStrategy.
+var (
+	ConflictStrategy_name = map[int32]string{
+		0: "CONFLICT_STRATEGY_UNSPECIFIED",
+		1: "CONFLICT_STRATEGY_SKIP",
+		2: "CONFLICT_STRATEGY_REPLACE",
+		3: "CONFLICT_STRATEGY_KEEP_BOTH",
+	}
+	ConflictStrategy_value = map[string]int32{
+		"CONFLICT_STRATEGY_UNSPECIFIED": 0,
+		"CONFLICT_STRATEGY_SKIP":        1,
+		"CONFLICT_STRATEGY_REPLACE":     2,
+		"CONFLICT_STRATEGY_KEEP_BOTH":   3,
+	}
+)
+
+func (x ConflictStrategy) Enum() *ConflictStrategy {
+	p := new(ConflictStrategy)
+	*p = x
+	return p
+}
+
+func (x ConflictStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_portfolio_proto_enumTypes[2].Descriptor()
+}
+
+func (ConflictStrategy) Type() protoreflect.EnumType {
+	return &file_ntx_v1_portfolio_proto_enumTypes[2]
+}
+
+func (x ConflictStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictStrategy.Descriptor instead.
+func (ConflictStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{2}
+}
+
+type HistoryInterval int32
+
+const (
+	HistoryInterval_HISTORY_INTERVAL_UNSPECIFIED HistoryInterval = 0 // daily
+	HistoryInterval_HISTORY_INTERVAL_DAILY       HistoryInterval = 1
+	HistoryInterval_HISTORY_INTERVAL_WEEKLY      HistoryInterval = 2
+	HistoryInterval_HISTORY_INTERVAL_MONTHLY     HistoryInterval = 3
+)
+
+// Enum value maps for HistoryInterval.
+var (
+	HistoryInterval_name = map[int32]string{
+		0: "HISTORY_INTERVAL_UNSPECIFIED",
+		1: "HISTORY_INTERVAL_DAILY",
+		2: "HISTORY_INTERVAL_WEEKLY",
+		3: "HISTORY_INTERVAL_MONTHLY",
+	}
+	HistoryInterval_value = map[string]int32{
+		"HISTORY_INTERVAL_UNSPECIFIED": 0,
+		"HISTORY_INTERVAL_DAILY":       1,
+		"HISTORY_INTERVAL_WEEKLY":      2,
+		"HISTORY_INTERVAL_MONTHLY":     3,
+	}
+)
+
+func (x HistoryInterval) Enum() *HistoryInterval {
+	p := new(HistoryInterval)
+	*p = x
+	return p
+}
+
+func (x HistoryInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HistoryInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_portfolio_proto_enumTypes[3].Descriptor()
+}
+
+func (HistoryInterval) Type() protoreflect.EnumType {
+	return &file_ntx_v1_portfolio_proto_enumTypes[3]
+}
+
+func (x HistoryInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HistoryInterval.Descriptor instead.
+func (HistoryInterval) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{3}
+}
+
+// CostSource is where a holding's average cost came from. When several
+// sources are recorded, manual entry wins over the WACC report, which wins
+// over the cost computed from transactions.
+type CostSource int32
+
+const (
+	CostSource_COST_SOURCE_UNSPECIFIED  CostSource = 0
+	CostSource_COST_SOURCE_TRANSACTIONS CostSource = 1 // open lots, sells taking the oldest first
+	CostSource_COST_SOURCE_WACC         CostSource = 2 // CDSC weighted average cost report
+	CostSource_COST_SOURCE_MANUAL       CostSource = 3
+)
+
+// Enum value maps for CostSource.
+var (
+	CostSource_name = map[int32]string{
+		0: "COST_SOURCE_UNSPECIFIED",
+		1: "COST_SOURCE_TRANSACTIONS",
+		2: "COST_SOURCE_WACC",
+		3: "COST_SOURCE_MANUAL",
+	}
+	CostSource_value = map[string]int32{
+		"COST_SOURCE_UNSPECIFIED":  0,
+		"COST_SOURCE_TRANSACTIONS": 1,
+		"COST_SOURCE_WACC":         2,
+		"COST_SOURCE_MANUAL":       3,
+	}
+)
+
+func (x CostSource) Enum() *CostSource {
+	p := new(CostSource)
+	*p = x
+	return p
+}
+
+func (x CostSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CostSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_portfolio_proto_enumTypes[4].Descriptor()
+}
+
+func (CostSource) Type() protoreflect.EnumType {
+	return &file_ntx_v1_portfolio_proto_enumTypes[4]
+}
+
+func (x CostSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CostSource.Descriptor instead.
+func (CostSource) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{4}
+}
+
+type TimelineEventKind int32
+
+const (
+	TimelineEventKind_TIMELINE_EVENT_KIND_UNSPECIFIED      TimelineEventKind = 0
+	TimelineEventKind_TIMELINE_EVENT_KIND_TRANSACTION      TimelineEventKind = 1
+	TimelineEventKind_TIMELINE_EVENT_KIND_DIVIDEND         TimelineEventKind = 2
+	TimelineEventKind_TIMELINE_EVENT_KIND_CORPORATE_ACTION TimelineEventKind = 3 // bonus and right issues
+	TimelineEventKind_TIMELINE_EVENT_KIND_ALERT            TimelineEventKind = 4
+	TimelineEventKind_TIMELINE_EVENT_KIND_NOTE             TimelineEventKind = 5
+)
+
+// Enum value maps for TimelineEventKind.
+var (
+	TimelineEventKind_name = map[int32]string{
+		0: "TIMELINE_EVENT_KIND_UNSPECIFIED",
+		1: "TIMELINE_EVENT_KIND_TRANSACTION",
+		2: "TIMELINE_EVENT_KIND_DIVIDEND",
+		3: "TIMELINE_EVENT_KIND_CORPORATE_ACTION",
+		4: "TIMELINE_EVENT_KIND_ALERT",
+		5: "TIMELINE_EVENT_KIND_NOTE",
+	}
+	TimelineEventKind_value = map[string]int32{
+		"TIMELINE_EVENT_KIND_UNSPECIFIED":      0,
+		"TIMELINE_EVENT_KIND_TRANSACTION":      1,
+		"TIMELINE_EVENT_KIND_DIVIDEND":         2,
+		"TIMELINE_EVENT_KIND_CORPORATE_ACTION": 3,
+		"TIMELINE_EVENT_KIND_ALERT":            4,
+		"TIMELINE_EVENT_KIND_NOTE":             5,
+	}
+)
+
+func (x TimelineEventKind) Enum() *TimelineEventKind {
+	p := new(TimelineEventKind)
+	*p = x
+	return p
+}
+
+func (x TimelineEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_ntx_v1_portfolio_proto_enumTypes[5].Descriptor()
+}
+
+func (TimelineEventKind) Type() protoreflect.EnumType {
+	return &file_ntx_v1_portfolio_proto_enumTypes[5]
+}
+
+func (x TimelineEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEventKind.Descriptor instead.
+func (TimelineEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
 type Portfolio struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ProfileId     *int64                 `protobuf:"varint,4,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"` // demat holder the portfolio belongs to
+	Paper         bool                   `protobuf:"varint,5,opt,name=paper,proto3" json:"paper,omitempty"`                                // hypothetical trades, kept out of consolidated totals
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -130,6 +410,20 @@ func (x *Portfolio) GetCreatedAt() string {
 	return ""
 }
 
+func (x *Portfolio) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+func (x *Portfolio) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
 type ListPortfoliosRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -213,6 +507,8 @@ func (x *ListPortfoliosResponse) GetPortfolios() []*Portfolio {
 type CreatePortfolioRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProfileId     *int64                 `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"`
+	Paper         bool                   `protobuf:"varint,3,opt,name=paper,proto3" json:"paper,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -254,6 +550,20 @@ func (x *CreatePortfolioRequest) GetName() string {
 	return ""
 }
 
+func (x *CreatePortfolioRequest) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+func (x *CreatePortfolioRequest) GetPaper() bool {
+	if x != nil {
+		return x.Paper
+	}
+	return false
+}
+
 type CreatePortfolioResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Portfolio     *Portfolio             `protobuf:"bytes,1,opt,name=portfolio,proto3" json:"portfolio,omitempty"`
@@ -298,6 +608,88 @@ func (x *CreatePortfolioResponse) GetPortfolio() *Portfolio {
 	return nil
 }
 
+// Deletes the portfolio with its transactions, holdings and everything else
+// recorded against it.
+type DeletePortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortfolioRequest) Reset() {
+	*x = DeletePortfolioRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortfolioRequest) ProtoMessage() {}
+
+func (x *DeletePortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortfolioRequest.ProtoReflect.Descriptor instead.
+func (*DeletePortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeletePortfolioRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+type DeletePortfolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortfolioResponse) Reset() {
+	*x = DeletePortfolioResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortfolioResponse) ProtoMessage() {}
+
+func (x *DeletePortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortfolioResponse.ProtoReflect.Descriptor instead.
+func (*DeletePortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{6}
+}
+
 type Transaction struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -307,13 +699,16 @@ type Transaction struct {
 	Quantity        int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	UnitPrice       float64                `protobuf:"fixed64,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
 	TransactionDate string                 `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	Intraday        bool                   `protobuf:"varint,8,opt,name=intraday,proto3" json:"intraday,omitempty"` // bought and sold on the same day
+	Tags            []*Tag                 `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	BrokerAccountId *int64                 `protobuf:"varint,10,opt,name=broker_account_id,json=brokerAccountId,proto3,oneof" json:"broker_account_id,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[5]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +720,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[5]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +733,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{5}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Transaction) GetId() int64 {
@@ -390,21 +785,43 @@ func (x *Transaction) GetTransactionDate() string {
 	return ""
 }
 
+func (x *Transaction) GetIntraday() bool {
+	if x != nil {
+		return x.Intraday
+	}
+	return false
+}
+
+func (x *Transaction) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Transaction) GetBrokerAccountId() int64 {
+	if x != nil && x.BrokerAccountId != nil {
+		return *x.BrokerAccountId
+	}
+	return 0
+}
+
 type AddTransactionRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	PortfolioId     int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
 	StockSymbol     string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
 	TransactionType TransactionType        `protobuf:"varint,3,opt,name=transaction_type,json=transactionType,proto3,enum=ntx.v1.TransactionType" json:"transaction_type,omitempty"`
 	Quantity        int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	UnitPrice       float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	UnitPrice       float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // paper portfolios fill at the latest price when 0
 	TransactionDate string                 `protobuf:"bytes,6,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	BrokerAccountId *int64                 `protobuf:"varint,7,opt,name=broker_account_id,json=brokerAccountId,proto3,oneof" json:"broker_account_id,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddTransactionRequest) Reset() {
 	*x = AddTransactionRequest{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[6]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -416,7 +833,7 @@ func (x *AddTransactionRequest) String() string {
 func (*AddTransactionRequest) ProtoMessage() {}
 
 func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[6]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,7 +846,7 @@ func (x *AddTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTransactionRequest.ProtoReflect.Descriptor instead.
 func (*AddTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{6}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AddTransactionRequest) GetPortfolioId() int64 {
@@ -474,6 +891,13 @@ func (x *AddTransactionRequest) GetTransactionDate() string {
 	return ""
 }
 
+func (x *AddTransactionRequest) GetBrokerAccountId() int64 {
+	if x != nil && x.BrokerAccountId != nil {
+		return *x.BrokerAccountId
+	}
+	return 0
+}
+
 type AddTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
@@ -483,7 +907,7 @@ type AddTransactionResponse struct {
 
 func (x *AddTransactionResponse) Reset() {
 	*x = AddTransactionResponse{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[7]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +919,7 @@ func (x *AddTransactionResponse) String() string {
 func (*AddTransactionResponse) ProtoMessage() {}
 
 func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[7]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +932,7 @@ func (x *AddTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddTransactionResponse.ProtoReflect.Descriptor instead.
 func (*AddTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{7}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AddTransactionResponse) GetTransaction() *Transaction {
@@ -519,16 +943,20 @@ func (x *AddTransactionResponse) GetTransaction() *Transaction {
 }
 
 type ListTransactionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
-	StockSymbol   *string                `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId     int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol     *string                `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	TagId           *int64                 `protobuf:"varint,3,opt,name=tag_id,json=tagId,proto3,oneof" json:"tag_id,omitempty"`
+	BrokerAccountId *int64                 `protobuf:"varint,4,opt,name=broker_account_id,json=brokerAccountId,proto3,oneof" json:"broker_account_id,omitempty"`
+	Limit           int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"` // 0 returns every match
+	Offset          int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListTransactionsRequest) Reset() {
 	*x = ListTransactionsRequest{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[8]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -540,7 +968,7 @@ func (x *ListTransactionsRequest) String() string {
 func (*ListTransactionsRequest) ProtoMessage() {}
 
 func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[8]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +981,7 @@ func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{8}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListTransactionsRequest) GetPortfolioId() int64 {
@@ -570,16 +998,45 @@ func (x *ListTransactionsRequest) GetStockSymbol() string {
 	return ""
 }
 
+func (x *ListTransactionsRequest) GetTagId() int64 {
+	if x != nil && x.TagId != nil {
+		return *x.TagId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetBrokerAccountId() int64 {
+	if x != nil && x.BrokerAccountId != nil {
+		return *x.BrokerAccountId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
 type ListTransactionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // matches before limit/offset
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTransactionsResponse) Reset() {
 	*x = ListTransactionsResponse{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[9]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +1048,7 @@ func (x *ListTransactionsResponse) String() string {
 func (*ListTransactionsResponse) ProtoMessage() {}
 
 func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[9]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +1061,7 @@ func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{9}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
@@ -614,6 +1071,13 @@ func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (x *ListTransactionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type DeleteTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -623,7 +1087,7 @@ type DeleteTransactionRequest struct {
 
 func (x *DeleteTransactionRequest) Reset() {
 	*x = DeleteTransactionRequest{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[10]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -635,7 +1099,7 @@ func (x *DeleteTransactionRequest) String() string {
 func (*DeleteTransactionRequest) ProtoMessage() {}
 
 func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[10]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +1112,7 @@ func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{10}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteTransactionRequest) GetTransactionId() int64 {
@@ -666,7 +1130,7 @@ type DeleteTransactionResponse struct {
 
 func (x *DeleteTransactionResponse) Reset() {
 	*x = DeleteTransactionResponse{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[11]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -678,7 +1142,7 @@ func (x *DeleteTransactionResponse) String() string {
 func (*DeleteTransactionResponse) ProtoMessage() {}
 
 func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[11]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +1155,7 @@ func (x *DeleteTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTransactionResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{11}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{13}
 }
 
 type Holding struct {
@@ -706,13 +1170,21 @@ type Holding struct {
 	Sector            string                 `protobuf:"bytes,8,opt,name=sector,proto3" json:"sector,omitempty"`
 	DayChangePercent  float64                `protobuf:"fixed64,9,opt,name=day_change_percent,json=dayChangePercent,proto3" json:"day_change_percent,omitempty"`
 	DayChangeValue    float64                `protobuf:"fixed64,10,opt,name=day_change_value,json=dayChangeValue,proto3" json:"day_change_value,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	WeightPercent     float64                `protobuf:"fixed64,11,opt,name=weight_percent,json=weightPercent,proto3" json:"weight_percent,omitempty"`              // share of total portfolio value
+	CostSource        CostSource             `protobuf:"varint,12,opt,name=cost_source,json=costSource,proto3,enum=ntx.v1.CostSource" json:"cost_source,omitempty"` // where avg_buy_price came from
+	InstrumentType    InstrumentType         `protobuf:"varint,13,opt,name=instrument_type,json=instrumentType,proto3,enum=ntx.v1.InstrumentType" json:"instrument_type,omitempty"`
+	// Coupon earned since the last payment; included in total_value.
+	AccruedInterest float64       `protobuf:"fixed64,14,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"`
+	ListingStatus   ListingStatus `protobuf:"varint,15,opt,name=listing_status,json=listingStatus,proto3,enum=ntx.v1.ListingStatus" json:"listing_status,omitempty"`
+	DelistedOn      string        `protobuf:"bytes,16,opt,name=delisted_on,json=delistedOn,proto3" json:"delisted_on,omitempty"` // first sync that saw the delisting
+	Group           string        `protobuf:"bytes,17,opt,name=group,proto3" json:"group,omitempty"`                             // the user's holding group, empty if none
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Holding) Reset() {
 	*x = Holding{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[12]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -724,7 +1196,7 @@ func (x *Holding) String() string {
 func (*Holding) ProtoMessage() {}
 
 func (x *Holding) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[12]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +1209,7 @@ func (x *Holding) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Holding.ProtoReflect.Descriptor instead.
 func (*Holding) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{12}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Holding) GetStockSymbol() string {
@@ -810,6 +1282,55 @@ func (x *Holding) GetDayChangeValue() float64 {
 	return 0
 }
 
+func (x *Holding) GetWeightPercent() float64 {
+	if x != nil {
+		return x.WeightPercent
+	}
+	return 0
+}
+
+func (x *Holding) GetCostSource() CostSource {
+	if x != nil {
+		return x.CostSource
+	}
+	return CostSource_COST_SOURCE_UNSPECIFIED
+}
+
+func (x *Holding) GetInstrumentType() InstrumentType {
+	if x != nil {
+		return x.InstrumentType
+	}
+	return InstrumentType_INSTRUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *Holding) GetAccruedInterest() float64 {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return 0
+}
+
+func (x *Holding) GetListingStatus() ListingStatus {
+	if x != nil {
+		return x.ListingStatus
+	}
+	return ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+func (x *Holding) GetDelistedOn() string {
+	if x != nil {
+		return x.DelistedOn
+	}
+	return ""
+}
+
+func (x *Holding) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
 type PortfolioSummary struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
 	PortfolioId            int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
@@ -821,13 +1342,15 @@ type PortfolioSummary struct {
 	TotalProfitLossPercent float64                `protobuf:"fixed64,7,opt,name=total_profit_loss_percent,json=totalProfitLossPercent,proto3" json:"total_profit_loss_percent,omitempty"`
 	ProjectedDividend      float64                `protobuf:"fixed64,8,opt,name=projected_dividend,json=projectedDividend,proto3" json:"projected_dividend,omitempty"`
 	HealthTips             []*HealthTip           `protobuf:"bytes,9,rep,name=health_tips,json=healthTips,proto3" json:"health_tips,omitempty"`
+	DayChangeValue         float64                `protobuf:"fixed64,10,opt,name=day_change_value,json=dayChangeValue,proto3" json:"day_change_value,omitempty"`
+	DayChangePercent       float64                `protobuf:"fixed64,11,opt,name=day_change_percent,json=dayChangePercent,proto3" json:"day_change_percent,omitempty"` // relative to the previous close value
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *PortfolioSummary) Reset() {
 	*x = PortfolioSummary{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[13]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -839,7 +1362,7 @@ func (x *PortfolioSummary) String() string {
 func (*PortfolioSummary) ProtoMessage() {}
 
 func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[13]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1375,7 @@ func (x *PortfolioSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortfolioSummary.ProtoReflect.Descriptor instead.
 func (*PortfolioSummary) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{13}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PortfolioSummary) GetPortfolioId() int64 {
@@ -918,6 +1441,20 @@ func (x *PortfolioSummary) GetHealthTips() []*HealthTip {
 	return nil
 }
 
+func (x *PortfolioSummary) GetDayChangeValue() float64 {
+	if x != nil {
+		return x.DayChangeValue
+	}
+	return 0
+}
+
+func (x *PortfolioSummary) GetDayChangePercent() float64 {
+	if x != nil {
+		return x.DayChangePercent
+	}
+	return 0
+}
+
 type HealthTip struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
@@ -929,7 +1466,7 @@ type HealthTip struct {
 
 func (x *HealthTip) Reset() {
 	*x = HealthTip{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[14]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +1478,7 @@ func (x *HealthTip) String() string {
 func (*HealthTip) ProtoMessage() {}
 
 func (x *HealthTip) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[14]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1491,7 @@ func (x *HealthTip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthTip.ProtoReflect.Descriptor instead.
 func (*HealthTip) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{14}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *HealthTip) GetSymbol() string {
@@ -981,13 +1518,14 @@ func (x *HealthTip) GetType() string {
 type GetPortfolioSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	AsOf          string                 `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // YYYY-MM-DD, empty for now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetPortfolioSummaryRequest) Reset() {
 	*x = GetPortfolioSummaryRequest{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[15]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -999,7 +1537,7 @@ func (x *GetPortfolioSummaryRequest) String() string {
 func (*GetPortfolioSummaryRequest) ProtoMessage() {}
 
 func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[15]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1550,7 @@ func (x *GetPortfolioSummaryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioSummaryRequest.ProtoReflect.Descriptor instead.
 func (*GetPortfolioSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{15}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetPortfolioSummaryRequest) GetPortfolioId() int64 {
@@ -1022,6 +1560,13 @@ func (x *GetPortfolioSummaryRequest) GetPortfolioId() int64 {
 	return 0
 }
 
+func (x *GetPortfolioSummaryRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
 type GetPortfolioSummaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Summary       *PortfolioSummary      `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
@@ -1031,7 +1576,7 @@ type GetPortfolioSummaryResponse struct {
 
 func (x *GetPortfolioSummaryResponse) Reset() {
 	*x = GetPortfolioSummaryResponse{}
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[16]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1043,7 +1588,7 @@ func (x *GetPortfolioSummaryResponse) String() string {
 func (*GetPortfolioSummaryResponse) ProtoMessage() {}
 
 func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ntx_v1_portfolio_proto_msgTypes[16]
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1056,7 +1601,7 @@ func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{16}
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetPortfolioSummaryResponse) GetSummary() *PortfolioSummary {
@@ -1066,97 +1611,7716 @@ func (x *GetPortfolioSummaryResponse) GetSummary() *PortfolioSummary {
 	return nil
 }
 
-var File_ntx_v1_portfolio_proto protoreflect.FileDescriptor
+type ListHoldingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	SortBy        HoldingSortField       `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=ntx.v1.HoldingSortField" json:"sort_by,omitempty"`
+	Descending    bool                   `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	Sector        *string                `protobuf:"bytes,4,opt,name=sector,proto3,oneof" json:"sector,omitempty"`
+	MinValue      *float64               `protobuf:"fixed64,5,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	OnlyGainers   bool                   `protobuf:"varint,6,opt,name=only_gainers,json=onlyGainers,proto3" json:"only_gainers,omitempty"`
+	OnlyLosers    bool                   `protobuf:"varint,7,opt,name=only_losers,json=onlyLosers,proto3" json:"only_losers,omitempty"`
+	Limit         int32                  `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"` // 0 returns every match
+	Offset        int32                  `protobuf:"varint,9,opt,name=offset,proto3" json:"offset,omitempty"`
+	AsOf          string                 `protobuf:"bytes,10,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // YYYY-MM-DD, empty for now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_ntx_v1_portfolio_proto_rawDesc = "" +
-	"\n" +
-	"\x16ntx/v1/portfolio.proto\x12\x06ntx.v1\"N\n" +
-	"\tPortfolio\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\x17\n" +
-	"\x15ListPortfoliosRequest\"K\n" +
-	"\x16ListPortfoliosResponse\x121\n" +
-	"\n" +
-	"portfolios\x18\x01 \x03(\v2\x11.ntx.v1.PortfolioR\n" +
-	"portfolios\",\n" +
-	"\x16CreatePortfolioRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"J\n" +
-	"\x17CreatePortfolioResponse\x12/\n" +
-	"\tportfolio\x18\x01 \x01(\v2\x11.ntx.v1.PortfolioR\tportfolio\"\x8d\x02\n" +
-	"\vTransaction\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
-	"\fportfolio_id\x18\x02 \x01(\x03R\vportfolioId\x12!\n" +
-	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x12B\n" +
-	"\x10transaction_type\x18\x04 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
-	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1d\n" +
-	"\n" +
-	"unit_price\x18\x06 \x01(\x01R\tunitPrice\x12)\n" +
-	"\x10transaction_date\x18\a \x01(\tR\x0ftransactionDate\"\x87\x02\n" +
-	"\x15AddTransactionRequest\x12!\n" +
-	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
-	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12B\n" +
-	"\x10transaction_type\x18\x03 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
-	"\n" +
-	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12)\n" +
-	"\x10transaction_date\x18\x06 \x01(\tR\x0ftransactionDate\"O\n" +
-	"\x16AddTransactionResponse\x125\n" +
-	"\vtransaction\x18\x01 \x01(\v2\x13.ntx.v1.TransactionR\vtransaction\"u\n" +
-	"\x17ListTransactionsRequest\x12!\n" +
-	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12&\n" +
-	"\fstock_symbol\x18\x02 \x01(\tH\x00R\vstockSymbol\x88\x01\x01B\x0f\n" +
-	"\r_stock_symbol\"S\n" +
-	"\x18ListTransactionsResponse\x127\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x13.ntx.v1.TransactionR\ftransactions\"A\n" +
-	"\x18DeleteTransactionRequest\x12%\n" +
-	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"\x1b\n" +
-	"\x19DeleteTransactionResponse\"\xf3\x02\n" +
-	"\aHolding\x12!\n" +
-	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\"\n" +
-	"\ravg_buy_price\x18\x03 \x01(\x01R\vavgBuyPrice\x12#\n" +
-	"\rcurrent_price\x18\x04 \x01(\x01R\fcurrentPrice\x12\x1f\n" +
-	"\vtotal_value\x18\x05 \x01(\x01R\n" +
-	"totalValue\x12\x1f\n" +
-	"\vprofit_loss\x18\x06 \x01(\x01R\n" +
-	"profitLoss\x12.\n" +
-	"\x13profit_loss_percent\x18\a \x01(\x01R\x11profitLossPercent\x12\x16\n" +
-	"\x06sector\x18\b \x01(\tR\x06sector\x12,\n" +
-	"\x12day_change_percent\x18\t \x01(\x01R\x10dayChangePercent\x12(\n" +
-	"\x10day_change_value\x18\n" +
-	" \x01(\x01R\x0edayChangeValue\"\xaa\x03\n" +
-	"\x10PortfolioSummary\x12!\n" +
-	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12%\n" +
-	"\x0eportfolio_name\x18\x02 \x01(\tR\rportfolioName\x12+\n" +
-	"\bholdings\x18\x03 \x03(\v2\x0f.ntx.v1.HoldingR\bholdings\x12%\n" +
-	"\x0etotal_invested\x18\x04 \x01(\x01R\rtotalInvested\x12.\n" +
-	"\x13total_current_value\x18\x05 \x01(\x01R\x11totalCurrentValue\x12*\n" +
-	"\x11total_profit_loss\x18\x06 \x01(\x01R\x0ftotalProfitLoss\x129\n" +
-	"\x19total_profit_loss_percent\x18\a \x01(\x01R\x16totalProfitLossPercent\x12-\n" +
-	"\x12projected_dividend\x18\b \x01(\x01R\x11projectedDividend\x122\n" +
-	"\vhealth_tips\x18\t \x03(\v2\x11.ntx.v1.HealthTipR\n" +
-	"healthTips\"Q\n" +
-	"\tHealthTip\x12\x16\n" +
-	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\"?\n" +
-	"\x1aGetPortfolioSummaryRequest\x12!\n" +
-	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"Q\n" +
-	"\x1bGetPortfolioSummaryResponse\x122\n" +
-	"\asummary\x18\x01 \x01(\v2\x18.ntx.v1.PortfolioSummaryR\asummary*h\n" +
-	"\x0fTransactionType\x12 \n" +
-	"\x1cTRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
-	"\x14TRANSACTION_TYPE_BUY\x10\x01\x12\x19\n" +
-	"\x15TRANSACTION_TYPE_SELL\x10\x022\x99\x04\n" +
+func (x *ListHoldingsRequest) Reset() {
+	*x = ListHoldingsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldingsRequest) ProtoMessage() {}
+
+func (x *ListHoldingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldingsRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldingsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListHoldingsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ListHoldingsRequest) GetSortBy() HoldingSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return HoldingSortField_HOLDING_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListHoldingsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListHoldingsRequest) GetSector() string {
+	if x != nil && x.Sector != nil {
+		return *x.Sector
+	}
+	return ""
+}
+
+func (x *ListHoldingsRequest) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *ListHoldingsRequest) GetOnlyGainers() bool {
+	if x != nil {
+		return x.OnlyGainers
+	}
+	return false
+}
+
+func (x *ListHoldingsRequest) GetOnlyLosers() bool {
+	if x != nil {
+		return x.OnlyLosers
+	}
+	return false
+}
+
+func (x *ListHoldingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHoldingsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListHoldingsRequest) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type ListHoldingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holdings      []*Holding             `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // matches before limit/offset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldingsResponse) Reset() {
+	*x = ListHoldingsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldingsResponse) ProtoMessage() {}
+
+func (x *ListHoldingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldingsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldingsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListHoldingsResponse) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *ListHoldingsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type Lot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol    string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Quantity       int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice      float64                `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	AcquiredDate   string                 `protobuf:"bytes,4,opt,name=acquired_date,json=acquiredDate,proto3" json:"acquired_date,omitempty"`
+	HoldingDays    int32                  `protobuf:"varint,5,opt,name=holding_days,json=holdingDays,proto3" json:"holding_days,omitempty"`
+	LongTermDate   string                 `protobuf:"bytes,6,opt,name=long_term_date,json=longTermDate,proto3" json:"long_term_date,omitempty"` // first day gains qualify for the 5% CGT rate
+	DaysToLongTerm int32                  `protobuf:"varint,7,opt,name=days_to_long_term,json=daysToLongTerm,proto3" json:"days_to_long_term,omitempty"`
+	LongTerm       bool                   `protobuf:"varint,8,opt,name=long_term,json=longTerm,proto3" json:"long_term,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Lot) Reset() {
+	*x = Lot{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lot) ProtoMessage() {}
+
+func (x *Lot) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lot.ProtoReflect.Descriptor instead.
+func (*Lot) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Lot) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *Lot) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Lot) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Lot) GetAcquiredDate() string {
+	if x != nil {
+		return x.AcquiredDate
+	}
+	return ""
+}
+
+func (x *Lot) GetHoldingDays() int32 {
+	if x != nil {
+		return x.HoldingDays
+	}
+	return 0
+}
+
+func (x *Lot) GetLongTermDate() string {
+	if x != nil {
+		return x.LongTermDate
+	}
+	return ""
+}
+
+func (x *Lot) GetDaysToLongTerm() int32 {
+	if x != nil {
+		return x.DaysToLongTerm
+	}
+	return 0
+}
+
+func (x *Lot) GetLongTerm() bool {
+	if x != nil {
+		return x.LongTerm
+	}
+	return false
+}
+
+type ListLotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   *string                `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLotsRequest) Reset() {
+	*x = ListLotsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsRequest) ProtoMessage() {}
+
+func (x *ListLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLotsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListLotsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ListLotsRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+type ListLotsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Lots              []*Lot                 `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	LongTermQuantity  int64                  `protobuf:"varint,2,opt,name=long_term_quantity,json=longTermQuantity,proto3" json:"long_term_quantity,omitempty"` // sellable at the 5% long-term rate
+	ShortTermQuantity int64                  `protobuf:"varint,3,opt,name=short_term_quantity,json=shortTermQuantity,proto3" json:"short_term_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListLotsResponse) Reset() {
+	*x = ListLotsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsResponse) ProtoMessage() {}
+
+func (x *ListLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLotsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListLotsResponse) GetLots() []*Lot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *ListLotsResponse) GetLongTermQuantity() int64 {
+	if x != nil {
+		return x.LongTermQuantity
+	}
+	return 0
+}
+
+func (x *ListLotsResponse) GetShortTermQuantity() int64 {
+	if x != nil {
+		return x.ShortTermQuantity
+	}
+	return 0
+}
+
+type ImportConflict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Existing      *Transaction           `protobuf:"bytes,2,opt,name=existing,proto3" json:"existing,omitempty"`
+	Imported      *Transaction           `protobuf:"bytes,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	Resolution    ConflictStrategy       `protobuf:"varint,4,opt,name=resolution,proto3,enum=ntx.v1.ConflictStrategy" json:"resolution,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConflict) Reset() {
+	*x = ImportConflict{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConflict) ProtoMessage() {}
+
+func (x *ImportConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConflict.ProtoReflect.Descriptor instead.
+func (*ImportConflict) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportConflict) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportConflict) GetExisting() *Transaction {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+func (x *ImportConflict) GetImported() *Transaction {
+	if x != nil {
+		return x.Imported
+	}
+	return nil
+}
+
+func (x *ImportConflict) GetResolution() ConflictStrategy {
+	if x != nil {
+		return x.Resolution
+	}
+	return ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED
+}
+
+type ImportTransactionsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// CSV with header: symbol,type,quantity,price,date
+	CsvData          []byte           `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	ConflictStrategy ConflictStrategy `protobuf:"varint,3,opt,name=conflict_strategy,json=conflictStrategy,proto3,enum=ntx.v1.ConflictStrategy" json:"conflict_strategy,omitempty"` // unspecified means skip
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsRequest) Reset() {
+	*x = ImportTransactionsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsRequest) ProtoMessage() {}
+
+func (x *ImportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportTransactionsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ImportTransactionsRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *ImportTransactionsRequest) GetConflictStrategy() ConflictStrategy {
+	if x != nil {
+		return x.ConflictStrategy
+	}
+	return ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED
+}
+
+type ImportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Replaced      int32                  `protobuf:"varint,3,opt,name=replaced,proto3" json:"replaced,omitempty"`
+	Conflicts     []*ImportConflict      `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTransactionsResponse) Reset() {
+	*x = ImportTransactionsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTransactionsResponse) ProtoMessage() {}
+
+func (x *ImportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ImportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ImportTransactionsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetReplaced() int32 {
+	if x != nil {
+		return x.Replaced
+	}
+	return 0
+}
+
+func (x *ImportTransactionsResponse) GetConflicts() []*ImportConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ExportTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsRequest) Reset() {
+	*x = ExportTransactionsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsRequest) ProtoMessage() {}
+
+func (x *ExportTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportTransactionsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+// ExportTransactionsResponse is every transaction in the import file layout,
+// oldest first. Importing it into another instance with the skip strategy
+// adds only the trades that instance is missing.
+type ExportTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTransactionsResponse) Reset() {
+	*x = ExportTransactionsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTransactionsResponse) ProtoMessage() {}
+
+func (x *ExportTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ExportTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportTransactionsResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportTransactionsResponse) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+type PortfolioHistoryPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Cost          float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	RealizedPnl   float64                `protobuf:"fixed64,4,opt,name=realized_pnl,json=realizedPnl,proto3" json:"realized_pnl,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,5,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioHistoryPoint) Reset() {
+	*x = PortfolioHistoryPoint{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioHistoryPoint) ProtoMessage() {}
+
+func (x *PortfolioHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioHistoryPoint.ProtoReflect.Descriptor instead.
+func (*PortfolioHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PortfolioHistoryPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PortfolioHistoryPoint) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PortfolioHistoryPoint) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *PortfolioHistoryPoint) GetRealizedPnl() float64 {
+	if x != nil {
+		return x.RealizedPnl
+	}
+	return 0
+}
+
+func (x *PortfolioHistoryPoint) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+type GetPortfolioHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // YYYY-MM-DD, defaults to one year ago
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // YYYY-MM-DD, defaults to today
+	Interval      HistoryInterval        `protobuf:"varint,4,opt,name=interval,proto3,enum=ntx.v1.HistoryInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioHistoryRequest) Reset() {
+	*x = GetPortfolioHistoryRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioHistoryRequest) ProtoMessage() {}
+
+func (x *GetPortfolioHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPortfolioHistoryRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetPortfolioHistoryRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetPortfolioHistoryRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *GetPortfolioHistoryRequest) GetInterval() HistoryInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return HistoryInterval_HISTORY_INTERVAL_UNSPECIFIED
+}
+
+type GetPortfolioHistoryResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Points        []*PortfolioHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortfolioHistoryResponse) Reset() {
+	*x = GetPortfolioHistoryResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortfolioHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortfolioHistoryResponse) ProtoMessage() {}
+
+func (x *GetPortfolioHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortfolioHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetPortfolioHistoryResponse) GetPoints() []*PortfolioHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type PortfolioBreakdown struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId       int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	PortfolioName     string                 `protobuf:"bytes,2,opt,name=portfolio_name,json=portfolioName,proto3" json:"portfolio_name,omitempty"`
+	TotalInvested     float64                `protobuf:"fixed64,3,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"`
+	TotalCurrentValue float64                `protobuf:"fixed64,4,opt,name=total_current_value,json=totalCurrentValue,proto3" json:"total_current_value,omitempty"`
+	TotalProfitLoss   float64                `protobuf:"fixed64,5,opt,name=total_profit_loss,json=totalProfitLoss,proto3" json:"total_profit_loss,omitempty"`
+	DayChangeValue    float64                `protobuf:"fixed64,6,opt,name=day_change_value,json=dayChangeValue,proto3" json:"day_change_value,omitempty"`
+	WeightPercent     float64                `protobuf:"fixed64,7,opt,name=weight_percent,json=weightPercent,proto3" json:"weight_percent,omitempty"`
+	ProfileId         *int64                 `protobuf:"varint,8,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PortfolioBreakdown) Reset() {
+	*x = PortfolioBreakdown{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioBreakdown) ProtoMessage() {}
+
+func (x *PortfolioBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioBreakdown.ProtoReflect.Descriptor instead.
+func (*PortfolioBreakdown) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PortfolioBreakdown) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetPortfolioName() string {
+	if x != nil {
+		return x.PortfolioName
+	}
+	return ""
+}
+
+func (x *PortfolioBreakdown) GetTotalInvested() float64 {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetTotalCurrentValue() float64 {
+	if x != nil {
+		return x.TotalCurrentValue
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetTotalProfitLoss() float64 {
+	if x != nil {
+		return x.TotalProfitLoss
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetDayChangeValue() float64 {
+	if x != nil {
+		return x.DayChangeValue
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetWeightPercent() float64 {
+	if x != nil {
+		return x.WeightPercent
+	}
+	return 0
+}
+
+func (x *PortfolioBreakdown) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+type TaxSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FiscalYearStart string                 `protobuf:"bytes,1,opt,name=fiscal_year_start,json=fiscalYearStart,proto3" json:"fiscal_year_start,omitempty"` // 1 Shrawan
+	ShortTermGain   float64                `protobuf:"fixed64,2,opt,name=short_term_gain,json=shortTermGain,proto3" json:"short_term_gain,omitempty"`
+	LongTermGain    float64                `protobuf:"fixed64,3,opt,name=long_term_gain,json=longTermGain,proto3" json:"long_term_gain,omitempty"`
+	EstimatedTax    float64                `protobuf:"fixed64,4,opt,name=estimated_tax,json=estimatedTax,proto3" json:"estimated_tax,omitempty"`
+	FiscalYearEnd   string                 `protobuf:"bytes,5,opt,name=fiscal_year_end,json=fiscalYearEnd,proto3" json:"fiscal_year_end,omitempty"` // the day before the next 1 Shrawan
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaxSummary) Reset() {
+	*x = TaxSummary{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaxSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxSummary) ProtoMessage() {}
+
+func (x *TaxSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxSummary.ProtoReflect.Descriptor instead.
+func (*TaxSummary) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TaxSummary) GetFiscalYearStart() string {
+	if x != nil {
+		return x.FiscalYearStart
+	}
+	return ""
+}
+
+func (x *TaxSummary) GetShortTermGain() float64 {
+	if x != nil {
+		return x.ShortTermGain
+	}
+	return 0
+}
+
+func (x *TaxSummary) GetLongTermGain() float64 {
+	if x != nil {
+		return x.LongTermGain
+	}
+	return 0
+}
+
+func (x *TaxSummary) GetEstimatedTax() float64 {
+	if x != nil {
+		return x.EstimatedTax
+	}
+	return 0
+}
+
+func (x *TaxSummary) GetFiscalYearEnd() string {
+	if x != nil {
+		return x.FiscalYearEnd
+	}
+	return ""
+}
+
+type ConsolidatedSummary struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Portfolios             []*PortfolioBreakdown  `protobuf:"bytes,1,rep,name=portfolios,proto3" json:"portfolios,omitempty"`
+	Holdings               []*Holding             `protobuf:"bytes,2,rep,name=holdings,proto3" json:"holdings,omitempty"` // merged by symbol across portfolios
+	TotalInvested          float64                `protobuf:"fixed64,3,opt,name=total_invested,json=totalInvested,proto3" json:"total_invested,omitempty"`
+	TotalCurrentValue      float64                `protobuf:"fixed64,4,opt,name=total_current_value,json=totalCurrentValue,proto3" json:"total_current_value,omitempty"`
+	TotalProfitLoss        float64                `protobuf:"fixed64,5,opt,name=total_profit_loss,json=totalProfitLoss,proto3" json:"total_profit_loss,omitempty"`
+	TotalProfitLossPercent float64                `protobuf:"fixed64,6,opt,name=total_profit_loss_percent,json=totalProfitLossPercent,proto3" json:"total_profit_loss_percent,omitempty"`
+	DayChangeValue         float64                `protobuf:"fixed64,7,opt,name=day_change_value,json=dayChangeValue,proto3" json:"day_change_value,omitempty"`
+	Tax                    *TaxSummary            `protobuf:"bytes,8,opt,name=tax,proto3" json:"tax,omitempty"` // realized gains in the current fiscal year
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ConsolidatedSummary) Reset() {
+	*x = ConsolidatedSummary{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsolidatedSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidatedSummary) ProtoMessage() {}
+
+func (x *ConsolidatedSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidatedSummary.ProtoReflect.Descriptor instead.
+func (*ConsolidatedSummary) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConsolidatedSummary) GetPortfolios() []*PortfolioBreakdown {
+	if x != nil {
+		return x.Portfolios
+	}
+	return nil
+}
+
+func (x *ConsolidatedSummary) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *ConsolidatedSummary) GetTotalInvested() float64 {
+	if x != nil {
+		return x.TotalInvested
+	}
+	return 0
+}
+
+func (x *ConsolidatedSummary) GetTotalCurrentValue() float64 {
+	if x != nil {
+		return x.TotalCurrentValue
+	}
+	return 0
+}
+
+func (x *ConsolidatedSummary) GetTotalProfitLoss() float64 {
+	if x != nil {
+		return x.TotalProfitLoss
+	}
+	return 0
+}
+
+func (x *ConsolidatedSummary) GetTotalProfitLossPercent() float64 {
+	if x != nil {
+		return x.TotalProfitLossPercent
+	}
+	return 0
+}
+
+func (x *ConsolidatedSummary) GetDayChangeValue() float64 {
+	if x != nil {
+		return x.DayChangeValue
+	}
+	return 0
+}
+
+func (x *ConsolidatedSummary) GetTax() *TaxSummary {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+type GetConsolidatedSummaryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only roll up this profile's portfolios; every portfolio when unset
+	ProfileId     *int64 `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsolidatedSummaryRequest) Reset() {
+	*x = GetConsolidatedSummaryRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsolidatedSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsolidatedSummaryRequest) ProtoMessage() {}
+
+func (x *GetConsolidatedSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsolidatedSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetConsolidatedSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetConsolidatedSummaryRequest) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+type GetConsolidatedSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ConsolidatedSummary   `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConsolidatedSummaryResponse) Reset() {
+	*x = GetConsolidatedSummaryResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConsolidatedSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsolidatedSummaryResponse) ProtoMessage() {}
+
+func (x *GetConsolidatedSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsolidatedSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetConsolidatedSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetConsolidatedSummaryResponse) GetSummary() *ConsolidatedSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type HoldingAttribution struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol         string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	StartQuantity       int64                  `protobuf:"varint,2,opt,name=start_quantity,json=startQuantity,proto3" json:"start_quantity,omitempty"`
+	EndQuantity         int64                  `protobuf:"varint,3,opt,name=end_quantity,json=endQuantity,proto3" json:"end_quantity,omitempty"`
+	StartValue          float64                `protobuf:"fixed64,4,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue            float64                `protobuf:"fixed64,5,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	NetFlow             float64                `protobuf:"fixed64,6,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`                        // buys minus sells within the period
+	PriceEffect         float64                `protobuf:"fixed64,7,opt,name=price_effect,json=priceEffect,proto3" json:"price_effect,omitempty"`            // P&L on the quantity held at the start
+	NewMoneyEffect      float64                `protobuf:"fixed64,8,opt,name=new_money_effect,json=newMoneyEffect,proto3" json:"new_money_effect,omitempty"` // P&L on trades made within the period
+	TotalPnl            float64                `protobuf:"fixed64,9,opt,name=total_pnl,json=totalPnl,proto3" json:"total_pnl,omitempty"`
+	ContributionPercent float64                `protobuf:"fixed64,10,opt,name=contribution_percent,json=contributionPercent,proto3" json:"contribution_percent,omitempty"` // share of the portfolio return, in points
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *HoldingAttribution) Reset() {
+	*x = HoldingAttribution{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldingAttribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldingAttribution) ProtoMessage() {}
+
+func (x *HoldingAttribution) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldingAttribution.ProtoReflect.Descriptor instead.
+func (*HoldingAttribution) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HoldingAttribution) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *HoldingAttribution) GetStartQuantity() int64 {
+	if x != nil {
+		return x.StartQuantity
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetEndQuantity() int64 {
+	if x != nil {
+		return x.EndQuantity
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetNetFlow() float64 {
+	if x != nil {
+		return x.NetFlow
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetPriceEffect() float64 {
+	if x != nil {
+		return x.PriceEffect
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetNewMoneyEffect() float64 {
+	if x != nil {
+		return x.NewMoneyEffect
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetTotalPnl() float64 {
+	if x != nil {
+		return x.TotalPnl
+	}
+	return 0
+}
+
+func (x *HoldingAttribution) GetContributionPercent() float64 {
+	if x != nil {
+		return x.ContributionPercent
+	}
+	return 0
+}
+
+type GetAttributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // YYYY-MM-DD, defaults to one year before to_date
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // YYYY-MM-DD, defaults to today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributionRequest) Reset() {
+	*x = GetAttributionRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributionRequest) ProtoMessage() {}
+
+func (x *GetAttributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributionRequest.ProtoReflect.Descriptor instead.
+func (*GetAttributionRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAttributionRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetAttributionRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetAttributionRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetAttributionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holdings      []*HoldingAttribution  `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"` // largest contribution first
+	StartValue    float64                `protobuf:"fixed64,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      float64                `protobuf:"fixed64,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	NetFlow       float64                `protobuf:"fixed64,4,opt,name=net_flow,json=netFlow,proto3" json:"net_flow,omitempty"`
+	TotalPnl      float64                `protobuf:"fixed64,5,opt,name=total_pnl,json=totalPnl,proto3" json:"total_pnl,omitempty"`
+	ReturnPercent float64                `protobuf:"fixed64,6,opt,name=return_percent,json=returnPercent,proto3" json:"return_percent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributionResponse) Reset() {
+	*x = GetAttributionResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributionResponse) ProtoMessage() {}
+
+func (x *GetAttributionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributionResponse.ProtoReflect.Descriptor instead.
+func (*GetAttributionResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAttributionResponse) GetHoldings() []*HoldingAttribution {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *GetAttributionResponse) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *GetAttributionResponse) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *GetAttributionResponse) GetNetFlow() float64 {
+	if x != nil {
+		return x.NetFlow
+	}
+	return 0
+}
+
+func (x *GetAttributionResponse) GetTotalPnl() float64 {
+	if x != nil {
+		return x.TotalPnl
+	}
+	return 0
+}
+
+func (x *GetAttributionResponse) GetReturnPercent() float64 {
+	if x != nil {
+		return x.ReturnPercent
+	}
+	return 0
+}
+
+type ProjectPortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	Simulations   int32                  `protobuf:"varint,2,opt,name=simulations,proto3" json:"simulations,omitempty"`                              // defaults to 2000, capped at 20000
+	HorizonYears  []int32                `protobuf:"varint,3,rep,packed,name=horizon_years,json=horizonYears,proto3" json:"horizon_years,omitempty"` // defaults to 1, 3 and 5
+	Seed          *uint64                `protobuf:"varint,4,opt,name=seed,proto3,oneof" json:"seed,omitempty"`                                      // for reproducible runs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectPortfolioRequest) Reset() {
+	*x = ProjectPortfolioRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectPortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectPortfolioRequest) ProtoMessage() {}
+
+func (x *ProjectPortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectPortfolioRequest.ProtoReflect.Descriptor instead.
+func (*ProjectPortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ProjectPortfolioRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ProjectPortfolioRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *ProjectPortfolioRequest) GetHorizonYears() []int32 {
+	if x != nil {
+		return x.HorizonYears
+	}
+	return nil
+}
+
+func (x *ProjectPortfolioRequest) GetSeed() uint64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+// ProjectionBand is the distribution of simulated portfolio values at one
+// horizon.
+type ProjectionBand struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	HorizonYears      int32                  `protobuf:"varint,1,opt,name=horizon_years,json=horizonYears,proto3" json:"horizon_years,omitempty"`
+	P5                float64                `protobuf:"fixed64,2,opt,name=p5,proto3" json:"p5,omitempty"`
+	P25               float64                `protobuf:"fixed64,3,opt,name=p25,proto3" json:"p25,omitempty"`
+	P50               float64                `protobuf:"fixed64,4,opt,name=p50,proto3" json:"p50,omitempty"`
+	P75               float64                `protobuf:"fixed64,5,opt,name=p75,proto3" json:"p75,omitempty"`
+	P95               float64                `protobuf:"fixed64,6,opt,name=p95,proto3" json:"p95,omitempty"`
+	ProbabilityOfLoss float64                `protobuf:"fixed64,7,opt,name=probability_of_loss,json=probabilityOfLoss,proto3" json:"probability_of_loss,omitempty"` // share of paths ending below current value
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProjectionBand) Reset() {
+	*x = ProjectionBand{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectionBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectionBand) ProtoMessage() {}
+
+func (x *ProjectionBand) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectionBand.ProtoReflect.Descriptor instead.
+func (*ProjectionBand) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ProjectionBand) GetHorizonYears() int32 {
+	if x != nil {
+		return x.HorizonYears
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetP5() float64 {
+	if x != nil {
+		return x.P5
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetP25() float64 {
+	if x != nil {
+		return x.P25
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetP75() float64 {
+	if x != nil {
+		return x.P75
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *ProjectionBand) GetProbabilityOfLoss() float64 {
+	if x != nil {
+		return x.ProbabilityOfLoss
+	}
+	return 0
+}
+
+type ProjectPortfolioResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CurrentValue float64                `protobuf:"fixed64,1,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	Bands        []*ProjectionBand      `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands,omitempty"`
+	HistoryDays  int32                  `protobuf:"varint,3,opt,name=history_days,json=historyDays,proto3" json:"history_days,omitempty"` // trading days of returns the model was fitted on
+	// holdings left at their current value for lack of price history
+	ExcludedSymbols []string `protobuf:"bytes,4,rep,name=excluded_symbols,json=excludedSymbols,proto3" json:"excluded_symbols,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProjectPortfolioResponse) Reset() {
+	*x = ProjectPortfolioResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectPortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectPortfolioResponse) ProtoMessage() {}
+
+func (x *ProjectPortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectPortfolioResponse.ProtoReflect.Descriptor instead.
+func (*ProjectPortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ProjectPortfolioResponse) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *ProjectPortfolioResponse) GetBands() []*ProjectionBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *ProjectPortfolioResponse) GetHistoryDays() int32 {
+	if x != nil {
+		return x.HistoryDays
+	}
+	return 0
+}
+
+func (x *ProjectPortfolioResponse) GetExcludedSymbols() []string {
+	if x != nil {
+		return x.ExcludedSymbols
+	}
+	return nil
+}
+
+type SectorShock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sector        string                 `protobuf:"bytes,1,opt,name=sector,proto3" json:"sector,omitempty"`
+	ChangePercent float64                `protobuf:"fixed64,2,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"` // e.g. -20 for a 20% fall
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SectorShock) Reset() {
+	*x = SectorShock{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SectorShock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectorShock) ProtoMessage() {}
+
+func (x *SectorShock) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectorShock.ProtoReflect.Descriptor instead.
+func (*SectorShock) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SectorShock) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *SectorShock) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+type RunScenarioRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// Market move applied to each holding scaled by its beta to NEPSE.
+	// Holdings in a shocked sector use the sector shock instead.
+	IndexChangePercent *float64       `protobuf:"fixed64,2,opt,name=index_change_percent,json=indexChangePercent,proto3,oneof" json:"index_change_percent,omitempty"`
+	SectorShocks       []*SectorShock `protobuf:"bytes,3,rep,name=sector_shocks,json=sectorShocks,proto3" json:"sector_shocks,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RunScenarioRequest) Reset() {
+	*x = RunScenarioRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScenarioRequest) ProtoMessage() {}
+
+func (x *RunScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScenarioRequest.ProtoReflect.Descriptor instead.
+func (*RunScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RunScenarioRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *RunScenarioRequest) GetIndexChangePercent() float64 {
+	if x != nil && x.IndexChangePercent != nil {
+		return *x.IndexChangePercent
+	}
+	return 0
+}
+
+func (x *RunScenarioRequest) GetSectorShocks() []*SectorShock {
+	if x != nil {
+		return x.SectorShocks
+	}
+	return nil
+}
+
+type ScenarioHolding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol    string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Sector         string                 `protobuf:"bytes,2,opt,name=sector,proto3" json:"sector,omitempty"`
+	CurrentValue   float64                `protobuf:"fixed64,3,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	ProjectedValue float64                `protobuf:"fixed64,4,opt,name=projected_value,json=projectedValue,proto3" json:"projected_value,omitempty"`
+	ChangePercent  float64                `protobuf:"fixed64,5,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	Beta           *float64               `protobuf:"fixed64,6,opt,name=beta,proto3,oneof" json:"beta,omitempty"` // set when the index shock was applied
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScenarioHolding) Reset() {
+	*x = ScenarioHolding{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioHolding) ProtoMessage() {}
+
+func (x *ScenarioHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioHolding.ProtoReflect.Descriptor instead.
+func (*ScenarioHolding) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ScenarioHolding) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *ScenarioHolding) GetSector() string {
+	if x != nil {
+		return x.Sector
+	}
+	return ""
+}
+
+func (x *ScenarioHolding) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *ScenarioHolding) GetProjectedValue() float64 {
+	if x != nil {
+		return x.ProjectedValue
+	}
+	return 0
+}
+
+func (x *ScenarioHolding) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *ScenarioHolding) GetBeta() float64 {
+	if x != nil && x.Beta != nil {
+		return *x.Beta
+	}
+	return 0
+}
+
+type RunScenarioResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Holdings            []*ScenarioHolding     `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	CurrentValue        float64                `protobuf:"fixed64,2,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	ProjectedValue      float64                `protobuf:"fixed64,3,opt,name=projected_value,json=projectedValue,proto3" json:"projected_value,omitempty"`
+	ChangeValue         float64                `protobuf:"fixed64,4,opt,name=change_value,json=changeValue,proto3" json:"change_value,omitempty"`
+	ChangePercent       float64                `protobuf:"fixed64,5,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`
+	ProjectedProfitLoss float64                `protobuf:"fixed64,6,opt,name=projected_profit_loss,json=projectedProfitLoss,proto3" json:"projected_profit_loss,omitempty"` // against total invested
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RunScenarioResponse) Reset() {
+	*x = RunScenarioResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunScenarioResponse) ProtoMessage() {}
+
+func (x *RunScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunScenarioResponse.ProtoReflect.Descriptor instead.
+func (*RunScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RunScenarioResponse) GetHoldings() []*ScenarioHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *RunScenarioResponse) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *RunScenarioResponse) GetProjectedValue() float64 {
+	if x != nil {
+		return x.ProjectedValue
+	}
+	return 0
+}
+
+func (x *RunScenarioResponse) GetChangeValue() float64 {
+	if x != nil {
+		return x.ChangeValue
+	}
+	return 0
+}
+
+func (x *RunScenarioResponse) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *RunScenarioResponse) GetProjectedProfitLoss() float64 {
+	if x != nil {
+		return x.ProjectedProfitLoss
+	}
+	return 0
+}
+
+type CalculatePositionSizeRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AccountSize float64                `protobuf:"fixed64,1,opt,name=account_size,json=accountSize,proto3" json:"account_size,omitempty"`
+	RiskPercent float64                `protobuf:"fixed64,2,opt,name=risk_percent,json=riskPercent,proto3" json:"risk_percent,omitempty"` // share of the account to lose if stopped out
+	EntryPrice  float64                `protobuf:"fixed64,3,opt,name=entry_price,json=entryPrice,proto3" json:"entry_price,omitempty"`
+	StopPrice   float64                `protobuf:"fixed64,4,opt,name=stop_price,json=stopPrice,proto3" json:"stop_price,omitempty"` // must be below entry
+	// Optional, used to fill in the transaction draft
+	PortfolioId   int64  `protobuf:"varint,5,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string `protobuf:"bytes,6,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePositionSizeRequest) Reset() {
+	*x = CalculatePositionSizeRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePositionSizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePositionSizeRequest) ProtoMessage() {}
+
+func (x *CalculatePositionSizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePositionSizeRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePositionSizeRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CalculatePositionSizeRequest) GetAccountSize() float64 {
+	if x != nil {
+		return x.AccountSize
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeRequest) GetRiskPercent() float64 {
+	if x != nil {
+		return x.RiskPercent
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeRequest) GetEntryPrice() float64 {
+	if x != nil {
+		return x.EntryPrice
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeRequest) GetStopPrice() float64 {
+	if x != nil {
+		return x.StopPrice
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+type CalculatePositionSizeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Quantity        int64                  `protobuf:"varint,1,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RiskAmount      float64                `protobuf:"fixed64,2,opt,name=risk_amount,json=riskAmount,proto3" json:"risk_amount,omitempty"`          // the most the trade may lose
+	RiskPerShare    float64                `protobuf:"fixed64,3,opt,name=risk_per_share,json=riskPerShare,proto3" json:"risk_per_share,omitempty"`  // entry minus stop
+	PositionValue   float64                `protobuf:"fixed64,4,opt,name=position_value,json=positionValue,proto3" json:"position_value,omitempty"` // quantity x entry
+	Commission      float64                `protobuf:"fixed64,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	SebonFee        float64                `protobuf:"fixed64,6,opt,name=sebon_fee,json=sebonFee,proto3" json:"sebon_fee,omitempty"`
+	DpCharge        float64                `protobuf:"fixed64,7,opt,name=dp_charge,json=dpCharge,proto3" json:"dp_charge,omitempty"`
+	TotalCost       float64                `protobuf:"fixed64,8,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`                     // position value plus fees
+	LossAtStop      float64                `protobuf:"fixed64,9,opt,name=loss_at_stop,json=lossAtStop,proto3" json:"loss_at_stop,omitempty"`                // including buy and sell fees
+	AccountPercent  float64                `protobuf:"fixed64,10,opt,name=account_percent,json=accountPercent,proto3" json:"account_percent,omitempty"`     // total cost as a share of the account
+	CappedByAccount bool                   `protobuf:"varint,11,opt,name=capped_by_account,json=cappedByAccount,proto3" json:"capped_by_account,omitempty"` // quantity was reduced to fit the account
+	Draft           *AddTransactionRequest `protobuf:"bytes,12,opt,name=draft,proto3" json:"draft,omitempty"`                                               // ready to submit as a BUY
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CalculatePositionSizeResponse) Reset() {
+	*x = CalculatePositionSizeResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePositionSizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePositionSizeResponse) ProtoMessage() {}
+
+func (x *CalculatePositionSizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePositionSizeResponse.ProtoReflect.Descriptor instead.
+func (*CalculatePositionSizeResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CalculatePositionSizeResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetRiskAmount() float64 {
+	if x != nil {
+		return x.RiskAmount
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetRiskPerShare() float64 {
+	if x != nil {
+		return x.RiskPerShare
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetPositionValue() float64 {
+	if x != nil {
+		return x.PositionValue
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetCommission() float64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetSebonFee() float64 {
+	if x != nil {
+		return x.SebonFee
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetDpCharge() float64 {
+	if x != nil {
+		return x.DpCharge
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetLossAtStop() float64 {
+	if x != nil {
+		return x.LossAtStop
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetAccountPercent() float64 {
+	if x != nil {
+		return x.AccountPercent
+	}
+	return 0
+}
+
+func (x *CalculatePositionSizeResponse) GetCappedByAccount() bool {
+	if x != nil {
+		return x.CappedByAccount
+	}
+	return false
+}
+
+func (x *CalculatePositionSizeResponse) GetDraft() *AddTransactionRequest {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+// Tag is a user-defined strategy label such as "IPO flip" or "dividend play".
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Tag) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagRequest) Reset() {
+	*x = CreateTagRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagRequest) ProtoMessage() {}
+
+func (x *CreateTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagRequest.ProtoReflect.Descriptor instead.
+func (*CreateTagRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTagResponse) Reset() {
+	*x = CreateTagResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTagResponse) ProtoMessage() {}
+
+func (x *CreateTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTagResponse.ProtoReflect.Descriptor instead.
+func (*CreateTagResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{52}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RenameTagRequest) GetTagId() int64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+func (x *RenameTagRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RenameTagResponse) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+type DeleteTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagId         int64                  `protobuf:"varint,1,opt,name=tag_id,json=tagId,proto3" json:"tag_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagRequest) ProtoMessage() {}
+
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteTagRequest) GetTagId() int64 {
+	if x != nil {
+		return x.TagId
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTagResponse) Reset() {
+	*x = DeleteTagResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagResponse) ProtoMessage() {}
+
+func (x *DeleteTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTagResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{57}
+}
+
+// SetTransactionTagsRequest replaces the tags on a transaction; an empty
+// list clears them.
+type SetTransactionTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	TagIds        []int64                `protobuf:"varint,2,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionTagsRequest) Reset() {
+	*x = SetTransactionTagsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionTagsRequest) ProtoMessage() {}
+
+func (x *SetTransactionTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionTagsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetTransactionTagsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetTransactionTagsRequest) GetTagIds() []int64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+type SetTransactionTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionTagsResponse) Reset() {
+	*x = SetTransactionTagsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionTagsResponse) ProtoMessage() {}
+
+func (x *SetTransactionTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionTagsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetTransactionTagsResponse) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagPerformance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *Tag                   `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"` // unset for trades without a tag
+	TradeCount    int32                  `protobuf:"varint,2,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	RealizedGain  float64                `protobuf:"fixed64,3,opt,name=realized_gain,json=realizedGain,proto3" json:"realized_gain,omitempty"`
+	ShortTermGain float64                `protobuf:"fixed64,4,opt,name=short_term_gain,json=shortTermGain,proto3" json:"short_term_gain,omitempty"`
+	LongTermGain  float64                `protobuf:"fixed64,5,opt,name=long_term_gain,json=longTermGain,proto3" json:"long_term_gain,omitempty"`
+	EstimatedTax  float64                `protobuf:"fixed64,6,opt,name=estimated_tax,json=estimatedTax,proto3" json:"estimated_tax,omitempty"`
+	OpenCost      float64                `protobuf:"fixed64,7,opt,name=open_cost,json=openCost,proto3" json:"open_cost,omitempty"` // cost of lots still held
+	OpenValue     float64                `protobuf:"fixed64,8,opt,name=open_value,json=openValue,proto3" json:"open_value,omitempty"`
+	UnrealizedPnl float64                `protobuf:"fixed64,9,opt,name=unrealized_pnl,json=unrealizedPnl,proto3" json:"unrealized_pnl,omitempty"`
+	TotalPnl      float64                `protobuf:"fixed64,10,opt,name=total_pnl,json=totalPnl,proto3" json:"total_pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagPerformance) Reset() {
+	*x = TagPerformance{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagPerformance) ProtoMessage() {}
+
+func (x *TagPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagPerformance.ProtoReflect.Descriptor instead.
+func (*TagPerformance) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *TagPerformance) GetTag() *Tag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+func (x *TagPerformance) GetTradeCount() int32 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetRealizedGain() float64 {
+	if x != nil {
+		return x.RealizedGain
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetShortTermGain() float64 {
+	if x != nil {
+		return x.ShortTermGain
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetLongTermGain() float64 {
+	if x != nil {
+		return x.LongTermGain
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetEstimatedTax() float64 {
+	if x != nil {
+		return x.EstimatedTax
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetOpenCost() float64 {
+	if x != nil {
+		return x.OpenCost
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetOpenValue() float64 {
+	if x != nil {
+		return x.OpenValue
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetUnrealizedPnl() float64 {
+	if x != nil {
+		return x.UnrealizedPnl
+	}
+	return 0
+}
+
+func (x *TagPerformance) GetTotalPnl() float64 {
+	if x != nil {
+		return x.TotalPnl
+	}
+	return 0
+}
+
+type GetTagPerformanceRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	TagId       *int64                 `protobuf:"varint,2,opt,name=tag_id,json=tagId,proto3,oneof" json:"tag_id,omitempty"` // only report this tag
+	// Limit realized gains to sales in this range; empty means all time
+	FromDate      string `protobuf:"bytes,3,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // YYYY-MM-DD
+	ToDate        string `protobuf:"bytes,4,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`       // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagPerformanceRequest) Reset() {
+	*x = GetTagPerformanceRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagPerformanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagPerformanceRequest) ProtoMessage() {}
+
+func (x *GetTagPerformanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagPerformanceRequest.ProtoReflect.Descriptor instead.
+func (*GetTagPerformanceRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetTagPerformanceRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetTagPerformanceRequest) GetTagId() int64 {
+	if x != nil && x.TagId != nil {
+		return *x.TagId
+	}
+	return 0
+}
+
+func (x *GetTagPerformanceRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetTagPerformanceRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+// Each sale counts toward the tags on both its buy and sell, so a trade with
+// several tags is reported under each of them.
+type GetTagPerformanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagPerformance      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTagPerformanceResponse) Reset() {
+	*x = GetTagPerformanceResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTagPerformanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTagPerformanceResponse) ProtoMessage() {}
+
+func (x *GetTagPerformanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTagPerformanceResponse.ProtoReflect.Descriptor instead.
+func (*GetTagPerformanceResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetTagPerformanceResponse) GetTags() []*TagPerformance {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// BrokerAccount is a trading account with a TMS broker.
+type BrokerAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BrokerNumber  int32                  `protobuf:"varint,2,opt,name=broker_number,json=brokerNumber,proto3" json:"broker_number,omitempty"` // NEPSE member number, e.g. 58
+	ClientId      string                 `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrokerAccount) Reset() {
+	*x = BrokerAccount{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrokerAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokerAccount) ProtoMessage() {}
+
+func (x *BrokerAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokerAccount.ProtoReflect.Descriptor instead.
+func (*BrokerAccount) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BrokerAccount) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BrokerAccount) GetBrokerNumber() int32 {
+	if x != nil {
+		return x.BrokerNumber
+	}
+	return 0
+}
+
+func (x *BrokerAccount) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *BrokerAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateBrokerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrokerNumber  int32                  `protobuf:"varint,1,opt,name=broker_number,json=brokerNumber,proto3" json:"broker_number,omitempty"`
+	ClientId      string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBrokerAccountRequest) Reset() {
+	*x = CreateBrokerAccountRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBrokerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrokerAccountRequest) ProtoMessage() {}
+
+func (x *CreateBrokerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrokerAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateBrokerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateBrokerAccountRequest) GetBrokerNumber() int32 {
+	if x != nil {
+		return x.BrokerNumber
+	}
+	return 0
+}
+
+func (x *CreateBrokerAccountRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateBrokerAccountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateBrokerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BrokerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBrokerAccountResponse) Reset() {
+	*x = CreateBrokerAccountResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBrokerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBrokerAccountResponse) ProtoMessage() {}
+
+func (x *CreateBrokerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBrokerAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateBrokerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreateBrokerAccountResponse) GetAccount() *BrokerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type ListBrokerAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrokerAccountsRequest) Reset() {
+	*x = ListBrokerAccountsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrokerAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrokerAccountsRequest) ProtoMessage() {}
+
+func (x *ListBrokerAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrokerAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListBrokerAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{66}
+}
+
+type ListBrokerAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*BrokerAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBrokerAccountsResponse) Reset() {
+	*x = ListBrokerAccountsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBrokerAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBrokerAccountsResponse) ProtoMessage() {}
+
+func (x *ListBrokerAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBrokerAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListBrokerAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListBrokerAccountsResponse) GetAccounts() []*BrokerAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type DeleteBrokerAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBrokerAccountRequest) Reset() {
+	*x = DeleteBrokerAccountRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrokerAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrokerAccountRequest) ProtoMessage() {}
+
+func (x *DeleteBrokerAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrokerAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBrokerAccountRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteBrokerAccountRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type DeleteBrokerAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBrokerAccountResponse) Reset() {
+	*x = DeleteBrokerAccountResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBrokerAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBrokerAccountResponse) ProtoMessage() {}
+
+func (x *DeleteBrokerAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBrokerAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBrokerAccountResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{69}
+}
+
+type SetTransactionBrokerRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	BrokerAccountId *int64                 `protobuf:"varint,2,opt,name=broker_account_id,json=brokerAccountId,proto3,oneof" json:"broker_account_id,omitempty"` // unset clears the broker
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetTransactionBrokerRequest) Reset() {
+	*x = SetTransactionBrokerRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionBrokerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionBrokerRequest) ProtoMessage() {}
+
+func (x *SetTransactionBrokerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionBrokerRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionBrokerRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetTransactionBrokerRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SetTransactionBrokerRequest) GetBrokerAccountId() int64 {
+	if x != nil && x.BrokerAccountId != nil {
+		return *x.BrokerAccountId
+	}
+	return 0
+}
+
+type SetTransactionBrokerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionBrokerResponse) Reset() {
+	*x = SetTransactionBrokerResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionBrokerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionBrokerResponse) ProtoMessage() {}
+
+func (x *SetTransactionBrokerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionBrokerResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionBrokerResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{71}
+}
+
+// BrokerCommission totals the charges paid through one broker account,
+// estimated from the SEBON fee schedule.
+type BrokerCommission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *BrokerAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"` // unset for transactions without a broker
+	TradeCount    int32                  `protobuf:"varint,2,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+	BuyAmount     float64                `protobuf:"fixed64,3,opt,name=buy_amount,json=buyAmount,proto3" json:"buy_amount,omitempty"`
+	SellAmount    float64                `protobuf:"fixed64,4,opt,name=sell_amount,json=sellAmount,proto3" json:"sell_amount,omitempty"`
+	Commission    float64                `protobuf:"fixed64,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	SebonFee      float64                `protobuf:"fixed64,6,opt,name=sebon_fee,json=sebonFee,proto3" json:"sebon_fee,omitempty"`
+	DpCharges     float64                `protobuf:"fixed64,7,opt,name=dp_charges,json=dpCharges,proto3" json:"dp_charges,omitempty"`
+	TotalFees     float64                `protobuf:"fixed64,8,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrokerCommission) Reset() {
+	*x = BrokerCommission{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrokerCommission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrokerCommission) ProtoMessage() {}
+
+func (x *BrokerCommission) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrokerCommission.ProtoReflect.Descriptor instead.
+func (*BrokerCommission) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BrokerCommission) GetAccount() *BrokerAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *BrokerCommission) GetTradeCount() int32 {
+	if x != nil {
+		return x.TradeCount
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetBuyAmount() float64 {
+	if x != nil {
+		return x.BuyAmount
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetSellAmount() float64 {
+	if x != nil {
+		return x.SellAmount
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetCommission() float64 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetSebonFee() float64 {
+	if x != nil {
+		return x.SebonFee
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetDpCharges() float64 {
+	if x != nil {
+		return x.DpCharges
+	}
+	return 0
+}
+
+func (x *BrokerCommission) GetTotalFees() float64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+type GetBrokerCommissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   *int64                 `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3,oneof" json:"portfolio_id,omitempty"` // every portfolio when unset
+	FromDate      string                 `protobuf:"bytes,2,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"`                 // YYYY-MM-DD, empty means all time
+	ToDate        string                 `protobuf:"bytes,3,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`                       // YYYY-MM-DD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBrokerCommissionsRequest) Reset() {
+	*x = GetBrokerCommissionsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBrokerCommissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrokerCommissionsRequest) ProtoMessage() {}
+
+func (x *GetBrokerCommissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrokerCommissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetBrokerCommissionsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetBrokerCommissionsRequest) GetPortfolioId() int64 {
+	if x != nil && x.PortfolioId != nil {
+		return *x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetBrokerCommissionsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *GetBrokerCommissionsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type GetBrokerCommissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Brokers       []*BrokerCommission    `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBrokerCommissionsResponse) Reset() {
+	*x = GetBrokerCommissionsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBrokerCommissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBrokerCommissionsResponse) ProtoMessage() {}
+
+func (x *GetBrokerCommissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBrokerCommissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetBrokerCommissionsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetBrokerCommissionsResponse) GetBrokers() []*BrokerCommission {
+	if x != nil {
+		return x.Brokers
+	}
+	return nil
+}
+
+// Profile is a demat account holder managed under the login, such as a
+// family member or a minor whose account a parent operates.
+type Profile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Boid          string                 `protobuf:"bytes,3,opt,name=boid,proto3" json:"boid,omitempty"` // 16-digit CDSC beneficiary owner ID
+	Relationship  string                 `protobuf:"bytes,4,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Minor         bool                   `protobuf:"varint,5,opt,name=minor,proto3" json:"minor,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Profile) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Profile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Profile) GetBoid() string {
+	if x != nil {
+		return x.Boid
+	}
+	return ""
+}
+
+func (x *Profile) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *Profile) GetMinor() bool {
+	if x != nil {
+		return x.Minor
+	}
+	return false
+}
+
+func (x *Profile) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Boid          string                 `protobuf:"bytes,2,opt,name=boid,proto3" json:"boid,omitempty"`
+	Relationship  string                 `protobuf:"bytes,3,opt,name=relationship,proto3" json:"relationship,omitempty"`
+	Minor         bool                   `protobuf:"varint,4,opt,name=minor,proto3" json:"minor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileRequest) Reset() {
+	*x = CreateProfileRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileRequest) ProtoMessage() {}
+
+func (x *CreateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProfileRequest) GetBoid() string {
+	if x != nil {
+		return x.Boid
+	}
+	return ""
+}
+
+func (x *CreateProfileRequest) GetRelationship() string {
+	if x != nil {
+		return x.Relationship
+	}
+	return ""
+}
+
+func (x *CreateProfileRequest) GetMinor() bool {
+	if x != nil {
+		return x.Minor
+	}
+	return false
+}
+
+type CreateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProfileResponse) Reset() {
+	*x = CreateProfileResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileResponse) ProtoMessage() {}
+
+func (x *CreateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfilesRequest) Reset() {
+	*x = ListProfilesRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesRequest) ProtoMessage() {}
+
+func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{78}
+}
+
+type ListProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*Profile             `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProfilesResponse) Reset() {
+	*x = ListProfilesResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesResponse) ProtoMessage() {}
+
+func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListProfilesResponse) GetProfiles() []*Profile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// DeleteProfileRequest removes a profile; its portfolios are kept and
+// become unassigned.
+type DeleteProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProfileId     int64                  `protobuf:"varint,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileRequest) Reset() {
+	*x = DeleteProfileRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileRequest) ProtoMessage() {}
+
+func (x *DeleteProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteProfileRequest) GetProfileId() int64 {
+	if x != nil {
+		return x.ProfileId
+	}
+	return 0
+}
+
+type DeleteProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProfileResponse) Reset() {
+	*x = DeleteProfileResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProfileResponse) ProtoMessage() {}
+
+func (x *DeleteProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{81}
+}
+
+type SetPortfolioProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	ProfileId     *int64                 `protobuf:"varint,2,opt,name=profile_id,json=profileId,proto3,oneof" json:"profile_id,omitempty"` // unset clears the profile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPortfolioProfileRequest) Reset() {
+	*x = SetPortfolioProfileRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPortfolioProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPortfolioProfileRequest) ProtoMessage() {}
+
+func (x *SetPortfolioProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPortfolioProfileRequest.ProtoReflect.Descriptor instead.
+func (*SetPortfolioProfileRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetPortfolioProfileRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *SetPortfolioProfileRequest) GetProfileId() int64 {
+	if x != nil && x.ProfileId != nil {
+		return *x.ProfileId
+	}
+	return 0
+}
+
+type SetPortfolioProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPortfolioProfileResponse) Reset() {
+	*x = SetPortfolioProfileResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPortfolioProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPortfolioProfileResponse) ProtoMessage() {}
+
+func (x *SetPortfolioProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPortfolioProfileResponse.ProtoReflect.Descriptor instead.
+func (*SetPortfolioProfileResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{83}
+}
+
+type CostEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        CostSource             `protobuf:"varint,1,opt,name=source,proto3,enum=ntx.v1.CostSource" json:"source,omitempty"`
+	AvgCost       float64                `protobuf:"fixed64,2,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	SetAt         string                 `protobuf:"bytes,4,opt,name=set_at,json=setAt,proto3" json:"set_at,omitempty"` // empty for the computed cost
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CostEntry) Reset() {
+	*x = CostEntry{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostEntry) ProtoMessage() {}
+
+func (x *CostEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostEntry.ProtoReflect.Descriptor instead.
+func (*CostEntry) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CostEntry) GetSource() CostSource {
+	if x != nil {
+		return x.Source
+	}
+	return CostSource_COST_SOURCE_UNSPECIFIED
+}
+
+func (x *CostEntry) GetAvgCost() float64 {
+	if x != nil {
+		return x.AvgCost
+	}
+	return 0
+}
+
+func (x *CostEntry) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CostEntry) GetSetAt() string {
+	if x != nil {
+		return x.SetAt
+	}
+	return ""
+}
+
+type SetHoldingCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Source        CostSource             `protobuf:"varint,3,opt,name=source,proto3,enum=ntx.v1.CostSource" json:"source,omitempty"` // WACC or MANUAL
+	AvgCost       float64                `protobuf:"fixed64,4,opt,name=avg_cost,json=avgCost,proto3" json:"avg_cost,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldingCostRequest) Reset() {
+	*x = SetHoldingCostRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldingCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldingCostRequest) ProtoMessage() {}
+
+func (x *SetHoldingCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldingCostRequest.ProtoReflect.Descriptor instead.
+func (*SetHoldingCostRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SetHoldingCostRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *SetHoldingCostRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *SetHoldingCostRequest) GetSource() CostSource {
+	if x != nil {
+		return x.Source
+	}
+	return CostSource_COST_SOURCE_UNSPECIFIED
+}
+
+func (x *SetHoldingCostRequest) GetAvgCost() float64 {
+	if x != nil {
+		return x.AvgCost
+	}
+	return 0
+}
+
+func (x *SetHoldingCostRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type SetHoldingCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *CostEntry             `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHoldingCostResponse) Reset() {
+	*x = SetHoldingCostResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHoldingCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHoldingCostResponse) ProtoMessage() {}
+
+func (x *SetHoldingCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHoldingCostResponse.ProtoReflect.Descriptor instead.
+func (*SetHoldingCostResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetHoldingCostResponse) GetEntry() *CostEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ClearHoldingCostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Source        CostSource             `protobuf:"varint,3,opt,name=source,proto3,enum=ntx.v1.CostSource" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHoldingCostRequest) Reset() {
+	*x = ClearHoldingCostRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHoldingCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHoldingCostRequest) ProtoMessage() {}
+
+func (x *ClearHoldingCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHoldingCostRequest.ProtoReflect.Descriptor instead.
+func (*ClearHoldingCostRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ClearHoldingCostRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ClearHoldingCostRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *ClearHoldingCostRequest) GetSource() CostSource {
+	if x != nil {
+		return x.Source
+	}
+	return CostSource_COST_SOURCE_UNSPECIFIED
+}
+
+type ClearHoldingCostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearHoldingCostResponse) Reset() {
+	*x = ClearHoldingCostResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearHoldingCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearHoldingCostResponse) ProtoMessage() {}
+
+func (x *ClearHoldingCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearHoldingCostResponse.ProtoReflect.Descriptor instead.
+func (*ClearHoldingCostResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{88}
+}
+
+// CostReconciliation lists every cost recorded for one holding and which
+// one is in effect.
+type CostReconciliation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol     string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Quantity        int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EffectiveSource CostSource             `protobuf:"varint,3,opt,name=effective_source,json=effectiveSource,proto3,enum=ntx.v1.CostSource" json:"effective_source,omitempty"`
+	EffectiveCost   float64                `protobuf:"fixed64,4,opt,name=effective_cost,json=effectiveCost,proto3" json:"effective_cost,omitempty"`
+	Entries         []*CostEntry           `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`    // the computed cost first
+	Conflict        bool                   `protobuf:"varint,6,opt,name=conflict,proto3" json:"conflict,omitempty"` // sources differ by more than 0.5%
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CostReconciliation) Reset() {
+	*x = CostReconciliation{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CostReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostReconciliation) ProtoMessage() {}
+
+func (x *CostReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CostReconciliation.ProtoReflect.Descriptor instead.
+func (*CostReconciliation) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CostReconciliation) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *CostReconciliation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CostReconciliation) GetEffectiveSource() CostSource {
+	if x != nil {
+		return x.EffectiveSource
+	}
+	return CostSource_COST_SOURCE_UNSPECIFIED
+}
+
+func (x *CostReconciliation) GetEffectiveCost() float64 {
+	if x != nil {
+		return x.EffectiveCost
+	}
+	return 0
+}
+
+func (x *CostReconciliation) GetEntries() []*CostEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *CostReconciliation) GetConflict() bool {
+	if x != nil {
+		return x.Conflict
+	}
+	return false
+}
+
+type GetCostReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	ConflictsOnly bool                   `protobuf:"varint,2,opt,name=conflicts_only,json=conflictsOnly,proto3" json:"conflicts_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCostReconciliationRequest) Reset() {
+	*x = GetCostReconciliationRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCostReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostReconciliationRequest) ProtoMessage() {}
+
+func (x *GetCostReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*GetCostReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetCostReconciliationRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetCostReconciliationRequest) GetConflictsOnly() bool {
+	if x != nil {
+		return x.ConflictsOnly
+	}
+	return false
+}
+
+type GetCostReconciliationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holdings      []*CostReconciliation  `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCostReconciliationResponse) Reset() {
+	*x = GetCostReconciliationResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCostReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCostReconciliationResponse) ProtoMessage() {}
+
+func (x *GetCostReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCostReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*GetCostReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetCostReconciliationResponse) GetHoldings() []*CostReconciliation {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type ExportCostBasisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCostBasisRequest) Reset() {
+	*x = ExportCostBasisRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCostBasisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCostBasisRequest) ProtoMessage() {}
+
+func (x *ExportCostBasisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCostBasisRequest.ProtoReflect.Descriptor instead.
+func (*ExportCostBasisRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ExportCostBasisRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+// ExportCostBasisResponse is every open holding at its effective cost, in
+// the column layout of the Mero Share WACC report.
+type ExportCostBasisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCostBasisResponse) Reset() {
+	*x = ExportCostBasisResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCostBasisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCostBasisResponse) ProtoMessage() {}
+
+func (x *ExportCostBasisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCostBasisResponse.ProtoReflect.Descriptor instead.
+func (*ExportCostBasisResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ExportCostBasisResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportCostBasisResponse) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+// BonusExpectation is the bonus a holding should receive from one announced
+// bonus issue. NEPSE data has no book-closure date, so eligibility is the
+// quantity held when the issue was announced.
+type BonusExpectation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol      string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	FiscalYear       string                 `protobuf:"bytes,2,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	BonusPercentage  float64                `protobuf:"fixed64,3,opt,name=bonus_percentage,json=bonusPercentage,proto3" json:"bonus_percentage,omitempty"`
+	AnnouncedOn      string                 `protobuf:"bytes,4,opt,name=announced_on,json=announcedOn,proto3" json:"announced_on,omitempty"`                 // YYYY-MM-DD
+	EligibleQuantity int64                  `protobuf:"varint,5,opt,name=eligible_quantity,json=eligibleQuantity,proto3" json:"eligible_quantity,omitempty"` // held on announced_on
+	ExpectedUnits    int64                  `protobuf:"varint,6,opt,name=expected_units,json=expectedUnits,proto3" json:"expected_units,omitempty"`          // whole shares credited to the demat account
+	FractionalUnits  float64                `protobuf:"fixed64,7,opt,name=fractional_units,json=fractionalUnits,proto3" json:"fractional_units,omitempty"`   // settled in cash, not shares
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BonusExpectation) Reset() {
+	*x = BonusExpectation{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BonusExpectation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BonusExpectation) ProtoMessage() {}
+
+func (x *BonusExpectation) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BonusExpectation.ProtoReflect.Descriptor instead.
+func (*BonusExpectation) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *BonusExpectation) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *BonusExpectation) GetFiscalYear() string {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return ""
+}
+
+func (x *BonusExpectation) GetBonusPercentage() float64 {
+	if x != nil {
+		return x.BonusPercentage
+	}
+	return 0
+}
+
+func (x *BonusExpectation) GetAnnouncedOn() string {
+	if x != nil {
+		return x.AnnouncedOn
+	}
+	return ""
+}
+
+func (x *BonusExpectation) GetEligibleQuantity() int64 {
+	if x != nil {
+		return x.EligibleQuantity
+	}
+	return 0
+}
+
+func (x *BonusExpectation) GetExpectedUnits() int64 {
+	if x != nil {
+		return x.ExpectedUnits
+	}
+	return 0
+}
+
+func (x *BonusExpectation) GetFractionalUnits() float64 {
+	if x != nil {
+		return x.FractionalUnits
+	}
+	return 0
+}
+
+type GetBonusExpectationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"` // announcements in the last N days, default 365
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBonusExpectationsRequest) Reset() {
+	*x = GetBonusExpectationsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBonusExpectationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBonusExpectationsRequest) ProtoMessage() {}
+
+func (x *GetBonusExpectationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBonusExpectationsRequest.ProtoReflect.Descriptor instead.
+func (*GetBonusExpectationsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetBonusExpectationsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetBonusExpectationsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetBonusExpectationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expectations  []*BonusExpectation    `protobuf:"bytes,1,rep,name=expectations,proto3" json:"expectations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBonusExpectationsResponse) Reset() {
+	*x = GetBonusExpectationsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBonusExpectationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBonusExpectationsResponse) ProtoMessage() {}
+
+func (x *GetBonusExpectationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBonusExpectationsResponse.ProtoReflect.Descriptor instead.
+func (*GetBonusExpectationsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetBonusExpectationsResponse) GetExpectations() []*BonusExpectation {
+	if x != nil {
+		return x.Expectations
+	}
+	return nil
+}
+
+// IncomeHolding is a holding's dividend income if the company repeats its
+// latest cash dividend, which is declared as a percent of the Rs. 100 face
+// value.
+type IncomeHolding struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol      string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Quantity         int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	FiscalYear       string                 `protobuf:"bytes,3,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"` // of the dividend used; empty if none
+	DividendPerShare float64                `protobuf:"fixed64,4,opt,name=dividend_per_share,json=dividendPerShare,proto3" json:"dividend_per_share,omitempty"`
+	YieldOnCost      float64                `protobuf:"fixed64,5,opt,name=yield_on_cost,json=yieldOnCost,proto3" json:"yield_on_cost,omitempty"`  // percent of average cost
+	CurrentYield     float64                `protobuf:"fixed64,6,opt,name=current_yield,json=currentYield,proto3" json:"current_yield,omitempty"` // percent of current price
+	AnnualIncome     float64                `protobuf:"fixed64,7,opt,name=annual_income,json=annualIncome,proto3" json:"annual_income,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *IncomeHolding) Reset() {
+	*x = IncomeHolding{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeHolding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeHolding) ProtoMessage() {}
+
+func (x *IncomeHolding) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeHolding.ProtoReflect.Descriptor instead.
+func (*IncomeHolding) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *IncomeHolding) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *IncomeHolding) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *IncomeHolding) GetFiscalYear() string {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return ""
+}
+
+func (x *IncomeHolding) GetDividendPerShare() float64 {
+	if x != nil {
+		return x.DividendPerShare
+	}
+	return 0
+}
+
+func (x *IncomeHolding) GetYieldOnCost() float64 {
+	if x != nil {
+		return x.YieldOnCost
+	}
+	return 0
+}
+
+func (x *IncomeHolding) GetCurrentYield() float64 {
+	if x != nil {
+		return x.CurrentYield
+	}
+	return 0
+}
+
+func (x *IncomeHolding) GetAnnualIncome() float64 {
+	if x != nil {
+		return x.AnnualIncome
+	}
+	return 0
+}
+
+type GetIncomeSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeSummaryRequest) Reset() {
+	*x = GetIncomeSummaryRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeSummaryRequest) ProtoMessage() {}
+
+func (x *GetIncomeSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetIncomeSummaryRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+type GetIncomeSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holdings      []*IncomeHolding       `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings,omitempty"` // highest income first
+	AnnualIncome  float64                `protobuf:"fixed64,2,opt,name=annual_income,json=annualIncome,proto3" json:"annual_income,omitempty"`
+	YieldOnCost   float64                `protobuf:"fixed64,3,opt,name=yield_on_cost,json=yieldOnCost,proto3" json:"yield_on_cost,omitempty"`
+	CurrentYield  float64                `protobuf:"fixed64,4,opt,name=current_yield,json=currentYield,proto3" json:"current_yield,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeSummaryResponse) Reset() {
+	*x = GetIncomeSummaryResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeSummaryResponse) ProtoMessage() {}
+
+func (x *GetIncomeSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetIncomeSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetIncomeSummaryResponse) GetHoldings() []*IncomeHolding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+func (x *GetIncomeSummaryResponse) GetAnnualIncome() float64 {
+	if x != nil {
+		return x.AnnualIncome
+	}
+	return 0
+}
+
+func (x *GetIncomeSummaryResponse) GetYieldOnCost() float64 {
+	if x != nil {
+		return x.YieldOnCost
+	}
+	return 0
+}
+
+func (x *GetIncomeSummaryResponse) GetCurrentYield() float64 {
+	if x != nil {
+		return x.CurrentYield
+	}
+	return 0
+}
+
+// BondTerms are the coupon terms of a debenture or bond held in a
+// portfolio. A holding with terms is valued as fixed income: its clean
+// price plus accrued interest.
+type BondTerms struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol    string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	FaceValue      float64                `protobuf:"fixed64,2,opt,name=face_value,json=faceValue,proto3" json:"face_value,omitempty"`
+	CouponRate     float64                `protobuf:"fixed64,3,opt,name=coupon_rate,json=couponRate,proto3" json:"coupon_rate,omitempty"` // annual percent of face value
+	CouponsPerYear int64                  `protobuf:"varint,4,opt,name=coupons_per_year,json=couponsPerYear,proto3" json:"coupons_per_year,omitempty"`
+	MaturityDate   string                 `protobuf:"bytes,5,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"` // YYYY-MM-DD
+	SetAt          string                 `protobuf:"bytes,6,opt,name=set_at,json=setAt,proto3" json:"set_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BondTerms) Reset() {
+	*x = BondTerms{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondTerms) ProtoMessage() {}
+
+func (x *BondTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondTerms.ProtoReflect.Descriptor instead.
+func (*BondTerms) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *BondTerms) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *BondTerms) GetFaceValue() float64 {
+	if x != nil {
+		return x.FaceValue
+	}
+	return 0
+}
+
+func (x *BondTerms) GetCouponRate() float64 {
+	if x != nil {
+		return x.CouponRate
+	}
+	return 0
+}
+
+func (x *BondTerms) GetCouponsPerYear() int64 {
+	if x != nil {
+		return x.CouponsPerYear
+	}
+	return 0
+}
+
+func (x *BondTerms) GetMaturityDate() string {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return ""
+}
+
+func (x *BondTerms) GetSetAt() string {
+	if x != nil {
+		return x.SetAt
+	}
+	return ""
+}
+
+type SetBondTermsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId    int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol    string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	FaceValue      float64                `protobuf:"fixed64,3,opt,name=face_value,json=faceValue,proto3" json:"face_value,omitempty"` // default 1000, the usual NEPSE debenture
+	CouponRate     float64                `protobuf:"fixed64,4,opt,name=coupon_rate,json=couponRate,proto3" json:"coupon_rate,omitempty"`
+	CouponsPerYear int64                  `protobuf:"varint,5,opt,name=coupons_per_year,json=couponsPerYear,proto3" json:"coupons_per_year,omitempty"` // 1, 2, 4 or 12; default 2
+	MaturityDate   string                 `protobuf:"bytes,6,opt,name=maturity_date,json=maturityDate,proto3" json:"maturity_date,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetBondTermsRequest) Reset() {
+	*x = SetBondTermsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBondTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBondTermsRequest) ProtoMessage() {}
+
+func (x *SetBondTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBondTermsRequest.ProtoReflect.Descriptor instead.
+func (*SetBondTermsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetBondTermsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *SetBondTermsRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *SetBondTermsRequest) GetFaceValue() float64 {
+	if x != nil {
+		return x.FaceValue
+	}
+	return 0
+}
+
+func (x *SetBondTermsRequest) GetCouponRate() float64 {
+	if x != nil {
+		return x.CouponRate
+	}
+	return 0
+}
+
+func (x *SetBondTermsRequest) GetCouponsPerYear() int64 {
+	if x != nil {
+		return x.CouponsPerYear
+	}
+	return 0
+}
+
+func (x *SetBondTermsRequest) GetMaturityDate() string {
+	if x != nil {
+		return x.MaturityDate
+	}
+	return ""
+}
+
+type SetBondTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         *BondTerms             `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBondTermsResponse) Reset() {
+	*x = SetBondTermsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBondTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBondTermsResponse) ProtoMessage() {}
+
+func (x *SetBondTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBondTermsResponse.ProtoReflect.Descriptor instead.
+func (*SetBondTermsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SetBondTermsResponse) GetTerms() *BondTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type ClearBondTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearBondTermsRequest) Reset() {
+	*x = ClearBondTermsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearBondTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearBondTermsRequest) ProtoMessage() {}
+
+func (x *ClearBondTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearBondTermsRequest.ProtoReflect.Descriptor instead.
+func (*ClearBondTermsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ClearBondTermsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *ClearBondTermsRequest) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+type ClearBondTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearBondTermsResponse) Reset() {
+	*x = ClearBondTermsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearBondTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearBondTermsResponse) ProtoMessage() {}
+
+func (x *ClearBondTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearBondTermsResponse.ProtoReflect.Descriptor instead.
+func (*ClearBondTermsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{104}
+}
+
+// BondSchedule is where a bond holding stands in its coupon cycle. Coupons
+// are assumed to fall on the maturity date's day of the month.
+type BondSchedule struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Terms            *BondTerms             `protobuf:"bytes,1,opt,name=terms,proto3" json:"terms,omitempty"`
+	Quantity         int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AccruedInterest  float64                `protobuf:"fixed64,3,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"` // for the whole holding
+	LastCouponOn     string                 `protobuf:"bytes,4,opt,name=last_coupon_on,json=lastCouponOn,proto3" json:"last_coupon_on,omitempty"`
+	NextCouponOn     string                 `protobuf:"bytes,5,opt,name=next_coupon_on,json=nextCouponOn,proto3" json:"next_coupon_on,omitempty"`               // empty once matured
+	NextCouponAmount float64                `protobuf:"fixed64,6,opt,name=next_coupon_amount,json=nextCouponAmount,proto3" json:"next_coupon_amount,omitempty"` // for the whole holding
+	DaysToMaturity   int32                  `protobuf:"varint,7,opt,name=days_to_maturity,json=daysToMaturity,proto3" json:"days_to_maturity,omitempty"`        // negative once matured
+	RedemptionValue  float64                `protobuf:"fixed64,8,opt,name=redemption_value,json=redemptionValue,proto3" json:"redemption_value,omitempty"`      // face value of the holding
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BondSchedule) Reset() {
+	*x = BondSchedule{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BondSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondSchedule) ProtoMessage() {}
+
+func (x *BondSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondSchedule.ProtoReflect.Descriptor instead.
+func (*BondSchedule) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *BondSchedule) GetTerms() *BondTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *BondSchedule) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BondSchedule) GetAccruedInterest() float64 {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return 0
+}
+
+func (x *BondSchedule) GetLastCouponOn() string {
+	if x != nil {
+		return x.LastCouponOn
+	}
+	return ""
+}
+
+func (x *BondSchedule) GetNextCouponOn() string {
+	if x != nil {
+		return x.NextCouponOn
+	}
+	return ""
+}
+
+func (x *BondSchedule) GetNextCouponAmount() float64 {
+	if x != nil {
+		return x.NextCouponAmount
+	}
+	return 0
+}
+
+func (x *BondSchedule) GetDaysToMaturity() int32 {
+	if x != nil {
+		return x.DaysToMaturity
+	}
+	return 0
+}
+
+func (x *BondSchedule) GetRedemptionValue() float64 {
+	if x != nil {
+		return x.RedemptionValue
+	}
+	return 0
+}
+
+type GetBondScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBondScheduleRequest) Reset() {
+	*x = GetBondScheduleRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondScheduleRequest) ProtoMessage() {}
+
+func (x *GetBondScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetBondScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetBondScheduleRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+type GetBondScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bonds         []*BondSchedule        `protobuf:"bytes,1,rep,name=bonds,proto3" json:"bonds,omitempty"` // soonest maturity first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBondScheduleResponse) Reset() {
+	*x = GetBondScheduleResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBondScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBondScheduleResponse) ProtoMessage() {}
+
+func (x *GetBondScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBondScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetBondScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetBondScheduleResponse) GetBonds() []*BondSchedule {
+	if x != nil {
+		return x.Bonds
+	}
+	return nil
+}
+
+// HoldingGroup is a user-defined bucket such as "Speculative" or "Banking",
+// used in place of the exchange sector for allocation. Members are whole
+// sectors or single symbols; a symbol member wins over its sector's group.
+type HoldingGroup struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sectors []string               `protobuf:"bytes,3,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	Symbols []string               `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	// Concentration limit; the summary warns when the group goes over it.
+	MaxWeightPercent *float64 `protobuf:"fixed64,5,opt,name=max_weight_percent,json=maxWeightPercent,proto3,oneof" json:"max_weight_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *HoldingGroup) Reset() {
+	*x = HoldingGroup{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldingGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldingGroup) ProtoMessage() {}
+
+func (x *HoldingGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldingGroup.ProtoReflect.Descriptor instead.
+func (*HoldingGroup) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *HoldingGroup) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HoldingGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HoldingGroup) GetSectors() []string {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *HoldingGroup) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *HoldingGroup) GetMaxWeightPercent() float64 {
+	if x != nil && x.MaxWeightPercent != nil {
+		return *x.MaxWeightPercent
+	}
+	return 0
+}
+
+type CreateHoldingGroupRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sectors          []string               `protobuf:"bytes,2,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	Symbols          []string               `protobuf:"bytes,3,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	MaxWeightPercent *float64               `protobuf:"fixed64,4,opt,name=max_weight_percent,json=maxWeightPercent,proto3,oneof" json:"max_weight_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateHoldingGroupRequest) Reset() {
+	*x = CreateHoldingGroupRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHoldingGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldingGroupRequest) ProtoMessage() {}
+
+func (x *CreateHoldingGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldingGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateHoldingGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreateHoldingGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateHoldingGroupRequest) GetSectors() []string {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *CreateHoldingGroupRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *CreateHoldingGroupRequest) GetMaxWeightPercent() float64 {
+	if x != nil && x.MaxWeightPercent != nil {
+		return *x.MaxWeightPercent
+	}
+	return 0
+}
+
+type CreateHoldingGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *HoldingGroup          `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHoldingGroupResponse) Reset() {
+	*x = CreateHoldingGroupResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHoldingGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldingGroupResponse) ProtoMessage() {}
+
+func (x *CreateHoldingGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldingGroupResponse.ProtoReflect.Descriptor instead.
+func (*CreateHoldingGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreateHoldingGroupResponse) GetGroup() *HoldingGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type ListHoldingGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldingGroupsRequest) Reset() {
+	*x = ListHoldingGroupsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldingGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldingGroupsRequest) ProtoMessage() {}
+
+func (x *ListHoldingGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldingGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListHoldingGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{111}
+}
+
+type ListHoldingGroupsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*HoldingGroup        `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHoldingGroupsResponse) Reset() {
+	*x = ListHoldingGroupsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHoldingGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHoldingGroupsResponse) ProtoMessage() {}
+
+func (x *ListHoldingGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHoldingGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListHoldingGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListHoldingGroupsResponse) GetGroups() []*HoldingGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// UpdateHoldingGroupRequest replaces the group's name, members and limit.
+type UpdateHoldingGroupRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GroupId          int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sectors          []string               `protobuf:"bytes,3,rep,name=sectors,proto3" json:"sectors,omitempty"`
+	Symbols          []string               `protobuf:"bytes,4,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	MaxWeightPercent *float64               `protobuf:"fixed64,5,opt,name=max_weight_percent,json=maxWeightPercent,proto3,oneof" json:"max_weight_percent,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateHoldingGroupRequest) Reset() {
+	*x = UpdateHoldingGroupRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHoldingGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHoldingGroupRequest) ProtoMessage() {}
+
+func (x *UpdateHoldingGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHoldingGroupRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHoldingGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UpdateHoldingGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *UpdateHoldingGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateHoldingGroupRequest) GetSectors() []string {
+	if x != nil {
+		return x.Sectors
+	}
+	return nil
+}
+
+func (x *UpdateHoldingGroupRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *UpdateHoldingGroupRequest) GetMaxWeightPercent() float64 {
+	if x != nil && x.MaxWeightPercent != nil {
+		return *x.MaxWeightPercent
+	}
+	return 0
+}
+
+type UpdateHoldingGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *HoldingGroup          `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateHoldingGroupResponse) Reset() {
+	*x = UpdateHoldingGroupResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateHoldingGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHoldingGroupResponse) ProtoMessage() {}
+
+func (x *UpdateHoldingGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHoldingGroupResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHoldingGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateHoldingGroupResponse) GetGroup() *HoldingGroup {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type DeleteHoldingGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       int64                  `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHoldingGroupRequest) Reset() {
+	*x = DeleteHoldingGroupRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHoldingGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHoldingGroupRequest) ProtoMessage() {}
+
+func (x *DeleteHoldingGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHoldingGroupRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHoldingGroupRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteHoldingGroupRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+type DeleteHoldingGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteHoldingGroupResponse) Reset() {
+	*x = DeleteHoldingGroupResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteHoldingGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHoldingGroupResponse) ProtoMessage() {}
+
+func (x *DeleteHoldingGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHoldingGroupResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHoldingGroupResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{116}
+}
+
+// GroupAllocation is one slice of a portfolio's allocation. Holdings outside
+// every group fall back to a slice for their sector.
+type GroupAllocation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GroupId          *int64                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3,oneof" json:"group_id,omitempty"` // unset for a sector slice
+	Value            float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	WeightPercent    float64                `protobuf:"fixed64,4,opt,name=weight_percent,json=weightPercent,proto3" json:"weight_percent,omitempty"`
+	Symbols          []string               `protobuf:"bytes,5,rep,name=symbols,proto3" json:"symbols,omitempty"`
+	MaxWeightPercent *float64               `protobuf:"fixed64,6,opt,name=max_weight_percent,json=maxWeightPercent,proto3,oneof" json:"max_weight_percent,omitempty"`
+	OverLimit        bool                   `protobuf:"varint,7,opt,name=over_limit,json=overLimit,proto3" json:"over_limit,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GroupAllocation) Reset() {
+	*x = GroupAllocation{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAllocation) ProtoMessage() {}
+
+func (x *GroupAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAllocation.ProtoReflect.Descriptor instead.
+func (*GroupAllocation) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GroupAllocation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupAllocation) GetGroupId() int64 {
+	if x != nil && x.GroupId != nil {
+		return *x.GroupId
+	}
+	return 0
+}
+
+func (x *GroupAllocation) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *GroupAllocation) GetWeightPercent() float64 {
+	if x != nil {
+		return x.WeightPercent
+	}
+	return 0
+}
+
+func (x *GroupAllocation) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+func (x *GroupAllocation) GetMaxWeightPercent() float64 {
+	if x != nil && x.MaxWeightPercent != nil {
+		return *x.MaxWeightPercent
+	}
+	return 0
+}
+
+func (x *GroupAllocation) GetOverLimit() bool {
+	if x != nil {
+		return x.OverLimit
+	}
+	return false
+}
+
+type GroupHoldingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupHoldingsRequest) Reset() {
+	*x = GroupHoldingsRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupHoldingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupHoldingsRequest) ProtoMessage() {}
+
+func (x *GroupHoldingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupHoldingsRequest.ProtoReflect.Descriptor instead.
+func (*GroupHoldingsRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GroupHoldingsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+type GroupHoldingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*GroupAllocation     `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // largest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupHoldingsResponse) Reset() {
+	*x = GroupHoldingsResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupHoldingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupHoldingsResponse) ProtoMessage() {}
+
+func (x *GroupHoldingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupHoldingsResponse.ProtoReflect.Descriptor instead.
+func (*GroupHoldingsResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GroupHoldingsResponse) GetGroups() []*GroupAllocation {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type TimelineEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD
+	Kind          TimelineEventKind      `protobuf:"varint,2,opt,name=kind,proto3,enum=ntx.v1.TimelineEventKind" json:"kind,omitempty"`
+	StockSymbol   string                 `protobuf:"bytes,3,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"` // empty for portfolio-wide alerts
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	RefId         int64                  `protobuf:"varint,6,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"` // transaction, alert or note id; 0 for corporate actions
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *TimelineEvent) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetKind() TimelineEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TimelineEventKind_TIMELINE_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *TimelineEvent) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetRefId() int64 {
+	if x != nil {
+		return x.RefId
+	}
+	return 0
+}
+
+type GetTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	StockSymbol   *string                `protobuf:"bytes,2,opt,name=stock_symbol,json=stockSymbol,proto3,oneof" json:"stock_symbol,omitempty"`
+	Month         string                 `protobuf:"bytes,3,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM; defaults to the latest month with events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineRequest) Reset() {
+	*x = GetTimelineRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineRequest) ProtoMessage() {}
+
+func (x *GetTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetTimelineRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetTimelineRequest) GetStockSymbol() string {
+	if x != nil && x.StockSymbol != nil {
+		return *x.StockSymbol
+	}
+	return ""
+}
+
+func (x *GetTimelineRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type GetTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Events        []*TimelineEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`                                    // oldest first
+	PreviousMonth string                 `protobuf:"bytes,3,opt,name=previous_month,json=previousMonth,proto3" json:"previous_month,omitempty"` // nearest earlier month with events
+	NextMonth     string                 `protobuf:"bytes,4,opt,name=next_month,json=nextMonth,proto3" json:"next_month,omitempty"`             // nearest later month with events
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimelineResponse) Reset() {
+	*x = GetTimelineResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimelineResponse) ProtoMessage() {}
+
+func (x *GetTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetTimelineResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *GetTimelineResponse) GetEvents() []*TimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetTimelineResponse) GetPreviousMonth() string {
+	if x != nil {
+		return x.PreviousMonth
+	}
+	return ""
+}
+
+func (x *GetTimelineResponse) GetNextMonth() string {
+	if x != nil {
+		return x.NextMonth
+	}
+	return ""
+}
+
+type FiscalYearSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartDate        string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                        // 1 Shrawan
+	EndDate          string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                              // today for the current year
+	Value            float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`                                               // at end_date
+	Cost             float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`                                                 // cost of the lots open at end_date
+	NetContributions float64                `protobuf:"fixed64,5,opt,name=net_contributions,json=netContributions,proto3" json:"net_contributions,omitempty"` // purchases less sale proceeds
+	RealizedGain     float64                `protobuf:"fixed64,6,opt,name=realized_gain,json=realizedGain,proto3" json:"realized_gain,omitempty"`
+	UnrealizedGain   float64                `protobuf:"fixed64,7,opt,name=unrealized_gain,json=unrealizedGain,proto3" json:"unrealized_gain,omitempty"` // at end_date
+	DividendIncome   float64                `protobuf:"fixed64,8,opt,name=dividend_income,json=dividendIncome,proto3" json:"dividend_income,omitempty"` // cash dividends announced while held
+	EstimatedTax     float64                `protobuf:"fixed64,9,opt,name=estimated_tax,json=estimatedTax,proto3" json:"estimated_tax,omitempty"`       // CGT withheld on the year's sales
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FiscalYearSummary) Reset() {
+	*x = FiscalYearSummary{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiscalYearSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiscalYearSummary) ProtoMessage() {}
+
+func (x *FiscalYearSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiscalYearSummary.ProtoReflect.Descriptor instead.
+func (*FiscalYearSummary) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *FiscalYearSummary) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FiscalYearSummary) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FiscalYearSummary) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetNetContributions() float64 {
+	if x != nil {
+		return x.NetContributions
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetRealizedGain() float64 {
+	if x != nil {
+		return x.RealizedGain
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetUnrealizedGain() float64 {
+	if x != nil {
+		return x.UnrealizedGain
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetDividendIncome() float64 {
+	if x != nil {
+		return x.DividendIncome
+	}
+	return 0
+}
+
+func (x *FiscalYearSummary) GetEstimatedTax() float64 {
+	if x != nil {
+		return x.EstimatedTax
+	}
+	return 0
+}
+
+type GetYearComparisonRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetYearComparisonRequest) Reset() {
+	*x = GetYearComparisonRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetYearComparisonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetYearComparisonRequest) ProtoMessage() {}
+
+func (x *GetYearComparisonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetYearComparisonRequest.ProtoReflect.Descriptor instead.
+func (*GetYearComparisonRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetYearComparisonRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+type GetYearComparisonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Years         []*FiscalYearSummary   `protobuf:"bytes,1,rep,name=years,proto3" json:"years,omitempty"` // oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetYearComparisonResponse) Reset() {
+	*x = GetYearComparisonResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetYearComparisonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetYearComparisonResponse) ProtoMessage() {}
+
+func (x *GetYearComparisonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetYearComparisonResponse.ProtoReflect.Descriptor instead.
+func (*GetYearComparisonResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetYearComparisonResponse) GetYears() []*FiscalYearSummary {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+type MonthlyGrowth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	StartValue    float64                `protobuf:"fixed64,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      float64                `protobuf:"fixed64,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	Contributions float64                `protobuf:"fixed64,4,opt,name=contributions,proto3" json:"contributions,omitempty"`                   // purchases less sale proceeds
+	MarketGrowth  float64                `protobuf:"fixed64,5,opt,name=market_growth,json=marketGrowth,proto3" json:"market_growth,omitempty"` // change in value not put in or taken out
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyGrowth) Reset() {
+	*x = MonthlyGrowth{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyGrowth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyGrowth) ProtoMessage() {}
+
+func (x *MonthlyGrowth) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyGrowth.ProtoReflect.Descriptor instead.
+func (*MonthlyGrowth) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *MonthlyGrowth) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyGrowth) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *MonthlyGrowth) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *MonthlyGrowth) GetContributions() float64 {
+	if x != nil {
+		return x.Contributions
+	}
+	return 0
+}
+
+func (x *MonthlyGrowth) GetMarketGrowth() float64 {
+	if x != nil {
+		return x.MarketGrowth
+	}
+	return 0
+}
+
+type GetGrowthBreakdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	Months        int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"` // most recent months to return; 0 for 12
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGrowthBreakdownRequest) Reset() {
+	*x = GetGrowthBreakdownRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGrowthBreakdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGrowthBreakdownRequest) ProtoMessage() {}
+
+func (x *GetGrowthBreakdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGrowthBreakdownRequest.ProtoReflect.Descriptor instead.
+func (*GetGrowthBreakdownRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetGrowthBreakdownRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetGrowthBreakdownRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+type GetGrowthBreakdownResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Months             []*MonthlyGrowth       `protobuf:"bytes,1,rep,name=months,proto3" json:"months,omitempty"` // oldest first
+	TotalContributions float64                `protobuf:"fixed64,2,opt,name=total_contributions,json=totalContributions,proto3" json:"total_contributions,omitempty"`
+	TotalMarketGrowth  float64                `protobuf:"fixed64,3,opt,name=total_market_growth,json=totalMarketGrowth,proto3" json:"total_market_growth,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGrowthBreakdownResponse) Reset() {
+	*x = GetGrowthBreakdownResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGrowthBreakdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGrowthBreakdownResponse) ProtoMessage() {}
+
+func (x *GetGrowthBreakdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGrowthBreakdownResponse.ProtoReflect.Descriptor instead.
+func (*GetGrowthBreakdownResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *GetGrowthBreakdownResponse) GetMonths() []*MonthlyGrowth {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetGrowthBreakdownResponse) GetTotalContributions() float64 {
+	if x != nil {
+		return x.TotalContributions
+	}
+	return 0
+}
+
+func (x *GetGrowthBreakdownResponse) GetTotalMarketGrowth() float64 {
+	if x != nil {
+		return x.TotalMarketGrowth
+	}
+	return 0
+}
+
+// RealizedGain is the part of a sale matched against one lot.
+type RealizedGain struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StockSymbol     string                 `protobuf:"bytes,1,opt,name=stock_symbol,json=stockSymbol,proto3" json:"stock_symbol,omitempty"`
+	Quantity        int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AcquiredDate    string                 `protobuf:"bytes,3,opt,name=acquired_date,json=acquiredDate,proto3" json:"acquired_date,omitempty"`
+	SoldDate        string                 `protobuf:"bytes,4,opt,name=sold_date,json=soldDate,proto3" json:"sold_date,omitempty"`
+	UnitCost        float64                `protobuf:"fixed64,5,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	SalePrice       float64                `protobuf:"fixed64,6,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	Gain            float64                `protobuf:"fixed64,7,opt,name=gain,proto3" json:"gain,omitempty"` // before fees
+	HoldingDays     int32                  `protobuf:"varint,8,opt,name=holding_days,json=holdingDays,proto3" json:"holding_days,omitempty"`
+	LongTerm        bool                   `protobuf:"varint,9,opt,name=long_term,json=longTerm,proto3" json:"long_term,omitempty"`
+	EstimatedTax    float64                `protobuf:"fixed64,10,opt,name=estimated_tax,json=estimatedTax,proto3" json:"estimated_tax,omitempty"` // CGT withheld; 0 on a loss
+	FiscalYearStart string                 `protobuf:"bytes,11,opt,name=fiscal_year_start,json=fiscalYearStart,proto3" json:"fiscal_year_start,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RealizedGain) Reset() {
+	*x = RealizedGain{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RealizedGain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RealizedGain) ProtoMessage() {}
+
+func (x *RealizedGain) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RealizedGain.ProtoReflect.Descriptor instead.
+func (*RealizedGain) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RealizedGain) GetStockSymbol() string {
+	if x != nil {
+		return x.StockSymbol
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetAcquiredDate() string {
+	if x != nil {
+		return x.AcquiredDate
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetSoldDate() string {
+	if x != nil {
+		return x.SoldDate
+	}
+	return ""
+}
+
+func (x *RealizedGain) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetHoldingDays() int32 {
+	if x != nil {
+		return x.HoldingDays
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetLongTerm() bool {
+	if x != nil {
+		return x.LongTerm
+	}
+	return false
+}
+
+func (x *RealizedGain) GetEstimatedTax() float64 {
+	if x != nil {
+		return x.EstimatedTax
+	}
+	return 0
+}
+
+func (x *RealizedGain) GetFiscalYearStart() string {
+	if x != nil {
+		return x.FiscalYearStart
+	}
+	return ""
+}
+
+type GetTaxReportRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// Any date in the fiscal year to report, YYYY-MM-DD; empty for every year
+	FiscalYear    string `protobuf:"bytes,2,opt,name=fiscal_year,json=fiscalYear,proto3" json:"fiscal_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxReportRequest) Reset() {
+	*x = GetTaxReportRequest{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxReportRequest) ProtoMessage() {}
+
+func (x *GetTaxReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxReportRequest.ProtoReflect.Descriptor instead.
+func (*GetTaxReportRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetTaxReportRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *GetTaxReportRequest) GetFiscalYear() string {
+	if x != nil {
+		return x.FiscalYear
+	}
+	return ""
+}
+
+type GetTaxReportResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Gains []*RealizedGain        `protobuf:"bytes,1,rep,name=gains,proto3" json:"gains,omitempty"` // oldest sale first
+	// Per fiscal year, oldest first. Rights renunciation proceeds count as
+	// short-term gain here without a line in gains.
+	Years         []*TaxSummary `protobuf:"bytes,2,rep,name=years,proto3" json:"years,omitempty"`
+	Total         *TaxSummary   `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"` // fiscal_year_start is empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaxReportResponse) Reset() {
+	*x = GetTaxReportResponse{}
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaxReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaxReportResponse) ProtoMessage() {}
+
+func (x *GetTaxReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_portfolio_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaxReportResponse.ProtoReflect.Descriptor instead.
+func (*GetTaxReportResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_portfolio_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetTaxReportResponse) GetGains() []*RealizedGain {
+	if x != nil {
+		return x.Gains
+	}
+	return nil
+}
+
+func (x *GetTaxReportResponse) GetYears() []*TaxSummary {
+	if x != nil {
+		return x.Years
+	}
+	return nil
+}
+
+func (x *GetTaxReportResponse) GetTotal() *TaxSummary {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+var File_ntx_v1_portfolio_proto protoreflect.FileDescriptor
+
+const file_ntx_v1_portfolio_proto_rawDesc = "" +
+	"\n" +
+	"\x16ntx/v1/portfolio.proto\x12\x06ntx.v1\x1a\x13ntx/v1/common.proto\"\x97\x01\n" +
+	"\tPortfolio\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\n" +
+	"profile_id\x18\x04 \x01(\x03H\x00R\tprofileId\x88\x01\x01\x12\x14\n" +
+	"\x05paper\x18\x05 \x01(\bR\x05paperB\r\n" +
+	"\v_profile_id\"\x17\n" +
+	"\x15ListPortfoliosRequest\"K\n" +
+	"\x16ListPortfoliosResponse\x121\n" +
+	"\n" +
+	"portfolios\x18\x01 \x03(\v2\x11.ntx.v1.PortfolioR\n" +
+	"portfolios\"u\n" +
+	"\x16CreatePortfolioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\x03H\x00R\tprofileId\x88\x01\x01\x12\x14\n" +
+	"\x05paper\x18\x03 \x01(\bR\x05paperB\r\n" +
+	"\v_profile_id\"J\n" +
+	"\x17CreatePortfolioResponse\x12/\n" +
+	"\tportfolio\x18\x01 \x01(\v2\x11.ntx.v1.PortfolioR\tportfolio\";\n" +
+	"\x16DeletePortfolioRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"\x19\n" +
+	"\x17DeletePortfolioResponse\"\x91\x03\n" +
+	"\vTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fportfolio_id\x18\x02 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x12B\n" +
+	"\x10transaction_type\x18\x04 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x01R\tunitPrice\x12)\n" +
+	"\x10transaction_date\x18\a \x01(\tR\x0ftransactionDate\x12\x1a\n" +
+	"\bintraday\x18\b \x01(\bR\bintraday\x12\x1f\n" +
+	"\x04tags\x18\t \x03(\v2\v.ntx.v1.TagR\x04tags\x12/\n" +
+	"\x11broker_account_id\x18\n" +
+	" \x01(\x03H\x00R\x0fbrokerAccountId\x88\x01\x01B\x14\n" +
+	"\x12_broker_account_id\"\xce\x02\n" +
+	"\x15AddTransactionRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12B\n" +
+	"\x10transaction_type\x18\x03 \x01(\x0e2\x17.ntx.v1.TransactionTypeR\x0ftransactionType\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12)\n" +
+	"\x10transaction_date\x18\x06 \x01(\tR\x0ftransactionDate\x12/\n" +
+	"\x11broker_account_id\x18\a \x01(\x03H\x00R\x0fbrokerAccountId\x88\x01\x01B\x14\n" +
+	"\x12_broker_account_id\"O\n" +
+	"\x16AddTransactionResponse\x125\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x13.ntx.v1.TransactionR\vtransaction\"\x91\x02\n" +
+	"\x17ListTransactionsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12&\n" +
+	"\fstock_symbol\x18\x02 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12\x1a\n" +
+	"\x06tag_id\x18\x03 \x01(\x03H\x01R\x05tagId\x88\x01\x01\x12/\n" +
+	"\x11broker_account_id\x18\x04 \x01(\x03H\x02R\x0fbrokerAccountId\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offsetB\x0f\n" +
+	"\r_stock_symbolB\t\n" +
+	"\a_tag_idB\x14\n" +
+	"\x12_broker_account_id\"t\n" +
+	"\x18ListTransactionsResponse\x127\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x13.ntx.v1.TransactionR\ftransactions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"A\n" +
+	"\x18DeleteTransactionRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\"\x1b\n" +
+	"\x19DeleteTransactionResponse\"\xb0\x05\n" +
+	"\aHolding\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\"\n" +
+	"\ravg_buy_price\x18\x03 \x01(\x01R\vavgBuyPrice\x12#\n" +
+	"\rcurrent_price\x18\x04 \x01(\x01R\fcurrentPrice\x12\x1f\n" +
+	"\vtotal_value\x18\x05 \x01(\x01R\n" +
+	"totalValue\x12\x1f\n" +
+	"\vprofit_loss\x18\x06 \x01(\x01R\n" +
+	"profitLoss\x12.\n" +
+	"\x13profit_loss_percent\x18\a \x01(\x01R\x11profitLossPercent\x12\x16\n" +
+	"\x06sector\x18\b \x01(\tR\x06sector\x12,\n" +
+	"\x12day_change_percent\x18\t \x01(\x01R\x10dayChangePercent\x12(\n" +
+	"\x10day_change_value\x18\n" +
+	" \x01(\x01R\x0edayChangeValue\x12%\n" +
+	"\x0eweight_percent\x18\v \x01(\x01R\rweightPercent\x123\n" +
+	"\vcost_source\x18\f \x01(\x0e2\x12.ntx.v1.CostSourceR\n" +
+	"costSource\x12?\n" +
+	"\x0finstrument_type\x18\r \x01(\x0e2\x16.ntx.v1.InstrumentTypeR\x0einstrumentType\x12)\n" +
+	"\x10accrued_interest\x18\x0e \x01(\x01R\x0faccruedInterest\x12<\n" +
+	"\x0elisting_status\x18\x0f \x01(\x0e2\x15.ntx.v1.ListingStatusR\rlistingStatus\x12\x1f\n" +
+	"\vdelisted_on\x18\x10 \x01(\tR\n" +
+	"delistedOn\x12\x14\n" +
+	"\x05group\x18\x11 \x01(\tR\x05group\"\x82\x04\n" +
+	"\x10PortfolioSummary\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12%\n" +
+	"\x0eportfolio_name\x18\x02 \x01(\tR\rportfolioName\x12+\n" +
+	"\bholdings\x18\x03 \x03(\v2\x0f.ntx.v1.HoldingR\bholdings\x12%\n" +
+	"\x0etotal_invested\x18\x04 \x01(\x01R\rtotalInvested\x12.\n" +
+	"\x13total_current_value\x18\x05 \x01(\x01R\x11totalCurrentValue\x12*\n" +
+	"\x11total_profit_loss\x18\x06 \x01(\x01R\x0ftotalProfitLoss\x129\n" +
+	"\x19total_profit_loss_percent\x18\a \x01(\x01R\x16totalProfitLossPercent\x12-\n" +
+	"\x12projected_dividend\x18\b \x01(\x01R\x11projectedDividend\x122\n" +
+	"\vhealth_tips\x18\t \x03(\v2\x11.ntx.v1.HealthTipR\n" +
+	"healthTips\x12(\n" +
+	"\x10day_change_value\x18\n" +
+	" \x01(\x01R\x0edayChangeValue\x12,\n" +
+	"\x12day_change_percent\x18\v \x01(\x01R\x10dayChangePercent\"Q\n" +
+	"\tHealthTip\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\"T\n" +
+	"\x1aGetPortfolioSummaryRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x13\n" +
+	"\x05as_of\x18\x02 \x01(\tR\x04asOf\"Q\n" +
+	"\x1bGetPortfolioSummaryResponse\x122\n" +
+	"\asummary\x18\x01 \x01(\v2\x18.ntx.v1.PortfolioSummaryR\asummary\"\xea\x02\n" +
+	"\x13ListHoldingsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x121\n" +
+	"\asort_by\x18\x02 \x01(\x0e2\x18.ntx.v1.HoldingSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x03 \x01(\bR\n" +
+	"descending\x12\x1b\n" +
+	"\x06sector\x18\x04 \x01(\tH\x00R\x06sector\x88\x01\x01\x12 \n" +
+	"\tmin_value\x18\x05 \x01(\x01H\x01R\bminValue\x88\x01\x01\x12!\n" +
+	"\fonly_gainers\x18\x06 \x01(\bR\vonlyGainers\x12\x1f\n" +
+	"\vonly_losers\x18\a \x01(\bR\n" +
+	"onlyLosers\x12\x14\n" +
+	"\x05limit\x18\b \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\t \x01(\x05R\x06offset\x12\x13\n" +
+	"\x05as_of\x18\n" +
+	" \x01(\tR\x04asOfB\t\n" +
+	"\a_sectorB\f\n" +
+	"\n" +
+	"_min_value\"d\n" +
+	"\x14ListHoldingsResponse\x12+\n" +
+	"\bholdings\x18\x01 \x03(\v2\x0f.ntx.v1.HoldingR\bholdings\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x99\x02\n" +
+	"\x03Lot\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01R\tunitPrice\x12#\n" +
+	"\racquired_date\x18\x04 \x01(\tR\facquiredDate\x12!\n" +
+	"\fholding_days\x18\x05 \x01(\x05R\vholdingDays\x12$\n" +
+	"\x0elong_term_date\x18\x06 \x01(\tR\flongTermDate\x12)\n" +
+	"\x11days_to_long_term\x18\a \x01(\x05R\x0edaysToLongTerm\x12\x1b\n" +
+	"\tlong_term\x18\b \x01(\bR\blongTerm\"m\n" +
+	"\x0fListLotsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12&\n" +
+	"\fstock_symbol\x18\x02 \x01(\tH\x00R\vstockSymbol\x88\x01\x01B\x0f\n" +
+	"\r_stock_symbol\"\x91\x01\n" +
+	"\x10ListLotsResponse\x12\x1f\n" +
+	"\x04lots\x18\x01 \x03(\v2\v.ntx.v1.LotR\x04lots\x12,\n" +
+	"\x12long_term_quantity\x18\x02 \x01(\x03R\x10longTermQuantity\x12.\n" +
+	"\x13short_term_quantity\x18\x03 \x01(\x03R\x11shortTermQuantity\"\xc0\x01\n" +
+	"\x0eImportConflict\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12/\n" +
+	"\bexisting\x18\x02 \x01(\v2\x13.ntx.v1.TransactionR\bexisting\x12/\n" +
+	"\bimported\x18\x03 \x01(\v2\x13.ntx.v1.TransactionR\bimported\x128\n" +
+	"\n" +
+	"resolution\x18\x04 \x01(\x0e2\x18.ntx.v1.ConflictStrategyR\n" +
+	"resolution\"\xa0\x01\n" +
+	"\x19ImportTransactionsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x19\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\x12E\n" +
+	"\x11conflict_strategy\x18\x03 \x01(\x0e2\x18.ntx.v1.ConflictStrategyR\x10conflictStrategy\"\xa4\x01\n" +
+	"\x1aImportTransactionsResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x12\x1a\n" +
+	"\breplaced\x18\x03 \x01(\x05R\breplaced\x124\n" +
+	"\tconflicts\x18\x04 \x03(\v2\x16.ntx.v1.ImportConflictR\tconflicts\">\n" +
+	"\x19ExportTransactionsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"S\n" +
+	"\x1aExportTransactionsResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x19\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\"\x9f\x01\n" +
+	"\x15PortfolioHistoryPoint\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12!\n" +
+	"\frealized_pnl\x18\x04 \x01(\x01R\vrealizedPnl\x12%\n" +
+	"\x0eunrealized_pnl\x18\x05 \x01(\x01R\runrealizedPnl\"\xaa\x01\n" +
+	"\x1aGetPortfolioHistoryRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\x123\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x17.ntx.v1.HistoryIntervalR\binterval\"T\n" +
+	"\x1bGetPortfolioHistoryResponse\x125\n" +
+	"\x06points\x18\x01 \x03(\v2\x1d.ntx.v1.PortfolioHistoryPointR\x06points\"\xe5\x02\n" +
+	"\x12PortfolioBreakdown\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12%\n" +
+	"\x0eportfolio_name\x18\x02 \x01(\tR\rportfolioName\x12%\n" +
+	"\x0etotal_invested\x18\x03 \x01(\x01R\rtotalInvested\x12.\n" +
+	"\x13total_current_value\x18\x04 \x01(\x01R\x11totalCurrentValue\x12*\n" +
+	"\x11total_profit_loss\x18\x05 \x01(\x01R\x0ftotalProfitLoss\x12(\n" +
+	"\x10day_change_value\x18\x06 \x01(\x01R\x0edayChangeValue\x12%\n" +
+	"\x0eweight_percent\x18\a \x01(\x01R\rweightPercent\x12\"\n" +
+	"\n" +
+	"profile_id\x18\b \x01(\x03H\x00R\tprofileId\x88\x01\x01B\r\n" +
+	"\v_profile_id\"\xd3\x01\n" +
+	"\n" +
+	"TaxSummary\x12*\n" +
+	"\x11fiscal_year_start\x18\x01 \x01(\tR\x0ffiscalYearStart\x12&\n" +
+	"\x0fshort_term_gain\x18\x02 \x01(\x01R\rshortTermGain\x12$\n" +
+	"\x0elong_term_gain\x18\x03 \x01(\x01R\flongTermGain\x12#\n" +
+	"\restimated_tax\x18\x04 \x01(\x01R\festimatedTax\x12&\n" +
+	"\x0ffiscal_year_end\x18\x05 \x01(\tR\rfiscalYearEnd\"\x8c\x03\n" +
+	"\x13ConsolidatedSummary\x12:\n" +
+	"\n" +
+	"portfolios\x18\x01 \x03(\v2\x1a.ntx.v1.PortfolioBreakdownR\n" +
+	"portfolios\x12+\n" +
+	"\bholdings\x18\x02 \x03(\v2\x0f.ntx.v1.HoldingR\bholdings\x12%\n" +
+	"\x0etotal_invested\x18\x03 \x01(\x01R\rtotalInvested\x12.\n" +
+	"\x13total_current_value\x18\x04 \x01(\x01R\x11totalCurrentValue\x12*\n" +
+	"\x11total_profit_loss\x18\x05 \x01(\x01R\x0ftotalProfitLoss\x129\n" +
+	"\x19total_profit_loss_percent\x18\x06 \x01(\x01R\x16totalProfitLossPercent\x12(\n" +
+	"\x10day_change_value\x18\a \x01(\x01R\x0edayChangeValue\x12$\n" +
+	"\x03tax\x18\b \x01(\v2\x12.ntx.v1.TaxSummaryR\x03tax\"R\n" +
+	"\x1dGetConsolidatedSummaryRequest\x12\"\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03H\x00R\tprofileId\x88\x01\x01B\r\n" +
+	"\v_profile_id\"W\n" +
+	"\x1eGetConsolidatedSummaryResponse\x125\n" +
+	"\asummary\x18\x01 \x01(\v2\x1b.ntx.v1.ConsolidatedSummaryR\asummary\"\xf7\x02\n" +
+	"\x12HoldingAttribution\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12%\n" +
+	"\x0estart_quantity\x18\x02 \x01(\x03R\rstartQuantity\x12!\n" +
+	"\fend_quantity\x18\x03 \x01(\x03R\vendQuantity\x12\x1f\n" +
+	"\vstart_value\x18\x04 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x05 \x01(\x01R\bendValue\x12\x19\n" +
+	"\bnet_flow\x18\x06 \x01(\x01R\anetFlow\x12!\n" +
+	"\fprice_effect\x18\a \x01(\x01R\vpriceEffect\x12(\n" +
+	"\x10new_money_effect\x18\b \x01(\x01R\x0enewMoneyEffect\x12\x1b\n" +
+	"\ttotal_pnl\x18\t \x01(\x01R\btotalPnl\x121\n" +
+	"\x14contribution_percent\x18\n" +
+	" \x01(\x01R\x13contributionPercent\"p\n" +
+	"\x15GetAttributionRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDate\"\xed\x01\n" +
+	"\x16GetAttributionResponse\x126\n" +
+	"\bholdings\x18\x01 \x03(\v2\x1a.ntx.v1.HoldingAttributionR\bholdings\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\x01R\bendValue\x12\x19\n" +
+	"\bnet_flow\x18\x04 \x01(\x01R\anetFlow\x12\x1b\n" +
+	"\ttotal_pnl\x18\x05 \x01(\x01R\btotalPnl\x12%\n" +
+	"\x0ereturn_percent\x18\x06 \x01(\x01R\rreturnPercent\"\xa5\x01\n" +
+	"\x17ProjectPortfolioRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12 \n" +
+	"\vsimulations\x18\x02 \x01(\x05R\vsimulations\x12#\n" +
+	"\rhorizon_years\x18\x03 \x03(\x05R\fhorizonYears\x12\x17\n" +
+	"\x04seed\x18\x04 \x01(\x04H\x00R\x04seed\x88\x01\x01B\a\n" +
+	"\x05_seed\"\xbd\x01\n" +
+	"\x0eProjectionBand\x12#\n" +
+	"\rhorizon_years\x18\x01 \x01(\x05R\fhorizonYears\x12\x0e\n" +
+	"\x02p5\x18\x02 \x01(\x01R\x02p5\x12\x10\n" +
+	"\x03p25\x18\x03 \x01(\x01R\x03p25\x12\x10\n" +
+	"\x03p50\x18\x04 \x01(\x01R\x03p50\x12\x10\n" +
+	"\x03p75\x18\x05 \x01(\x01R\x03p75\x12\x10\n" +
+	"\x03p95\x18\x06 \x01(\x01R\x03p95\x12.\n" +
+	"\x13probability_of_loss\x18\a \x01(\x01R\x11probabilityOfLoss\"\xbb\x01\n" +
+	"\x18ProjectPortfolioResponse\x12#\n" +
+	"\rcurrent_value\x18\x01 \x01(\x01R\fcurrentValue\x12,\n" +
+	"\x05bands\x18\x02 \x03(\v2\x16.ntx.v1.ProjectionBandR\x05bands\x12!\n" +
+	"\fhistory_days\x18\x03 \x01(\x05R\vhistoryDays\x12)\n" +
+	"\x10excluded_symbols\x18\x04 \x03(\tR\x0fexcludedSymbols\"L\n" +
+	"\vSectorShock\x12\x16\n" +
+	"\x06sector\x18\x01 \x01(\tR\x06sector\x12%\n" +
+	"\x0echange_percent\x18\x02 \x01(\x01R\rchangePercent\"\xc1\x01\n" +
+	"\x12RunScenarioRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x125\n" +
+	"\x14index_change_percent\x18\x02 \x01(\x01H\x00R\x12indexChangePercent\x88\x01\x01\x128\n" +
+	"\rsector_shocks\x18\x03 \x03(\v2\x13.ntx.v1.SectorShockR\fsectorShocksB\x17\n" +
+	"\x15_index_change_percent\"\xe3\x01\n" +
+	"\x0fScenarioHolding\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x16\n" +
+	"\x06sector\x18\x02 \x01(\tR\x06sector\x12#\n" +
+	"\rcurrent_value\x18\x03 \x01(\x01R\fcurrentValue\x12'\n" +
+	"\x0fprojected_value\x18\x04 \x01(\x01R\x0eprojectedValue\x12%\n" +
+	"\x0echange_percent\x18\x05 \x01(\x01R\rchangePercent\x12\x17\n" +
+	"\x04beta\x18\x06 \x01(\x01H\x00R\x04beta\x88\x01\x01B\a\n" +
+	"\x05_beta\"\x96\x02\n" +
+	"\x13RunScenarioResponse\x123\n" +
+	"\bholdings\x18\x01 \x03(\v2\x17.ntx.v1.ScenarioHoldingR\bholdings\x12#\n" +
+	"\rcurrent_value\x18\x02 \x01(\x01R\fcurrentValue\x12'\n" +
+	"\x0fprojected_value\x18\x03 \x01(\x01R\x0eprojectedValue\x12!\n" +
+	"\fchange_value\x18\x04 \x01(\x01R\vchangeValue\x12%\n" +
+	"\x0echange_percent\x18\x05 \x01(\x01R\rchangePercent\x122\n" +
+	"\x15projected_profit_loss\x18\x06 \x01(\x01R\x13projectedProfitLoss\"\xea\x01\n" +
+	"\x1cCalculatePositionSizeRequest\x12!\n" +
+	"\faccount_size\x18\x01 \x01(\x01R\vaccountSize\x12!\n" +
+	"\frisk_percent\x18\x02 \x01(\x01R\vriskPercent\x12\x1f\n" +
+	"\ventry_price\x18\x03 \x01(\x01R\n" +
+	"entryPrice\x12\x1d\n" +
+	"\n" +
+	"stop_price\x18\x04 \x01(\x01R\tstopPrice\x12!\n" +
+	"\fportfolio_id\x18\x05 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x06 \x01(\tR\vstockSymbol\"\xce\x03\n" +
+	"\x1dCalculatePositionSizeResponse\x12\x1a\n" +
+	"\bquantity\x18\x01 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vrisk_amount\x18\x02 \x01(\x01R\n" +
+	"riskAmount\x12$\n" +
+	"\x0erisk_per_share\x18\x03 \x01(\x01R\friskPerShare\x12%\n" +
+	"\x0eposition_value\x18\x04 \x01(\x01R\rpositionValue\x12\x1e\n" +
+	"\n" +
+	"commission\x18\x05 \x01(\x01R\n" +
+	"commission\x12\x1b\n" +
+	"\tsebon_fee\x18\x06 \x01(\x01R\bsebonFee\x12\x1b\n" +
+	"\tdp_charge\x18\a \x01(\x01R\bdpCharge\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\b \x01(\x01R\ttotalCost\x12 \n" +
+	"\floss_at_stop\x18\t \x01(\x01R\n" +
+	"lossAtStop\x12'\n" +
+	"\x0faccount_percent\x18\n" +
+	" \x01(\x01R\x0eaccountPercent\x12*\n" +
+	"\x11capped_by_account\x18\v \x01(\bR\x0fcappedByAccount\x123\n" +
+	"\x05draft\x18\f \x01(\v2\x1d.ntx.v1.AddTransactionRequestR\x05draft\")\n" +
+	"\x03Tag\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"&\n" +
+	"\x10CreateTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"2\n" +
+	"\x11CreateTagResponse\x12\x1d\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.ntx.v1.TagR\x03tag\"\x11\n" +
+	"\x0fListTagsRequest\"3\n" +
+	"\x10ListTagsResponse\x12\x1f\n" +
+	"\x04tags\x18\x01 \x03(\v2\v.ntx.v1.TagR\x04tags\"=\n" +
+	"\x10RenameTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"2\n" +
+	"\x11RenameTagResponse\x12\x1d\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.ntx.v1.TagR\x03tag\")\n" +
+	"\x10DeleteTagRequest\x12\x15\n" +
+	"\x06tag_id\x18\x01 \x01(\x03R\x05tagId\"\x13\n" +
+	"\x11DeleteTagResponse\"[\n" +
+	"\x19SetTransactionTagsRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x17\n" +
+	"\atag_ids\x18\x02 \x03(\x03R\x06tagIds\"=\n" +
+	"\x1aSetTransactionTagsResponse\x12\x1f\n" +
+	"\x04tags\x18\x01 \x03(\v2\v.ntx.v1.TagR\x04tags\"\xe8\x02\n" +
+	"\x0eTagPerformance\x12\x1d\n" +
+	"\x03tag\x18\x01 \x01(\v2\v.ntx.v1.TagR\x03tag\x12\x1f\n" +
+	"\vtrade_count\x18\x02 \x01(\x05R\n" +
+	"tradeCount\x12#\n" +
+	"\rrealized_gain\x18\x03 \x01(\x01R\frealizedGain\x12&\n" +
+	"\x0fshort_term_gain\x18\x04 \x01(\x01R\rshortTermGain\x12$\n" +
+	"\x0elong_term_gain\x18\x05 \x01(\x01R\flongTermGain\x12#\n" +
+	"\restimated_tax\x18\x06 \x01(\x01R\festimatedTax\x12\x1b\n" +
+	"\topen_cost\x18\a \x01(\x01R\bopenCost\x12\x1d\n" +
+	"\n" +
+	"open_value\x18\b \x01(\x01R\topenValue\x12%\n" +
+	"\x0eunrealized_pnl\x18\t \x01(\x01R\runrealizedPnl\x12\x1b\n" +
+	"\ttotal_pnl\x18\n" +
+	" \x01(\x01R\btotalPnl\"\x9a\x01\n" +
+	"\x18GetTagPerformanceRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x1a\n" +
+	"\x06tag_id\x18\x02 \x01(\x03H\x00R\x05tagId\x88\x01\x01\x12\x1b\n" +
+	"\tfrom_date\x18\x03 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x04 \x01(\tR\x06toDateB\t\n" +
+	"\a_tag_id\"G\n" +
+	"\x19GetTagPerformanceResponse\x12*\n" +
+	"\x04tags\x18\x01 \x03(\v2\x16.ntx.v1.TagPerformanceR\x04tags\"u\n" +
+	"\rBrokerAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rbroker_number\x18\x02 \x01(\x05R\fbrokerNumber\x12\x1b\n" +
+	"\tclient_id\x18\x03 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"r\n" +
+	"\x1aCreateBrokerAccountRequest\x12#\n" +
+	"\rbroker_number\x18\x01 \x01(\x05R\fbrokerNumber\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"N\n" +
+	"\x1bCreateBrokerAccountResponse\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.ntx.v1.BrokerAccountR\aaccount\"\x1b\n" +
+	"\x19ListBrokerAccountsRequest\"O\n" +
+	"\x1aListBrokerAccountsResponse\x121\n" +
+	"\baccounts\x18\x01 \x03(\v2\x15.ntx.v1.BrokerAccountR\baccounts\";\n" +
+	"\x1aDeleteBrokerAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\"\x1d\n" +
+	"\x1bDeleteBrokerAccountResponse\"\x8b\x01\n" +
+	"\x1bSetTransactionBrokerRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12/\n" +
+	"\x11broker_account_id\x18\x02 \x01(\x03H\x00R\x0fbrokerAccountId\x88\x01\x01B\x14\n" +
+	"\x12_broker_account_id\"\x1e\n" +
+	"\x1cSetTransactionBrokerResponse\"\x9f\x02\n" +
+	"\x10BrokerCommission\x12/\n" +
+	"\aaccount\x18\x01 \x01(\v2\x15.ntx.v1.BrokerAccountR\aaccount\x12\x1f\n" +
+	"\vtrade_count\x18\x02 \x01(\x05R\n" +
+	"tradeCount\x12\x1d\n" +
+	"\n" +
+	"buy_amount\x18\x03 \x01(\x01R\tbuyAmount\x12\x1f\n" +
+	"\vsell_amount\x18\x04 \x01(\x01R\n" +
+	"sellAmount\x12\x1e\n" +
+	"\n" +
+	"commission\x18\x05 \x01(\x01R\n" +
+	"commission\x12\x1b\n" +
+	"\tsebon_fee\x18\x06 \x01(\x01R\bsebonFee\x12\x1d\n" +
+	"\n" +
+	"dp_charges\x18\a \x01(\x01R\tdpCharges\x12\x1d\n" +
+	"\n" +
+	"total_fees\x18\b \x01(\x01R\ttotalFees\"\x8c\x01\n" +
+	"\x1bGetBrokerCommissionsRequest\x12&\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03H\x00R\vportfolioId\x88\x01\x01\x12\x1b\n" +
+	"\tfrom_date\x18\x02 \x01(\tR\bfromDate\x12\x17\n" +
+	"\ato_date\x18\x03 \x01(\tR\x06toDateB\x0f\n" +
+	"\r_portfolio_id\"R\n" +
+	"\x1cGetBrokerCommissionsResponse\x122\n" +
+	"\abrokers\x18\x01 \x03(\v2\x18.ntx.v1.BrokerCommissionR\abrokers\"\x9a\x01\n" +
+	"\aProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04boid\x18\x03 \x01(\tR\x04boid\x12\"\n" +
+	"\frelationship\x18\x04 \x01(\tR\frelationship\x12\x14\n" +
+	"\x05minor\x18\x05 \x01(\bR\x05minor\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"x\n" +
+	"\x14CreateProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04boid\x18\x02 \x01(\tR\x04boid\x12\"\n" +
+	"\frelationship\x18\x03 \x01(\tR\frelationship\x12\x14\n" +
+	"\x05minor\x18\x04 \x01(\bR\x05minor\"B\n" +
+	"\x15CreateProfileResponse\x12)\n" +
+	"\aprofile\x18\x01 \x01(\v2\x0f.ntx.v1.ProfileR\aprofile\"\x15\n" +
+	"\x13ListProfilesRequest\"C\n" +
+	"\x14ListProfilesResponse\x12+\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x0f.ntx.v1.ProfileR\bprofiles\"5\n" +
+	"\x14DeleteProfileRequest\x12\x1d\n" +
+	"\n" +
+	"profile_id\x18\x01 \x01(\x03R\tprofileId\"\x17\n" +
+	"\x15DeleteProfileResponse\"r\n" +
+	"\x1aSetPortfolioProfileRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\"\n" +
+	"\n" +
+	"profile_id\x18\x02 \x01(\x03H\x00R\tprofileId\x88\x01\x01B\r\n" +
+	"\v_profile_id\"\x1d\n" +
+	"\x1bSetPortfolioProfileResponse\"}\n" +
+	"\tCostEntry\x12*\n" +
+	"\x06source\x18\x01 \x01(\x0e2\x12.ntx.v1.CostSourceR\x06source\x12\x19\n" +
+	"\bavg_cost\x18\x02 \x01(\x01R\aavgCost\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12\x15\n" +
+	"\x06set_at\x18\x04 \x01(\tR\x05setAt\"\xb8\x01\n" +
+	"\x15SetHoldingCostRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12*\n" +
+	"\x06source\x18\x03 \x01(\x0e2\x12.ntx.v1.CostSourceR\x06source\x12\x19\n" +
+	"\bavg_cost\x18\x04 \x01(\x01R\aavgCost\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"A\n" +
+	"\x16SetHoldingCostResponse\x12'\n" +
+	"\x05entry\x18\x01 \x01(\v2\x11.ntx.v1.CostEntryR\x05entry\"\x8b\x01\n" +
+	"\x17ClearHoldingCostRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12*\n" +
+	"\x06source\x18\x03 \x01(\x0e2\x12.ntx.v1.CostSourceR\x06source\"\x1a\n" +
+	"\x18ClearHoldingCostResponse\"\x82\x02\n" +
+	"\x12CostReconciliation\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12=\n" +
+	"\x10effective_source\x18\x03 \x01(\x0e2\x12.ntx.v1.CostSourceR\x0feffectiveSource\x12%\n" +
+	"\x0eeffective_cost\x18\x04 \x01(\x01R\reffectiveCost\x12+\n" +
+	"\aentries\x18\x05 \x03(\v2\x11.ntx.v1.CostEntryR\aentries\x12\x1a\n" +
+	"\bconflict\x18\x06 \x01(\bR\bconflict\"h\n" +
+	"\x1cGetCostReconciliationRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12%\n" +
+	"\x0econflicts_only\x18\x02 \x01(\bR\rconflictsOnly\"W\n" +
+	"\x1dGetCostReconciliationResponse\x126\n" +
+	"\bholdings\x18\x01 \x03(\v2\x1a.ntx.v1.CostReconciliationR\bholdings\";\n" +
+	"\x16ExportCostBasisRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"P\n" +
+	"\x17ExportCostBasisResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x19\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\"\xa3\x02\n" +
+	"\x10BonusExpectation\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1f\n" +
+	"\vfiscal_year\x18\x02 \x01(\tR\n" +
+	"fiscalYear\x12)\n" +
+	"\x10bonus_percentage\x18\x03 \x01(\x01R\x0fbonusPercentage\x12!\n" +
+	"\fannounced_on\x18\x04 \x01(\tR\vannouncedOn\x12+\n" +
+	"\x11eligible_quantity\x18\x05 \x01(\x03R\x10eligibleQuantity\x12%\n" +
+	"\x0eexpected_units\x18\x06 \x01(\x03R\rexpectedUnits\x12)\n" +
+	"\x10fractional_units\x18\a \x01(\x01R\x0ffractionalUnits\"T\n" +
+	"\x1bGetBonusExpectationsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\\\n" +
+	"\x1cGetBonusExpectationsResponse\x12<\n" +
+	"\fexpectations\x18\x01 \x03(\v2\x18.ntx.v1.BonusExpectationR\fexpectations\"\x8b\x02\n" +
+	"\rIncomeHolding\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vfiscal_year\x18\x03 \x01(\tR\n" +
+	"fiscalYear\x12,\n" +
+	"\x12dividend_per_share\x18\x04 \x01(\x01R\x10dividendPerShare\x12\"\n" +
+	"\ryield_on_cost\x18\x05 \x01(\x01R\vyieldOnCost\x12#\n" +
+	"\rcurrent_yield\x18\x06 \x01(\x01R\fcurrentYield\x12#\n" +
+	"\rannual_income\x18\a \x01(\x01R\fannualIncome\"<\n" +
+	"\x17GetIncomeSummaryRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"\xbb\x01\n" +
+	"\x18GetIncomeSummaryResponse\x121\n" +
+	"\bholdings\x18\x01 \x03(\v2\x15.ntx.v1.IncomeHoldingR\bholdings\x12#\n" +
+	"\rannual_income\x18\x02 \x01(\x01R\fannualIncome\x12\"\n" +
+	"\ryield_on_cost\x18\x03 \x01(\x01R\vyieldOnCost\x12#\n" +
+	"\rcurrent_yield\x18\x04 \x01(\x01R\fcurrentYield\"\xd4\x01\n" +
+	"\tBondTerms\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1d\n" +
+	"\n" +
+	"face_value\x18\x02 \x01(\x01R\tfaceValue\x12\x1f\n" +
+	"\vcoupon_rate\x18\x03 \x01(\x01R\n" +
+	"couponRate\x12(\n" +
+	"\x10coupons_per_year\x18\x04 \x01(\x03R\x0ecouponsPerYear\x12#\n" +
+	"\rmaturity_date\x18\x05 \x01(\tR\fmaturityDate\x12\x15\n" +
+	"\x06set_at\x18\x06 \x01(\tR\x05setAt\"\xea\x01\n" +
+	"\x13SetBondTermsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\x12\x1d\n" +
+	"\n" +
+	"face_value\x18\x03 \x01(\x01R\tfaceValue\x12\x1f\n" +
+	"\vcoupon_rate\x18\x04 \x01(\x01R\n" +
+	"couponRate\x12(\n" +
+	"\x10coupons_per_year\x18\x05 \x01(\x03R\x0ecouponsPerYear\x12#\n" +
+	"\rmaturity_date\x18\x06 \x01(\tR\fmaturityDate\"?\n" +
+	"\x14SetBondTermsResponse\x12'\n" +
+	"\x05terms\x18\x01 \x01(\v2\x11.ntx.v1.BondTermsR\x05terms\"]\n" +
+	"\x15ClearBondTermsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12!\n" +
+	"\fstock_symbol\x18\x02 \x01(\tR\vstockSymbol\"\x18\n" +
+	"\x16ClearBondTermsResponse\"\xcd\x02\n" +
+	"\fBondSchedule\x12'\n" +
+	"\x05terms\x18\x01 \x01(\v2\x11.ntx.v1.BondTermsR\x05terms\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12)\n" +
+	"\x10accrued_interest\x18\x03 \x01(\x01R\x0faccruedInterest\x12$\n" +
+	"\x0elast_coupon_on\x18\x04 \x01(\tR\flastCouponOn\x12$\n" +
+	"\x0enext_coupon_on\x18\x05 \x01(\tR\fnextCouponOn\x12,\n" +
+	"\x12next_coupon_amount\x18\x06 \x01(\x01R\x10nextCouponAmount\x12(\n" +
+	"\x10days_to_maturity\x18\a \x01(\x05R\x0edaysToMaturity\x12)\n" +
+	"\x10redemption_value\x18\b \x01(\x01R\x0fredemptionValue\";\n" +
+	"\x16GetBondScheduleRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"E\n" +
+	"\x17GetBondScheduleResponse\x12*\n" +
+	"\x05bonds\x18\x01 \x03(\v2\x14.ntx.v1.BondScheduleR\x05bonds\"\xb0\x01\n" +
+	"\fHoldingGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\asectors\x18\x03 \x03(\tR\asectors\x12\x18\n" +
+	"\asymbols\x18\x04 \x03(\tR\asymbols\x121\n" +
+	"\x12max_weight_percent\x18\x05 \x01(\x01H\x00R\x10maxWeightPercent\x88\x01\x01B\x15\n" +
+	"\x13_max_weight_percent\"\xad\x01\n" +
+	"\x19CreateHoldingGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\asectors\x18\x02 \x03(\tR\asectors\x12\x18\n" +
+	"\asymbols\x18\x03 \x03(\tR\asymbols\x121\n" +
+	"\x12max_weight_percent\x18\x04 \x01(\x01H\x00R\x10maxWeightPercent\x88\x01\x01B\x15\n" +
+	"\x13_max_weight_percent\"H\n" +
+	"\x1aCreateHoldingGroupResponse\x12*\n" +
+	"\x05group\x18\x01 \x01(\v2\x14.ntx.v1.HoldingGroupR\x05group\"\x1a\n" +
+	"\x18ListHoldingGroupsRequest\"I\n" +
+	"\x19ListHoldingGroupsResponse\x12,\n" +
+	"\x06groups\x18\x01 \x03(\v2\x14.ntx.v1.HoldingGroupR\x06groups\"\xc8\x01\n" +
+	"\x19UpdateHoldingGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\asectors\x18\x03 \x03(\tR\asectors\x12\x18\n" +
+	"\asymbols\x18\x04 \x03(\tR\asymbols\x121\n" +
+	"\x12max_weight_percent\x18\x05 \x01(\x01H\x00R\x10maxWeightPercent\x88\x01\x01B\x15\n" +
+	"\x13_max_weight_percent\"H\n" +
+	"\x1aUpdateHoldingGroupResponse\x12*\n" +
+	"\x05group\x18\x01 \x01(\v2\x14.ntx.v1.HoldingGroupR\x05group\"6\n" +
+	"\x19DeleteHoldingGroupRequest\x12\x19\n" +
+	"\bgroup_id\x18\x01 \x01(\x03R\agroupId\"\x1c\n" +
+	"\x1aDeleteHoldingGroupResponse\"\x92\x02\n" +
+	"\x0fGroupAllocation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\bgroup_id\x18\x02 \x01(\x03H\x00R\agroupId\x88\x01\x01\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12%\n" +
+	"\x0eweight_percent\x18\x04 \x01(\x01R\rweightPercent\x12\x18\n" +
+	"\asymbols\x18\x05 \x03(\tR\asymbols\x121\n" +
+	"\x12max_weight_percent\x18\x06 \x01(\x01H\x01R\x10maxWeightPercent\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"over_limit\x18\a \x01(\bR\toverLimitB\v\n" +
+	"\t_group_idB\x15\n" +
+	"\x13_max_weight_percent\"9\n" +
+	"\x14GroupHoldingsRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"H\n" +
+	"\x15GroupHoldingsResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.ntx.v1.GroupAllocationR\x06groups\"\xba\x01\n" +
+	"\rTimelineEvent\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.ntx.v1.TimelineEventKindR\x04kind\x12!\n" +
+	"\fstock_symbol\x18\x03 \x01(\tR\vstockSymbol\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\x12\x15\n" +
+	"\x06ref_id\x18\x06 \x01(\x03R\x05refId\"\x86\x01\n" +
+	"\x12GetTimelineRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12&\n" +
+	"\fstock_symbol\x18\x02 \x01(\tH\x00R\vstockSymbol\x88\x01\x01\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05monthB\x0f\n" +
+	"\r_stock_symbol\"\xa0\x01\n" +
+	"\x13GetTimelineResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12-\n" +
+	"\x06events\x18\x02 \x03(\v2\x15.ntx.v1.TimelineEventR\x06events\x12%\n" +
+	"\x0eprevious_month\x18\x03 \x01(\tR\rpreviousMonth\x12\x1d\n" +
+	"\n" +
+	"next_month\x18\x04 \x01(\tR\tnextMonth\"\xc0\x02\n" +
+	"\x11FiscalYearSummary\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\x12+\n" +
+	"\x11net_contributions\x18\x05 \x01(\x01R\x10netContributions\x12#\n" +
+	"\rrealized_gain\x18\x06 \x01(\x01R\frealizedGain\x12'\n" +
+	"\x0funrealized_gain\x18\a \x01(\x01R\x0eunrealizedGain\x12'\n" +
+	"\x0fdividend_income\x18\b \x01(\x01R\x0edividendIncome\x12#\n" +
+	"\restimated_tax\x18\t \x01(\x01R\festimatedTax\"=\n" +
+	"\x18GetYearComparisonRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\"L\n" +
+	"\x19GetYearComparisonResponse\x12/\n" +
+	"\x05years\x18\x01 \x03(\v2\x19.ntx.v1.FiscalYearSummaryR\x05years\"\xae\x01\n" +
+	"\rMonthlyGrowth\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\x01R\bendValue\x12$\n" +
+	"\rcontributions\x18\x04 \x01(\x01R\rcontributions\x12#\n" +
+	"\rmarket_growth\x18\x05 \x01(\x01R\fmarketGrowth\"V\n" +
+	"\x19GetGrowthBreakdownRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\"\xac\x01\n" +
+	"\x1aGetGrowthBreakdownResponse\x12-\n" +
+	"\x06months\x18\x01 \x03(\v2\x15.ntx.v1.MonthlyGrowthR\x06months\x12/\n" +
+	"\x13total_contributions\x18\x02 \x01(\x01R\x12totalContributions\x12.\n" +
+	"\x13total_market_growth\x18\x03 \x01(\x01R\x11totalMarketGrowth\"\xf0\x02\n" +
+	"\fRealizedGain\x12!\n" +
+	"\fstock_symbol\x18\x01 \x01(\tR\vstockSymbol\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12#\n" +
+	"\racquired_date\x18\x03 \x01(\tR\facquiredDate\x12\x1b\n" +
+	"\tsold_date\x18\x04 \x01(\tR\bsoldDate\x12\x1b\n" +
+	"\tunit_cost\x18\x05 \x01(\x01R\bunitCost\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x06 \x01(\x01R\tsalePrice\x12\x12\n" +
+	"\x04gain\x18\a \x01(\x01R\x04gain\x12!\n" +
+	"\fholding_days\x18\b \x01(\x05R\vholdingDays\x12\x1b\n" +
+	"\tlong_term\x18\t \x01(\bR\blongTerm\x12#\n" +
+	"\restimated_tax\x18\n" +
+	" \x01(\x01R\festimatedTax\x12*\n" +
+	"\x11fiscal_year_start\x18\v \x01(\tR\x0ffiscalYearStart\"Y\n" +
+	"\x13GetTaxReportRequest\x12!\n" +
+	"\fportfolio_id\x18\x01 \x01(\x03R\vportfolioId\x12\x1f\n" +
+	"\vfiscal_year\x18\x02 \x01(\tR\n" +
+	"fiscalYear\"\x96\x01\n" +
+	"\x14GetTaxReportResponse\x12*\n" +
+	"\x05gains\x18\x01 \x03(\v2\x14.ntx.v1.RealizedGainR\x05gains\x12(\n" +
+	"\x05years\x18\x02 \x03(\v2\x12.ntx.v1.TaxSummaryR\x05years\x12(\n" +
+	"\x05total\x18\x03 \x01(\v2\x12.ntx.v1.TaxSummaryR\x05total*h\n" +
+	"\x0fTransactionType\x12 \n" +
+	"\x1cTRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14TRANSACTION_TYPE_BUY\x10\x01\x12\x19\n" +
+	"\x15TRANSACTION_TYPE_SELL\x10\x02*\xf5\x01\n" +
+	"\x10HoldingSortField\x12\"\n" +
+	"\x1eHOLDING_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19HOLDING_SORT_FIELD_SYMBOL\x10\x01\x12\x1c\n" +
+	"\x18HOLDING_SORT_FIELD_VALUE\x10\x02\x12\x1a\n" +
+	"\x16HOLDING_SORT_FIELD_PNL\x10\x03\x12\"\n" +
+	"\x1eHOLDING_SORT_FIELD_PNL_PERCENT\x10\x04\x12!\n" +
+	"\x1dHOLDING_SORT_FIELD_DAY_CHANGE\x10\x05\x12\x1d\n" +
+	"\x19HOLDING_SORT_FIELD_WEIGHT\x10\x06*\x91\x01\n" +
+	"\x10ConflictStrategy\x12!\n" +
+	"\x1dCONFLICT_STRATEGY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CONFLICT_STRATEGY_SKIP\x10\x01\x12\x1d\n" +
+	"\x19CONFLICT_STRATEGY_REPLACE\x10\x02\x12\x1f\n" +
+	"\x1bCONFLICT_STRATEGY_KEEP_BOTH\x10\x03*\x8a\x01\n" +
+	"\x0fHistoryInterval\x12 \n" +
+	"\x1cHISTORY_INTERVAL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16HISTORY_INTERVAL_DAILY\x10\x01\x12\x1b\n" +
+	"\x17HISTORY_INTERVAL_WEEKLY\x10\x02\x12\x1c\n" +
+	"\x18HISTORY_INTERVAL_MONTHLY\x10\x03*u\n" +
+	"\n" +
+	"CostSource\x12\x1b\n" +
+	"\x17COST_SOURCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COST_SOURCE_TRANSACTIONS\x10\x01\x12\x14\n" +
+	"\x10COST_SOURCE_WACC\x10\x02\x12\x16\n" +
+	"\x12COST_SOURCE_MANUAL\x10\x03*\xe6\x01\n" +
+	"\x11TimelineEventKind\x12#\n" +
+	"\x1fTIMELINE_EVENT_KIND_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fTIMELINE_EVENT_KIND_TRANSACTION\x10\x01\x12 \n" +
+	"\x1cTIMELINE_EVENT_KIND_DIVIDEND\x10\x02\x12(\n" +
+	"$TIMELINE_EVENT_KIND_CORPORATE_ACTION\x10\x03\x12\x1d\n" +
+	"\x19TIMELINE_EVENT_KIND_ALERT\x10\x04\x12\x1c\n" +
+	"\x18TIMELINE_EVENT_KIND_NOTE\x10\x052\xcb!\n" +
 	"\x10PortfolioService\x12O\n" +
 	"\x0eListPortfolios\x12\x1d.ntx.v1.ListPortfoliosRequest\x1a\x1e.ntx.v1.ListPortfoliosResponse\x12R\n" +
-	"\x0fCreatePortfolio\x12\x1e.ntx.v1.CreatePortfolioRequest\x1a\x1f.ntx.v1.CreatePortfolioResponse\x12O\n" +
+	"\x0fCreatePortfolio\x12\x1e.ntx.v1.CreatePortfolioRequest\x1a\x1f.ntx.v1.CreatePortfolioResponse\x12R\n" +
+	"\x0fDeletePortfolio\x12\x1e.ntx.v1.DeletePortfolioRequest\x1a\x1f.ntx.v1.DeletePortfolioResponse\x12O\n" +
 	"\x0eAddTransaction\x12\x1d.ntx.v1.AddTransactionRequest\x1a\x1e.ntx.v1.AddTransactionResponse\x12U\n" +
 	"\x10ListTransactions\x12\x1f.ntx.v1.ListTransactionsRequest\x1a .ntx.v1.ListTransactionsResponse\x12X\n" +
 	"\x11DeleteTransaction\x12 .ntx.v1.DeleteTransactionRequest\x1a!.ntx.v1.DeleteTransactionResponse\x12^\n" +
-	"\x13GetPortfolioSummary\x12\".ntx.v1.GetPortfolioSummaryRequest\x1a#.ntx.v1.GetPortfolioSummaryResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+	"\x13GetPortfolioSummary\x12\".ntx.v1.GetPortfolioSummaryRequest\x1a#.ntx.v1.GetPortfolioSummaryResponse\x12I\n" +
+	"\fListHoldings\x12\x1b.ntx.v1.ListHoldingsRequest\x1a\x1c.ntx.v1.ListHoldingsResponse\x12^\n" +
+	"\x13GetPortfolioHistory\x12\".ntx.v1.GetPortfolioHistoryRequest\x1a#.ntx.v1.GetPortfolioHistoryResponse\x12g\n" +
+	"\x16GetConsolidatedSummary\x12%.ntx.v1.GetConsolidatedSummaryRequest\x1a&.ntx.v1.GetConsolidatedSummaryResponse\x12=\n" +
+	"\bListLots\x12\x17.ntx.v1.ListLotsRequest\x1a\x18.ntx.v1.ListLotsResponse\x12[\n" +
+	"\x12ImportTransactions\x12!.ntx.v1.ImportTransactionsRequest\x1a\".ntx.v1.ImportTransactionsResponse\x12[\n" +
+	"\x12ExportTransactions\x12!.ntx.v1.ExportTransactionsRequest\x1a\".ntx.v1.ExportTransactionsResponse\x12O\n" +
+	"\x0eGetAttribution\x12\x1d.ntx.v1.GetAttributionRequest\x1a\x1e.ntx.v1.GetAttributionResponse\x12U\n" +
+	"\x10ProjectPortfolio\x12\x1f.ntx.v1.ProjectPortfolioRequest\x1a .ntx.v1.ProjectPortfolioResponse\x12F\n" +
+	"\vRunScenario\x12\x1a.ntx.v1.RunScenarioRequest\x1a\x1b.ntx.v1.RunScenarioResponse\x12d\n" +
+	"\x15CalculatePositionSize\x12$.ntx.v1.CalculatePositionSizeRequest\x1a%.ntx.v1.CalculatePositionSizeResponse\x12@\n" +
+	"\tCreateTag\x12\x18.ntx.v1.CreateTagRequest\x1a\x19.ntx.v1.CreateTagResponse\x12=\n" +
+	"\bListTags\x12\x17.ntx.v1.ListTagsRequest\x1a\x18.ntx.v1.ListTagsResponse\x12@\n" +
+	"\tRenameTag\x12\x18.ntx.v1.RenameTagRequest\x1a\x19.ntx.v1.RenameTagResponse\x12@\n" +
+	"\tDeleteTag\x12\x18.ntx.v1.DeleteTagRequest\x1a\x19.ntx.v1.DeleteTagResponse\x12[\n" +
+	"\x12SetTransactionTags\x12!.ntx.v1.SetTransactionTagsRequest\x1a\".ntx.v1.SetTransactionTagsResponse\x12X\n" +
+	"\x11GetTagPerformance\x12 .ntx.v1.GetTagPerformanceRequest\x1a!.ntx.v1.GetTagPerformanceResponse\x12^\n" +
+	"\x13CreateBrokerAccount\x12\".ntx.v1.CreateBrokerAccountRequest\x1a#.ntx.v1.CreateBrokerAccountResponse\x12[\n" +
+	"\x12ListBrokerAccounts\x12!.ntx.v1.ListBrokerAccountsRequest\x1a\".ntx.v1.ListBrokerAccountsResponse\x12^\n" +
+	"\x13DeleteBrokerAccount\x12\".ntx.v1.DeleteBrokerAccountRequest\x1a#.ntx.v1.DeleteBrokerAccountResponse\x12a\n" +
+	"\x14SetTransactionBroker\x12#.ntx.v1.SetTransactionBrokerRequest\x1a$.ntx.v1.SetTransactionBrokerResponse\x12a\n" +
+	"\x14GetBrokerCommissions\x12#.ntx.v1.GetBrokerCommissionsRequest\x1a$.ntx.v1.GetBrokerCommissionsResponse\x12L\n" +
+	"\rCreateProfile\x12\x1c.ntx.v1.CreateProfileRequest\x1a\x1d.ntx.v1.CreateProfileResponse\x12I\n" +
+	"\fListProfiles\x12\x1b.ntx.v1.ListProfilesRequest\x1a\x1c.ntx.v1.ListProfilesResponse\x12L\n" +
+	"\rDeleteProfile\x12\x1c.ntx.v1.DeleteProfileRequest\x1a\x1d.ntx.v1.DeleteProfileResponse\x12^\n" +
+	"\x13SetPortfolioProfile\x12\".ntx.v1.SetPortfolioProfileRequest\x1a#.ntx.v1.SetPortfolioProfileResponse\x12O\n" +
+	"\x0eSetHoldingCost\x12\x1d.ntx.v1.SetHoldingCostRequest\x1a\x1e.ntx.v1.SetHoldingCostResponse\x12U\n" +
+	"\x10ClearHoldingCost\x12\x1f.ntx.v1.ClearHoldingCostRequest\x1a .ntx.v1.ClearHoldingCostResponse\x12d\n" +
+	"\x15GetCostReconciliation\x12$.ntx.v1.GetCostReconciliationRequest\x1a%.ntx.v1.GetCostReconciliationResponse\x12R\n" +
+	"\x0fExportCostBasis\x12\x1e.ntx.v1.ExportCostBasisRequest\x1a\x1f.ntx.v1.ExportCostBasisResponse\x12a\n" +
+	"\x14GetBonusExpectations\x12#.ntx.v1.GetBonusExpectationsRequest\x1a$.ntx.v1.GetBonusExpectationsResponse\x12U\n" +
+	"\x10GetIncomeSummary\x12\x1f.ntx.v1.GetIncomeSummaryRequest\x1a .ntx.v1.GetIncomeSummaryResponse\x12I\n" +
+	"\fSetBondTerms\x12\x1b.ntx.v1.SetBondTermsRequest\x1a\x1c.ntx.v1.SetBondTermsResponse\x12O\n" +
+	"\x0eClearBondTerms\x12\x1d.ntx.v1.ClearBondTermsRequest\x1a\x1e.ntx.v1.ClearBondTermsResponse\x12R\n" +
+	"\x0fGetBondSchedule\x12\x1e.ntx.v1.GetBondScheduleRequest\x1a\x1f.ntx.v1.GetBondScheduleResponse\x12[\n" +
+	"\x12CreateHoldingGroup\x12!.ntx.v1.CreateHoldingGroupRequest\x1a\".ntx.v1.CreateHoldingGroupResponse\x12X\n" +
+	"\x11ListHoldingGroups\x12 .ntx.v1.ListHoldingGroupsRequest\x1a!.ntx.v1.ListHoldingGroupsResponse\x12[\n" +
+	"\x12UpdateHoldingGroup\x12!.ntx.v1.UpdateHoldingGroupRequest\x1a\".ntx.v1.UpdateHoldingGroupResponse\x12[\n" +
+	"\x12DeleteHoldingGroup\x12!.ntx.v1.DeleteHoldingGroupRequest\x1a\".ntx.v1.DeleteHoldingGroupResponse\x12L\n" +
+	"\rGroupHoldings\x12\x1c.ntx.v1.GroupHoldingsRequest\x1a\x1d.ntx.v1.GroupHoldingsResponse\x12F\n" +
+	"\vGetTimeline\x12\x1a.ntx.v1.GetTimelineRequest\x1a\x1b.ntx.v1.GetTimelineResponse\x12X\n" +
+	"\x11GetYearComparison\x12 .ntx.v1.GetYearComparisonRequest\x1a!.ntx.v1.GetYearComparisonResponse\x12[\n" +
+	"\x12GetGrowthBreakdown\x12!.ntx.v1.GetGrowthBreakdownRequest\x1a\".ntx.v1.GetGrowthBreakdownResponse\x12I\n" +
+	"\fGetTaxReport\x12\x1b.ntx.v1.GetTaxReportRequest\x1a\x1c.ntx.v1.GetTaxReportResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
 
 var (
 	file_ntx_v1_portfolio_proto_rawDescOnce sync.Once
@@ -1170,55 +9334,323 @@ func file_ntx_v1_portfolio_proto_rawDescGZIP() []byte {
 	return file_ntx_v1_portfolio_proto_rawDescData
 }
 
-var file_ntx_v1_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ntx_v1_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_ntx_v1_portfolio_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_ntx_v1_portfolio_proto_msgTypes = make([]protoimpl.MessageInfo, 132)
 var file_ntx_v1_portfolio_proto_goTypes = []any{
-	(TransactionType)(0),                // 0: ntx.v1.TransactionType
-	(*Portfolio)(nil),                   // 1: ntx.v1.Portfolio
-	(*ListPortfoliosRequest)(nil),       // 2: ntx.v1.ListPortfoliosRequest
-	(*ListPortfoliosResponse)(nil),      // 3: ntx.v1.ListPortfoliosResponse
-	(*CreatePortfolioRequest)(nil),      // 4: ntx.v1.CreatePortfolioRequest
-	(*CreatePortfolioResponse)(nil),     // 5: ntx.v1.CreatePortfolioResponse
-	(*Transaction)(nil),                 // 6: ntx.v1.Transaction
-	(*AddTransactionRequest)(nil),       // 7: ntx.v1.AddTransactionRequest
-	(*AddTransactionResponse)(nil),      // 8: ntx.v1.AddTransactionResponse
-	(*ListTransactionsRequest)(nil),     // 9: ntx.v1.ListTransactionsRequest
-	(*ListTransactionsResponse)(nil),    // 10: ntx.v1.ListTransactionsResponse
-	(*DeleteTransactionRequest)(nil),    // 11: ntx.v1.DeleteTransactionRequest
-	(*DeleteTransactionResponse)(nil),   // 12: ntx.v1.DeleteTransactionResponse
-	(*Holding)(nil),                     // 13: ntx.v1.Holding
-	(*PortfolioSummary)(nil),            // 14: ntx.v1.PortfolioSummary
-	(*HealthTip)(nil),                   // 15: ntx.v1.HealthTip
-	(*GetPortfolioSummaryRequest)(nil),  // 16: ntx.v1.GetPortfolioSummaryRequest
-	(*GetPortfolioSummaryResponse)(nil), // 17: ntx.v1.GetPortfolioSummaryResponse
+	(TransactionType)(0),                   // 0: ntx.v1.TransactionType
+	(HoldingSortField)(0),                  // 1: ntx.v1.HoldingSortField
+	(ConflictStrategy)(0),                  // 2: ntx.v1.ConflictStrategy
+	(HistoryInterval)(0),                   // 3: ntx.v1.HistoryInterval
+	(CostSource)(0),                        // 4: ntx.v1.CostSource
+	(TimelineEventKind)(0),                 // 5: ntx.v1.TimelineEventKind
+	(*Portfolio)(nil),                      // 6: ntx.v1.Portfolio
+	(*ListPortfoliosRequest)(nil),          // 7: ntx.v1.ListPortfoliosRequest
+	(*ListPortfoliosResponse)(nil),         // 8: ntx.v1.ListPortfoliosResponse
+	(*CreatePortfolioRequest)(nil),         // 9: ntx.v1.CreatePortfolioRequest
+	(*CreatePortfolioResponse)(nil),        // 10: ntx.v1.CreatePortfolioResponse
+	(*DeletePortfolioRequest)(nil),         // 11: ntx.v1.DeletePortfolioRequest
+	(*DeletePortfolioResponse)(nil),        // 12: ntx.v1.DeletePortfolioResponse
+	(*Transaction)(nil),                    // 13: ntx.v1.Transaction
+	(*AddTransactionRequest)(nil),          // 14: ntx.v1.AddTransactionRequest
+	(*AddTransactionResponse)(nil),         // 15: ntx.v1.AddTransactionResponse
+	(*ListTransactionsRequest)(nil),        // 16: ntx.v1.ListTransactionsRequest
+	(*ListTransactionsResponse)(nil),       // 17: ntx.v1.ListTransactionsResponse
+	(*DeleteTransactionRequest)(nil),       // 18: ntx.v1.DeleteTransactionRequest
+	(*DeleteTransactionResponse)(nil),      // 19: ntx.v1.DeleteTransactionResponse
+	(*Holding)(nil),                        // 20: ntx.v1.Holding
+	(*PortfolioSummary)(nil),               // 21: ntx.v1.PortfolioSummary
+	(*HealthTip)(nil),                      // 22: ntx.v1.HealthTip
+	(*GetPortfolioSummaryRequest)(nil),     // 23: ntx.v1.GetPortfolioSummaryRequest
+	(*GetPortfolioSummaryResponse)(nil),    // 24: ntx.v1.GetPortfolioSummaryResponse
+	(*ListHoldingsRequest)(nil),            // 25: ntx.v1.ListHoldingsRequest
+	(*ListHoldingsResponse)(nil),           // 26: ntx.v1.ListHoldingsResponse
+	(*Lot)(nil),                            // 27: ntx.v1.Lot
+	(*ListLotsRequest)(nil),                // 28: ntx.v1.ListLotsRequest
+	(*ListLotsResponse)(nil),               // 29: ntx.v1.ListLotsResponse
+	(*ImportConflict)(nil),                 // 30: ntx.v1.ImportConflict
+	(*ImportTransactionsRequest)(nil),      // 31: ntx.v1.ImportTransactionsRequest
+	(*ImportTransactionsResponse)(nil),     // 32: ntx.v1.ImportTransactionsResponse
+	(*ExportTransactionsRequest)(nil),      // 33: ntx.v1.ExportTransactionsRequest
+	(*ExportTransactionsResponse)(nil),     // 34: ntx.v1.ExportTransactionsResponse
+	(*PortfolioHistoryPoint)(nil),          // 35: ntx.v1.PortfolioHistoryPoint
+	(*GetPortfolioHistoryRequest)(nil),     // 36: ntx.v1.GetPortfolioHistoryRequest
+	(*GetPortfolioHistoryResponse)(nil),    // 37: ntx.v1.GetPortfolioHistoryResponse
+	(*PortfolioBreakdown)(nil),             // 38: ntx.v1.PortfolioBreakdown
+	(*TaxSummary)(nil),                     // 39: ntx.v1.TaxSummary
+	(*ConsolidatedSummary)(nil),            // 40: ntx.v1.ConsolidatedSummary
+	(*GetConsolidatedSummaryRequest)(nil),  // 41: ntx.v1.GetConsolidatedSummaryRequest
+	(*GetConsolidatedSummaryResponse)(nil), // 42: ntx.v1.GetConsolidatedSummaryResponse
+	(*HoldingAttribution)(nil),             // 43: ntx.v1.HoldingAttribution
+	(*GetAttributionRequest)(nil),          // 44: ntx.v1.GetAttributionRequest
+	(*GetAttributionResponse)(nil),         // 45: ntx.v1.GetAttributionResponse
+	(*ProjectPortfolioRequest)(nil),        // 46: ntx.v1.ProjectPortfolioRequest
+	(*ProjectionBand)(nil),                 // 47: ntx.v1.ProjectionBand
+	(*ProjectPortfolioResponse)(nil),       // 48: ntx.v1.ProjectPortfolioResponse
+	(*SectorShock)(nil),                    // 49: ntx.v1.SectorShock
+	(*RunScenarioRequest)(nil),             // 50: ntx.v1.RunScenarioRequest
+	(*ScenarioHolding)(nil),                // 51: ntx.v1.ScenarioHolding
+	(*RunScenarioResponse)(nil),            // 52: ntx.v1.RunScenarioResponse
+	(*CalculatePositionSizeRequest)(nil),   // 53: ntx.v1.CalculatePositionSizeRequest
+	(*CalculatePositionSizeResponse)(nil),  // 54: ntx.v1.CalculatePositionSizeResponse
+	(*Tag)(nil),                            // 55: ntx.v1.Tag
+	(*CreateTagRequest)(nil),               // 56: ntx.v1.CreateTagRequest
+	(*CreateTagResponse)(nil),              // 57: ntx.v1.CreateTagResponse
+	(*ListTagsRequest)(nil),                // 58: ntx.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),               // 59: ntx.v1.ListTagsResponse
+	(*RenameTagRequest)(nil),               // 60: ntx.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),              // 61: ntx.v1.RenameTagResponse
+	(*DeleteTagRequest)(nil),               // 62: ntx.v1.DeleteTagRequest
+	(*DeleteTagResponse)(nil),              // 63: ntx.v1.DeleteTagResponse
+	(*SetTransactionTagsRequest)(nil),      // 64: ntx.v1.SetTransactionTagsRequest
+	(*SetTransactionTagsResponse)(nil),     // 65: ntx.v1.SetTransactionTagsResponse
+	(*TagPerformance)(nil),                 // 66: ntx.v1.TagPerformance
+	(*GetTagPerformanceRequest)(nil),       // 67: ntx.v1.GetTagPerformanceRequest
+	(*GetTagPerformanceResponse)(nil),      // 68: ntx.v1.GetTagPerformanceResponse
+	(*BrokerAccount)(nil),                  // 69: ntx.v1.BrokerAccount
+	(*CreateBrokerAccountRequest)(nil),     // 70: ntx.v1.CreateBrokerAccountRequest
+	(*CreateBrokerAccountResponse)(nil),    // 71: ntx.v1.CreateBrokerAccountResponse
+	(*ListBrokerAccountsRequest)(nil),      // 72: ntx.v1.ListBrokerAccountsRequest
+	(*ListBrokerAccountsResponse)(nil),     // 73: ntx.v1.ListBrokerAccountsResponse
+	(*DeleteBrokerAccountRequest)(nil),     // 74: ntx.v1.DeleteBrokerAccountRequest
+	(*DeleteBrokerAccountResponse)(nil),    // 75: ntx.v1.DeleteBrokerAccountResponse
+	(*SetTransactionBrokerRequest)(nil),    // 76: ntx.v1.SetTransactionBrokerRequest
+	(*SetTransactionBrokerResponse)(nil),   // 77: ntx.v1.SetTransactionBrokerResponse
+	(*BrokerCommission)(nil),               // 78: ntx.v1.BrokerCommission
+	(*GetBrokerCommissionsRequest)(nil),    // 79: ntx.v1.GetBrokerCommissionsRequest
+	(*GetBrokerCommissionsResponse)(nil),   // 80: ntx.v1.GetBrokerCommissionsResponse
+	(*Profile)(nil),                        // 81: ntx.v1.Profile
+	(*CreateProfileRequest)(nil),           // 82: ntx.v1.CreateProfileRequest
+	(*CreateProfileResponse)(nil),          // 83: ntx.v1.CreateProfileResponse
+	(*ListProfilesRequest)(nil),            // 84: ntx.v1.ListProfilesRequest
+	(*ListProfilesResponse)(nil),           // 85: ntx.v1.ListProfilesResponse
+	(*DeleteProfileRequest)(nil),           // 86: ntx.v1.DeleteProfileRequest
+	(*DeleteProfileResponse)(nil),          // 87: ntx.v1.DeleteProfileResponse
+	(*SetPortfolioProfileRequest)(nil),     // 88: ntx.v1.SetPortfolioProfileRequest
+	(*SetPortfolioProfileResponse)(nil),    // 89: ntx.v1.SetPortfolioProfileResponse
+	(*CostEntry)(nil),                      // 90: ntx.v1.CostEntry
+	(*SetHoldingCostRequest)(nil),          // 91: ntx.v1.SetHoldingCostRequest
+	(*SetHoldingCostResponse)(nil),         // 92: ntx.v1.SetHoldingCostResponse
+	(*ClearHoldingCostRequest)(nil),        // 93: ntx.v1.ClearHoldingCostRequest
+	(*ClearHoldingCostResponse)(nil),       // 94: ntx.v1.ClearHoldingCostResponse
+	(*CostReconciliation)(nil),             // 95: ntx.v1.CostReconciliation
+	(*GetCostReconciliationRequest)(nil),   // 96: ntx.v1.GetCostReconciliationRequest
+	(*GetCostReconciliationResponse)(nil),  // 97: ntx.v1.GetCostReconciliationResponse
+	(*ExportCostBasisRequest)(nil),         // 98: ntx.v1.ExportCostBasisRequest
+	(*ExportCostBasisResponse)(nil),        // 99: ntx.v1.ExportCostBasisResponse
+	(*BonusExpectation)(nil),               // 100: ntx.v1.BonusExpectation
+	(*GetBonusExpectationsRequest)(nil),    // 101: ntx.v1.GetBonusExpectationsRequest
+	(*GetBonusExpectationsResponse)(nil),   // 102: ntx.v1.GetBonusExpectationsResponse
+	(*IncomeHolding)(nil),                  // 103: ntx.v1.IncomeHolding
+	(*GetIncomeSummaryRequest)(nil),        // 104: ntx.v1.GetIncomeSummaryRequest
+	(*GetIncomeSummaryResponse)(nil),       // 105: ntx.v1.GetIncomeSummaryResponse
+	(*BondTerms)(nil),                      // 106: ntx.v1.BondTerms
+	(*SetBondTermsRequest)(nil),            // 107: ntx.v1.SetBondTermsRequest
+	(*SetBondTermsResponse)(nil),           // 108: ntx.v1.SetBondTermsResponse
+	(*ClearBondTermsRequest)(nil),          // 109: ntx.v1.ClearBondTermsRequest
+	(*ClearBondTermsResponse)(nil),         // 110: ntx.v1.ClearBondTermsResponse
+	(*BondSchedule)(nil),                   // 111: ntx.v1.BondSchedule
+	(*GetBondScheduleRequest)(nil),         // 112: ntx.v1.GetBondScheduleRequest
+	(*GetBondScheduleResponse)(nil),        // 113: ntx.v1.GetBondScheduleResponse
+	(*HoldingGroup)(nil),                   // 114: ntx.v1.HoldingGroup
+	(*CreateHoldingGroupRequest)(nil),      // 115: ntx.v1.CreateHoldingGroupRequest
+	(*CreateHoldingGroupResponse)(nil),     // 116: ntx.v1.CreateHoldingGroupResponse
+	(*ListHoldingGroupsRequest)(nil),       // 117: ntx.v1.ListHoldingGroupsRequest
+	(*ListHoldingGroupsResponse)(nil),      // 118: ntx.v1.ListHoldingGroupsResponse
+	(*UpdateHoldingGroupRequest)(nil),      // 119: ntx.v1.UpdateHoldingGroupRequest
+	(*UpdateHoldingGroupResponse)(nil),     // 120: ntx.v1.UpdateHoldingGroupResponse
+	(*DeleteHoldingGroupRequest)(nil),      // 121: ntx.v1.DeleteHoldingGroupRequest
+	(*DeleteHoldingGroupResponse)(nil),     // 122: ntx.v1.DeleteHoldingGroupResponse
+	(*GroupAllocation)(nil),                // 123: ntx.v1.GroupAllocation
+	(*GroupHoldingsRequest)(nil),           // 124: ntx.v1.GroupHoldingsRequest
+	(*GroupHoldingsResponse)(nil),          // 125: ntx.v1.GroupHoldingsResponse
+	(*TimelineEvent)(nil),                  // 126: ntx.v1.TimelineEvent
+	(*GetTimelineRequest)(nil),             // 127: ntx.v1.GetTimelineRequest
+	(*GetTimelineResponse)(nil),            // 128: ntx.v1.GetTimelineResponse
+	(*FiscalYearSummary)(nil),              // 129: ntx.v1.FiscalYearSummary
+	(*GetYearComparisonRequest)(nil),       // 130: ntx.v1.GetYearComparisonRequest
+	(*GetYearComparisonResponse)(nil),      // 131: ntx.v1.GetYearComparisonResponse
+	(*MonthlyGrowth)(nil),                  // 132: ntx.v1.MonthlyGrowth
+	(*GetGrowthBreakdownRequest)(nil),      // 133: ntx.v1.GetGrowthBreakdownRequest
+	(*GetGrowthBreakdownResponse)(nil),     // 134: ntx.v1.GetGrowthBreakdownResponse
+	(*RealizedGain)(nil),                   // 135: ntx.v1.RealizedGain
+	(*GetTaxReportRequest)(nil),            // 136: ntx.v1.GetTaxReportRequest
+	(*GetTaxReportResponse)(nil),           // 137: ntx.v1.GetTaxReportResponse
+	(InstrumentType)(0),                    // 138: ntx.v1.InstrumentType
+	(ListingStatus)(0),                     // 139: ntx.v1.ListingStatus
 }
 var file_ntx_v1_portfolio_proto_depIdxs = []int32{
-	1,  // 0: ntx.v1.ListPortfoliosResponse.portfolios:type_name -> ntx.v1.Portfolio
-	1,  // 1: ntx.v1.CreatePortfolioResponse.portfolio:type_name -> ntx.v1.Portfolio
-	0,  // 2: ntx.v1.Transaction.transaction_type:type_name -> ntx.v1.TransactionType
-	0,  // 3: ntx.v1.AddTransactionRequest.transaction_type:type_name -> ntx.v1.TransactionType
-	6,  // 4: ntx.v1.AddTransactionResponse.transaction:type_name -> ntx.v1.Transaction
-	6,  // 5: ntx.v1.ListTransactionsResponse.transactions:type_name -> ntx.v1.Transaction
-	13, // 6: ntx.v1.PortfolioSummary.holdings:type_name -> ntx.v1.Holding
-	15, // 7: ntx.v1.PortfolioSummary.health_tips:type_name -> ntx.v1.HealthTip
-	14, // 8: ntx.v1.GetPortfolioSummaryResponse.summary:type_name -> ntx.v1.PortfolioSummary
-	2,  // 9: ntx.v1.PortfolioService.ListPortfolios:input_type -> ntx.v1.ListPortfoliosRequest
-	4,  // 10: ntx.v1.PortfolioService.CreatePortfolio:input_type -> ntx.v1.CreatePortfolioRequest
-	7,  // 11: ntx.v1.PortfolioService.AddTransaction:input_type -> ntx.v1.AddTransactionRequest
-	9,  // 12: ntx.v1.PortfolioService.ListTransactions:input_type -> ntx.v1.ListTransactionsRequest
-	11, // 13: ntx.v1.PortfolioService.DeleteTransaction:input_type -> ntx.v1.DeleteTransactionRequest
-	16, // 14: ntx.v1.PortfolioService.GetPortfolioSummary:input_type -> ntx.v1.GetPortfolioSummaryRequest
-	3,  // 15: ntx.v1.PortfolioService.ListPortfolios:output_type -> ntx.v1.ListPortfoliosResponse
-	5,  // 16: ntx.v1.PortfolioService.CreatePortfolio:output_type -> ntx.v1.CreatePortfolioResponse
-	8,  // 17: ntx.v1.PortfolioService.AddTransaction:output_type -> ntx.v1.AddTransactionResponse
-	10, // 18: ntx.v1.PortfolioService.ListTransactions:output_type -> ntx.v1.ListTransactionsResponse
-	12, // 19: ntx.v1.PortfolioService.DeleteTransaction:output_type -> ntx.v1.DeleteTransactionResponse
-	17, // 20: ntx.v1.PortfolioService.GetPortfolioSummary:output_type -> ntx.v1.GetPortfolioSummaryResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	6,   // 0: ntx.v1.ListPortfoliosResponse.portfolios:type_name -> ntx.v1.Portfolio
+	6,   // 1: ntx.v1.CreatePortfolioResponse.portfolio:type_name -> ntx.v1.Portfolio
+	0,   // 2: ntx.v1.Transaction.transaction_type:type_name -> ntx.v1.TransactionType
+	55,  // 3: ntx.v1.Transaction.tags:type_name -> ntx.v1.Tag
+	0,   // 4: ntx.v1.AddTransactionRequest.transaction_type:type_name -> ntx.v1.TransactionType
+	13,  // 5: ntx.v1.AddTransactionResponse.transaction:type_name -> ntx.v1.Transaction
+	13,  // 6: ntx.v1.ListTransactionsResponse.transactions:type_name -> ntx.v1.Transaction
+	4,   // 7: ntx.v1.Holding.cost_source:type_name -> ntx.v1.CostSource
+	138, // 8: ntx.v1.Holding.instrument_type:type_name -> ntx.v1.InstrumentType
+	139, // 9: ntx.v1.Holding.listing_status:type_name -> ntx.v1.ListingStatus
+	20,  // 10: ntx.v1.PortfolioSummary.holdings:type_name -> ntx.v1.Holding
+	22,  // 11: ntx.v1.PortfolioSummary.health_tips:type_name -> ntx.v1.HealthTip
+	21,  // 12: ntx.v1.GetPortfolioSummaryResponse.summary:type_name -> ntx.v1.PortfolioSummary
+	1,   // 13: ntx.v1.ListHoldingsRequest.sort_by:type_name -> ntx.v1.HoldingSortField
+	20,  // 14: ntx.v1.ListHoldingsResponse.holdings:type_name -> ntx.v1.Holding
+	27,  // 15: ntx.v1.ListLotsResponse.lots:type_name -> ntx.v1.Lot
+	13,  // 16: ntx.v1.ImportConflict.existing:type_name -> ntx.v1.Transaction
+	13,  // 17: ntx.v1.ImportConflict.imported:type_name -> ntx.v1.Transaction
+	2,   // 18: ntx.v1.ImportConflict.resolution:type_name -> ntx.v1.ConflictStrategy
+	2,   // 19: ntx.v1.ImportTransactionsRequest.conflict_strategy:type_name -> ntx.v1.ConflictStrategy
+	30,  // 20: ntx.v1.ImportTransactionsResponse.conflicts:type_name -> ntx.v1.ImportConflict
+	3,   // 21: ntx.v1.GetPortfolioHistoryRequest.interval:type_name -> ntx.v1.HistoryInterval
+	35,  // 22: ntx.v1.GetPortfolioHistoryResponse.points:type_name -> ntx.v1.PortfolioHistoryPoint
+	38,  // 23: ntx.v1.ConsolidatedSummary.portfolios:type_name -> ntx.v1.PortfolioBreakdown
+	20,  // 24: ntx.v1.ConsolidatedSummary.holdings:type_name -> ntx.v1.Holding
+	39,  // 25: ntx.v1.ConsolidatedSummary.tax:type_name -> ntx.v1.TaxSummary
+	40,  // 26: ntx.v1.GetConsolidatedSummaryResponse.summary:type_name -> ntx.v1.ConsolidatedSummary
+	43,  // 27: ntx.v1.GetAttributionResponse.holdings:type_name -> ntx.v1.HoldingAttribution
+	47,  // 28: ntx.v1.ProjectPortfolioResponse.bands:type_name -> ntx.v1.ProjectionBand
+	49,  // 29: ntx.v1.RunScenarioRequest.sector_shocks:type_name -> ntx.v1.SectorShock
+	51,  // 30: ntx.v1.RunScenarioResponse.holdings:type_name -> ntx.v1.ScenarioHolding
+	14,  // 31: ntx.v1.CalculatePositionSizeResponse.draft:type_name -> ntx.v1.AddTransactionRequest
+	55,  // 32: ntx.v1.CreateTagResponse.tag:type_name -> ntx.v1.Tag
+	55,  // 33: ntx.v1.ListTagsResponse.tags:type_name -> ntx.v1.Tag
+	55,  // 34: ntx.v1.RenameTagResponse.tag:type_name -> ntx.v1.Tag
+	55,  // 35: ntx.v1.SetTransactionTagsResponse.tags:type_name -> ntx.v1.Tag
+	55,  // 36: ntx.v1.TagPerformance.tag:type_name -> ntx.v1.Tag
+	66,  // 37: ntx.v1.GetTagPerformanceResponse.tags:type_name -> ntx.v1.TagPerformance
+	69,  // 38: ntx.v1.CreateBrokerAccountResponse.account:type_name -> ntx.v1.BrokerAccount
+	69,  // 39: ntx.v1.ListBrokerAccountsResponse.accounts:type_name -> ntx.v1.BrokerAccount
+	69,  // 40: ntx.v1.BrokerCommission.account:type_name -> ntx.v1.BrokerAccount
+	78,  // 41: ntx.v1.GetBrokerCommissionsResponse.brokers:type_name -> ntx.v1.BrokerCommission
+	81,  // 42: ntx.v1.CreateProfileResponse.profile:type_name -> ntx.v1.Profile
+	81,  // 43: ntx.v1.ListProfilesResponse.profiles:type_name -> ntx.v1.Profile
+	4,   // 44: ntx.v1.CostEntry.source:type_name -> ntx.v1.CostSource
+	4,   // 45: ntx.v1.SetHoldingCostRequest.source:type_name -> ntx.v1.CostSource
+	90,  // 46: ntx.v1.SetHoldingCostResponse.entry:type_name -> ntx.v1.CostEntry
+	4,   // 47: ntx.v1.ClearHoldingCostRequest.source:type_name -> ntx.v1.CostSource
+	4,   // 48: ntx.v1.CostReconciliation.effective_source:type_name -> ntx.v1.CostSource
+	90,  // 49: ntx.v1.CostReconciliation.entries:type_name -> ntx.v1.CostEntry
+	95,  // 50: ntx.v1.GetCostReconciliationResponse.holdings:type_name -> ntx.v1.CostReconciliation
+	100, // 51: ntx.v1.GetBonusExpectationsResponse.expectations:type_name -> ntx.v1.BonusExpectation
+	103, // 52: ntx.v1.GetIncomeSummaryResponse.holdings:type_name -> ntx.v1.IncomeHolding
+	106, // 53: ntx.v1.SetBondTermsResponse.terms:type_name -> ntx.v1.BondTerms
+	106, // 54: ntx.v1.BondSchedule.terms:type_name -> ntx.v1.BondTerms
+	111, // 55: ntx.v1.GetBondScheduleResponse.bonds:type_name -> ntx.v1.BondSchedule
+	114, // 56: ntx.v1.CreateHoldingGroupResponse.group:type_name -> ntx.v1.HoldingGroup
+	114, // 57: ntx.v1.ListHoldingGroupsResponse.groups:type_name -> ntx.v1.HoldingGroup
+	114, // 58: ntx.v1.UpdateHoldingGroupResponse.group:type_name -> ntx.v1.HoldingGroup
+	123, // 59: ntx.v1.GroupHoldingsResponse.groups:type_name -> ntx.v1.GroupAllocation
+	5,   // 60: ntx.v1.TimelineEvent.kind:type_name -> ntx.v1.TimelineEventKind
+	126, // 61: ntx.v1.GetTimelineResponse.events:type_name -> ntx.v1.TimelineEvent
+	129, // 62: ntx.v1.GetYearComparisonResponse.years:type_name -> ntx.v1.FiscalYearSummary
+	132, // 63: ntx.v1.GetGrowthBreakdownResponse.months:type_name -> ntx.v1.MonthlyGrowth
+	135, // 64: ntx.v1.GetTaxReportResponse.gains:type_name -> ntx.v1.RealizedGain
+	39,  // 65: ntx.v1.GetTaxReportResponse.years:type_name -> ntx.v1.TaxSummary
+	39,  // 66: ntx.v1.GetTaxReportResponse.total:type_name -> ntx.v1.TaxSummary
+	7,   // 67: ntx.v1.PortfolioService.ListPortfolios:input_type -> ntx.v1.ListPortfoliosRequest
+	9,   // 68: ntx.v1.PortfolioService.CreatePortfolio:input_type -> ntx.v1.CreatePortfolioRequest
+	11,  // 69: ntx.v1.PortfolioService.DeletePortfolio:input_type -> ntx.v1.DeletePortfolioRequest
+	14,  // 70: ntx.v1.PortfolioService.AddTransaction:input_type -> ntx.v1.AddTransactionRequest
+	16,  // 71: ntx.v1.PortfolioService.ListTransactions:input_type -> ntx.v1.ListTransactionsRequest
+	18,  // 72: ntx.v1.PortfolioService.DeleteTransaction:input_type -> ntx.v1.DeleteTransactionRequest
+	23,  // 73: ntx.v1.PortfolioService.GetPortfolioSummary:input_type -> ntx.v1.GetPortfolioSummaryRequest
+	25,  // 74: ntx.v1.PortfolioService.ListHoldings:input_type -> ntx.v1.ListHoldingsRequest
+	36,  // 75: ntx.v1.PortfolioService.GetPortfolioHistory:input_type -> ntx.v1.GetPortfolioHistoryRequest
+	41,  // 76: ntx.v1.PortfolioService.GetConsolidatedSummary:input_type -> ntx.v1.GetConsolidatedSummaryRequest
+	28,  // 77: ntx.v1.PortfolioService.ListLots:input_type -> ntx.v1.ListLotsRequest
+	31,  // 78: ntx.v1.PortfolioService.ImportTransactions:input_type -> ntx.v1.ImportTransactionsRequest
+	33,  // 79: ntx.v1.PortfolioService.ExportTransactions:input_type -> ntx.v1.ExportTransactionsRequest
+	44,  // 80: ntx.v1.PortfolioService.GetAttribution:input_type -> ntx.v1.GetAttributionRequest
+	46,  // 81: ntx.v1.PortfolioService.ProjectPortfolio:input_type -> ntx.v1.ProjectPortfolioRequest
+	50,  // 82: ntx.v1.PortfolioService.RunScenario:input_type -> ntx.v1.RunScenarioRequest
+	53,  // 83: ntx.v1.PortfolioService.CalculatePositionSize:input_type -> ntx.v1.CalculatePositionSizeRequest
+	56,  // 84: ntx.v1.PortfolioService.CreateTag:input_type -> ntx.v1.CreateTagRequest
+	58,  // 85: ntx.v1.PortfolioService.ListTags:input_type -> ntx.v1.ListTagsRequest
+	60,  // 86: ntx.v1.PortfolioService.RenameTag:input_type -> ntx.v1.RenameTagRequest
+	62,  // 87: ntx.v1.PortfolioService.DeleteTag:input_type -> ntx.v1.DeleteTagRequest
+	64,  // 88: ntx.v1.PortfolioService.SetTransactionTags:input_type -> ntx.v1.SetTransactionTagsRequest
+	67,  // 89: ntx.v1.PortfolioService.GetTagPerformance:input_type -> ntx.v1.GetTagPerformanceRequest
+	70,  // 90: ntx.v1.PortfolioService.CreateBrokerAccount:input_type -> ntx.v1.CreateBrokerAccountRequest
+	72,  // 91: ntx.v1.PortfolioService.ListBrokerAccounts:input_type -> ntx.v1.ListBrokerAccountsRequest
+	74,  // 92: ntx.v1.PortfolioService.DeleteBrokerAccount:input_type -> ntx.v1.DeleteBrokerAccountRequest
+	76,  // 93: ntx.v1.PortfolioService.SetTransactionBroker:input_type -> ntx.v1.SetTransactionBrokerRequest
+	79,  // 94: ntx.v1.PortfolioService.GetBrokerCommissions:input_type -> ntx.v1.GetBrokerCommissionsRequest
+	82,  // 95: ntx.v1.PortfolioService.CreateProfile:input_type -> ntx.v1.CreateProfileRequest
+	84,  // 96: ntx.v1.PortfolioService.ListProfiles:input_type -> ntx.v1.ListProfilesRequest
+	86,  // 97: ntx.v1.PortfolioService.DeleteProfile:input_type -> ntx.v1.DeleteProfileRequest
+	88,  // 98: ntx.v1.PortfolioService.SetPortfolioProfile:input_type -> ntx.v1.SetPortfolioProfileRequest
+	91,  // 99: ntx.v1.PortfolioService.SetHoldingCost:input_type -> ntx.v1.SetHoldingCostRequest
+	93,  // 100: ntx.v1.PortfolioService.ClearHoldingCost:input_type -> ntx.v1.ClearHoldingCostRequest
+	96,  // 101: ntx.v1.PortfolioService.GetCostReconciliation:input_type -> ntx.v1.GetCostReconciliationRequest
+	98,  // 102: ntx.v1.PortfolioService.ExportCostBasis:input_type -> ntx.v1.ExportCostBasisRequest
+	101, // 103: ntx.v1.PortfolioService.GetBonusExpectations:input_type -> ntx.v1.GetBonusExpectationsRequest
+	104, // 104: ntx.v1.PortfolioService.GetIncomeSummary:input_type -> ntx.v1.GetIncomeSummaryRequest
+	107, // 105: ntx.v1.PortfolioService.SetBondTerms:input_type -> ntx.v1.SetBondTermsRequest
+	109, // 106: ntx.v1.PortfolioService.ClearBondTerms:input_type -> ntx.v1.ClearBondTermsRequest
+	112, // 107: ntx.v1.PortfolioService.GetBondSchedule:input_type -> ntx.v1.GetBondScheduleRequest
+	115, // 108: ntx.v1.PortfolioService.CreateHoldingGroup:input_type -> ntx.v1.CreateHoldingGroupRequest
+	117, // 109: ntx.v1.PortfolioService.ListHoldingGroups:input_type -> ntx.v1.ListHoldingGroupsRequest
+	119, // 110: ntx.v1.PortfolioService.UpdateHoldingGroup:input_type -> ntx.v1.UpdateHoldingGroupRequest
+	121, // 111: ntx.v1.PortfolioService.DeleteHoldingGroup:input_type -> ntx.v1.DeleteHoldingGroupRequest
+	124, // 112: ntx.v1.PortfolioService.GroupHoldings:input_type -> ntx.v1.GroupHoldingsRequest
+	127, // 113: ntx.v1.PortfolioService.GetTimeline:input_type -> ntx.v1.GetTimelineRequest
+	130, // 114: ntx.v1.PortfolioService.GetYearComparison:input_type -> ntx.v1.GetYearComparisonRequest
+	133, // 115: ntx.v1.PortfolioService.GetGrowthBreakdown:input_type -> ntx.v1.GetGrowthBreakdownRequest
+	136, // 116: ntx.v1.PortfolioService.GetTaxReport:input_type -> ntx.v1.GetTaxReportRequest
+	8,   // 117: ntx.v1.PortfolioService.ListPortfolios:output_type -> ntx.v1.ListPortfoliosResponse
+	10,  // 118: ntx.v1.PortfolioService.CreatePortfolio:output_type -> ntx.v1.CreatePortfolioResponse
+	12,  // 119: ntx.v1.PortfolioService.DeletePortfolio:output_type -> ntx.v1.DeletePortfolioResponse
+	15,  // 120: ntx.v1.PortfolioService.AddTransaction:output_type -> ntx.v1.AddTransactionResponse
+	17,  // 121: ntx.v1.PortfolioService.ListTransactions:output_type -> ntx.v1.ListTransactionsResponse
+	19,  // 122: ntx.v1.PortfolioService.DeleteTransaction:output_type -> ntx.v1.DeleteTransactionResponse
+	24,  // 123: ntx.v1.PortfolioService.GetPortfolioSummary:output_type -> ntx.v1.GetPortfolioSummaryResponse
+	26,  // 124: ntx.v1.PortfolioService.ListHoldings:output_type -> ntx.v1.ListHoldingsResponse
+	37,  // 125: ntx.v1.PortfolioService.GetPortfolioHistory:output_type -> ntx.v1.GetPortfolioHistoryResponse
+	42,  // 126: ntx.v1.PortfolioService.GetConsolidatedSummary:output_type -> ntx.v1.GetConsolidatedSummaryResponse
+	29,  // 127: ntx.v1.PortfolioService.ListLots:output_type -> ntx.v1.ListLotsResponse
+	32,  // 128: ntx.v1.PortfolioService.ImportTransactions:output_type -> ntx.v1.ImportTransactionsResponse
+	34,  // 129: ntx.v1.PortfolioService.ExportTransactions:output_type -> ntx.v1.ExportTransactionsResponse
+	45,  // 130: ntx.v1.PortfolioService.GetAttribution:output_type -> ntx.v1.GetAttributionResponse
+	48,  // 131: ntx.v1.PortfolioService.ProjectPortfolio:output_type -> ntx.v1.ProjectPortfolioResponse
+	52,  // 132: ntx.v1.PortfolioService.RunScenario:output_type -> ntx.v1.RunScenarioResponse
+	54,  // 133: ntx.v1.PortfolioService.CalculatePositionSize:output_type -> ntx.v1.CalculatePositionSizeResponse
+	57,  // 134: ntx.v1.PortfolioService.CreateTag:output_type -> ntx.v1.CreateTagResponse
+	59,  // 135: ntx.v1.PortfolioService.ListTags:output_type -> ntx.v1.ListTagsResponse
+	61,  // 136: ntx.v1.PortfolioService.RenameTag:output_type -> ntx.v1.RenameTagResponse
+	63,  // 137: ntx.v1.PortfolioService.DeleteTag:output_type -> ntx.v1.DeleteTagResponse
+	65,  // 138: ntx.v1.PortfolioService.SetTransactionTags:output_type -> ntx.v1.SetTransactionTagsResponse
+	68,  // 139: ntx.v1.PortfolioService.GetTagPerformance:output_type -> ntx.v1.GetTagPerformanceResponse
+	71,  // 140: ntx.v1.PortfolioService.CreateBrokerAccount:output_type -> ntx.v1.CreateBrokerAccountResponse
+	73,  // 141: ntx.v1.PortfolioService.ListBrokerAccounts:output_type -> ntx.v1.ListBrokerAccountsResponse
+	75,  // 142: ntx.v1.PortfolioService.DeleteBrokerAccount:output_type -> ntx.v1.DeleteBrokerAccountResponse
+	77,  // 143: ntx.v1.PortfolioService.SetTransactionBroker:output_type -> ntx.v1.SetTransactionBrokerResponse
+	80,  // 144: ntx.v1.PortfolioService.GetBrokerCommissions:output_type -> ntx.v1.GetBrokerCommissionsResponse
+	83,  // 145: ntx.v1.PortfolioService.CreateProfile:output_type -> ntx.v1.CreateProfileResponse
+	85,  // 146: ntx.v1.PortfolioService.ListProfiles:output_type -> ntx.v1.ListProfilesResponse
+	87,  // 147: ntx.v1.PortfolioService.DeleteProfile:output_type -> ntx.v1.DeleteProfileResponse
+	89,  // 148: ntx.v1.PortfolioService.SetPortfolioProfile:output_type -> ntx.v1.SetPortfolioProfileResponse
+	92,  // 149: ntx.v1.PortfolioService.SetHoldingCost:output_type -> ntx.v1.SetHoldingCostResponse
+	94,  // 150: ntx.v1.PortfolioService.ClearHoldingCost:output_type -> ntx.v1.ClearHoldingCostResponse
+	97,  // 151: ntx.v1.PortfolioService.GetCostReconciliation:output_type -> ntx.v1.GetCostReconciliationResponse
+	99,  // 152: ntx.v1.PortfolioService.ExportCostBasis:output_type -> ntx.v1.ExportCostBasisResponse
+	102, // 153: ntx.v1.PortfolioService.GetBonusExpectations:output_type -> ntx.v1.GetBonusExpectationsResponse
+	105, // 154: ntx.v1.PortfolioService.GetIncomeSummary:output_type -> ntx.v1.GetIncomeSummaryResponse
+	108, // 155: ntx.v1.PortfolioService.SetBondTerms:output_type -> ntx.v1.SetBondTermsResponse
+	110, // 156: ntx.v1.PortfolioService.ClearBondTerms:output_type -> ntx.v1.ClearBondTermsResponse
+	113, // 157: ntx.v1.PortfolioService.GetBondSchedule:output_type -> ntx.v1.GetBondScheduleResponse
+	116, // 158: ntx.v1.PortfolioService.CreateHoldingGroup:output_type -> ntx.v1.CreateHoldingGroupResponse
+	118, // 159: ntx.v1.PortfolioService.ListHoldingGroups:output_type -> ntx.v1.ListHoldingGroupsResponse
+	120, // 160: ntx.v1.PortfolioService.UpdateHoldingGroup:output_type -> ntx.v1.UpdateHoldingGroupResponse
+	122, // 161: ntx.v1.PortfolioService.DeleteHoldingGroup:output_type -> ntx.v1.DeleteHoldingGroupResponse
+	125, // 162: ntx.v1.PortfolioService.GroupHoldings:output_type -> ntx.v1.GroupHoldingsResponse
+	128, // 163: ntx.v1.PortfolioService.GetTimeline:output_type -> ntx.v1.GetTimelineResponse
+	131, // 164: ntx.v1.PortfolioService.GetYearComparison:output_type -> ntx.v1.GetYearComparisonResponse
+	134, // 165: ntx.v1.PortfolioService.GetGrowthBreakdown:output_type -> ntx.v1.GetGrowthBreakdownResponse
+	137, // 166: ntx.v1.PortfolioService.GetTaxReport:output_type -> ntx.v1.GetTaxReportResponse
+	117, // [117:167] is the sub-list for method output_type
+	67,  // [67:117] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_ntx_v1_portfolio_proto_init() }
@@ -1226,14 +9658,35 @@ func file_ntx_v1_portfolio_proto_init() {
 	if File_ntx_v1_portfolio_proto != nil {
 		return
 	}
+	file_ntx_v1_common_proto_init()
+	file_ntx_v1_portfolio_proto_msgTypes[0].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[3].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[7].OneofWrappers = []any{}
 	file_ntx_v1_portfolio_proto_msgTypes[8].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[10].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[19].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[22].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[32].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[35].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[40].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[44].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[45].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[61].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[70].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[73].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[82].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[108].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[109].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[113].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[117].OneofWrappers = []any{}
+	file_ntx_v1_portfolio_proto_msgTypes[121].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_portfolio_proto_rawDesc), len(file_ntx_v1_portfolio_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      6,
+			NumMessages:   132,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
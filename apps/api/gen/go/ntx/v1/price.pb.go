@@ -285,6 +285,384 @@ func (x *ListLatestPricesResponse) GetPrices() []*Price {
 	return nil
 }
 
+type SyncPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbols       []string               `protobuf:"bytes,1,rep,name=symbols,proto3" json:"symbols,omitempty"` // empty syncs every listed company
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPricesRequest) Reset() {
+	*x = SyncPricesRequest{}
+	mi := &file_ntx_v1_price_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPricesRequest) ProtoMessage() {}
+
+func (x *SyncPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPricesRequest.ProtoReflect.Descriptor instead.
+func (*SyncPricesRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SyncPricesRequest) GetSymbols() []string {
+	if x != nil {
+		return x.Symbols
+	}
+	return nil
+}
+
+type SymbolSyncResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Updated       bool                   `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`          // why the symbol was not updated
+	Unchanged     bool                   `protobuf:"varint,4,opt,name=unchanged,proto3" json:"unchanged,omitempty"` // the stored price already matched, so nothing was written
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SymbolSyncResult) Reset() {
+	*x = SymbolSyncResult{}
+	mi := &file_ntx_v1_price_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SymbolSyncResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SymbolSyncResult) ProtoMessage() {}
+
+func (x *SymbolSyncResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SymbolSyncResult.ProtoReflect.Descriptor instead.
+func (*SymbolSyncResult) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SymbolSyncResult) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *SymbolSyncResult) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *SymbolSyncResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SymbolSyncResult) GetUnchanged() bool {
+	if x != nil {
+		return x.Unchanged
+	}
+	return false
+}
+
+type SyncPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	Results       []*SymbolSyncResult    `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPricesResponse) Reset() {
+	*x = SyncPricesResponse{}
+	mi := &file_ntx_v1_price_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPricesResponse) ProtoMessage() {}
+
+func (x *SyncPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPricesResponse.ProtoReflect.Descriptor instead.
+func (*SyncPricesResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SyncPricesResponse) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *SyncPricesResponse) GetResults() []*SymbolSyncResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetBackfillStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBackfillStatusRequest) Reset() {
+	*x = GetBackfillStatusRequest{}
+	mi := &file_ntx_v1_price_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBackfillStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackfillStatusRequest) ProtoMessage() {}
+
+func (x *GetBackfillStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackfillStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBackfillStatusRequest) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{9}
+}
+
+// Progress of an `ntx backfill` run. Phases run in order: companies,
+// fundamentals, prices, ownership, corporate-actions.
+type BackfillRun struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // running, succeeded or failed
+	Phase          string                 `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	Symbol         string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`                                     // last company finished
+	CompaniesDone  int64                  `protobuf:"varint,5,opt,name=companies_done,json=companiesDone,proto3" json:"companies_done,omitempty"` // within the phase
+	CompaniesTotal int64                  `protobuf:"varint,6,opt,name=companies_total,json=companiesTotal,proto3" json:"companies_total,omitempty"`
+	RowsFetched    int64                  `protobuf:"varint,7,opt,name=rows_fetched,json=rowsFetched,proto3" json:"rows_fetched,omitempty"` // across the whole run
+	StartedAt      string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     string                 `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // empty while running
+	UpdatedAt      string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EtaSeconds     int64                  `protobuf:"varint,11,opt,name=eta_seconds,json=etaSeconds,proto3" json:"eta_seconds,omitempty"` // for the current phase; 0 when unknown
+	Error          string                 `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BackfillRun) Reset() {
+	*x = BackfillRun{}
+	mi := &file_ntx_v1_price_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillRun) ProtoMessage() {}
+
+func (x *BackfillRun) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillRun.ProtoReflect.Descriptor instead.
+func (*BackfillRun) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BackfillRun) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BackfillRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetCompaniesDone() int64 {
+	if x != nil {
+		return x.CompaniesDone
+	}
+	return 0
+}
+
+func (x *BackfillRun) GetCompaniesTotal() int64 {
+	if x != nil {
+		return x.CompaniesTotal
+	}
+	return 0
+}
+
+func (x *BackfillRun) GetRowsFetched() int64 {
+	if x != nil {
+		return x.RowsFetched
+	}
+	return 0
+}
+
+func (x *BackfillRun) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *BackfillRun) GetEtaSeconds() int64 {
+	if x != nil {
+		return x.EtaSeconds
+	}
+	return 0
+}
+
+func (x *BackfillRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GetBackfillStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *BackfillRun           `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"` // unset if no backfill has run
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBackfillStatusResponse) Reset() {
+	*x = GetBackfillStatusResponse{}
+	mi := &file_ntx_v1_price_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBackfillStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBackfillStatusResponse) ProtoMessage() {}
+
+func (x *GetBackfillStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ntx_v1_price_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBackfillStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBackfillStatusResponse) Descriptor() ([]byte, []int) {
+	return file_ntx_v1_price_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBackfillStatusResponse) GetRun() *BackfillRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
 var File_ntx_v1_price_proto protoreflect.FileDescriptor
 
 const file_ntx_v1_price_proto_rawDesc = "" +
@@ -302,11 +680,45 @@ const file_ntx_v1_price_proto_rawDesc = "" +
 	"\x06prices\x18\x01 \x03(\v2\r.ntx.v1.PriceR\x06prices\"\x19\n" +
 	"\x17ListLatestPricesRequest\"A\n" +
 	"\x18ListLatestPricesResponse\x12%\n" +
-	"\x06prices\x18\x01 \x03(\v2\r.ntx.v1.PriceR\x06prices2\xf8\x01\n" +
+	"\x06prices\x18\x01 \x03(\v2\r.ntx.v1.PriceR\x06prices\"-\n" +
+	"\x11SyncPricesRequest\x12\x18\n" +
+	"\asymbols\x18\x01 \x03(\tR\asymbols\"x\n" +
+	"\x10SymbolSyncResult\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\bR\aupdated\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1c\n" +
+	"\tunchanged\x18\x04 \x01(\bR\tunchanged\"m\n" +
+	"\x12SyncPricesResponse\x12#\n" +
+	"\rbusiness_date\x18\x01 \x01(\tR\fbusinessDate\x122\n" +
+	"\aresults\x18\x02 \x03(\v2\x18.ntx.v1.SymbolSyncResultR\aresults\"\x1a\n" +
+	"\x18GetBackfillStatusRequest\"\xec\x02\n" +
+	"\vBackfillRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05phase\x18\x03 \x01(\tR\x05phase\x12\x16\n" +
+	"\x06symbol\x18\x04 \x01(\tR\x06symbol\x12%\n" +
+	"\x0ecompanies_done\x18\x05 \x01(\x03R\rcompaniesDone\x12'\n" +
+	"\x0fcompanies_total\x18\x06 \x01(\x03R\x0ecompaniesTotal\x12!\n" +
+	"\frows_fetched\x18\a \x01(\x03R\vrowsFetched\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\t \x01(\tR\n" +
+	"finishedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x1f\n" +
+	"\veta_seconds\x18\v \x01(\x03R\n" +
+	"etaSeconds\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\"B\n" +
+	"\x19GetBackfillStatusResponse\x12%\n" +
+	"\x03run\x18\x01 \x01(\v2\x13.ntx.v1.BackfillRunR\x03run2\x97\x03\n" +
 	"\fPriceService\x12=\n" +
 	"\bGetPrice\x12\x17.ntx.v1.GetPriceRequest\x1a\x18.ntx.v1.GetPriceResponse\x12R\n" +
 	"\x0fGetPriceHistory\x12\x1e.ntx.v1.GetPriceHistoryRequest\x1a\x1f.ntx.v1.GetPriceHistoryResponse\x12U\n" +
-	"\x10ListLatestPrices\x12\x1f.ntx.v1.ListLatestPricesRequest\x1a .ntx.v1.ListLatestPricesResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
+	"\x10ListLatestPrices\x12\x1f.ntx.v1.ListLatestPricesRequest\x1a .ntx.v1.ListLatestPricesResponse\x12C\n" +
+	"\n" +
+	"SyncPrices\x12\x19.ntx.v1.SyncPricesRequest\x1a\x1a.ntx.v1.SyncPricesResponse\x12X\n" +
+	"\x11GetBackfillStatus\x12 .ntx.v1.GetBackfillStatusRequest\x1a!.ntx.v1.GetBackfillStatusResponseB0Z.github.com/voidarchive/ntx/gen/go/ntx/v1;ntxv1b\x06proto3"
 
 var (
 	file_ntx_v1_price_proto_rawDescOnce sync.Once
@@ -320,31 +732,43 @@ func file_ntx_v1_price_proto_rawDescGZIP() []byte {
 	return file_ntx_v1_price_proto_rawDescData
 }
 
-var file_ntx_v1_price_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_ntx_v1_price_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_ntx_v1_price_proto_goTypes = []any{
-	(*GetPriceRequest)(nil),          // 0: ntx.v1.GetPriceRequest
-	(*GetPriceResponse)(nil),         // 1: ntx.v1.GetPriceResponse
-	(*GetPriceHistoryRequest)(nil),   // 2: ntx.v1.GetPriceHistoryRequest
-	(*GetPriceHistoryResponse)(nil),  // 3: ntx.v1.GetPriceHistoryResponse
-	(*ListLatestPricesRequest)(nil),  // 4: ntx.v1.ListLatestPricesRequest
-	(*ListLatestPricesResponse)(nil), // 5: ntx.v1.ListLatestPricesResponse
-	(*Price)(nil),                    // 6: ntx.v1.Price
+	(*GetPriceRequest)(nil),           // 0: ntx.v1.GetPriceRequest
+	(*GetPriceResponse)(nil),          // 1: ntx.v1.GetPriceResponse
+	(*GetPriceHistoryRequest)(nil),    // 2: ntx.v1.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),   // 3: ntx.v1.GetPriceHistoryResponse
+	(*ListLatestPricesRequest)(nil),   // 4: ntx.v1.ListLatestPricesRequest
+	(*ListLatestPricesResponse)(nil),  // 5: ntx.v1.ListLatestPricesResponse
+	(*SyncPricesRequest)(nil),         // 6: ntx.v1.SyncPricesRequest
+	(*SymbolSyncResult)(nil),          // 7: ntx.v1.SymbolSyncResult
+	(*SyncPricesResponse)(nil),        // 8: ntx.v1.SyncPricesResponse
+	(*GetBackfillStatusRequest)(nil),  // 9: ntx.v1.GetBackfillStatusRequest
+	(*BackfillRun)(nil),               // 10: ntx.v1.BackfillRun
+	(*GetBackfillStatusResponse)(nil), // 11: ntx.v1.GetBackfillStatusResponse
+	(*Price)(nil),                     // 12: ntx.v1.Price
 }
 var file_ntx_v1_price_proto_depIdxs = []int32{
-	6, // 0: ntx.v1.GetPriceResponse.price:type_name -> ntx.v1.Price
-	6, // 1: ntx.v1.GetPriceHistoryResponse.prices:type_name -> ntx.v1.Price
-	6, // 2: ntx.v1.ListLatestPricesResponse.prices:type_name -> ntx.v1.Price
-	0, // 3: ntx.v1.PriceService.GetPrice:input_type -> ntx.v1.GetPriceRequest
-	2, // 4: ntx.v1.PriceService.GetPriceHistory:input_type -> ntx.v1.GetPriceHistoryRequest
-	4, // 5: ntx.v1.PriceService.ListLatestPrices:input_type -> ntx.v1.ListLatestPricesRequest
-	1, // 6: ntx.v1.PriceService.GetPrice:output_type -> ntx.v1.GetPriceResponse
-	3, // 7: ntx.v1.PriceService.GetPriceHistory:output_type -> ntx.v1.GetPriceHistoryResponse
-	5, // 8: ntx.v1.PriceService.ListLatestPrices:output_type -> ntx.v1.ListLatestPricesResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	12, // 0: ntx.v1.GetPriceResponse.price:type_name -> ntx.v1.Price
+	12, // 1: ntx.v1.GetPriceHistoryResponse.prices:type_name -> ntx.v1.Price
+	12, // 2: ntx.v1.ListLatestPricesResponse.prices:type_name -> ntx.v1.Price
+	7,  // 3: ntx.v1.SyncPricesResponse.results:type_name -> ntx.v1.SymbolSyncResult
+	10, // 4: ntx.v1.GetBackfillStatusResponse.run:type_name -> ntx.v1.BackfillRun
+	0,  // 5: ntx.v1.PriceService.GetPrice:input_type -> ntx.v1.GetPriceRequest
+	2,  // 6: ntx.v1.PriceService.GetPriceHistory:input_type -> ntx.v1.GetPriceHistoryRequest
+	4,  // 7: ntx.v1.PriceService.ListLatestPrices:input_type -> ntx.v1.ListLatestPricesRequest
+	6,  // 8: ntx.v1.PriceService.SyncPrices:input_type -> ntx.v1.SyncPricesRequest
+	9,  // 9: ntx.v1.PriceService.GetBackfillStatus:input_type -> ntx.v1.GetBackfillStatusRequest
+	1,  // 10: ntx.v1.PriceService.GetPrice:output_type -> ntx.v1.GetPriceResponse
+	3,  // 11: ntx.v1.PriceService.GetPriceHistory:output_type -> ntx.v1.GetPriceHistoryResponse
+	5,  // 12: ntx.v1.PriceService.ListLatestPrices:output_type -> ntx.v1.ListLatestPricesResponse
+	8,  // 13: ntx.v1.PriceService.SyncPrices:output_type -> ntx.v1.SyncPricesResponse
+	11, // 14: ntx.v1.PriceService.GetBackfillStatus:output_type -> ntx.v1.GetBackfillStatusResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_ntx_v1_price_proto_init() }
@@ -360,7 +784,7 @@ func file_ntx_v1_price_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ntx_v1_price_proto_rawDesc), len(file_ntx_v1_price_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,341 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/flags"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// drawdownLookback is how far back the peak for drawdown alerts is searched.
+const drawdownLookback = 365
+
+// Evaluator checks active alerts against the latest stored data.
+type Evaluator struct {
+	queries    *sqlc.Queries
+	portfolios *portfolio.PortfolioService
+}
+
+// NewEvaluator creates an Evaluator.
+func NewEvaluator(queries *sqlc.Queries, portfolios *portfolio.PortfolioService) *Evaluator {
+	return &Evaluator{queries: queries, portfolios: portfolios}
+}
+
+// Run checks every active alert and records the ones whose condition holds.
+// An alert fires at most once per business day; an alert that can't be
+// evaluated is logged and skipped so it doesn't hold up the others.
+func (e *Evaluator) Run(ctx context.Context) error {
+	alerts, err := e.queries.ListActiveAlerts(ctx)
+	if err != nil {
+		return fmt.Errorf("list alerts: %w", err)
+	}
+
+	now := time.Now()
+	today := worker.BusinessDate(now)
+
+	for _, a := range alerts {
+		if a.TriggeredAt.Valid && worker.BusinessDate(a.TriggeredAt.Time) == today {
+			continue
+		}
+
+		msg, err := e.check(ctx, a, now)
+		if err != nil {
+			slog.WarnContext(ctx, "alert check failed", slog.Int64("alert", a.ID), slog.Any("err", err))
+			continue
+		}
+		if msg == "" {
+			continue
+		}
+
+		err = e.queries.MarkAlertTriggered(ctx, sqlc.MarkAlertTriggeredParams{
+			LastMessage: sql.NullString{String: msg, Valid: true},
+			ID:          a.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("mark alert %d: %w", a.ID, err)
+		}
+		slog.InfoContext(ctx, "alert triggered",
+			slog.Int64("alert", a.ID), slog.Int64("user", a.UserID), slog.String("message", msg))
+	}
+
+	return nil
+}
+
+// check returns a message describing why the alert fired, or "" if its
+// condition doesn't hold.
+func (e *Evaluator) check(ctx context.Context, a sqlc.Alert, now time.Time) (string, error) {
+	switch a.AlertType {
+	case typePriceAbove, typePriceBelow:
+		return e.checkPrice(ctx, a)
+	case typeDayLoss:
+		return e.checkDayLoss(ctx, a)
+	case typeDrawdown:
+		return e.checkDrawdown(ctx, a, now)
+	case typeIndexUnderperformance:
+		return e.checkIndexUnderperformance(ctx, a, now)
+	case typeVolumeSpike, typeTurnoverAbove:
+		return e.checkActivity(ctx, a)
+	case typeAboveWAC, typeBelowWAC:
+		return e.checkWAC(ctx, a)
+	case typeTrailingStop:
+		return e.checkTrailingStop(ctx, a)
+	default:
+		return "", fmt.Errorf("unknown alert type %q", a.AlertType)
+	}
+}
+
+func (e *Evaluator) checkPrice(ctx context.Context, a sqlc.Alert) (string, error) {
+	company, err := e.queries.GetCompany(ctx, a.StockSymbol.String)
+	if err != nil {
+		return "", fmt.Errorf("company %s: %w", a.StockSymbol.String, err)
+	}
+	price, err := e.queries.GetLatestPrice(ctx, company.ID)
+	if err != nil {
+		return "", fmt.Errorf("price %s: %w", company.Symbol, err)
+	}
+	if !price.ClosePrice.Valid {
+		return "", nil
+	}
+
+	closePrice := price.ClosePrice.Float64
+	if a.AlertType == typePriceAbove && closePrice >= a.Threshold {
+		return fmt.Sprintf("%s closed at Rs.%.2f, above Rs.%.2f", company.Symbol, closePrice, a.Threshold), nil
+	}
+	if a.AlertType == typePriceBelow && closePrice <= a.Threshold {
+		return fmt.Sprintf("%s closed at Rs.%.2f, below Rs.%.2f", company.Symbol, closePrice, a.Threshold), nil
+	}
+	return "", nil
+}
+
+// checkTrailingStop raises the stored peak to the latest close before
+// comparing, so the stop only ever moves up.
+func (e *Evaluator) checkTrailingStop(ctx context.Context, a sqlc.Alert) (string, error) {
+	company, err := e.queries.GetCompany(ctx, a.StockSymbol.String)
+	if err != nil {
+		return "", fmt.Errorf("company %s: %w", a.StockSymbol.String, err)
+	}
+	price, err := e.queries.GetLatestPrice(ctx, company.ID)
+	if err != nil {
+		return "", fmt.Errorf("price %s: %w", company.Symbol, err)
+	}
+	if !price.ClosePrice.Valid {
+		return "", nil
+	}
+
+	closePrice := price.ClosePrice.Float64
+	peak := a.PeakPrice.Float64
+	if closePrice > peak {
+		peak = closePrice
+		err := e.queries.UpdateAlertPeak(ctx, sqlc.UpdateAlertPeakParams{
+			PeakPrice: sql.NullFloat64{Float64: peak, Valid: true},
+			ID:        a.ID,
+		})
+		if err != nil {
+			return "", fmt.Errorf("update peak: %w", err)
+		}
+	}
+
+	drop := (1 - closePrice/peak) * 100
+	if drop < a.Threshold {
+		return "", nil
+	}
+	return fmt.Sprintf("%s closed at Rs.%.2f, %.1f%% below its peak of Rs.%.2f",
+		company.Symbol, closePrice, drop, peak), nil
+}
+
+// checkActivity flags unusual trading in a symbol: volume well above its
+// recent average, or turnover above a fixed amount. Spikes in thinly traded
+// scrips are often the first sign of accumulation.
+func (e *Evaluator) checkActivity(ctx context.Context, a sqlc.Alert) (string, error) {
+	company, err := e.queries.GetCompany(ctx, a.StockSymbol.String)
+	if err != nil {
+		return "", fmt.Errorf("company %s: %w", a.StockSymbol.String, err)
+	}
+
+	window := a.WindowDays
+	if window <= 0 {
+		window = defaultVolumeWindowDays
+	}
+
+	// Latest day first, followed by the days it is compared against
+	prices, err := e.queries.ListPricesByCompany(ctx, sqlc.ListPricesByCompanyParams{
+		CompanyID: company.ID,
+		Limit:     window + 1,
+	})
+	if err != nil {
+		return "", fmt.Errorf("prices %s: %w", company.Symbol, err)
+	}
+	if len(prices) == 0 {
+		return "", nil
+	}
+	latest := prices[0]
+
+	if a.AlertType == typeTurnoverAbove {
+		if latest.Turnover.Float64 < a.Threshold {
+			return "", nil
+		}
+		return fmt.Sprintf("%s turnover was Rs.%.0f on %s, above Rs.%.0f",
+			company.Symbol, latest.Turnover.Float64, latest.BusinessDate, a.Threshold), nil
+	}
+
+	var total int64
+	for _, p := range prices[1:] {
+		total += p.Volume.Int64
+	}
+	if len(prices) < 2 || total == 0 {
+		return "", nil
+	}
+	avg := float64(total) / float64(len(prices)-1)
+	multiple := float64(latest.Volume.Int64) / avg
+	if multiple < a.Threshold {
+		return "", nil
+	}
+	return fmt.Sprintf("%s traded %d shares on %s, %.1fx its %d-day average",
+		company.Symbol, latest.Volume.Int64, latest.BusinessDate, multiple, len(prices)-1), nil
+}
+
+// checkWAC resolves the trigger price from the current cost basis of the
+// holding, so the alert follows the user's averaging in and out.
+func (e *Evaluator) checkWAC(ctx context.Context, a sqlc.Alert) (string, error) {
+	p, err := e.portfolio(ctx, a)
+	if err != nil {
+		return "", err
+	}
+
+	holdings, err := e.portfolios.Holdings(ctx, p.ID)
+	if err != nil {
+		return "", err
+	}
+
+	for _, h := range holdings {
+		if h.StockSymbol != a.StockSymbol.String || h.AvgBuyPrice <= 0 || h.CurrentPrice <= 0 {
+			continue
+		}
+
+		change := (h.CurrentPrice/h.AvgBuyPrice - 1) * 100
+		if a.AlertType == typeAboveWAC && change >= a.Threshold {
+			return fmt.Sprintf("%s at Rs.%.2f is %.1f%% above your WAC of Rs.%.2f",
+				h.StockSymbol, h.CurrentPrice, change, h.AvgBuyPrice), nil
+		}
+		if a.AlertType == typeBelowWAC && -change >= a.Threshold {
+			return fmt.Sprintf("%s at Rs.%.2f is %.1f%% below your WAC of Rs.%.2f",
+				h.StockSymbol, h.CurrentPrice, -change, h.AvgBuyPrice), nil
+		}
+	}
+	return "", nil
+}
+
+func (e *Evaluator) checkDayLoss(ctx context.Context, a sqlc.Alert) (string, error) {
+	p, err := e.portfolio(ctx, a)
+	if err != nil {
+		return "", err
+	}
+
+	value, dayChange, err := e.portfolios.CurrentValue(ctx, p.ID)
+	if err != nil {
+		return "", err
+	}
+	if -dayChange < a.Threshold {
+		return "", nil
+	}
+	if previous := value - dayChange; flags.PrivateReports.Enabled() && previous > 0 {
+		return fmt.Sprintf("%s lost %.2f%% today", p.Name, -dayChange/previous*100), nil
+	}
+	return fmt.Sprintf("%s lost Rs.%.2f today", p.Name, -dayChange), nil
+}
+
+// checkDrawdown measures the fall in total P&L from its peak, relative to
+// the portfolio value at that peak. Using P&L rather than raw value keeps
+// deposits and withdrawals from looking like gains and losses.
+func (e *Evaluator) checkDrawdown(ctx context.Context, a sqlc.Alert, now time.Time) (string, error) {
+	p, err := e.portfolio(ctx, a)
+	if err != nil {
+		return "", err
+	}
+
+	points, err := e.portfolios.History(ctx, p.ID, now.AddDate(0, 0, -drawdownLookback), now)
+	if err != nil {
+		return "", err
+	}
+	if len(points) == 0 {
+		return "", nil
+	}
+
+	peak := points[0]
+	for _, pt := range points {
+		if pt.RealizedPnl+pt.UnrealizedPnl > peak.RealizedPnl+peak.UnrealizedPnl {
+			peak = pt
+		}
+	}
+	if peak.Value <= 0 {
+		return "", nil
+	}
+
+	last := points[len(points)-1]
+	drop := (peak.RealizedPnl + peak.UnrealizedPnl) - (last.RealizedPnl + last.UnrealizedPnl)
+	drawdown := (drop / peak.Value) * 100
+	if drawdown < a.Threshold {
+		return "", nil
+	}
+	return fmt.Sprintf("%s is down %.1f%% from its peak on %s", p.Name, drawdown, peak.Date), nil
+}
+
+// checkIndexUnderperformance compares the portfolio's return over the
+// alert window with the NEPSE index over the same trading days.
+func (e *Evaluator) checkIndexUnderperformance(ctx context.Context, a sqlc.Alert, now time.Time) (string, error) {
+	p, err := e.portfolio(ctx, a)
+	if err != nil {
+		return "", err
+	}
+
+	window := a.WindowDays
+	if window <= 0 {
+		window = defaultWindowDays
+	}
+
+	points, err := e.portfolios.History(ctx, p.ID, now.AddDate(0, 0, -int(window)), now)
+	if err != nil {
+		return "", err
+	}
+	if len(points) < 2 || points[0].Value <= 0 {
+		return "", nil
+	}
+	first, last := points[0], points[len(points)-1]
+
+	start, err := e.queries.GetIndexValueOnOrBefore(ctx, first.Date)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("no index value on or before %s", first.Date)
+	}
+	if err != nil {
+		return "", err
+	}
+	end, err := e.queries.GetIndexValueOnOrBefore(ctx, last.Date)
+	if err != nil {
+		return "", err
+	}
+
+	gain := (last.RealizedPnl + last.UnrealizedPnl) - (first.RealizedPnl + first.UnrealizedPnl)
+	portfolioReturn := (gain / first.Value) * 100
+	indexReturn := (end.CloseValue/start.CloseValue - 1) * 100
+	if indexReturn-portfolioReturn < a.Threshold {
+		return "", nil
+	}
+	return fmt.Sprintf("%s returned %.1f%% over %d days while NEPSE returned %.1f%%",
+		p.Name, portfolioReturn, window, indexReturn), nil
+}
+
+// portfolio loads the alert's portfolio, checking it still belongs to the
+// alert's owner.
+func (e *Evaluator) portfolio(ctx context.Context, a sqlc.Alert) (sqlc.Portfolio, error) {
+	return e.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     a.PortfolioID.Int64,
+		UserID: a.UserID,
+	})
+}
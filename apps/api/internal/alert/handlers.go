@@ -0,0 +1,140 @@
+package alert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// CreateAlert adds an alert rule for the authenticated user.
+func (s *AlertService) CreateAlert(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateAlertRequest],
+) (*connect.Response[ntxv1.CreateAlertResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alertType, ok := typeToDB[req.Msg.Type]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("alert type is required"))
+	}
+	if req.Msg.Threshold <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("threshold must be positive"))
+	}
+	if alertType == typeTrailingStop && req.Msg.Threshold >= 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("trailing stop must be below 100%"))
+	}
+	if req.Msg.WindowDays < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("window_days cannot be negative"))
+	}
+
+	params := sqlc.CreateAlertParams{
+		UserID:     userID,
+		AlertType:  alertType,
+		Threshold:  req.Msg.Threshold,
+		WindowDays: int64(req.Msg.WindowDays),
+	}
+
+	if needsPortfolio(alertType) {
+		if req.Msg.PortfolioId == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("portfolio_id is required"))
+		}
+		// Verify portfolio belongs to user
+		_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+			ID:     *req.Msg.PortfolioId,
+			UserID: userID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+		}
+		params.PortfolioID = sql.NullInt64{Int64: *req.Msg.PortfolioId, Valid: true}
+	}
+
+	if needsSymbol(alertType) {
+		if req.Msg.StockSymbol == nil || *req.Msg.StockSymbol == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock_symbol is required"))
+		}
+		symbol := strings.ToUpper(*req.Msg.StockSymbol)
+		company, err := s.queries.GetCompany(ctx, symbol)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("company not found"))
+		}
+		params.StockSymbol = sql.NullString{String: symbol, Valid: true}
+
+		// Trailing stops track the peak from the moment they're created
+		if alertType == typeTrailingStop {
+			price, err := s.queries.GetLatestPrice(ctx, company.ID)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			params.PeakPrice = price.ClosePrice
+		}
+	}
+
+	if alertType == typeIndexUnderperformance && params.WindowDays == 0 {
+		params.WindowDays = defaultWindowDays
+	}
+	if alertType == typeVolumeSpike && params.WindowDays == 0 {
+		params.WindowDays = defaultVolumeWindowDays
+	}
+
+	alert, err := s.queries.CreateAlert(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateAlertResponse{Alert: alertToProto(alert)}), nil
+}
+
+// ListAlerts returns the user's alerts, newest first.
+func (s *AlertService) ListAlerts(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListAlertsRequest],
+) (*connect.Response[ntxv1.ListAlertsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts, err := s.queries.ListAlertsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.Alert, len(alerts))
+	for i, a := range alerts {
+		result[i] = alertToProto(a)
+	}
+
+	return connect.NewResponse(&ntxv1.ListAlertsResponse{Alerts: result}), nil
+}
+
+// DeleteAlert removes one of the user's alerts.
+func (s *AlertService) DeleteAlert(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteAlertRequest],
+) (*connect.Response[ntxv1.DeleteAlertResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteAlert(ctx, sqlc.DeleteAlertParams{
+		ID:     req.Msg.AlertId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteAlertResponse{}), nil
+}
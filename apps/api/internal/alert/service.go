@@ -0,0 +1,109 @@
+// Package alert provides alert rules and their end-of-day evaluation.
+package alert
+
+import (
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// Alert types as stored in alerts.alert_type.
+const (
+	typePriceAbove            = "PRICE_ABOVE"
+	typePriceBelow            = "PRICE_BELOW"
+	typeDayLoss               = "DAY_LOSS"
+	typeDrawdown              = "DRAWDOWN"
+	typeIndexUnderperformance = "INDEX_UNDERPERFORMANCE"
+	typeVolumeSpike           = "VOLUME_SPIKE"
+	typeTurnoverAbove         = "TURNOVER_ABOVE"
+	typeAboveWAC              = "ABOVE_WAC"
+	typeBelowWAC              = "BELOW_WAC"
+	typeTrailingStop          = "TRAILING_STOP"
+)
+
+// Default windows used when an alert is created without window_days.
+const (
+	defaultWindowDays       = 30 // calendar days, for index underperformance
+	defaultVolumeWindowDays = 20 // trading days, for volume spikes
+)
+
+var typeToDB = map[ntxv1.AlertType]string{
+	ntxv1.AlertType_ALERT_TYPE_PRICE_ABOVE:            typePriceAbove,
+	ntxv1.AlertType_ALERT_TYPE_PRICE_BELOW:            typePriceBelow,
+	ntxv1.AlertType_ALERT_TYPE_DAY_LOSS:               typeDayLoss,
+	ntxv1.AlertType_ALERT_TYPE_DRAWDOWN:               typeDrawdown,
+	ntxv1.AlertType_ALERT_TYPE_INDEX_UNDERPERFORMANCE: typeIndexUnderperformance,
+	ntxv1.AlertType_ALERT_TYPE_VOLUME_SPIKE:           typeVolumeSpike,
+	ntxv1.AlertType_ALERT_TYPE_TURNOVER_ABOVE:         typeTurnoverAbove,
+	ntxv1.AlertType_ALERT_TYPE_ABOVE_WAC:              typeAboveWAC,
+	ntxv1.AlertType_ALERT_TYPE_BELOW_WAC:              typeBelowWAC,
+	ntxv1.AlertType_ALERT_TYPE_TRAILING_STOP:          typeTrailingStop,
+}
+
+// AlertService implements the AlertService RPCs.
+type AlertService struct {
+	ntxv1connect.UnimplementedAlertServiceHandler
+	queries *sqlc.Queries
+}
+
+// NewAlertService creates a new AlertService.
+func NewAlertService(queries *sqlc.Queries) *AlertService {
+	return &AlertService{queries: queries}
+}
+
+func typeFromDB(t string) ntxv1.AlertType {
+	for k, v := range typeToDB {
+		if v == t {
+			return k
+		}
+	}
+	return ntxv1.AlertType_ALERT_TYPE_UNSPECIFIED
+}
+
+// needsPortfolio reports whether the alert is evaluated against a portfolio.
+func needsPortfolio(t string) bool {
+	switch t {
+	case typeDayLoss, typeDrawdown, typeIndexUnderperformance, typeAboveWAC, typeBelowWAC:
+		return true
+	}
+	return false
+}
+
+// needsSymbol reports whether the alert watches a single stock.
+func needsSymbol(t string) bool {
+	switch t {
+	case typeDayLoss, typeDrawdown, typeIndexUnderperformance:
+		return false
+	}
+	return true
+}
+
+func alertToProto(a sqlc.Alert) *ntxv1.Alert {
+	out := &ntxv1.Alert{
+		Id:          a.ID,
+		Type:        typeFromDB(a.AlertType),
+		Threshold:   a.Threshold,
+		WindowDays:  convert.SafeInt32(a.WindowDays),
+		Active:      a.Active,
+		LastMessage: a.LastMessage.String,
+	}
+	if a.PortfolioID.Valid {
+		out.PortfolioId = &a.PortfolioID.Int64
+	}
+	if a.StockSymbol.Valid {
+		out.StockSymbol = &a.StockSymbol.String
+	}
+	if a.PeakPrice.Valid {
+		out.PeakPrice = &a.PeakPrice.Float64
+	}
+	if a.TriggeredAt.Valid {
+		out.TriggeredAt = a.TriggeredAt.Time.Format(time.RFC3339)
+	}
+	if a.CreatedAt.Valid {
+		out.CreatedAt = a.CreatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,225 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// CreateApplication records an application and the amount it blocks.
+func (s *ApplicationService) CreateApplication(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateApplicationRequest],
+) (*connect.Response[ntxv1.CreateApplicationResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	// Validate input. The company may not be listed yet, so it isn't looked up.
+	symbol := strings.ToUpper(strings.TrimSpace(req.Msg.StockSymbol))
+	if symbol == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock_symbol is required"))
+	}
+	issueType, ok := issueTypeToDB[req.Msg.IssueType]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("issue_type is required"))
+	}
+	if req.Msg.Units <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("units must be positive"))
+	}
+	price := req.Msg.PricePerUnit
+	if price == 0 {
+		price = faceValue
+	}
+	if price < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("price_per_unit must be positive"))
+	}
+
+	appliedOn := req.Msg.AppliedOn
+	if appliedOn == "" {
+		appliedOn = worker.BusinessDate(time.Now())
+	}
+	if _, err := time.Parse("2006-01-02", appliedOn); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("applied_on must be YYYY-MM-DD"))
+	}
+	var expected sql.NullString
+	if req.Msg.ExpectedAllotmentOn != "" {
+		if _, err := time.Parse("2006-01-02", req.Msg.ExpectedAllotmentOn); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				errors.New("expected_allotment_on must be YYYY-MM-DD"))
+		}
+		if req.Msg.ExpectedAllotmentOn < appliedOn {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				errors.New("expected_allotment_on is before applied_on"))
+		}
+		expected = sql.NullString{String: req.Msg.ExpectedAllotmentOn, Valid: true}
+	}
+
+	application, err := s.queries.CreateShareApplication(ctx, sqlc.CreateShareApplicationParams{
+		UserID:              userID,
+		PortfolioID:         req.Msg.PortfolioId,
+		StockSymbol:         symbol,
+		IssueType:           issueType,
+		Units:               req.Msg.Units,
+		PricePerUnit:        price,
+		AppliedOn:           appliedOn,
+		ExpectedAllotmentOn: expected,
+		Note:                strings.TrimSpace(req.Msg.Note),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateApplicationResponse{
+		Application: applicationToProto(application),
+	}), nil
+}
+
+// ListApplications returns the user's applications, newest first, and the
+// total still blocked by pending ones.
+func (s *ApplicationService) ListApplications(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListApplicationsRequest],
+) (*connect.Response[ntxv1.ListApplicationsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applications, err := s.queries.ListShareApplicationsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &ntxv1.ListApplicationsResponse{}
+	for _, a := range applications {
+		resp.TotalBlocked += blocked(a)
+		if req.Msg.Status != nil && statusToDB[*req.Msg.Status] != a.Status {
+			continue
+		}
+		resp.Applications = append(resp.Applications, applicationToProto(a))
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// WithdrawApplication closes a pending application and releases its amount.
+func (s *ApplicationService) WithdrawApplication(
+	ctx context.Context,
+	req *connect.Request[ntxv1.WithdrawApplicationRequest],
+) (*connect.Response[ntxv1.WithdrawApplicationResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.queries.CloseShareApplication(ctx, sqlc.CloseShareApplicationParams{
+		Status: statusWithdrawn,
+		ID:     req.Msg.ApplicationId,
+		UserID: userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, s.notPending(ctx, req.Msg.ApplicationId, userID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.WithdrawApplicationResponse{
+		Application: applicationToProto(application),
+	}), nil
+}
+
+// AllotApplication records the allotment result, books allotted units as a
+// buy at the issue price and releases the rest of the blocked amount.
+func (s *ApplicationService) AllotApplication(
+	ctx context.Context,
+	req *connect.Request[ntxv1.AllotApplicationRequest],
+) (*connect.Response[ntxv1.AllotApplicationResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := s.queries.GetShareApplication(ctx, sqlc.GetShareApplicationParams{
+		ID:     req.Msg.ApplicationId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("application not found"))
+	}
+	if application.Status != statusPending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("application is not pending"))
+	}
+	if req.Msg.AllottedUnits < 0 || req.Msg.AllottedUnits > application.Units {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("allotted_units must be between 0 and units"))
+	}
+
+	params := sqlc.CloseShareApplicationParams{
+		Status: statusNotAllotted,
+		ID:     application.ID,
+		UserID: userID,
+	}
+
+	var tx *ntxv1.Transaction
+	if req.Msg.AllottedUnits > 0 {
+		allottedOn := req.Msg.AllottedOn
+		if allottedOn == "" {
+			allottedOn = worker.BusinessDate(time.Now())
+		}
+		resp, err := s.portfolios.AddTransaction(ctx, connect.NewRequest(&ntxv1.AddTransactionRequest{
+			PortfolioId:     application.PortfolioID,
+			StockSymbol:     application.StockSymbol,
+			TransactionType: ntxv1.TransactionType_TRANSACTION_TYPE_BUY,
+			Quantity:        req.Msg.AllottedUnits,
+			UnitPrice:       application.PricePerUnit,
+			TransactionDate: allottedOn,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		tx = resp.Msg.Transaction
+
+		params.Status = statusAllotted
+		params.AllottedUnits = req.Msg.AllottedUnits
+		params.TransactionID = sql.NullInt64{Int64: tx.Id, Valid: true}
+	}
+
+	application, err = s.queries.CloseShareApplication(ctx, params)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.AllotApplicationResponse{
+		Application: applicationToProto(application),
+		Transaction: tx,
+	}), nil
+}
+
+// notPending explains why an application couldn't be closed.
+func (s *ApplicationService) notPending(ctx context.Context, applicationID, userID int64) error {
+	_, err := s.queries.GetShareApplication(ctx, sqlc.GetShareApplicationParams{ID: applicationID, UserID: userID})
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, errors.New("application not found"))
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, errors.New("application is not pending"))
+}
@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// RenounceRights records rights entitlement the user chose not to subscribe.
+// No transaction is booked: only subscribed units, allotted through
+// AllotApplication, carry a cost basis.
+func (s *ApplicationService) RenounceRights(
+	ctx context.Context,
+	req *connect.Request[ntxv1.RenounceRightsRequest],
+) (*connect.Response[ntxv1.RenounceRightsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	symbol := strings.ToUpper(strings.TrimSpace(req.Msg.StockSymbol))
+	if symbol == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock_symbol is required"))
+	}
+	if req.Msg.Units <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("units must be positive"))
+	}
+	renouncedOn := req.Msg.RenouncedOn
+	if renouncedOn == "" {
+		renouncedOn = worker.BusinessDate(time.Now())
+	}
+	if _, err := time.Parse("2006-01-02", renouncedOn); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("renounced_on must be YYYY-MM-DD"))
+	}
+
+	renunciation, err := s.queries.CreateRightRenunciation(ctx, sqlc.CreateRightRenunciationParams{
+		UserID:      userID,
+		PortfolioID: req.Msg.PortfolioId,
+		StockSymbol: symbol,
+		Units:       req.Msg.Units,
+		RenouncedOn: renouncedOn,
+		Note:        strings.TrimSpace(req.Msg.Note),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.RenounceRightsResponse{
+		Renunciation: renunciationToProto(renunciation),
+	}), nil
+}
+
+// ListRenunciations returns the user's renounced rights, newest first.
+func (s *ApplicationService) ListRenunciations(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListRenunciationsRequest],
+) (*connect.Response[ntxv1.ListRenunciationsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	renunciations, err := s.queries.ListRightRenunciationsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.RightRenunciation, len(renunciations))
+	for i, r := range renunciations {
+		result[i] = renunciationToProto(r)
+	}
+
+	return connect.NewResponse(&ntxv1.ListRenunciationsResponse{Renunciations: result}), nil
+}
+
+// SetRenunciationProceeds records what the rights auction paid out. The
+// date decides which fiscal year the gain is taxed in.
+func (s *ApplicationService) SetRenunciationProceeds(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetRenunciationProceedsRequest],
+) (*connect.Response[ntxv1.SetRenunciationProceedsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.Proceeds < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("proceeds can't be negative"))
+	}
+	receivedOn := req.Msg.ReceivedOn
+	if receivedOn == "" {
+		receivedOn = worker.BusinessDate(time.Now())
+	}
+	if _, err := time.Parse("2006-01-02", receivedOn); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("received_on must be YYYY-MM-DD"))
+	}
+
+	renunciation, err := s.queries.SetRenunciationProceeds(ctx, sqlc.SetRenunciationProceedsParams{
+		Proceeds:           req.Msg.Proceeds,
+		ProceedsReceivedOn: sql.NullString{String: receivedOn, Valid: true},
+		ID:                 req.Msg.RenunciationId,
+		UserID:             userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("renunciation not found"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.SetRenunciationProceedsResponse{
+		Renunciation: renunciationToProto(renunciation),
+	}), nil
+}
+
+func renunciationToProto(r sqlc.RightRenunciation) *ntxv1.RightRenunciation {
+	out := &ntxv1.RightRenunciation{
+		Id:                 r.ID,
+		PortfolioId:        r.PortfolioID,
+		StockSymbol:        r.StockSymbol,
+		Units:              r.Units,
+		RenouncedOn:        r.RenouncedOn,
+		Proceeds:           r.Proceeds,
+		ProceedsReceivedOn: r.ProceedsReceivedOn.String,
+		Note:               r.Note,
+	}
+	if r.CreatedAt.Valid {
+		out.CreatedAt = r.CreatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+// Package application tracks share applications made through ASBA.
+package application
+
+import (
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// faceValue is the issue price of most NEPSE public and rights offerings.
+const faceValue = 100
+
+// Application statuses as stored in share_applications.status.
+const (
+	statusPending     = "PENDING"
+	statusAllotted    = "ALLOTTED"
+	statusNotAllotted = "NOT_ALLOTTED"
+	statusWithdrawn   = "WITHDRAWN"
+)
+
+var statusToDB = map[ntxv1.ApplicationStatus]string{
+	ntxv1.ApplicationStatus_APPLICATION_STATUS_PENDING:      statusPending,
+	ntxv1.ApplicationStatus_APPLICATION_STATUS_ALLOTTED:     statusAllotted,
+	ntxv1.ApplicationStatus_APPLICATION_STATUS_NOT_ALLOTTED: statusNotAllotted,
+	ntxv1.ApplicationStatus_APPLICATION_STATUS_WITHDRAWN:    statusWithdrawn,
+}
+
+var issueTypeToDB = map[ntxv1.IssueType]string{
+	ntxv1.IssueType_ISSUE_TYPE_IPO:   "IPO",
+	ntxv1.IssueType_ISSUE_TYPE_FPO:   "FPO",
+	ntxv1.IssueType_ISSUE_TYPE_RIGHT: "RIGHT",
+}
+
+// ApplicationService implements the ApplicationService RPCs.
+type ApplicationService struct {
+	ntxv1connect.UnimplementedApplicationServiceHandler
+	queries    *sqlc.Queries
+	portfolios *portfolio.PortfolioService
+}
+
+// NewApplicationService creates a new ApplicationService. Allotments are
+// booked through portfolios so they get the same validation as manual entries.
+func NewApplicationService(queries *sqlc.Queries, portfolios *portfolio.PortfolioService) *ApplicationService {
+	return &ApplicationService{queries: queries, portfolios: portfolios}
+}
+
+func statusFromDB(s string) ntxv1.ApplicationStatus {
+	for k, v := range statusToDB {
+		if v == s {
+			return k
+		}
+	}
+	return ntxv1.ApplicationStatus_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func issueTypeFromDB(s string) ntxv1.IssueType {
+	for k, v := range issueTypeToDB {
+		if v == s {
+			return k
+		}
+	}
+	return ntxv1.IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+// blocked is the amount the bank holds while the application is pending.
+func blocked(a sqlc.ShareApplication) float64 {
+	if a.Status != statusPending {
+		return 0
+	}
+	return float64(a.Units) * a.PricePerUnit
+}
+
+// released is the amount unblocked once the application closed: all of it
+// unless units were allotted, which are paid for from the blocked amount.
+func released(a sqlc.ShareApplication) float64 {
+	if a.Status == statusPending {
+		return 0
+	}
+	return float64(a.Units-a.AllottedUnits) * a.PricePerUnit
+}
+
+func applicationToProto(a sqlc.ShareApplication) *ntxv1.ShareApplication {
+	out := &ntxv1.ShareApplication{
+		Id:                  a.ID,
+		PortfolioId:         a.PortfolioID,
+		StockSymbol:         a.StockSymbol,
+		IssueType:           issueTypeFromDB(a.IssueType),
+		Units:               a.Units,
+		PricePerUnit:        a.PricePerUnit,
+		AmountBlocked:       blocked(a),
+		AppliedOn:           a.AppliedOn,
+		ExpectedAllotmentOn: a.ExpectedAllotmentOn.String,
+		Status:              statusFromDB(a.Status),
+		AllottedUnits:       a.AllottedUnits,
+		AmountReleased:      released(a),
+		Note:                a.Note,
+	}
+	if a.TransactionID.Valid {
+		out.TransactionId = &a.TransactionID.Int64
+	}
+	if a.ClosedAt.Valid {
+		out.ClosedAt = a.ClosedAt.Time.Format(time.RFC3339)
+	}
+	if a.CreatedAt.Valid {
+		out.CreatedAt = a.CreatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
@@ -4,6 +4,7 @@ package auth
 import (
 	"context"
 	"crypto/rand"
+	"database/sql"
 	"encoding/hex"
 	"errors"
 	"os"
@@ -19,24 +20,38 @@ import (
 
 var ErrInvalidCredentials = errors.New("invalid credentials")
 
+// envUserID is the user in single user mode, where AUTH_EMAIL stands in for
+// the users table.
+const envUserID int64 = 1
+
 // Session stores user session info.
 type Session struct {
-	UserID    int64
-	ExpiresAt time.Time
+	ID         string // public handle for listing and revoking; not the token
+	UserID     int64
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
 }
 
 // AuthService implements the AuthService gRPC service.
 type AuthService struct {
-	queries  *sqlc.Queries
-	sessions map[string]Session
-	mu       sync.RWMutex
+	db          *sql.DB
+	queries     *sqlc.Queries
+	sessions    map[string]Session
+	envTotpStep int64 // last AUTH_TOTP_SECRET step used, against replay
+	otpAttempts map[int64]otpAttempts
+	mu          sync.RWMutex
 }
 
 // NewAuthService creates a new AuthService.
-func NewAuthService(queries *sqlc.Queries) *AuthService {
+func NewAuthService(db *sql.DB, queries *sqlc.Queries) *AuthService {
 	return &AuthService{
-		queries:  queries,
-		sessions: make(map[string]Session),
+		db:          db,
+		queries:     queries,
+		sessions:    make(map[string]Session),
+		otpAttempts: make(map[int64]otpAttempts),
 	}
 }
 
@@ -66,7 +81,20 @@ func (s *AuthService) Login(
 		if err := bcrypt.CompareHashAndPassword([]byte(envPasswordHash), []byte(password)); err != nil {
 			return nil, connect.NewError(connect.CodeUnauthenticated, ErrInvalidCredentials)
 		}
-		userID = 1 // Fake user ID for env-based auth
+		if secret := os.Getenv("AUTH_TOTP_SECRET"); secret != "" {
+			if req.Msg.OtpCode == "" {
+				return connect.NewResponse(&ntxv1.LoginResponse{OtpRequired: true}), nil
+			}
+			if s.otpLocked(envUserID, time.Now()) {
+				return nil, connect.NewError(connect.CodeResourceExhausted, errOtpLocked)
+			}
+			ok := s.checkEnvSecondFactor(secret, req.Msg.OtpCode)
+			s.recordOtp(envUserID, ok, time.Now())
+			if !ok {
+				return nil, connect.NewError(connect.CodeUnauthenticated, errInvalidCode)
+			}
+		}
+		userID = envUserID
 	} else {
 		// Database-based auth
 		user, err := s.queries.GetUserByEmail(ctx, email)
@@ -77,6 +105,30 @@ func (s *AuthService) Login(
 		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
 			return nil, connect.NewError(connect.CodeUnauthenticated, ErrInvalidCredentials)
 		}
+
+		// Second factor, if enrolled
+		totp, err := s.queries.GetUserTotp(ctx, user.ID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if err == nil && totp.Enabled {
+			if req.Msg.OtpCode == "" {
+				return connect.NewResponse(&ntxv1.LoginResponse{OtpRequired: true}), nil
+			}
+			if s.otpLocked(user.ID, time.Now()) {
+				return nil, connect.NewError(connect.CodeResourceExhausted, errOtpLocked)
+			}
+			err := s.checkSecondFactor(ctx, totp, req.Msg.OtpCode)
+			if err == nil || errors.Is(err, errInvalidCode) {
+				s.recordOtp(user.ID, err == nil, time.Now())
+			}
+			if errors.Is(err, errInvalidCode) {
+				return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			}
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+		}
 		userID = user.ID
 	}
 
@@ -87,11 +139,22 @@ func (s *AuthService) Login(
 	}
 	token := hex.EncodeToString(tokenBytes)
 
+	idBytes := make([]byte, 8)
+	if _, err := rand.Read(idBytes); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to generate token"))
+	}
+
 	// Store session
+	now := time.Now()
 	s.mu.Lock()
 	s.sessions[token] = Session{
-		UserID:    userID,
-		ExpiresAt: time.Now().Add(24 * time.Hour * 7), // 7 days
+		ID:         hex.EncodeToString(idBytes),
+		UserID:     userID,
+		UserAgent:  req.Header().Get("User-Agent"),
+		IPAddress:  peerIP(req.Peer().Addr),
+		CreatedAt:  now,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(24 * time.Hour * 7), // 7 days
 	}
 	s.mu.Unlock()
 
@@ -101,23 +164,35 @@ func (s *AuthService) Login(
 	}), nil
 }
 
-// ValidateToken checks if a token is valid and returns the user ID.
+// ValidateToken checks if a token is valid and returns the user ID. It also
+// records the session as last seen now.
 func (s *AuthService) ValidateToken(token string) (int64, bool) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	session, ok := s.sessions[token]
 	if !ok {
 		return 0, false
 	}
 
-	if time.Now().After(session.ExpiresAt) {
+	now := time.Now()
+	if now.After(session.ExpiresAt) {
+		delete(s.sessions, token)
 		return 0, false
 	}
 
+	session.LastSeenAt = now
+	s.sessions[token] = session
 	return session.UserID, true
 }
 
+// Revoke ends a session so its token stops validating.
+func (s *AuthService) Revoke(token string) {
+	s.mu.Lock()
+	delete(s.sessions, token)
+	s.mu.Unlock()
+}
+
 // Register creates a new user account.
 func (s *AuthService) Register(
 	ctx context.Context,
@@ -30,9 +30,10 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return next(ctx, req)
 		}
 
-		// Skip auth for public endpoints (CompanyService, PriceService)
+		// Skip auth for public endpoints (CompanyService, PriceService reads)
 		if strings.Contains(req.Spec().Procedure, "CompanyService") ||
-			strings.Contains(req.Spec().Procedure, "PriceService") {
+			(strings.Contains(req.Spec().Procedure, "PriceService") &&
+				!strings.Contains(req.Spec().Procedure, "PriceService/SyncPrices")) {
 			return next(ctx, req)
 		}
 
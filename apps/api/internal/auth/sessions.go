@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// ListSessions lists the user's signed-in devices, most recently active first.
+func (s *AuthService) ListSessions(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListSessionsRequest],
+) (*connect.Response[ntxv1.ListSessionsResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := strings.TrimPrefix(req.Header().Get("Authorization"), "Bearer ")
+
+	s.mu.RLock()
+	var sessions []Session
+	var currentID string
+	now := time.Now()
+	for token, session := range s.sessions {
+		if session.UserID != userID || now.After(session.ExpiresAt) {
+			continue
+		}
+		if token == current {
+			currentID = session.ID
+		}
+		sessions = append(sessions, session)
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(sessions, func(a, b Session) int {
+		return b.LastSeenAt.Compare(a.LastSeenAt)
+	})
+
+	result := make([]*ntxv1.Session, len(sessions))
+	for i, session := range sessions {
+		result[i] = &ntxv1.Session{
+			Id:         session.ID,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt.Format(time.RFC3339),
+			LastSeenAt: session.LastSeenAt.Format(time.RFC3339),
+			ExpiresAt:  session.ExpiresAt.Format(time.RFC3339),
+			Current:    session.ID == currentID,
+		}
+	}
+
+	return connect.NewResponse(&ntxv1.ListSessionsResponse{Sessions: result}), nil
+}
+
+// RevokeSession signs out one of the user's sessions, which may be the
+// current one.
+func (s *AuthService) RevokeSession(
+	ctx context.Context,
+	req *connect.Request[ntxv1.RevokeSessionRequest],
+) (*connect.Response[ntxv1.RevokeSessionResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Msg.Id == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("session id required"))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for token, session := range s.sessions {
+		if session.UserID == userID && session.ID == req.Msg.Id {
+			delete(s.sessions, token)
+			return connect.NewResponse(&ntxv1.RevokeSessionResponse{}), nil
+		}
+	}
+
+	return nil, connect.NewError(connect.CodeNotFound, errors.New("session not found"))
+}
+
+// peerIP strips the port from a peer address.
+func peerIP(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
@@ -0,0 +1,347 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 defaults to HMAC-SHA1, which authenticator apps expect
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/bcrypt"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+const (
+	totpIssuer = "NTX"
+	totpPeriod = 30 // seconds per code
+	totpDigits = 6
+	// totpSkew is how many periods either side of now are accepted, to allow
+	// for clock drift between the server and the phone.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+
+	// maxOtpFailures wrong codes in a row lock a user's second factor for
+	// otpLockout, so the code space can't be walked through.
+	maxOtpFailures = 5
+	otpLockout     = 15 * time.Minute
+)
+
+var (
+	errInvalidCode = errors.New("invalid authentication code")
+	errOtpLocked   = errors.New("too many invalid authentication codes, try again later")
+)
+
+// otpAttempts counts a user's consecutive wrong codes.
+type otpAttempts struct {
+	failures    int
+	lockedUntil time.Time
+}
+
+// otpLocked reports whether userID's second factor is locked out.
+func (s *AuthService) otpLocked(userID int64, now time.Time) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return now.Before(s.otpAttempts[userID].lockedUntil)
+}
+
+// recordOtp counts a wrong code against userID, locking the second factor
+// after maxOtpFailures, or clears the count after a right one.
+func (s *AuthService) recordOtp(userID int64, ok bool, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ok {
+		delete(s.otpAttempts, userID)
+		return
+	}
+	a := s.otpAttempts[userID]
+	a.failures++
+	if a.failures >= maxOtpFailures {
+		a = otpAttempts{lockedUntil: now.Add(otpLockout)}
+	}
+	s.otpAttempts[userID] = a
+}
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCode is the HOTP value (RFC 4226) for one time step.
+func totpCode(secret []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step)) //nolint:gosec // steps are positive
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1_000_000)
+}
+
+// verifyTotp checks a code against the steps around now and returns the step
+// it matched. Steps at or before lastStep are refused so a code can't be
+// replayed.
+func verifyTotp(secret, code string, lastStep int64, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func newTotpSecret() (string, error) {
+	key := make([]byte, 20)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(key), nil
+}
+
+// newRecoveryCode returns a code like "k3f9q-7xm2p".
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(totpEncoding.EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// normalizeCode drops the spacing and dashes people type into codes.
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(code))
+}
+
+// checkSecondFactor verifies an authenticator or recovery code for a user with
+// two-factor enabled, and marks it used. Marking is conditional on the code
+// still being unused, so of two logins racing with the same code only one
+// gets in.
+func (s *AuthService) checkSecondFactor(ctx context.Context, totp sqlc.UserTotp, code string) error {
+	code = normalizeCode(code)
+	if step, ok := verifyTotp(totp.Secret, code, totp.LastUsedStep, time.Now()); ok {
+		n, err := s.queries.SetTotpLastUsedStep(ctx, sqlc.SetTotpLastUsedStepParams{
+			LastUsedStep: step,
+			UserID:       totp.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errInvalidCode
+		}
+		return nil
+	}
+
+	codes, err := s.queries.ListUnusedRecoveryCodes(ctx, totp.UserID)
+	if err != nil {
+		return err
+	}
+	for _, c := range codes {
+		if bcrypt.CompareHashAndPassword([]byte(c.CodeHash), []byte(code)) != nil {
+			continue
+		}
+		n, err := s.queries.UseRecoveryCode(ctx, c.ID)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errInvalidCode
+		}
+		return nil
+	}
+	return errInvalidCode
+}
+
+// checkEnvSecondFactor verifies a code against AUTH_TOTP_SECRET in single
+// user mode. There are no recovery codes; losing the device means editing
+// the environment.
+func (s *AuthService) checkEnvSecondFactor(secret, code string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	step, ok := verifyTotp(secret, normalizeCode(code), s.envTotpStep, time.Now())
+	if !ok {
+		return false
+	}
+	s.envTotpStep = step
+	return true
+}
+
+// BeginTotpEnrollment creates a new secret for the user's authenticator app.
+// Two-factor stays off until ConfirmTotpEnrollment.
+func (s *AuthService) BeginTotpEnrollment(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.BeginTotpEnrollmentRequest],
+) (*connect.Response[ntxv1.BeginTotpEnrollmentResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("AUTH_EMAIL") != "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("single user mode: set AUTH_TOTP_SECRET instead"))
+	}
+
+	user, err := s.queries.GetUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	existing, err := s.queries.GetUserTotp(ctx, userID)
+	if err == nil && existing.Enabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("two-factor is already enabled"))
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	secret, err := newTotpSecret()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to generate secret"))
+	}
+	err = s.queries.SetPendingTotp(ctx, sqlc.SetPendingTotpParams{UserID: userID, Secret: secret})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", totpIssuer)
+	otpauth := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + user.Email,
+		RawQuery: params.Encode(),
+	}
+
+	return connect.NewResponse(&ntxv1.BeginTotpEnrollmentResponse{
+		Secret:     secret,
+		OtpauthUrl: otpauth.String(),
+	}), nil
+}
+
+// ConfirmTotpEnrollment turns two-factor on once the app produces a valid
+// code, and issues fresh recovery codes.
+func (s *AuthService) ConfirmTotpEnrollment(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ConfirmTotpEnrollmentRequest],
+) (*connect.Response[ntxv1.ConfirmTotpEnrollmentResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := s.queries.GetUserTotp(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no enrollment in progress"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if totp.Enabled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("two-factor is already enabled"))
+	}
+
+	step, ok := verifyTotp(totp.Secret, normalizeCode(req.Msg.Code), 0, time.Now())
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errInvalidCode)
+	}
+
+	// Hash the codes before opening the transaction; bcrypt is slow and the
+	// pool has one connection.
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to generate recovery codes"))
+		}
+		hash, err := bcrypt.GenerateFromPassword([]byte(normalizeCode(code)), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("failed to hash recovery codes"))
+		}
+		codes[i], hashes[i] = code, string(hash)
+	}
+
+	err = database.WithTx(ctx, s.db, func(q *sqlc.Queries) error {
+		if err := q.DeleteRecoveryCodes(ctx, userID); err != nil {
+			return err
+		}
+		for _, hash := range hashes {
+			err := q.CreateRecoveryCode(ctx, sqlc.CreateRecoveryCodeParams{UserID: userID, CodeHash: hash})
+			if err != nil {
+				return err
+			}
+		}
+		return q.EnableTotp(ctx, sqlc.EnableTotpParams{LastUsedStep: step, UserID: userID})
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ConfirmTotpEnrollmentResponse{RecoveryCodes: codes}), nil
+}
+
+// DisableTotp turns two-factor off. It takes a current code so a stolen
+// session alone can't remove the second factor.
+func (s *AuthService) DisableTotp(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DisableTotpRequest],
+) (*connect.Response[ntxv1.DisableTotpResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totp, err := s.queries.GetUserTotp(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewResponse(&ntxv1.DisableTotpResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if totp.Enabled {
+		if s.otpLocked(userID, time.Now()) {
+			return nil, connect.NewError(connect.CodeResourceExhausted, errOtpLocked)
+		}
+		err := s.checkSecondFactor(ctx, totp, req.Msg.Code)
+		if err == nil || errors.Is(err, errInvalidCode) {
+			s.recordOtp(userID, err == nil, time.Now())
+		}
+		if errors.Is(err, errInvalidCode) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	if err := s.queries.DeleteUserTotp(ctx, userID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DisableTotpResponse{}), nil
+}
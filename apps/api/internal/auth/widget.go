@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// hashWidgetToken is the stored form of a widget token. The token is 32
+// random bytes, so a plain SHA-256 is enough and keeps lookups by hash.
+func hashWidgetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateWidgetToken issues a read-only token for the widget endpoint,
+// replacing any earlier one.
+func (s *AuthService) CreateWidgetToken(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.CreateWidgetTokenRequest],
+) (*connect.Response[ntxv1.CreateWidgetTokenResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, errors.New("failed to generate token"))
+	}
+	token := hex.EncodeToString(tokenBytes)
+
+	err = s.queries.SetWidgetToken(ctx, sqlc.SetWidgetTokenParams{
+		UserID:    userID,
+		TokenHash: hashWidgetToken(token),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateWidgetTokenResponse{Token: token}), nil
+}
+
+// RevokeWidgetToken removes the user's widget token.
+func (s *AuthService) RevokeWidgetToken(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.RevokeWidgetTokenRequest],
+) (*connect.Response[ntxv1.RevokeWidgetTokenResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.DeleteWidgetToken(ctx, userID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.RevokeWidgetTokenResponse{}), nil
+}
+
+// ValidateWidgetToken returns the user a widget token belongs to.
+func (s *AuthService) ValidateWidgetToken(ctx context.Context, token string) (int64, bool) {
+	if token == "" {
+		return 0, false
+	}
+	userID, err := s.queries.GetWidgetTokenUser(ctx, hashWidgetToken(token))
+	if err != nil {
+		return 0, false
+	}
+	return userID, true
+}
@@ -0,0 +1,221 @@
+// Package backtest replays daily prices against simple rule-based strategies
+// and reports the trades they would have made.
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/voidarchive/ntx/internal/indicator"
+)
+
+// Rule selects how buy and sell signals are generated.
+type Rule string
+
+const (
+	// SMACross buys when the fast average crosses above the slow one and
+	// sells when it crosses back below.
+	SMACross Rule = "sma_cross"
+	// RSIThreshold buys when RSI drops under BuyBelow and sells when it
+	// rises over SellAbove.
+	RSIThreshold Rule = "rsi"
+)
+
+// Strategy is a rule with its parameters. Trading is limited to [From, To];
+// earlier history only warms up the indicators.
+type Strategy struct {
+	Rule      Rule    `json:"rule"`
+	Fast      int     `json:"fast,omitempty"`
+	Slow      int     `json:"slow,omitempty"`
+	Period    int     `json:"period,omitempty"`
+	BuyBelow  float64 `json:"buy_below,omitempty"`
+	SellAbove float64 `json:"sell_above,omitempty"`
+	Capital   float64 `json:"capital"`
+	From      string  `json:"from,omitempty"`
+	To        string  `json:"to,omitempty"`
+}
+
+// Validate checks the rule parameters make sense.
+func (s Strategy) Validate() error {
+	if s.Capital <= 0 {
+		return errors.New("capital must be positive")
+	}
+	switch s.Rule {
+	case SMACross:
+		if s.Fast <= 0 || s.Slow <= s.Fast {
+			return errors.New("sma_cross needs 0 < fast < slow")
+		}
+	case RSIThreshold:
+		if s.Period <= 0 {
+			return errors.New("rsi needs a positive period")
+		}
+		if s.BuyBelow <= 0 || s.SellAbove >= 100 || s.BuyBelow >= s.SellAbove {
+			return errors.New("rsi needs 0 < buy_below < sell_above < 100")
+		}
+	default:
+		return fmt.Errorf("unknown rule %q", s.Rule)
+	}
+	return nil
+}
+
+// Bar is one trading day. Open may be zero when only the close is known.
+type Bar struct {
+	Date  string
+	Open  float64
+	Close float64
+}
+
+// Trade is a simulated fill.
+type Trade struct {
+	Side     string  `json:"side"`
+	Date     string  `json:"date"`
+	Price    float64 `json:"price"`
+	Quantity int64   `json:"quantity"`
+	Fees     float64 `json:"fees"`
+}
+
+// Result is a strategy's outcome on one symbol. An open position is valued
+// at the last close.
+type Result struct {
+	Symbol             string  `json:"symbol"`
+	Trades             []Trade `json:"trades"`
+	EndValue           float64 `json:"end_value"`
+	ReturnPercent      float64 `json:"return_percent"`
+	BuyHoldPercent     float64 `json:"buy_hold_percent"`
+	MaxDrawdownPercent float64 `json:"max_drawdown_percent"`
+	RoundTrips         int     `json:"round_trips"`
+	Wins               int     `json:"wins"`
+	FeesPaid           float64 `json:"fees_paid"`
+}
+
+type signal int
+
+const (
+	hold signal = iota
+	buy
+	sell
+)
+
+// Run simulates a long-only strategy that puts all its cash into the symbol
+// on a buy signal and exits fully on a sell. Signals are read at a day's
+// close and filled at the next day's open, so no trade uses prices it
+// couldn't have seen. fees returns the charges on a trade amount; nil means
+// no fees.
+func Run(s Strategy, symbol string, bars []Bar, fees func(amount float64) float64) Result {
+	if fees == nil {
+		fees = func(float64) float64 { return 0 }
+	}
+	signals := signalsFor(s, bars)
+
+	r := Result{Symbol: symbol, EndValue: s.Capital}
+	cash := s.Capital
+	var quantity int64
+	var entryCost, peak, firstClose, lastClose float64
+
+	for i, bar := range bars {
+		if !inRange(s, bar.Date) {
+			continue
+		}
+		if firstClose == 0 {
+			firstClose = bar.Close
+		}
+		lastClose = bar.Close
+
+		// Fill yesterday's signal at today's open
+		price := bar.Open
+		if price <= 0 {
+			price = bar.Close
+		}
+		if i > 0 && inRange(s, bars[i-1].Date) {
+			switch {
+			case signals[i-1] == buy && quantity == 0:
+				quantity = affordable(cash, price, fees)
+				if quantity > 0 {
+					amount := float64(quantity) * price
+					fee := fees(amount)
+					cash -= amount + fee
+					entryCost = amount + fee
+					r.FeesPaid += fee
+					r.Trades = append(r.Trades, Trade{"BUY", bar.Date, price, quantity, fee})
+				}
+			case signals[i-1] == sell && quantity > 0:
+				amount := float64(quantity) * price
+				fee := fees(amount)
+				cash += amount - fee
+				r.FeesPaid += fee
+				r.RoundTrips++
+				if amount-fee > entryCost {
+					r.Wins++
+				}
+				r.Trades = append(r.Trades, Trade{"SELL", bar.Date, price, quantity, fee})
+				quantity = 0
+			}
+		}
+
+		value := cash + float64(quantity)*bar.Close
+		peak = max(peak, value)
+		if peak > 0 {
+			r.MaxDrawdownPercent = max(r.MaxDrawdownPercent, (peak-value)/peak*100)
+		}
+		r.EndValue = value
+	}
+
+	r.ReturnPercent = (r.EndValue - s.Capital) / s.Capital * 100
+	if firstClose > 0 {
+		r.BuyHoldPercent = (lastClose - firstClose) / firstClose * 100
+	}
+	return r
+}
+
+// signalsFor evaluates the rule at each day's close.
+func signalsFor(s Strategy, bars []Bar) []signal {
+	closes := make([]float64, len(bars))
+	for i, b := range bars {
+		closes[i] = b.Close
+	}
+
+	out := make([]signal, len(bars))
+	switch s.Rule {
+	case SMACross:
+		fast := indicator.SMA(closes, s.Fast)
+		slow := indicator.SMA(closes, s.Slow)
+		for i := 1; i < len(bars); i++ {
+			if math.IsNaN(slow[i-1]) {
+				continue
+			}
+			wasAbove := fast[i-1] > slow[i-1]
+			isAbove := fast[i] > slow[i]
+			if isAbove && !wasAbove {
+				out[i] = buy
+			}
+			if !isAbove && wasAbove {
+				out[i] = sell
+			}
+		}
+	case RSIThreshold:
+		rsi := indicator.RSI(closes, s.Period)
+		for i, v := range rsi {
+			if v < s.BuyBelow {
+				out[i] = buy
+			}
+			if v > s.SellAbove {
+				out[i] = sell
+			}
+		}
+	}
+	return out
+}
+
+// affordable is the most shares cash can buy at price, fees included.
+func affordable(cash, price float64, fees func(float64) float64) int64 {
+	quantity := int64(cash / price)
+	for quantity > 0 && float64(quantity)*price+fees(float64(quantity)*price) > cash {
+		quantity--
+	}
+	return quantity
+}
+
+func inRange(s Strategy, date string) bool {
+	return (s.From == "" || date >= s.From) && (s.To == "" || date <= s.To)
+}
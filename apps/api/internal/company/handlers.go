@@ -8,6 +8,7 @@ import (
 	"connectrpc.com/connect"
 
 	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
 )
 
@@ -168,7 +169,7 @@ func (s *CompanyService) GetSectorStats(
 	return connect.NewResponse(&ntxv1.GetSectorStatsResponse{
 		Stats: &ntxv1.SectorStats{
 			Sector:       sector,
-			CompanyCount: safeInt32(stats.CompanyCount),
+			CompanyCount: convert.SafeInt32(stats.CompanyCount),
 			AvgEps:       nullFloat64(stats.AvgEps),
 			AvgPeRatio:   nullFloat64(stats.AvgPeRatio),
 			AvgBookValue: nullFloat64(stats.AvgBookValue),
@@ -268,11 +269,3 @@ func nullStringVal(ns sql.NullString) string {
 	}
 	return ns.String
 }
-
-func safeInt32(v int64) int32 {
-	const maxInt32 = 1<<31 - 1
-	if v > maxInt32 {
-		return maxInt32
-	}
-	return int32(v) //nolint:gosec // bounds checked above
-}
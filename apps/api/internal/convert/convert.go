@@ -0,0 +1,13 @@
+// Package convert holds the numeric conversions shared between the database
+// layer, which stores integers as int64, and the proto and NEPSE APIs.
+package convert
+
+// SafeInt32 converts a count for a proto int32 field, capping it at the
+// int32 maximum.
+func SafeInt32(v int64) int32 {
+	const maxInt32 = 1<<31 - 1
+	if v > maxInt32 {
+		return maxInt32
+	}
+	return int32(v) //nolint:gosec // bounds checked above
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Compact folds the WAL back into the main file and rebuilds the database so
+// pages freed by deletes are returned to the filesystem. It blocks other
+// queries while it runs.
+func Compact(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE);"); err != nil {
+		return fmt.Errorf("checkpoint: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, "VACUUM;"); err != nil {
+		return fmt.Errorf("vacuum: %w", err)
+	}
+	return nil
+}
+
+// CopyTo writes a consistent copy of the database to path, which must not
+// exist yet.
+func CopyTo(ctx context.Context, db *sql.DB, path string) error {
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?;", path); err != nil {
+		return fmt.Errorf("copy to %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS holdings (
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    total_buy_cost REAL NOT NULL,
+    total_buy_quantity INTEGER NOT NULL,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (portfolio_id, stock_symbol)
+);
+
+CREATE TABLE IF NOT EXISTS holding_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    transaction_id INTEGER NOT NULL,
+    stock_symbol TEXT NOT NULL,
+    event_type TEXT NOT NULL CHECK(event_type IN ('ADDED', 'EDITED', 'DELETED')),
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    processed_at DATETIME
+);
+
+CREATE INDEX idx_holding_events_pending ON holding_events(portfolio_id, processed_at);
+
+INSERT INTO holdings (portfolio_id, stock_symbol, quantity, total_buy_cost, total_buy_quantity)
+SELECT
+    portfolio_id,
+    stock_symbol,
+    SUM(CASE WHEN transaction_type = 'BUY' THEN quantity ELSE -quantity END),
+    SUM(CASE WHEN transaction_type = 'BUY' THEN quantity * unit_price ELSE 0 END),
+    SUM(CASE WHEN transaction_type = 'BUY' THEN quantity ELSE 0 END)
+FROM transactions
+GROUP BY portfolio_id, stock_symbol;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_holding_events_pending;
+DROP TABLE IF EXISTS holding_events;
+DROP TABLE IF EXISTS holdings;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS alerts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    portfolio_id INTEGER REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT,
+    alert_type TEXT NOT NULL,
+    threshold REAL NOT NULL,
+    window_days INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT 1,
+    triggered_at DATETIME,
+    last_message TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_alerts_user_id ON alerts(user_id);
+
+CREATE TABLE IF NOT EXISTS index_values (
+    business_date TEXT PRIMARY KEY,
+    close_value REAL NOT NULL,
+    change_percent REAL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS index_values;
+DROP INDEX IF EXISTS idx_alerts_user_id;
+DROP TABLE IF EXISTS alerts;
+-- +goose StatementEnd
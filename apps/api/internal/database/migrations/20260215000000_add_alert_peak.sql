@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE alerts ADD COLUMN peak_price REAL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE alerts DROP COLUMN peak_price;
+-- +goose StatementEnd
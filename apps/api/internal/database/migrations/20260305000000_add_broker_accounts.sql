@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS broker_accounts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    broker_number INTEGER NOT NULL,
+    client_id TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, broker_number, client_id)
+);
+
+CREATE TABLE IF NOT EXISTS transaction_brokers (
+    transaction_id INTEGER PRIMARY KEY REFERENCES transactions(id) ON DELETE CASCADE,
+    broker_account_id INTEGER NOT NULL REFERENCES broker_accounts(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transaction_brokers;
+DROP TABLE IF EXISTS broker_accounts;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS holding_costs (
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    source TEXT NOT NULL CHECK(source IN ('WACC', 'MANUAL')),
+    avg_cost REAL NOT NULL CHECK(avg_cost > 0),
+    note TEXT NOT NULL DEFAULT '',
+    set_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (portfolio_id, stock_symbol, source)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS holding_costs;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS share_applications (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    issue_type TEXT NOT NULL CHECK (issue_type IN ('IPO', 'FPO', 'RIGHT')),
+    units INTEGER NOT NULL CHECK (units > 0),
+    price_per_unit REAL NOT NULL CHECK (price_per_unit > 0),
+    applied_on TEXT NOT NULL,
+    expected_allotment_on TEXT,
+    status TEXT NOT NULL DEFAULT 'PENDING',
+    allotted_units INTEGER NOT NULL DEFAULT 0,
+    transaction_id INTEGER REFERENCES transactions(id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    closed_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_share_applications_user_id ON share_applications(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_share_applications_user_id;
+DROP TABLE IF EXISTS share_applications;
+-- +goose StatementEnd
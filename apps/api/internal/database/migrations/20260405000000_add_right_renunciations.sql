@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS right_renunciations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    units INTEGER NOT NULL CHECK (units > 0),
+    renounced_on TEXT NOT NULL,
+    proceeds REAL NOT NULL DEFAULT 0 CHECK (proceeds >= 0),
+    proceeds_received_on TEXT,
+    note TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_right_renunciations_portfolio_id ON right_renunciations(portfolio_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_right_renunciations_portfolio_id;
+DROP TABLE IF EXISTS right_renunciations;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS bond_terms (
+    portfolio_id INTEGER NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    stock_symbol TEXT NOT NULL,
+    face_value REAL NOT NULL DEFAULT 1000 CHECK(face_value > 0),
+    coupon_rate REAL NOT NULL CHECK(coupon_rate >= 0),
+    coupons_per_year INTEGER NOT NULL DEFAULT 2 CHECK(coupons_per_year IN (1, 2, 4, 12)),
+    maturity_date TEXT NOT NULL,
+    set_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (portfolio_id, stock_symbol)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS bond_terms;
+-- +goose StatementEnd
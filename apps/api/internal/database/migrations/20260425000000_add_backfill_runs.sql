@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS backfill_runs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    started_at DATETIME NOT NULL,
+    finished_at DATETIME,
+    status TEXT NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'succeeded', 'failed')),
+    phase TEXT NOT NULL DEFAULT '',
+    phase_started_at DATETIME,
+    symbol TEXT NOT NULL DEFAULT '',
+    companies_done INTEGER NOT NULL DEFAULT 0,
+    companies_total INTEGER NOT NULL DEFAULT 0,
+    rows_fetched INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    updated_at DATETIME NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS backfill_runs;
+-- +goose StatementEnd
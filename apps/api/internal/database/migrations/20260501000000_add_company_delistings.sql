@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- companies.status carries NEPSE's current code (A active, S suspended,
+-- D delisted); this records when a delisting was first seen.
+CREATE TABLE IF NOT EXISTS company_delistings (
+    company_id INTEGER PRIMARY KEY REFERENCES companies(id) ON DELETE CASCADE,
+    delisted_on TEXT NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS company_delistings;
+-- +goose StatementEnd
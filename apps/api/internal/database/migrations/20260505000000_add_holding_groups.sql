@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS holding_groups (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    max_weight_percent REAL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+-- A member is a whole sector or a single symbol. Symbol members take
+-- precedence, so a stock can be pulled out of its sector's group.
+CREATE TABLE IF NOT EXISTS holding_group_members (
+    group_id INTEGER NOT NULL REFERENCES holding_groups(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('sector', 'symbol')),
+    value TEXT NOT NULL,
+    PRIMARY KEY (group_id, kind, value)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS holding_group_members;
+DROP TABLE IF EXISTS holding_groups;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Lets ListTransactionsPage read one window of a portfolio's history in
+-- order instead of sorting all of it.
+CREATE INDEX IF NOT EXISTS idx_transactions_portfolio_date
+    ON transactions(portfolio_id, transaction_date DESC, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transactions_portfolio_date;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- name: CreateAlert :one
+INSERT INTO alerts (user_id, portfolio_id, stock_symbol, alert_type, threshold, window_days, peak_price)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListAlertsByUser :many
+SELECT * FROM alerts
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListActiveAlerts :many
+SELECT * FROM alerts
+WHERE active = 1
+ORDER BY id;
+
+-- name: DeleteAlert :exec
+DELETE FROM alerts WHERE id = ? AND user_id = ?;
+
+-- name: MarkAlertTriggered :exec
+UPDATE alerts
+SET triggered_at = CURRENT_TIMESTAMP, last_message = ?
+WHERE id = ?;
+
+-- name: UpdateAlertPeak :exec
+UPDATE alerts SET peak_price = ? WHERE id = ?;
+
+-- name: UpsertIndexValue :exec
+INSERT INTO index_values (business_date, close_value, change_percent)
+VALUES (?, ?, ?)
+ON CONFLICT(business_date) DO UPDATE SET
+    close_value = excluded.close_value,
+    change_percent = excluded.change_percent;
+
+-- name: GetIndexValueOnOrBefore :one
+SELECT * FROM index_values
+WHERE business_date <= ?
+ORDER BY business_date DESC
+LIMIT 1;
+
+-- name: ListIndexValues :many
+SELECT * FROM index_values
+WHERE business_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+ORDER BY business_date;
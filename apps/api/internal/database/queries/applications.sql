@@ -0,0 +1,42 @@
+-- name: CreateShareApplication :one
+INSERT INTO share_applications (
+    user_id, portfolio_id, stock_symbol, issue_type, units, price_per_unit,
+    applied_on, expected_allotment_on, note
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetShareApplication :one
+SELECT * FROM share_applications WHERE id = ? AND user_id = ?;
+
+-- name: ListShareApplicationsByUser :many
+SELECT * FROM share_applications
+WHERE user_id = ?
+ORDER BY applied_on DESC, id DESC;
+
+-- name: CloseShareApplication :one
+UPDATE share_applications
+SET status = ?, allotted_units = ?, transaction_id = ?, closed_at = CURRENT_TIMESTAMP
+WHERE id = ? AND user_id = ? AND status = 'PENDING'
+RETURNING *;
+
+-- name: CreateRightRenunciation :one
+INSERT INTO right_renunciations (user_id, portfolio_id, stock_symbol, units, renounced_on, note)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: ListRightRenunciationsByUser :many
+SELECT * FROM right_renunciations
+WHERE user_id = ?
+ORDER BY renounced_on DESC, id DESC;
+
+-- name: ListRenunciationProceeds :many
+SELECT * FROM right_renunciations
+WHERE portfolio_id = ? AND proceeds_received_on >= ?
+ORDER BY proceeds_received_on;
+
+-- name: SetRenunciationProceeds :one
+UPDATE right_renunciations
+SET proceeds = ?, proceeds_received_on = ?
+WHERE id = ? AND user_id = ?
+RETURNING *;
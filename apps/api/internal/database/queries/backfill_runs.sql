@@ -0,0 +1,20 @@
+-- name: CreateBackfillRun :one
+INSERT INTO backfill_runs (started_at, updated_at)
+VALUES (?, ?)
+RETURNING id;
+
+-- name: UpdateBackfillProgress :exec
+UPDATE backfill_runs
+SET phase = ?, phase_started_at = ?, symbol = ?, companies_done = ?, companies_total = ?,
+    rows_fetched = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: FinishBackfillRun :exec
+UPDATE backfill_runs
+SET finished_at = ?, status = ?, error = ?, updated_at = ?
+WHERE id = ?;
+
+-- name: GetLatestBackfillRun :one
+SELECT * FROM backfill_runs
+ORDER BY started_at DESC, id DESC
+LIMIT 1;
@@ -0,0 +1,18 @@
+-- name: SetBondTerms :one
+INSERT INTO bond_terms (portfolio_id, stock_symbol, face_value, coupon_rate, coupons_per_year, maturity_date)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(portfolio_id, stock_symbol) DO UPDATE SET
+    face_value = excluded.face_value,
+    coupon_rate = excluded.coupon_rate,
+    coupons_per_year = excluded.coupons_per_year,
+    maturity_date = excluded.maturity_date,
+    set_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteBondTerms :exec
+DELETE FROM bond_terms WHERE portfolio_id = ? AND stock_symbol = ?;
+
+-- name: ListBondTerms :many
+SELECT * FROM bond_terms
+WHERE portfolio_id = ?
+ORDER BY maturity_date, stock_symbol;
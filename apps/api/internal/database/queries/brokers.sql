@@ -0,0 +1,30 @@
+-- name: CreateBrokerAccount :one
+INSERT INTO broker_accounts (user_id, broker_number, client_id, name)
+VALUES (?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetBrokerAccount :one
+SELECT * FROM broker_accounts WHERE id = ? AND user_id = ?;
+
+-- name: ListBrokerAccountsByUser :many
+SELECT * FROM broker_accounts
+WHERE user_id = ?
+ORDER BY broker_number, client_id;
+
+-- name: DeleteBrokerAccount :exec
+DELETE FROM broker_accounts WHERE id = ? AND user_id = ?;
+
+-- name: SetTransactionBroker :exec
+INSERT INTO transaction_brokers (transaction_id, broker_account_id)
+VALUES (?, ?)
+ON CONFLICT(transaction_id) DO UPDATE SET
+    broker_account_id = excluded.broker_account_id;
+
+-- name: ClearTransactionBroker :exec
+DELETE FROM transaction_brokers WHERE transaction_id = ?;
+
+-- name: ListTransactionBrokersByPortfolio :many
+SELECT tb.transaction_id, tb.broker_account_id
+FROM transaction_brokers tb
+JOIN transactions t ON t.id = tb.transaction_id
+WHERE t.portfolio_id = ?;
@@ -23,3 +23,11 @@ JOIN companies c ON c.id = ca.company_id
 WHERE c.symbol = ?
 ORDER BY ca.submitted_date DESC
 LIMIT 1;
+
+-- name: ListRecentCorporateActionsForPortfolio :many
+SELECT c.symbol, ca.fiscal_year, ca.bonus_percentage, ca.right_percentage, ca.cash_dividend, ca.submitted_date
+FROM corporate_actions ca
+JOIN companies c ON c.id = ca.company_id
+JOIN holdings h ON h.stock_symbol = c.symbol
+WHERE h.portfolio_id = sqlc.arg(portfolio_id) AND h.quantity > 0 AND ca.submitted_date >= sqlc.arg(since)
+ORDER BY ca.submitted_date DESC, c.symbol;
@@ -0,0 +1,16 @@
+-- name: SetHoldingCost :one
+INSERT INTO holding_costs (portfolio_id, stock_symbol, source, avg_cost, note)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(portfolio_id, stock_symbol, source) DO UPDATE SET
+    avg_cost = excluded.avg_cost,
+    note = excluded.note,
+    set_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeleteHoldingCost :exec
+DELETE FROM holding_costs WHERE portfolio_id = ? AND stock_symbol = ? AND source = ?;
+
+-- name: ListHoldingCosts :many
+SELECT * FROM holding_costs
+WHERE portfolio_id = ?
+ORDER BY stock_symbol, source;
@@ -0,0 +1,19 @@
+-- name: ListOrphanTransactionSymbols :many
+SELECT DISTINCT stock_symbol FROM transactions
+WHERE stock_symbol NOT IN (SELECT symbol FROM companies)
+ORDER BY stock_symbol;
+
+-- name: ListStaleHeldPrices :many
+SELECT c.symbol, CAST(MAX(p.business_date) AS TEXT) as last_price_date
+FROM companies c
+JOIN prices p ON p.company_id = c.id
+WHERE c.symbol IN (SELECT stock_symbol FROM holdings WHERE quantity > 0)
+  AND c.status != 'D'
+GROUP BY c.id, c.symbol
+HAVING MAX(p.business_date) < (SELECT MAX(business_date) FROM prices)
+ORDER BY c.symbol;
+
+-- name: ListOffPaisaTransactions :many
+SELECT * FROM transactions
+WHERE ABS(unit_price * 100 - ROUND(unit_price * 100)) > 0.000001
+ORDER BY id;
@@ -0,0 +1,31 @@
+-- name: CreateHoldingGroup :one
+INSERT INTO holding_groups (user_id, name, max_weight_percent)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: ListHoldingGroupsByUser :many
+SELECT * FROM holding_groups
+WHERE user_id = ?
+ORDER BY name;
+
+-- name: UpdateHoldingGroup :one
+UPDATE holding_groups SET name = ?, max_weight_percent = ?
+WHERE id = ? AND user_id = ?
+RETURNING *;
+
+-- name: DeleteHoldingGroup :exec
+DELETE FROM holding_groups WHERE id = ? AND user_id = ?;
+
+-- name: AddHoldingGroupMember :exec
+INSERT OR IGNORE INTO holding_group_members (group_id, kind, value)
+VALUES (?, ?, ?);
+
+-- name: ClearHoldingGroupMembers :exec
+DELETE FROM holding_group_members WHERE group_id = ?;
+
+-- name: ListHoldingGroupMembersByUser :many
+SELECT m.group_id, m.kind, m.value
+FROM holding_group_members m
+JOIN holding_groups g ON g.id = m.group_id
+WHERE g.user_id = ?
+ORDER BY m.value;
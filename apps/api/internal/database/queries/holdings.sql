@@ -0,0 +1,36 @@
+-- name: ListHoldings :many
+SELECT * FROM holdings
+WHERE portfolio_id = ? AND quantity > 0
+ORDER BY stock_symbol;
+
+-- name: ListAllHoldings :many
+SELECT * FROM holdings
+ORDER BY portfolio_id, stock_symbol;
+
+-- name: DeleteHolding :exec
+DELETE FROM holdings WHERE portfolio_id = ? AND stock_symbol = ?;
+
+-- name: InsertHolding :exec
+INSERT INTO holdings (portfolio_id, stock_symbol, quantity, total_buy_cost, total_buy_quantity)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: DeleteAllHoldings :exec
+DELETE FROM holdings;
+
+-- name: CreateHoldingEvent :exec
+INSERT INTO holding_events (portfolio_id, transaction_id, stock_symbol, event_type)
+VALUES (?, ?, ?, ?);
+
+-- name: ListPendingHoldingEvents :many
+SELECT * FROM holding_events
+WHERE portfolio_id = ? AND processed_at IS NULL
+ORDER BY id;
+
+-- name: MarkHoldingEventProcessed :exec
+UPDATE holding_events SET processed_at = CURRENT_TIMESTAMP WHERE id = ?;
+
+-- name: MarkAllHoldingEventsProcessed :exec
+UPDATE holding_events SET processed_at = CURRENT_TIMESTAMP WHERE processed_at IS NULL;
+
+-- name: PruneHoldingEvents :execrows
+DELETE FROM holding_events WHERE processed_at IS NOT NULL;
@@ -0,0 +1,13 @@
+-- name: RecordDelisting :exec
+INSERT INTO company_delistings (company_id, delisted_on)
+VALUES (?, ?)
+ON CONFLICT(company_id) DO NOTHING;
+
+-- name: ClearDelisting :exec
+DELETE FROM company_delistings WHERE company_id = ?;
+
+-- name: GetListingStatus :one
+SELECT c.status, d.delisted_on
+FROM companies c
+LEFT JOIN company_delistings d ON d.company_id = c.id
+WHERE c.symbol = ?;
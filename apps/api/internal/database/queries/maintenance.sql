@@ -0,0 +1,3 @@
+-- name: DatabaseSize :one
+SELECT CAST(page_count * page_size AS INTEGER) as size_bytes
+FROM pragma_page_count(), pragma_page_size();
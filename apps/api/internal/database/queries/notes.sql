@@ -0,0 +1,21 @@
+-- name: CreateNote :one
+INSERT INTO notes (user_id, note_date, title, body, stock_symbol, transaction_id)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetNote :one
+SELECT * FROM notes WHERE id = ? AND user_id = ?;
+
+-- name: ListNotesByUser :many
+SELECT * FROM notes
+WHERE user_id = ?
+ORDER BY note_date DESC, id DESC;
+
+-- name: UpdateNote :one
+UPDATE notes
+SET note_date = ?, title = ?, body = ?, stock_symbol = ?, transaction_id = ?, updated_at = CURRENT_TIMESTAMP
+WHERE id = ? AND user_id = ?
+RETURNING *;
+
+-- name: DeleteNote :exec
+DELETE FROM notes WHERE id = ? AND user_id = ?;
@@ -0,0 +1,28 @@
+-- name: CreateOrder :one
+INSERT INTO orders (user_id, portfolio_id, stock_symbol, side, quantity, limit_price, expires_on, note)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetOrder :one
+SELECT * FROM orders WHERE id = ? AND user_id = ?;
+
+-- name: ListOrdersByUser :many
+SELECT * FROM orders
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListOpenOrders :many
+SELECT * FROM orders
+WHERE status = 'OPEN'
+ORDER BY user_id, id;
+
+-- name: CloseOrder :one
+UPDATE orders
+SET status = ?, transaction_id = ?, closed_at = CURRENT_TIMESTAMP
+WHERE id = ? AND user_id = ? AND status = 'OPEN'
+RETURNING *;
+
+-- name: ExpireOrders :execrows
+UPDATE orders
+SET status = 'EXPIRED', closed_at = CURRENT_TIMESTAMP
+WHERE status = 'OPEN' AND expires_on < ?;
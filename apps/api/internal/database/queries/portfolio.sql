@@ -1,21 +1,27 @@
 -- name: GetUserByEmail :one
 SELECT id, email, password_hash, created_at FROM users WHERE email = ?;
 
+-- name: GetUser :one
+SELECT id, email, password_hash, created_at FROM users WHERE id = ?;
+
 -- name: CreateUser :one
 INSERT INTO users (email, password_hash)
 VALUES (?, ?)
 RETURNING id, email, password_hash, created_at;
 
+-- name: ListUsers :many
+SELECT id, email, password_hash, created_at FROM users ORDER BY id;
+
 -- name: ListPortfoliosByUser :many
-SELECT id, user_id, name, created_at FROM portfolios WHERE user_id = ? ORDER BY created_at DESC;
+SELECT id, user_id, name, created_at, paper FROM portfolios WHERE user_id = ? ORDER BY created_at DESC;
 
 -- name: GetPortfolio :one
-SELECT id, user_id, name, created_at FROM portfolios WHERE id = ? AND user_id = ?;
+SELECT id, user_id, name, created_at, paper FROM portfolios WHERE id = ? AND user_id = ?;
 
 -- name: CreatePortfolio :one
-INSERT INTO portfolios (user_id, name)
-VALUES (?, ?)
-RETURNING id, user_id, name, created_at;
+INSERT INTO portfolios (user_id, name, paper)
+VALUES (?, ?, ?)
+RETURNING id, user_id, name, created_at, paper;
 
 -- name: DeletePortfolio :exec
 DELETE FROM portfolios WHERE id = ? AND user_id = ?;
@@ -26,11 +32,55 @@ FROM transactions
 WHERE portfolio_id = ?
 ORDER BY transaction_date DESC, created_at DESC;
 
--- name: ListTransactionsBySymbol :many
+-- name: ListTransactionsPage :many
+SELECT t.id, t.portfolio_id, t.stock_symbol, t.transaction_type, t.quantity, t.unit_price, t.transaction_date, t.created_at,
+  CAST(EXISTS (
+    SELECT 1 FROM transactions o
+    WHERE o.portfolio_id = t.portfolio_id
+      AND o.stock_symbol = t.stock_symbol
+      AND substr(o.transaction_date, 1, 10) = substr(t.transaction_date, 1, 10)
+      AND o.transaction_type <> t.transaction_type
+  ) AS BOOLEAN) as intraday
+FROM transactions t
+WHERE t.portfolio_id = sqlc.arg(portfolio_id)
+  AND (sqlc.narg(stock_symbol) IS NULL OR t.stock_symbol = sqlc.narg(stock_symbol))
+  AND (sqlc.narg(tag_id) IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = t.id AND tt.tag_id = sqlc.narg(tag_id)
+  ))
+  AND (sqlc.narg(broker_account_id) IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_brokers tb WHERE tb.transaction_id = t.id AND tb.broker_account_id = sqlc.narg(broker_account_id)
+  ))
+ORDER BY t.transaction_date DESC, t.created_at DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: CountTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+WHERE t.portfolio_id = sqlc.arg(portfolio_id)
+  AND (sqlc.narg(stock_symbol) IS NULL OR t.stock_symbol = sqlc.narg(stock_symbol))
+  AND (sqlc.narg(tag_id) IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = t.id AND tt.tag_id = sqlc.narg(tag_id)
+  ))
+  AND (sqlc.narg(broker_account_id) IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_brokers tb WHERE tb.transaction_id = t.id AND tb.broker_account_id = sqlc.narg(broker_account_id)
+  ));
+
+-- name: ListTransactionsChronological :many
+SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
+FROM transactions
+WHERE portfolio_id = ?
+ORDER BY transaction_date ASC, transaction_type ASC, id ASC;
+
+-- name: ListSymbolTransactionsChronological :many
 SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
 FROM transactions
 WHERE portfolio_id = ? AND stock_symbol = ?
-ORDER BY transaction_date DESC, created_at DESC;
+ORDER BY transaction_date ASC, transaction_type ASC, id ASC;
+
+-- name: ListAllTransactionsChronological :many
+SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
+FROM transactions
+ORDER BY portfolio_id ASC, transaction_date ASC, transaction_type ASC, id ASC;
 
 -- name: GetTransaction :one
 SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
@@ -51,3 +51,18 @@ WHERE c.symbol = ?
 ORDER BY p.business_date DESC
 LIMIT 1;
 
+
+-- name: ListPortfolioClosePrices :many
+SELECT c.symbol, p.business_date, CAST(COALESCE(p.close_price, p.last_traded_price, 0) AS REAL) as close_price
+FROM prices p
+JOIN companies c ON c.id = p.company_id
+WHERE c.symbol IN (SELECT DISTINCT stock_symbol FROM transactions WHERE portfolio_id = sqlc.arg(portfolio_id))
+  AND p.business_date BETWEEN sqlc.arg(from_date) AND sqlc.arg(to_date)
+ORDER BY p.business_date, c.symbol;
+
+-- name: ListPricesByDate :many
+SELECT * FROM prices
+WHERE business_date = ?;
+
+-- name: DeletePricesBefore :execrows
+DELETE FROM prices WHERE business_date < ?;
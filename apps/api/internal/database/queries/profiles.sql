@@ -0,0 +1,30 @@
+-- name: CreateProfile :one
+INSERT INTO profiles (user_id, name, boid, relationship, minor)
+VALUES (?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetProfile :one
+SELECT * FROM profiles WHERE id = ? AND user_id = ?;
+
+-- name: ListProfilesByUser :many
+SELECT * FROM profiles
+WHERE user_id = ?
+ORDER BY name, id;
+
+-- name: DeleteProfile :exec
+DELETE FROM profiles WHERE id = ? AND user_id = ?;
+
+-- name: SetPortfolioProfile :exec
+INSERT INTO portfolio_profiles (portfolio_id, profile_id)
+VALUES (?, ?)
+ON CONFLICT(portfolio_id) DO UPDATE SET
+    profile_id = excluded.profile_id;
+
+-- name: ClearPortfolioProfile :exec
+DELETE FROM portfolio_profiles WHERE portfolio_id = ?;
+
+-- name: ListPortfolioProfilesByUser :many
+SELECT pp.portfolio_id, pp.profile_id
+FROM portfolio_profiles pp
+JOIN portfolios p ON p.id = pp.portfolio_id
+WHERE p.user_id = ?;
@@ -0,0 +1,12 @@
+-- name: CreateSyncRun :exec
+INSERT INTO sync_runs (started_at, finished_at, success, prices_synced, prices_skipped, error)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListRecentSyncRuns :many
+SELECT * FROM sync_runs
+ORDER BY started_at DESC
+LIMIT ?;
+
+-- name: PruneSyncRuns :execrows
+DELETE FROM sync_runs
+WHERE id NOT IN (SELECT id FROM sync_runs ORDER BY started_at DESC LIMIT ?);
@@ -0,0 +1,35 @@
+-- name: CreateTag :one
+INSERT INTO tags (user_id, name)
+VALUES (?, ?)
+RETURNING *;
+
+-- name: GetTag :one
+SELECT * FROM tags WHERE id = ? AND user_id = ?;
+
+-- name: ListTagsByUser :many
+SELECT * FROM tags
+WHERE user_id = ?
+ORDER BY name;
+
+-- name: RenameTag :one
+UPDATE tags SET name = ?
+WHERE id = ? AND user_id = ?
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags WHERE id = ? AND user_id = ?;
+
+-- name: AddTransactionTag :exec
+INSERT OR IGNORE INTO transaction_tags (transaction_id, tag_id)
+VALUES (?, ?);
+
+-- name: ClearTransactionTags :exec
+DELETE FROM transaction_tags WHERE transaction_id = ?;
+
+-- name: ListTransactionTagsByPortfolio :many
+SELECT tt.transaction_id, t.id AS tag_id, t.name
+FROM transaction_tags tt
+JOIN tags t ON t.id = tt.tag_id
+JOIN transactions x ON x.id = tt.transaction_id
+WHERE x.portfolio_id = ?
+ORDER BY t.name;
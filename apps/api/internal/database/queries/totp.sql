@@ -0,0 +1,33 @@
+-- name: GetUserTotp :one
+SELECT * FROM user_totp WHERE user_id = ?;
+
+-- name: SetPendingTotp :exec
+INSERT INTO user_totp (user_id, secret)
+VALUES (?, ?)
+ON CONFLICT(user_id) DO UPDATE SET
+    secret = excluded.secret,
+    enabled = 0,
+    last_used_step = 0,
+    created_at = CURRENT_TIMESTAMP;
+
+-- name: EnableTotp :exec
+UPDATE user_totp SET enabled = 1, last_used_step = ? WHERE user_id = ?;
+
+-- name: SetTotpLastUsedStep :execrows
+UPDATE user_totp SET last_used_step = sqlc.arg(last_used_step)
+WHERE user_id = sqlc.arg(user_id) AND last_used_step < sqlc.arg(last_used_step);
+
+-- name: DeleteUserTotp :exec
+DELETE FROM user_totp WHERE user_id = ?;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO totp_recovery_codes (user_id, code_hash) VALUES (?, ?);
+
+-- name: ListUnusedRecoveryCodes :many
+SELECT * FROM totp_recovery_codes WHERE user_id = ? AND used_at IS NULL;
+
+-- name: UseRecoveryCode :execrows
+UPDATE totp_recovery_codes SET used_at = CURRENT_TIMESTAMP WHERE id = ? AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM totp_recovery_codes WHERE user_id = ?;
@@ -0,0 +1,12 @@
+-- name: SetWidgetToken :exec
+INSERT INTO widget_tokens (user_id, token_hash)
+VALUES (?, ?)
+ON CONFLICT(user_id) DO UPDATE SET
+    token_hash = excluded.token_hash,
+    created_at = CURRENT_TIMESTAMP;
+
+-- name: GetWidgetTokenUser :one
+SELECT user_id FROM widget_tokens WHERE token_hash = ?;
+
+-- name: DeleteWidgetToken :exec
+DELETE FROM widget_tokens WHERE user_id = ?;
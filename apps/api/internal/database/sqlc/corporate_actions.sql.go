@@ -108,6 +108,59 @@ func (q *Queries) ListCorporateActionsByCompany(ctx context.Context, companyID i
 	return items, nil
 }
 
+const listRecentCorporateActionsForPortfolio = `-- name: ListRecentCorporateActionsForPortfolio :many
+SELECT c.symbol, ca.fiscal_year, ca.bonus_percentage, ca.right_percentage, ca.cash_dividend, ca.submitted_date
+FROM corporate_actions ca
+JOIN companies c ON c.id = ca.company_id
+JOIN holdings h ON h.stock_symbol = c.symbol
+WHERE h.portfolio_id = ? AND h.quantity > 0 AND ca.submitted_date >= ?
+ORDER BY ca.submitted_date DESC, c.symbol
+`
+
+type ListRecentCorporateActionsForPortfolioParams struct {
+	PortfolioID int64          `json:"portfolio_id"`
+	Since       sql.NullString `json:"since"`
+}
+
+type ListRecentCorporateActionsForPortfolioRow struct {
+	Symbol          string          `json:"symbol"`
+	FiscalYear      string          `json:"fiscal_year"`
+	BonusPercentage sql.NullFloat64 `json:"bonus_percentage"`
+	RightPercentage sql.NullFloat64 `json:"right_percentage"`
+	CashDividend    sql.NullFloat64 `json:"cash_dividend"`
+	SubmittedDate   sql.NullString  `json:"submitted_date"`
+}
+
+func (q *Queries) ListRecentCorporateActionsForPortfolio(ctx context.Context, arg ListRecentCorporateActionsForPortfolioParams) ([]ListRecentCorporateActionsForPortfolioRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRecentCorporateActionsForPortfolio, arg.PortfolioID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRecentCorporateActionsForPortfolioRow
+	for rows.Next() {
+		var i ListRecentCorporateActionsForPortfolioRow
+		if err := rows.Scan(
+			&i.Symbol,
+			&i.FiscalYear,
+			&i.BonusPercentage,
+			&i.RightPercentage,
+			&i.CashDividend,
+			&i.SubmittedDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertCorporateAction = `-- name: UpsertCorporateAction :exec
 INSERT INTO corporate_actions (company_id, fiscal_year, bonus_percentage, right_percentage, cash_dividend, submitted_date)
 VALUES (?, ?, ?, ?, ?, ?)
@@ -9,6 +9,55 @@ import (
 	"time"
 )
 
+type Alert struct {
+	ID          int64           `json:"id"`
+	UserID      int64           `json:"user_id"`
+	PortfolioID sql.NullInt64   `json:"portfolio_id"`
+	StockSymbol sql.NullString  `json:"stock_symbol"`
+	AlertType   string          `json:"alert_type"`
+	Threshold   float64         `json:"threshold"`
+	WindowDays  int64           `json:"window_days"`
+	Active      bool            `json:"active"`
+	TriggeredAt sql.NullTime    `json:"triggered_at"`
+	LastMessage sql.NullString  `json:"last_message"`
+	CreatedAt   sql.NullTime    `json:"created_at"`
+	PeakPrice   sql.NullFloat64 `json:"peak_price"`
+}
+
+type BackfillRun struct {
+	ID             int64        `json:"id"`
+	StartedAt      time.Time    `json:"started_at"`
+	FinishedAt     sql.NullTime `json:"finished_at"`
+	Status         string       `json:"status"`
+	Phase          string       `json:"phase"`
+	PhaseStartedAt sql.NullTime `json:"phase_started_at"`
+	Symbol         string       `json:"symbol"`
+	CompaniesDone  int64        `json:"companies_done"`
+	CompaniesTotal int64        `json:"companies_total"`
+	RowsFetched    int64        `json:"rows_fetched"`
+	Error          string       `json:"error"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+type BondTerm struct {
+	PortfolioID    int64        `json:"portfolio_id"`
+	StockSymbol    string       `json:"stock_symbol"`
+	FaceValue      float64      `json:"face_value"`
+	CouponRate     float64      `json:"coupon_rate"`
+	CouponsPerYear int64        `json:"coupons_per_year"`
+	MaturityDate   string       `json:"maturity_date"`
+	SetAt          sql.NullTime `json:"set_at"`
+}
+
+type BrokerAccount struct {
+	ID           int64        `json:"id"`
+	UserID       int64        `json:"user_id"`
+	BrokerNumber int64        `json:"broker_number"`
+	ClientID     string       `json:"client_id"`
+	Name         string       `json:"name"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+}
+
 type Company struct {
 	ID             int64          `json:"id"`
 	Name           string         `json:"name"`
@@ -22,6 +71,11 @@ type Company struct {
 	UpdatedAt      time.Time      `json:"updated_at"`
 }
 
+type CompanyDelisting struct {
+	CompanyID  int64  `json:"company_id"`
+	DelistedOn string `json:"delisted_on"`
+}
+
 type CorporateAction struct {
 	ID              int64           `json:"id"`
 	CompanyID       int64           `json:"company_id"`
@@ -46,6 +100,83 @@ type Fundamental struct {
 	UpdatedAt     time.Time       `json:"updated_at"`
 }
 
+type Holding struct {
+	PortfolioID      int64        `json:"portfolio_id"`
+	StockSymbol      string       `json:"stock_symbol"`
+	Quantity         int64        `json:"quantity"`
+	TotalBuyCost     float64      `json:"total_buy_cost"`
+	TotalBuyQuantity int64        `json:"total_buy_quantity"`
+	UpdatedAt        sql.NullTime `json:"updated_at"`
+}
+
+type HoldingCost struct {
+	PortfolioID int64        `json:"portfolio_id"`
+	StockSymbol string       `json:"stock_symbol"`
+	Source      string       `json:"source"`
+	AvgCost     float64      `json:"avg_cost"`
+	Note        string       `json:"note"`
+	SetAt       sql.NullTime `json:"set_at"`
+}
+
+type HoldingEvent struct {
+	ID            int64        `json:"id"`
+	PortfolioID   int64        `json:"portfolio_id"`
+	TransactionID int64        `json:"transaction_id"`
+	StockSymbol   string       `json:"stock_symbol"`
+	EventType     string       `json:"event_type"`
+	CreatedAt     sql.NullTime `json:"created_at"`
+	ProcessedAt   sql.NullTime `json:"processed_at"`
+}
+
+type HoldingGroup struct {
+	ID               int64           `json:"id"`
+	UserID           int64           `json:"user_id"`
+	Name             string          `json:"name"`
+	MaxWeightPercent sql.NullFloat64 `json:"max_weight_percent"`
+	CreatedAt        sql.NullTime    `json:"created_at"`
+}
+
+type HoldingGroupMember struct {
+	GroupID int64  `json:"group_id"`
+	Kind    string `json:"kind"`
+	Value   string `json:"value"`
+}
+
+type IndexValue struct {
+	BusinessDate  string          `json:"business_date"`
+	CloseValue    float64         `json:"close_value"`
+	ChangePercent sql.NullFloat64 `json:"change_percent"`
+	CreatedAt     sql.NullTime    `json:"created_at"`
+}
+
+type Note struct {
+	ID            int64          `json:"id"`
+	UserID        int64          `json:"user_id"`
+	NoteDate      string         `json:"note_date"`
+	Title         string         `json:"title"`
+	Body          string         `json:"body"`
+	StockSymbol   sql.NullString `json:"stock_symbol"`
+	TransactionID sql.NullInt64  `json:"transaction_id"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+}
+
+type Order struct {
+	ID            int64          `json:"id"`
+	UserID        int64          `json:"user_id"`
+	PortfolioID   int64          `json:"portfolio_id"`
+	StockSymbol   string         `json:"stock_symbol"`
+	Side          string         `json:"side"`
+	Quantity      int64          `json:"quantity"`
+	LimitPrice    float64        `json:"limit_price"`
+	ExpiresOn     sql.NullString `json:"expires_on"`
+	Status        string         `json:"status"`
+	Note          string         `json:"note"`
+	TransactionID sql.NullInt64  `json:"transaction_id"`
+	ClosedAt      sql.NullTime   `json:"closed_at"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+}
+
 type Ownership struct {
 	CompanyID       int64           `json:"company_id"`
 	ListedShares    sql.NullInt64   `json:"listed_shares"`
@@ -61,6 +192,12 @@ type Portfolio struct {
 	UserID    int64        `json:"user_id"`
 	Name      string       `json:"name"`
 	CreatedAt sql.NullTime `json:"created_at"`
+	Paper     bool         `json:"paper"`
+}
+
+type PortfolioProfile struct {
+	PortfolioID int64 `json:"portfolio_id"`
+	ProfileID   int64 `json:"profile_id"`
 }
 
 type Price struct {
@@ -81,6 +218,71 @@ type Price struct {
 	CreatedAt       time.Time       `json:"created_at"`
 }
 
+type Profile struct {
+	ID           int64        `json:"id"`
+	UserID       int64        `json:"user_id"`
+	Name         string       `json:"name"`
+	Boid         string       `json:"boid"`
+	Relationship string       `json:"relationship"`
+	Minor        bool         `json:"minor"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+}
+
+type RightRenunciation struct {
+	ID                 int64          `json:"id"`
+	UserID             int64          `json:"user_id"`
+	PortfolioID        int64          `json:"portfolio_id"`
+	StockSymbol        string         `json:"stock_symbol"`
+	Units              int64          `json:"units"`
+	RenouncedOn        string         `json:"renounced_on"`
+	Proceeds           float64        `json:"proceeds"`
+	ProceedsReceivedOn sql.NullString `json:"proceeds_received_on"`
+	Note               string         `json:"note"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+}
+
+type ShareApplication struct {
+	ID                  int64          `json:"id"`
+	UserID              int64          `json:"user_id"`
+	PortfolioID         int64          `json:"portfolio_id"`
+	StockSymbol         string         `json:"stock_symbol"`
+	IssueType           string         `json:"issue_type"`
+	Units               int64          `json:"units"`
+	PricePerUnit        float64        `json:"price_per_unit"`
+	AppliedOn           string         `json:"applied_on"`
+	ExpectedAllotmentOn sql.NullString `json:"expected_allotment_on"`
+	Status              string         `json:"status"`
+	AllottedUnits       int64          `json:"allotted_units"`
+	TransactionID       sql.NullInt64  `json:"transaction_id"`
+	Note                string         `json:"note"`
+	ClosedAt            sql.NullTime   `json:"closed_at"`
+	CreatedAt           sql.NullTime   `json:"created_at"`
+}
+
+type SyncRun struct {
+	ID            int64     `json:"id"`
+	StartedAt     time.Time `json:"started_at"`
+	FinishedAt    time.Time `json:"finished_at"`
+	Success       bool      `json:"success"`
+	PricesSynced  int64     `json:"prices_synced"`
+	PricesSkipped int64     `json:"prices_skipped"`
+	Error         string    `json:"error"`
+}
+
+type Tag struct {
+	ID        int64        `json:"id"`
+	UserID    int64        `json:"user_id"`
+	Name      string       `json:"name"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
+
+type TotpRecoveryCode struct {
+	ID       int64        `json:"id"`
+	UserID   int64        `json:"user_id"`
+	CodeHash string       `json:"code_hash"`
+	UsedAt   sql.NullTime `json:"used_at"`
+}
+
 type Transaction struct {
 	ID              int64        `json:"id"`
 	PortfolioID     int64        `json:"portfolio_id"`
@@ -92,9 +294,33 @@ type Transaction struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 }
 
+type TransactionBroker struct {
+	TransactionID   int64 `json:"transaction_id"`
+	BrokerAccountID int64 `json:"broker_account_id"`
+}
+
+type TransactionTag struct {
+	TransactionID int64 `json:"transaction_id"`
+	TagID         int64 `json:"tag_id"`
+}
+
 type User struct {
 	ID           int64        `json:"id"`
 	Email        string       `json:"email"`
 	PasswordHash string       `json:"password_hash"`
 	CreatedAt    sql.NullTime `json:"created_at"`
 }
+
+type UserTotp struct {
+	UserID       int64        `json:"user_id"`
+	Secret       string       `json:"secret"`
+	Enabled      bool         `json:"enabled"`
+	LastUsedStep int64        `json:"last_used_step"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+}
+
+type WidgetToken struct {
+	UserID    int64        `json:"user_id"`
+	TokenHash string       `json:"token_hash"`
+	CreatedAt sql.NullTime `json:"created_at"`
+}
@@ -11,25 +11,62 @@ import (
 	"time"
 )
 
+const countTransactions = `-- name: CountTransactions :one
+SELECT COUNT(*)
+FROM transactions t
+WHERE t.portfolio_id = ?
+  AND (? IS NULL OR t.stock_symbol = ?)
+  AND (? IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = t.id AND tt.tag_id = ?
+  ))
+  AND (? IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_brokers tb WHERE tb.transaction_id = t.id AND tb.broker_account_id = ?
+  ))
+`
+
+type CountTransactionsParams struct {
+	PortfolioID     int64          `json:"portfolio_id"`
+	StockSymbol     sql.NullString `json:"stock_symbol"`
+	TagID           sql.NullInt64  `json:"tag_id"`
+	BrokerAccountID sql.NullInt64  `json:"broker_account_id"`
+}
+
+func (q *Queries) CountTransactions(ctx context.Context, arg CountTransactionsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countTransactions,
+		arg.PortfolioID,
+		arg.StockSymbol,
+		arg.StockSymbol,
+		arg.TagID,
+		arg.TagID,
+		arg.BrokerAccountID,
+		arg.BrokerAccountID,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPortfolio = `-- name: CreatePortfolio :one
-INSERT INTO portfolios (user_id, name)
-VALUES (?, ?)
-RETURNING id, user_id, name, created_at
+INSERT INTO portfolios (user_id, name, paper)
+VALUES (?, ?, ?)
+RETURNING id, user_id, name, created_at, paper
 `
 
 type CreatePortfolioParams struct {
 	UserID int64  `json:"user_id"`
 	Name   string `json:"name"`
+	Paper  bool   `json:"paper"`
 }
 
 func (q *Queries) CreatePortfolio(ctx context.Context, arg CreatePortfolioParams) (Portfolio, error) {
-	row := q.db.QueryRowContext(ctx, createPortfolio, arg.UserID, arg.Name)
+	row := q.db.QueryRowContext(ctx, createPortfolio, arg.UserID, arg.Name, arg.Paper)
 	var i Portfolio
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
 		&i.Name,
 		&i.CreatedAt,
+		&i.Paper,
 	)
 	return i, err
 }
@@ -166,7 +203,7 @@ func (q *Queries) GetHoldingsByPortfolio(ctx context.Context, portfolioID int64)
 }
 
 const getPortfolio = `-- name: GetPortfolio :one
-SELECT id, user_id, name, created_at FROM portfolios WHERE id = ? AND user_id = ?
+SELECT id, user_id, name, created_at, paper FROM portfolios WHERE id = ? AND user_id = ?
 `
 
 type GetPortfolioParams struct {
@@ -182,6 +219,7 @@ func (q *Queries) GetPortfolio(ctx context.Context, arg GetPortfolioParams) (Por
 		&i.UserID,
 		&i.Name,
 		&i.CreatedAt,
+		&i.Paper,
 	)
 	return i, err
 }
@@ -208,6 +246,22 @@ func (q *Queries) GetTransaction(ctx context.Context, id int64) (Transaction, er
 	return i, err
 }
 
+const getUser = `-- name: GetUser :one
+SELECT id, email, password_hash, created_at FROM users WHERE id = ?
+`
+
+func (q *Queries) GetUser(ctx context.Context, id int64) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
 SELECT id, email, password_hash, created_at FROM users WHERE email = ?
 `
@@ -224,8 +278,46 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 	return i, err
 }
 
+const listAllTransactionsChronological = `-- name: ListAllTransactionsChronological :many
+SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
+FROM transactions
+ORDER BY portfolio_id ASC, transaction_date ASC, transaction_type ASC, id ASC
+`
+
+func (q *Queries) ListAllTransactionsChronological(ctx context.Context) ([]Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listAllTransactionsChronological)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.PortfolioID,
+			&i.StockSymbol,
+			&i.TransactionType,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.TransactionDate,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPortfoliosByUser = `-- name: ListPortfoliosByUser :many
-SELECT id, user_id, name, created_at FROM portfolios WHERE user_id = ? ORDER BY created_at DESC
+SELECT id, user_id, name, created_at, paper FROM portfolios WHERE user_id = ? ORDER BY created_at DESC
 `
 
 func (q *Queries) ListPortfoliosByUser(ctx context.Context, userID int64) ([]Portfolio, error) {
@@ -242,6 +334,51 @@ func (q *Queries) ListPortfoliosByUser(ctx context.Context, userID int64) ([]Por
 			&i.UserID,
 			&i.Name,
 			&i.CreatedAt,
+			&i.Paper,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSymbolTransactionsChronological = `-- name: ListSymbolTransactionsChronological :many
+SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
+FROM transactions
+WHERE portfolio_id = ? AND stock_symbol = ?
+ORDER BY transaction_date ASC, transaction_type ASC, id ASC
+`
+
+type ListSymbolTransactionsChronologicalParams struct {
+	PortfolioID int64  `json:"portfolio_id"`
+	StockSymbol string `json:"stock_symbol"`
+}
+
+func (q *Queries) ListSymbolTransactionsChronological(ctx context.Context, arg ListSymbolTransactionsChronologicalParams) ([]Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listSymbolTransactionsChronological, arg.PortfolioID, arg.StockSymbol)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transaction
+	for rows.Next() {
+		var i Transaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.PortfolioID,
+			&i.StockSymbol,
+			&i.TransactionType,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.TransactionDate,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -295,20 +432,15 @@ func (q *Queries) ListTransactionsByPortfolio(ctx context.Context, portfolioID i
 	return items, nil
 }
 
-const listTransactionsBySymbol = `-- name: ListTransactionsBySymbol :many
+const listTransactionsChronological = `-- name: ListTransactionsChronological :many
 SELECT id, portfolio_id, stock_symbol, transaction_type, quantity, unit_price, transaction_date, created_at
 FROM transactions
-WHERE portfolio_id = ? AND stock_symbol = ?
-ORDER BY transaction_date DESC, created_at DESC
+WHERE portfolio_id = ?
+ORDER BY transaction_date ASC, transaction_type ASC, id ASC
 `
 
-type ListTransactionsBySymbolParams struct {
-	PortfolioID int64  `json:"portfolio_id"`
-	StockSymbol string `json:"stock_symbol"`
-}
-
-func (q *Queries) ListTransactionsBySymbol(ctx context.Context, arg ListTransactionsBySymbolParams) ([]Transaction, error) {
-	rows, err := q.db.QueryContext(ctx, listTransactionsBySymbol, arg.PortfolioID, arg.StockSymbol)
+func (q *Queries) ListTransactionsChronological(ctx context.Context, portfolioID int64) ([]Transaction, error) {
+	rows, err := q.db.QueryContext(ctx, listTransactionsChronological, portfolioID)
 	if err != nil {
 		return nil, err
 	}
@@ -338,3 +470,121 @@ func (q *Queries) ListTransactionsBySymbol(ctx context.Context, arg ListTransact
 	}
 	return items, nil
 }
+
+const listTransactionsPage = `-- name: ListTransactionsPage :many
+SELECT t.id, t.portfolio_id, t.stock_symbol, t.transaction_type, t.quantity, t.unit_price, t.transaction_date, t.created_at,
+  CAST(EXISTS (
+    SELECT 1 FROM transactions o
+    WHERE o.portfolio_id = t.portfolio_id
+      AND o.stock_symbol = t.stock_symbol
+      AND substr(o.transaction_date, 1, 10) = substr(t.transaction_date, 1, 10)
+      AND o.transaction_type <> t.transaction_type
+  ) AS BOOLEAN) as intraday
+FROM transactions t
+WHERE t.portfolio_id = ?
+  AND (? IS NULL OR t.stock_symbol = ?)
+  AND (? IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = t.id AND tt.tag_id = ?
+  ))
+  AND (? IS NULL OR EXISTS (
+    SELECT 1 FROM transaction_brokers tb WHERE tb.transaction_id = t.id AND tb.broker_account_id = ?
+  ))
+ORDER BY t.transaction_date DESC, t.created_at DESC
+LIMIT ? OFFSET ?
+`
+
+type ListTransactionsPageParams struct {
+	PortfolioID     int64          `json:"portfolio_id"`
+	StockSymbol     sql.NullString `json:"stock_symbol"`
+	TagID           sql.NullInt64  `json:"tag_id"`
+	BrokerAccountID sql.NullInt64  `json:"broker_account_id"`
+	Limit           int64          `json:"limit"`
+	Offset          int64          `json:"offset"`
+}
+
+type ListTransactionsPageRow struct {
+	ID              int64     `json:"id"`
+	PortfolioID     int64     `json:"portfolio_id"`
+	StockSymbol     string    `json:"stock_symbol"`
+	TransactionType string    `json:"transaction_type"`
+	Quantity        int64     `json:"quantity"`
+	UnitPrice       float64   `json:"unit_price"`
+	TransactionDate time.Time `json:"transaction_date"`
+	CreatedAt       time.Time `json:"created_at"`
+	Intraday        bool      `json:"intraday"`
+}
+
+func (q *Queries) ListTransactionsPage(ctx context.Context, arg ListTransactionsPageParams) ([]ListTransactionsPageRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTransactionsPage,
+		arg.PortfolioID,
+		arg.StockSymbol,
+		arg.StockSymbol,
+		arg.TagID,
+		arg.TagID,
+		arg.BrokerAccountID,
+		arg.BrokerAccountID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTransactionsPageRow
+	for rows.Next() {
+		var i ListTransactionsPageRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PortfolioID,
+			&i.StockSymbol,
+			&i.TransactionType,
+			&i.Quantity,
+			&i.UnitPrice,
+			&i.TransactionDate,
+			&i.CreatedAt,
+			&i.Intraday,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, email, password_hash, created_at FROM users ORDER BY id
+`
+
+func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsers)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -11,6 +11,18 @@ import (
 	"time"
 )
 
+const deletePricesBefore = `-- name: DeletePricesBefore :execrows
+DELETE FROM prices WHERE business_date < ?
+`
+
+func (q *Queries) DeletePricesBefore(ctx context.Context, businessDate string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePricesBefore, businessDate)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getLatestPrice = `-- name: GetLatestPrice :one
 SELECT id, company_id, business_date, open_price, high_price, low_price, close_price, last_traded_price, previous_close, change_amount, change_percent, volume, turnover, trades, created_at FROM prices
 WHERE company_id = ?
@@ -177,6 +189,50 @@ func (q *Queries) ListLatestPrices(ctx context.Context) ([]Price, error) {
 	return items, nil
 }
 
+const listPortfolioClosePrices = `-- name: ListPortfolioClosePrices :many
+SELECT c.symbol, p.business_date, CAST(COALESCE(p.close_price, p.last_traded_price, 0) AS REAL) as close_price
+FROM prices p
+JOIN companies c ON c.id = p.company_id
+WHERE c.symbol IN (SELECT DISTINCT stock_symbol FROM transactions WHERE portfolio_id = ?)
+  AND p.business_date BETWEEN ? AND ?
+ORDER BY p.business_date, c.symbol
+`
+
+type ListPortfolioClosePricesParams struct {
+	PortfolioID int64  `json:"portfolio_id"`
+	FromDate    string `json:"from_date"`
+	ToDate      string `json:"to_date"`
+}
+
+type ListPortfolioClosePricesRow struct {
+	Symbol       string  `json:"symbol"`
+	BusinessDate string  `json:"business_date"`
+	ClosePrice   float64 `json:"close_price"`
+}
+
+func (q *Queries) ListPortfolioClosePrices(ctx context.Context, arg ListPortfolioClosePricesParams) ([]ListPortfolioClosePricesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPortfolioClosePrices, arg.PortfolioID, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPortfolioClosePricesRow
+	for rows.Next() {
+		var i ListPortfolioClosePricesRow
+		if err := rows.Scan(&i.Symbol, &i.BusinessDate, &i.ClosePrice); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPricesByCompany = `-- name: ListPricesByCompany :many
 SELECT id, company_id, business_date, open_price, high_price, low_price, close_price, last_traded_price, previous_close, change_amount, change_percent, volume, turnover, trades, created_at FROM prices
 WHERE company_id = ?
@@ -229,6 +285,50 @@ func (q *Queries) ListPricesByCompany(ctx context.Context, arg ListPricesByCompa
 	return items, nil
 }
 
+const listPricesByDate = `-- name: ListPricesByDate :many
+SELECT id, company_id, business_date, open_price, high_price, low_price, close_price, last_traded_price, previous_close, change_amount, change_percent, volume, turnover, trades, created_at FROM prices
+WHERE business_date = ?
+`
+
+func (q *Queries) ListPricesByDate(ctx context.Context, businessDate string) ([]Price, error) {
+	rows, err := q.db.QueryContext(ctx, listPricesByDate, businessDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Price
+	for rows.Next() {
+		var i Price
+		if err := rows.Scan(
+			&i.ID,
+			&i.CompanyID,
+			&i.BusinessDate,
+			&i.OpenPrice,
+			&i.HighPrice,
+			&i.LowPrice,
+			&i.ClosePrice,
+			&i.LastTradedPrice,
+			&i.PreviousClose,
+			&i.ChangeAmount,
+			&i.ChangePercent,
+			&i.Volume,
+			&i.Turnover,
+			&i.Trades,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const upsertPrice = `-- name: UpsertPrice :exec
 INSERT INTO prices (
     company_id, business_date, open_price, high_price, low_price, close_price,
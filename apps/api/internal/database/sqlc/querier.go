@@ -6,46 +6,156 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
+	AddHoldingGroupMember(ctx context.Context, arg AddHoldingGroupMemberParams) error
+	AddTransactionTag(ctx context.Context, arg AddTransactionTagParams) error
+	ClearDelisting(ctx context.Context, companyID int64) error
+	ClearHoldingGroupMembers(ctx context.Context, groupID int64) error
+	ClearPortfolioProfile(ctx context.Context, portfolioID int64) error
+	ClearTransactionBroker(ctx context.Context, transactionID int64) error
+	ClearTransactionTags(ctx context.Context, transactionID int64) error
+	CloseOrder(ctx context.Context, arg CloseOrderParams) (Order, error)
+	CloseShareApplication(ctx context.Context, arg CloseShareApplicationParams) (ShareApplication, error)
 	CountCompanies(ctx context.Context) (int64, error)
 	CountCompaniesBySearch(ctx context.Context, arg CountCompaniesBySearchParams) (int64, error)
 	CountCompaniesBySector(ctx context.Context, sector string) (int64, error)
+	CountTransactions(ctx context.Context, arg CountTransactionsParams) (int64, error)
+	CreateAlert(ctx context.Context, arg CreateAlertParams) (Alert, error)
+	CreateBackfillRun(ctx context.Context, arg CreateBackfillRunParams) (int64, error)
+	CreateBrokerAccount(ctx context.Context, arg CreateBrokerAccountParams) (BrokerAccount, error)
+	CreateHoldingEvent(ctx context.Context, arg CreateHoldingEventParams) error
+	CreateHoldingGroup(ctx context.Context, arg CreateHoldingGroupParams) (HoldingGroup, error)
+	CreateNote(ctx context.Context, arg CreateNoteParams) (Note, error)
+	CreateOrder(ctx context.Context, arg CreateOrderParams) (Order, error)
 	CreatePortfolio(ctx context.Context, arg CreatePortfolioParams) (Portfolio, error)
+	CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
+	CreateRightRenunciation(ctx context.Context, arg CreateRightRenunciationParams) (RightRenunciation, error)
+	CreateShareApplication(ctx context.Context, arg CreateShareApplicationParams) (ShareApplication, error)
+	CreateSyncRun(ctx context.Context, arg CreateSyncRunParams) error
+	CreateTag(ctx context.Context, arg CreateTagParams) (Tag, error)
 	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transaction, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	DatabaseSize(ctx context.Context) (int64, error)
+	DeleteAlert(ctx context.Context, arg DeleteAlertParams) error
+	DeleteAllHoldings(ctx context.Context) error
+	DeleteBondTerms(ctx context.Context, arg DeleteBondTermsParams) error
+	DeleteBrokerAccount(ctx context.Context, arg DeleteBrokerAccountParams) error
+	DeleteHolding(ctx context.Context, arg DeleteHoldingParams) error
+	DeleteHoldingCost(ctx context.Context, arg DeleteHoldingCostParams) error
+	DeleteHoldingGroup(ctx context.Context, arg DeleteHoldingGroupParams) error
+	DeleteNote(ctx context.Context, arg DeleteNoteParams) error
 	DeletePortfolio(ctx context.Context, arg DeletePortfolioParams) error
+	DeletePricesBefore(ctx context.Context, businessDate string) (int64, error)
+	DeleteProfile(ctx context.Context, arg DeleteProfileParams) error
+	DeleteRecoveryCodes(ctx context.Context, userID int64) error
+	DeleteTag(ctx context.Context, arg DeleteTagParams) error
 	DeleteTransaction(ctx context.Context, id int64) error
+	DeleteUserTotp(ctx context.Context, userID int64) error
+	DeleteWidgetToken(ctx context.Context, userID int64) error
+	EnableTotp(ctx context.Context, arg EnableTotpParams) error
+	ExpireOrders(ctx context.Context, expiresOn sql.NullString) (int64, error)
+	FinishBackfillRun(ctx context.Context, arg FinishBackfillRunParams) error
+	GetBrokerAccount(ctx context.Context, arg GetBrokerAccountParams) (BrokerAccount, error)
 	GetCompany(ctx context.Context, symbol string) (Company, error)
 	GetCorporateActionsBySymbol(ctx context.Context, symbol string) ([]CorporateAction, error)
 	GetHoldingsByPortfolio(ctx context.Context, portfolioID int64) ([]GetHoldingsByPortfolioRow, error)
+	GetIndexValueOnOrBefore(ctx context.Context, businessDate string) (IndexValue, error)
+	GetLatestBackfillRun(ctx context.Context) (BackfillRun, error)
 	GetLatestCorporateAction(ctx context.Context, symbol string) (CorporateAction, error)
 	GetLatestFundamental(ctx context.Context, companyID int64) (Fundamental, error)
 	GetLatestPrice(ctx context.Context, companyID int64) (Price, error)
 	GetLatestPriceBySymbol(ctx context.Context, symbol string) (GetLatestPriceBySymbolRow, error)
+	GetListingStatus(ctx context.Context, symbol string) (GetListingStatusRow, error)
+	GetNote(ctx context.Context, arg GetNoteParams) (Note, error)
+	GetOrder(ctx context.Context, arg GetOrderParams) (Order, error)
 	GetOwnership(ctx context.Context, companyID int64) (Ownership, error)
 	GetOwnershipBySymbol(ctx context.Context, symbol string) (Ownership, error)
 	GetPortfolio(ctx context.Context, arg GetPortfolioParams) (Portfolio, error)
 	GetPriceByDate(ctx context.Context, arg GetPriceByDateParams) (Price, error)
+	GetProfile(ctx context.Context, arg GetProfileParams) (Profile, error)
 	GetSectorStats(ctx context.Context, sector string) (GetSectorStatsRow, error)
+	GetShareApplication(ctx context.Context, arg GetShareApplicationParams) (ShareApplication, error)
+	GetTag(ctx context.Context, arg GetTagParams) (Tag, error)
 	GetTransaction(ctx context.Context, id int64) (Transaction, error)
+	GetUser(ctx context.Context, id int64) (User, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserTotp(ctx context.Context, userID int64) (UserTotp, error)
+	GetWidgetTokenUser(ctx context.Context, tokenHash string) (int64, error)
+	InsertHolding(ctx context.Context, arg InsertHoldingParams) error
+	ListActiveAlerts(ctx context.Context) ([]Alert, error)
+	ListAlertsByUser(ctx context.Context, userID int64) ([]Alert, error)
+	ListAllHoldings(ctx context.Context) ([]Holding, error)
+	ListAllTransactionsChronological(ctx context.Context) ([]Transaction, error)
+	ListBondTerms(ctx context.Context, portfolioID int64) ([]BondTerm, error)
+	ListBrokerAccountsByUser(ctx context.Context, userID int64) ([]BrokerAccount, error)
 	ListCompanies(ctx context.Context, arg ListCompaniesParams) ([]ListCompaniesRow, error)
 	ListCompaniesBySector(ctx context.Context, arg ListCompaniesBySectorParams) ([]Company, error)
 	ListCorporateActionsByCompany(ctx context.Context, companyID int64) ([]CorporateAction, error)
 	ListFundamentalsByCompany(ctx context.Context, companyID int64) ([]Fundamental, error)
+	ListHoldingCosts(ctx context.Context, portfolioID int64) ([]HoldingCost, error)
+	ListHoldingGroupMembersByUser(ctx context.Context, userID int64) ([]HoldingGroupMember, error)
+	ListHoldingGroupsByUser(ctx context.Context, userID int64) ([]HoldingGroup, error)
+	ListHoldings(ctx context.Context, portfolioID int64) ([]Holding, error)
+	ListIndexValues(ctx context.Context, arg ListIndexValuesParams) ([]IndexValue, error)
 	ListLatestPrices(ctx context.Context) ([]Price, error)
+	ListNotesByUser(ctx context.Context, userID int64) ([]Note, error)
+	ListOffPaisaTransactions(ctx context.Context) ([]Transaction, error)
+	ListOpenOrders(ctx context.Context) ([]Order, error)
+	ListOrdersByUser(ctx context.Context, userID int64) ([]Order, error)
+	ListOrphanTransactionSymbols(ctx context.Context) ([]string, error)
+	ListPendingHoldingEvents(ctx context.Context, portfolioID int64) ([]HoldingEvent, error)
+	ListPortfolioClosePrices(ctx context.Context, arg ListPortfolioClosePricesParams) ([]ListPortfolioClosePricesRow, error)
+	ListPortfolioProfilesByUser(ctx context.Context, userID int64) ([]PortfolioProfile, error)
 	ListPortfoliosByUser(ctx context.Context, userID int64) ([]Portfolio, error)
 	ListPricesByCompany(ctx context.Context, arg ListPricesByCompanyParams) ([]Price, error)
+	ListPricesByDate(ctx context.Context, businessDate string) ([]Price, error)
+	ListProfilesByUser(ctx context.Context, userID int64) ([]Profile, error)
+	ListRecentCorporateActionsForPortfolio(ctx context.Context, arg ListRecentCorporateActionsForPortfolioParams) ([]ListRecentCorporateActionsForPortfolioRow, error)
+	ListRecentSyncRuns(ctx context.Context, limit int64) ([]SyncRun, error)
+	ListRenunciationProceeds(ctx context.Context, arg ListRenunciationProceedsParams) ([]RightRenunciation, error)
+	ListRightRenunciationsByUser(ctx context.Context, userID int64) ([]RightRenunciation, error)
+	ListShareApplicationsByUser(ctx context.Context, userID int64) ([]ShareApplication, error)
+	ListStaleHeldPrices(ctx context.Context) ([]ListStaleHeldPricesRow, error)
+	ListSymbolTransactionsChronological(ctx context.Context, arg ListSymbolTransactionsChronologicalParams) ([]Transaction, error)
+	ListTagsByUser(ctx context.Context, userID int64) ([]Tag, error)
+	ListTransactionBrokersByPortfolio(ctx context.Context, portfolioID int64) ([]TransactionBroker, error)
+	ListTransactionTagsByPortfolio(ctx context.Context, portfolioID int64) ([]ListTransactionTagsByPortfolioRow, error)
 	ListTransactionsByPortfolio(ctx context.Context, portfolioID int64) ([]Transaction, error)
-	ListTransactionsBySymbol(ctx context.Context, arg ListTransactionsBySymbolParams) ([]Transaction, error)
+	ListTransactionsChronological(ctx context.Context, portfolioID int64) ([]Transaction, error)
+	ListTransactionsPage(ctx context.Context, arg ListTransactionsPageParams) ([]ListTransactionsPageRow, error)
+	ListUnusedRecoveryCodes(ctx context.Context, userID int64) ([]TotpRecoveryCode, error)
+	ListUsers(ctx context.Context) ([]User, error)
+	MarkAlertTriggered(ctx context.Context, arg MarkAlertTriggeredParams) error
+	MarkAllHoldingEventsProcessed(ctx context.Context) error
+	MarkHoldingEventProcessed(ctx context.Context, id int64) error
+	PruneHoldingEvents(ctx context.Context) (int64, error)
+	PruneSyncRuns(ctx context.Context, limit int64) (int64, error)
+	RecordDelisting(ctx context.Context, arg RecordDelistingParams) error
+	RenameTag(ctx context.Context, arg RenameTagParams) (Tag, error)
 	SearchCompanies(ctx context.Context, arg SearchCompaniesParams) ([]Company, error)
+	SetBondTerms(ctx context.Context, arg SetBondTermsParams) (BondTerm, error)
+	SetHoldingCost(ctx context.Context, arg SetHoldingCostParams) (HoldingCost, error)
+	SetPendingTotp(ctx context.Context, arg SetPendingTotpParams) error
+	SetPortfolioProfile(ctx context.Context, arg SetPortfolioProfileParams) error
+	SetRenunciationProceeds(ctx context.Context, arg SetRenunciationProceedsParams) (RightRenunciation, error)
+	SetTotpLastUsedStep(ctx context.Context, arg SetTotpLastUsedStepParams) (int64, error)
+	SetTransactionBroker(ctx context.Context, arg SetTransactionBrokerParams) error
+	SetWidgetToken(ctx context.Context, arg SetWidgetTokenParams) error
+	UpdateAlertPeak(ctx context.Context, arg UpdateAlertPeakParams) error
+	UpdateBackfillProgress(ctx context.Context, arg UpdateBackfillProgressParams) error
+	UpdateHoldingGroup(ctx context.Context, arg UpdateHoldingGroupParams) (HoldingGroup, error)
+	UpdateNote(ctx context.Context, arg UpdateNoteParams) (Note, error)
 	UpsertCompany(ctx context.Context, arg UpsertCompanyParams) error
 	UpsertCorporateAction(ctx context.Context, arg UpsertCorporateActionParams) error
 	UpsertFundamental(ctx context.Context, arg UpsertFundamentalParams) error
+	UpsertIndexValue(ctx context.Context, arg UpsertIndexValueParams) error
 	UpsertOwnership(ctx context.Context, arg UpsertOwnershipParams) error
 	UpsertPrice(ctx context.Context, arg UpsertPriceParams) error
+	UseRecoveryCode(ctx context.Context, id int64) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
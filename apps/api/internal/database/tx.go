@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// WithTx runs fn against queries bound to one transaction, committing when
+// fn returns nil and rolling back otherwise. The pool holds one connection,
+// so fn must only use the queries it is given; anything else waits on the
+// transaction forever.
+func WithTx(ctx context.Context, db *sql.DB, fn func(*sqlc.Queries) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(sqlc.New(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,100 @@
+package digest
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+)
+
+const (
+	chartWidth   = 640
+	chartHeight  = 240
+	chartPadding = 16
+)
+
+var (
+	chartBackground = color.RGBA{255, 255, 255, 255}
+	chartAxis       = color.RGBA{200, 200, 200, 255}
+	chartValue      = color.RGBA{37, 99, 235, 255}
+	chartCost       = color.RGBA{156, 163, 175, 255}
+)
+
+// renderEquityCurve draws portfolio value against cost as a PNG line chart.
+// It is deliberately plain: no labels, so it needs no font rendering.
+func renderEquityCurve(points []*ntxv1.PortfolioHistoryPoint) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, chartWidth, chartHeight))
+	draw.Draw(img, img.Bounds(), &image.Uniform{C: chartBackground}, image.Point{}, draw.Src)
+
+	bottom := chartHeight - chartPadding
+	drawLine(img, chartPadding, bottom, chartWidth-chartPadding, bottom, chartAxis)
+	drawLine(img, chartPadding, chartPadding, chartPadding, bottom, chartAxis)
+
+	if len(points) > 1 {
+		lo, hi := points[0].Value, points[0].Value
+		for _, p := range points {
+			lo = min(lo, p.Value, p.Cost)
+			hi = max(hi, p.Value, p.Cost)
+		}
+		if hi == lo {
+			hi = lo + 1
+		}
+
+		x := func(i int) int {
+			return chartPadding + i*(chartWidth-2*chartPadding)/(len(points)-1)
+		}
+		y := func(v float64) int {
+			return bottom - int((v-lo)/(hi-lo)*float64(chartHeight-2*chartPadding))
+		}
+
+		for i := 1; i < len(points); i++ {
+			drawLine(img, x(i-1), y(points[i-1].Cost), x(i), y(points[i].Cost), chartCost)
+			drawLine(img, x(i-1), y(points[i-1].Value), x(i), y(points[i].Value), chartValue)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// drawLine plots a line with Bresenham's algorithm.
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+
+	for e := dx + dy; ; {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
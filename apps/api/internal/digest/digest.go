@@ -0,0 +1,262 @@
+// Package digest composes the after-close summary sent to each user.
+package digest
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/flags"
+	"github.com/voidarchive/ntx/internal/notify"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+const (
+	// moversPerSide is how many gainers and losers are listed per portfolio.
+	moversPerSide = 3
+
+	// announcementDays is how far back corporate actions count as news.
+	announcementDays = 30
+
+	// maturityReminderDays is how far ahead bond maturities are flagged.
+	maturityReminderDays = 30
+)
+
+// Digest builds and sends the daily digest.
+type Digest struct {
+	queries    *sqlc.Queries
+	portfolios *portfolio.PortfolioService
+	notifier   *notify.Notifier
+	private    bool // leave out rupee amounts
+}
+
+// New creates a Digest.
+func New(queries *sqlc.Queries, portfolios *portfolio.PortfolioService, notifier *notify.Notifier) *Digest {
+	return &Digest{
+		queries:    queries,
+		portfolios: portfolios,
+		notifier:   notifier,
+		private:    flags.PrivateReports.Enabled(),
+	}
+}
+
+// Run sends a digest to every user with at least one portfolio. It should run
+// after alert evaluation so the day's triggered alerts are included.
+func (d *Digest) Run(ctx context.Context) error {
+	users, err := d.queries.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, u := range users {
+		body, err := d.compose(ctx, u.ID, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", u.ID, err))
+			continue
+		}
+		if body == "" {
+			continue
+		}
+
+		err = d.notifier.Send(ctx, notify.Message{
+			To:      u.Email,
+			Subject: "NTX daily digest for " + worker.BusinessDate(now),
+			Body:    body,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", u.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// compose renders the digest for one user, or "" if they have no portfolios.
+func (d *Digest) compose(ctx context.Context, userID int64, now time.Time) (string, error) {
+	portfolios, err := d.queries.ListPortfoliosByUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if len(portfolios) == 0 {
+		return "", nil
+	}
+
+	today := worker.BusinessDate(now)
+	since := now.AddDate(0, 0, -announcementDays).Format("2006-01-02")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Daily digest for %s\n", today)
+
+	var announcements, maturing []string
+	seen := make(map[string]bool)
+	for _, p := range portfolios {
+		holdings, err := d.portfolios.Holdings(ctx, p.ID)
+		if err != nil {
+			return "", fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+		writePortfolio(&b, portfolioName(p), holdings, d.private)
+
+		lines, err := d.maturingBonds(ctx, p, holdings, now)
+		if err != nil {
+			return "", fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+		maturing = append(maturing, lines...)
+
+		params := sqlc.ListRecentCorporateActionsForPortfolioParams{
+			PortfolioID: p.ID,
+			Since:       sql.NullString{String: since, Valid: true},
+		}
+		actions, err := d.queries.ListRecentCorporateActionsForPortfolio(ctx, params)
+		if err != nil {
+			return "", fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+		for _, a := range actions {
+			line := describeAction(a)
+			if seen[line] {
+				continue
+			}
+			seen[line] = true
+			announcements = append(announcements, line)
+		}
+	}
+
+	alerts, err := d.queries.ListAlertsByUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	var triggered []string
+	for _, a := range alerts {
+		if a.TriggeredAt.Valid && worker.BusinessDate(a.TriggeredAt.Time) == today {
+			triggered = append(triggered, a.LastMessage.String)
+		}
+	}
+
+	writeSection(&b, "Triggered alerts", triggered)
+	writeSection(&b, "Bonds maturing in the next 30 days", maturing)
+	// NEPSE doesn't publish book closure dates, so newly announced corporate
+	// actions are the earliest warning of an upcoming closure.
+	writeSection(&b, "Corporate actions announced in the last 30 days", announcements)
+
+	return b.String(), nil
+}
+
+// maturingBonds describes the bonds held in p that mature within
+// maturityReminderDays of now.
+func (d *Digest) maturingBonds(
+	ctx context.Context,
+	p sqlc.Portfolio,
+	holdings []*ntxv1.Holding,
+	now time.Time,
+) ([]string, error) {
+	terms, err := d.queries.ListBondTerms(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+	quantities := make(map[string]int64, len(holdings))
+	for _, h := range holdings {
+		quantities[h.StockSymbol] = h.Quantity
+	}
+
+	today := worker.BusinessDate(now)
+	horizon := now.AddDate(0, 0, maturityReminderDays).Format("2006-01-02")
+	var lines []string
+	for _, t := range terms {
+		qty := quantities[t.StockSymbol]
+		if qty <= 0 || t.MaturityDate < today || t.MaturityDate > horizon {
+			continue
+		}
+		line := fmt.Sprintf("%s in %s matures on %s", t.StockSymbol, portfolioName(p), t.MaturityDate)
+		if !d.private {
+			line += fmt.Sprintf(": Rs.%.2f face value", t.FaceValue*float64(qty))
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// portfolioName marks paper portfolios so hypothetical trades are never
+// mistaken for real holdings.
+func portfolioName(p sqlc.Portfolio) string {
+	if p.Paper {
+		return p.Name + " (paper)"
+	}
+	return p.Name
+}
+
+func writePortfolio(b *strings.Builder, name string, holdings []*ntxv1.Holding, private bool) {
+	var value, dayChange float64
+	for _, h := range holdings {
+		value += h.TotalValue
+		dayChange += h.DayChangeValue
+	}
+	dayChangePercent := 0.0
+	if previous := value - dayChange; previous > 0 {
+		dayChangePercent = (dayChange / previous) * 100
+	}
+	summary := fmt.Sprintf("Rs.%.2f (%+.2f, %+.2f%% today)", value, dayChange, dayChangePercent)
+	if private {
+		summary = fmt.Sprintf("%+.2f%% today", dayChangePercent)
+	}
+	fmt.Fprintf(b, "\n%s: %s\n", name, summary)
+
+	sorted := slices.Clone(holdings)
+	slices.SortFunc(sorted, func(x, y *ntxv1.Holding) int {
+		return cmp.Compare(y.DayChangePercent, x.DayChangePercent)
+	})
+
+	var gainers, losers []string
+	for _, h := range sorted {
+		if h.DayChangePercent > 0 && len(gainers) < moversPerSide {
+			gainers = append(gainers, fmt.Sprintf("%s %+.2f%%", h.StockSymbol, h.DayChangePercent))
+		}
+	}
+	for i := len(sorted) - 1; i >= 0; i-- {
+		h := sorted[i]
+		if h.DayChangePercent < 0 && len(losers) < moversPerSide {
+			losers = append(losers, fmt.Sprintf("%s %+.2f%%", h.StockSymbol, h.DayChangePercent))
+		}
+	}
+	if len(gainers) > 0 {
+		fmt.Fprintf(b, "  Top gainers: %s\n", strings.Join(gainers, ", "))
+	}
+	if len(losers) > 0 {
+		fmt.Fprintf(b, "  Top losers: %s\n", strings.Join(losers, ", "))
+	}
+}
+
+func writeSection(b *strings.Builder, title string, lines []string) {
+	if len(lines) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n%s:\n", title)
+	for _, l := range lines {
+		fmt.Fprintf(b, "  - %s\n", l)
+	}
+}
+
+func describeAction(a sqlc.ListRecentCorporateActionsForPortfolioRow) string {
+	var parts []string
+	if a.BonusPercentage.Float64 > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f%% bonus", a.BonusPercentage.Float64))
+	}
+	if a.CashDividend.Float64 > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f%% cash dividend", a.CashDividend.Float64))
+	}
+	if a.RightPercentage.Float64 > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f%% right shares", a.RightPercentage.Float64))
+	}
+	if len(parts) == 0 {
+		parts = append(parts, "corporate action")
+	}
+	return fmt.Sprintf("%s FY %s: %s (announced %s)",
+		a.Symbol, a.FiscalYear, strings.Join(parts, ", "), a.SubmittedDate.String)
+}
@@ -0,0 +1,229 @@
+package digest
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"slices"
+	"strings"
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/notify"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// weeklyHistoryDays is the span of the equity curve in the weekly recap.
+const weeklyHistoryDays = 90
+
+var weeklyTemplate = template.Must(template.New("weekly").Parse(`<!DOCTYPE html>
+<html><body style="font-family: sans-serif">
+<h2>Weekly recap for {{.Date}}</h2>
+{{range .Portfolios}}
+<h3>{{.Name}}</h3>
+<p>{{with .Value}}Value Rs.{{.}}, {{end}}{{.Change}} this week</p>
+<img src="{{.Chart}}" alt="Value (blue) against cost (grey), last 90 days" width="640" height="240">
+<table cellpadding="4">
+<tr><th align="left">Sector</th><th>Last week</th><th>This week</th><th>Change</th></tr>
+{{range .Sectors}}<tr><td>{{.Sector}}</td><td>{{.Before}}</td><td>{{.After}}</td><td>{{.Delta}}</td></tr>
+{{end}}</table>
+{{end}}
+</body></html>
+`))
+
+type weeklyPortfolio struct {
+	Name    string
+	Value   string // empty in private reports
+	Change  string
+	Chart   template.URL
+	Sectors []sectorShift
+}
+
+// sectorShift is a sector's share of the portfolio a week ago and now.
+type sectorShift struct {
+	Sector string
+	Before string
+	After  string
+	Delta  string
+}
+
+// RunWeekly sends each user a recap of the week with an equity curve and
+// the change in sector allocation since the previous week.
+func (d *Digest) RunWeekly(ctx context.Context) error {
+	users, err := d.queries.ListUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+
+	now := time.Now()
+	var errs []error
+	for _, u := range users {
+		msg, err := d.composeWeekly(ctx, u, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", u.ID, err))
+			continue
+		}
+		if msg == nil {
+			continue
+		}
+		if err := d.notifier.Send(ctx, *msg); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", u.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// composeWeekly builds the recap for one user, or nil if they have no portfolios.
+func (d *Digest) composeWeekly(ctx context.Context, u sqlc.User, now time.Time) (*notify.Message, error) {
+	portfolios, err := d.queries.ListPortfoliosByUser(ctx, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(portfolios) == 0 {
+		return nil, nil
+	}
+
+	date := worker.BusinessDate(now)
+	weekAgo := now.AddDate(0, 0, -7)
+	msg := &notify.Message{To: u.Email, Subject: "NTX weekly recap for " + date}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Weekly recap for %s\n", date)
+
+	var views []weeklyPortfolio
+	for _, p := range portfolios {
+		points, err := d.portfolios.History(ctx, p.ID, now.AddDate(0, 0, -weeklyHistoryDays), now)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+		before, err := d.portfolios.SectorValues(ctx, p.ID, weekAgo)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+		after, err := d.portfolios.SectorValues(ctx, p.ID, now)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio %d: %w", p.ID, err)
+		}
+
+		chart, err := renderEquityCurve(points)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio %d chart: %w", p.ID, err)
+		}
+		chartName := fmt.Sprintf("equity-%d", p.ID)
+		msg.Images = append(msg.Images, notify.Image{Name: chartName, Data: chart})
+
+		view := weeklyPortfolio{
+			Name:    portfolioName(p),
+			Value:   "0.00",
+			Change:  "no change",
+			Chart:   template.URL("cid:" + chartName), //nolint:gosec // fixed scheme and generated name
+			Sectors: sectorShifts(before, after),
+		}
+		if len(points) > 0 {
+			last := points[len(points)-1]
+			view.Value = fmt.Sprintf("%.2f", last.Value)
+			view.Change = weeklyChange(points, weekAgo.Format("2006-01-02"), d.private)
+		}
+		if d.private {
+			view.Value = ""
+		}
+		views = append(views, view)
+
+		fmt.Fprintf(&text, "\n%s: ", view.Name)
+		if view.Value != "" {
+			fmt.Fprintf(&text, "Rs.%s, ", view.Value)
+		}
+		fmt.Fprintf(&text, "%s this week\n", view.Change)
+		for _, s := range view.Sectors {
+			fmt.Fprintf(&text, "  %s: %s -> %s (%s)\n", s.Sector, s.Before, s.After, s.Delta)
+		}
+	}
+
+	var html bytes.Buffer
+	err = weeklyTemplate.Execute(&html, struct {
+		Date       string
+		Portfolios []weeklyPortfolio
+	}{date, views})
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Body = text.String()
+	msg.HTML = html.String()
+	return msg, nil
+}
+
+// weeklyChange describes the P&L made since the last point on or before
+// weekAgo. P&L is used rather than value so deposits don't count as gains.
+// Private reports give only the percentage.
+func weeklyChange(points []*ntxv1.PortfolioHistoryPoint, weekAgo string, private bool) string {
+	start := points[0]
+	for _, p := range points {
+		if p.Date > weekAgo {
+			break
+		}
+		start = p
+	}
+	last := points[len(points)-1]
+
+	gain := (last.RealizedPnl + last.UnrealizedPnl) - (start.RealizedPnl + start.UnrealizedPnl)
+	if start.Value <= 0 {
+		if private {
+			return "n/a"
+		}
+		return fmt.Sprintf("Rs.%+.2f", gain)
+	}
+	if private {
+		return fmt.Sprintf("%+.2f%%", gain/start.Value*100)
+	}
+	return fmt.Sprintf("Rs.%+.2f (%+.2f%%)", gain, gain/start.Value*100)
+}
+
+// sectorShifts compares allocation weights, largest current sector first.
+func sectorShifts(before, after map[string]float64) []sectorShift {
+	weights := func(values map[string]float64) map[string]float64 {
+		var total float64
+		for _, v := range values {
+			total += v
+		}
+		out := make(map[string]float64, len(values))
+		for k, v := range values {
+			if total > 0 {
+				out[k] = v / total * 100
+			}
+		}
+		return out
+	}
+	was, now := weights(before), weights(after)
+
+	var sectors []string
+	for s := range now {
+		sectors = append(sectors, s)
+	}
+	for s := range was {
+		if _, ok := now[s]; !ok {
+			sectors = append(sectors, s)
+		}
+	}
+	slices.SortFunc(sectors, func(a, b string) int {
+		if c := cmp.Compare(now[b], now[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	shifts := make([]sectorShift, len(sectors))
+	for i, s := range sectors {
+		shifts[i] = sectorShift{
+			Sector: s,
+			Before: fmt.Sprintf("%.1f%%", was[s]),
+			After:  fmt.Sprintf("%.1f%%", now[s]),
+			Delta:  fmt.Sprintf("%+.1f pp", now[s]-was[s]),
+		}
+	}
+	return shifts
+}
@@ -0,0 +1,99 @@
+// Package flags switches experimental features on or off per deployment
+// without a rebuild. Features are toggled through NTX_FEATURES, a comma
+// separated list such as "metrics=false,weekly-recap".
+package flags
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Flag is a named feature toggle with a default used when the deployment
+// doesn't mention it.
+type Flag struct {
+	Name        string
+	Description string
+	Default     bool
+}
+
+var (
+	// Metrics serves the sync SLO on /metrics.
+	Metrics = register("metrics", "serve the market sync SLO on /metrics", true)
+	// PrivateReports leaves rupee amounts out of digests, recaps, alert
+	// messages and CLI snapshots, for deployments whose reports are shown
+	// to others.
+	PrivateReports = register("private-reports", "show only percentages in reports and snapshots", false)
+	// PublicQuotes serves latest market quotes on /api/quotes without signing
+	// in, rate limited per client.
+	PublicQuotes = register("public-quotes", "serve rate-limited market quotes on /api/quotes", false)
+	// WeeklyRecap sends the portfolio recap after Thursday's close.
+	WeeklyRecap = register("weekly-recap", "send the weekly portfolio recap", true)
+	// WebClient serves the embedded web client build for every path the API
+	// doesn't handle. Off by default since most builds don't embed one.
+	WebClient = register("web-client", "serve the embedded web client on /", false)
+	// WebUI serves the server-rendered portfolio pages on /ui/.
+	WebUI = register("web-ui", "serve the portfolio pages on /ui/", true)
+)
+
+var (
+	registry  []*Flag
+	overrides = map[string]bool{}
+)
+
+func register(name, description string, def bool) *Flag {
+	f := &Flag{Name: name, Description: description, Default: def}
+	registry = append(registry, f)
+	return f
+}
+
+// Enabled reports whether the feature is on for this deployment.
+func (f *Flag) Enabled() bool {
+	if on, ok := overrides[f.Name]; ok {
+		return on
+	}
+	return f.Default
+}
+
+// All returns every known flag by name.
+func All() []*Flag {
+	out := slices.Clone(registry)
+	slices.SortFunc(out, func(a, b *Flag) int { return strings.Compare(a.Name, b.Name) })
+	return out
+}
+
+// Load applies NTX_FEATURES. It must run before any flag is read; an unknown
+// flag is an error so typos don't silently leave a feature off.
+func Load() error {
+	return parse(os.Getenv("NTX_FEATURES"))
+}
+
+// parse reads entries of the form "name", "-name" or "name=bool".
+func parse(value string) error {
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, on := entry, true
+		if rest, ok := strings.CutPrefix(entry, "-"); ok {
+			name, on = rest, false
+		}
+		if n, v, ok := strings.Cut(entry, "="); ok {
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err != nil {
+				return fmt.Errorf("feature %q: %w", n, err)
+			}
+			name, on = strings.TrimSpace(n), b
+		}
+
+		if !slices.ContainsFunc(registry, func(f *Flag) bool { return f.Name == name }) {
+			return fmt.Errorf("unknown feature %q", name)
+		}
+		overrides[name] = on
+	}
+	return nil
+}
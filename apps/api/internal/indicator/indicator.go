@@ -0,0 +1,73 @@
+// Package indicator computes technical indicators over daily closes. Every
+// function returns a series aligned with its input, with NaN on the days
+// that don't have enough history yet.
+package indicator
+
+import "math"
+
+// SMA is the simple moving average of the last n closes.
+func SMA(closes []float64, n int) []float64 {
+	out := nanSeries(len(closes))
+	if n <= 0 {
+		return out
+	}
+
+	var sum float64
+	for i, c := range closes {
+		sum += c
+		if i >= n {
+			sum -= closes[i-n]
+		}
+		if i >= n-1 {
+			out[i] = sum / float64(n)
+		}
+	}
+	return out
+}
+
+// RSI is Wilder's relative strength index over n periods, from 0 to 100.
+func RSI(closes []float64, n int) []float64 {
+	out := nanSeries(len(closes))
+	if n <= 0 || len(closes) <= n {
+		return out
+	}
+
+	var gain, loss float64
+	for i := 1; i <= n; i++ {
+		gain, loss = addMove(gain, loss, closes[i]-closes[i-1], 1)
+	}
+	gain /= float64(n)
+	loss /= float64(n)
+	out[n] = rsi(gain, loss)
+
+	for i := n + 1; i < len(closes); i++ {
+		gain *= float64(n-1) / float64(n)
+		loss *= float64(n-1) / float64(n)
+		gain, loss = addMove(gain, loss, closes[i]-closes[i-1], 1/float64(n))
+		out[i] = rsi(gain, loss)
+	}
+	return out
+}
+
+// addMove adds a price change, scaled by weight, to the gain or loss side.
+func addMove(gain, loss, change, weight float64) (float64, float64) {
+	if change > 0 {
+		return gain + change*weight, loss
+	}
+	return gain, loss - change*weight
+}
+
+func rsi(gain, loss float64) float64 {
+	if loss == 0 {
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+func nanSeries(n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.NaN()
+	}
+	return out
+}
@@ -0,0 +1,61 @@
+package journal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// ExportNotes renders the whole journal as a single markdown document with
+// one section per note, oldest first.
+func (s *JournalService) ExportNotes(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ExportNotesRequest],
+) (*connect.Response[ntxv1.ExportNotesResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.queries.ListNotesByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	slices.Reverse(notes)
+
+	var b strings.Builder
+	b.WriteString("# Trading journal\n")
+	for _, n := range notes {
+		heading := n.NoteDate
+		if n.Title != "" {
+			heading += " " + n.Title
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", heading)
+
+		var links []string
+		if n.StockSymbol.Valid {
+			links = append(links, "Symbol: "+n.StockSymbol.String)
+		}
+		if n.TransactionID.Valid {
+			links = append(links, fmt.Sprintf("Transaction: #%d", n.TransactionID.Int64))
+		}
+		if len(links) > 0 {
+			fmt.Fprintf(&b, "_%s_\n\n", strings.Join(links, ", "))
+		}
+
+		b.WriteString(n.Body)
+		b.WriteString("\n")
+	}
+
+	return connect.NewResponse(&ntxv1.ExportNotesResponse{
+		Filename: "journal-" + time.Now().Format("2006-01-02") + ".md",
+		Markdown: []byte(b.String()),
+	}), nil
+}
@@ -0,0 +1,136 @@
+package journal
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// CreateNote adds a journal entry for the authenticated user.
+func (s *JournalService) CreateNote(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateNoteRequest],
+) (*connect.Response[ntxv1.CreateNoteResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.parseNote(ctx, userID, req.Msg.Date, req.Msg.Title, req.Msg.Body,
+		req.Msg.StockSymbol, req.Msg.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateNote(ctx, sqlc.CreateNoteParams{
+		UserID:        userID,
+		NoteDate:      n.date,
+		Title:         n.title,
+		Body:          n.body,
+		StockSymbol:   n.stockSymbol,
+		TransactionID: n.transactionID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateNoteResponse{Note: noteToProto(created)}), nil
+}
+
+// UpdateNote replaces the contents and links of a journal entry.
+func (s *JournalService) UpdateNote(
+	ctx context.Context,
+	req *connect.Request[ntxv1.UpdateNoteRequest],
+) (*connect.Response[ntxv1.UpdateNoteResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := s.parseNote(ctx, userID, req.Msg.Date, req.Msg.Title, req.Msg.Body,
+		req.Msg.StockSymbol, req.Msg.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.queries.UpdateNote(ctx, sqlc.UpdateNoteParams{
+		NoteDate:      n.date,
+		Title:         n.title,
+		Body:          n.body,
+		StockSymbol:   n.stockSymbol,
+		TransactionID: n.transactionID,
+		ID:            req.Msg.NoteId,
+		UserID:        userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("note not found"))
+	}
+
+	return connect.NewResponse(&ntxv1.UpdateNoteResponse{Note: noteToProto(updated)}), nil
+}
+
+// ListNotes returns the user's notes, newest first, narrowed by the given filters.
+func (s *JournalService) ListNotes(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListNotesRequest],
+) (*connect.Response[ntxv1.ListNotesResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.queries.ListNotesByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	query := strings.ToLower(strings.TrimSpace(req.Msg.Query))
+	result := make([]*ntxv1.Note, 0, len(notes))
+	for _, n := range notes {
+		if req.Msg.StockSymbol != nil && !strings.EqualFold(n.StockSymbol.String, *req.Msg.StockSymbol) {
+			continue
+		}
+		if req.Msg.TransactionId != nil && n.TransactionID.Int64 != *req.Msg.TransactionId {
+			continue
+		}
+		if req.Msg.FromDate != "" && n.NoteDate < req.Msg.FromDate {
+			continue
+		}
+		if req.Msg.ToDate != "" && n.NoteDate > req.Msg.ToDate {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(n.Title+"\n"+n.Body), query) {
+			continue
+		}
+		result = append(result, noteToProto(n))
+	}
+
+	return connect.NewResponse(&ntxv1.ListNotesResponse{Notes: result}), nil
+}
+
+// DeleteNote removes one of the user's notes.
+func (s *JournalService) DeleteNote(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteNoteRequest],
+) (*connect.Response[ntxv1.DeleteNoteResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteNote(ctx, sqlc.DeleteNoteParams{
+		ID:     req.Msg.NoteId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteNoteResponse{}), nil
+}
@@ -0,0 +1,109 @@
+// Package journal provides dated trading notes linked to symbols or transactions.
+package journal
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// JournalService implements the JournalService RPCs.
+type JournalService struct {
+	ntxv1connect.UnimplementedJournalServiceHandler
+	queries *sqlc.Queries
+}
+
+// NewJournalService creates a new JournalService.
+func NewJournalService(queries *sqlc.Queries) *JournalService {
+	return &JournalService{queries: queries}
+}
+
+// note is a validated create or update request.
+type note struct {
+	date          string
+	title         string
+	body          string
+	stockSymbol   sql.NullString
+	transactionID sql.NullInt64
+}
+
+// parseNote validates the fields shared by create and update. A linked
+// transaction must belong to the user and fills in the symbol when none is
+// given.
+func (s *JournalService) parseNote(
+	ctx context.Context,
+	userID int64,
+	date, title, body string,
+	symbol *string,
+	transactionID *int64,
+) (note, error) {
+	n := note{
+		date:  date,
+		title: strings.TrimSpace(title),
+		body:  strings.TrimSpace(body),
+	}
+	if n.body == "" {
+		return n, connect.NewError(connect.CodeInvalidArgument, errors.New("body is required"))
+	}
+	if n.date == "" {
+		n.date = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", n.date); err != nil {
+		return n, connect.NewError(connect.CodeInvalidArgument, errors.New("date must be YYYY-MM-DD"))
+	}
+
+	if symbol != nil && strings.TrimSpace(*symbol) != "" {
+		n.stockSymbol = sql.NullString{String: strings.ToUpper(strings.TrimSpace(*symbol)), Valid: true}
+	}
+
+	if transactionID != nil {
+		// Get the transaction to verify ownership
+		tx, err := s.queries.GetTransaction(ctx, *transactionID)
+		if err != nil {
+			return n, connect.NewError(connect.CodeNotFound, errors.New("transaction not found"))
+		}
+		_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+			ID:     tx.PortfolioID,
+			UserID: userID,
+		})
+		if err != nil {
+			return n, connect.NewError(connect.CodeNotFound, errors.New("transaction not found"))
+		}
+		n.transactionID = sql.NullInt64{Int64: tx.ID, Valid: true}
+		if !n.stockSymbol.Valid {
+			n.stockSymbol = sql.NullString{String: tx.StockSymbol, Valid: true}
+		}
+	}
+
+	return n, nil
+}
+
+func noteToProto(n sqlc.Note) *ntxv1.Note {
+	out := &ntxv1.Note{
+		Id:    n.ID,
+		Date:  n.NoteDate,
+		Title: n.Title,
+		Body:  n.Body,
+	}
+	if n.StockSymbol.Valid {
+		out.StockSymbol = &n.StockSymbol.String
+	}
+	if n.TransactionID.Valid {
+		out.TransactionId = &n.TransactionID.Int64
+	}
+	if n.CreatedAt.Valid {
+		out.CreatedAt = n.CreatedAt.Time.Format(time.RFC3339)
+	}
+	if n.UpdatedAt.Valid {
+		out.UpdatedAt = n.UpdatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+// Package logging configures the process-wide slog logger and carries request
+// IDs through contexts so every log line of a request can be correlated.
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type contextKey struct{}
+
+// WithRequestID returns a context whose log records carry the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// RequestID returns the request ID stored in ctx, if any.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
+
+// Setup installs the default logger from the environment:
+//
+//	LOG_LEVEL    debug, info (default), warn or error
+//	LOG_FORMAT   json (default) or text
+//	LOG_FILE     append to this file instead of stdout
+//	LOG_MAX_MB   rotate LOG_FILE once it grows past this size (default 100)
+//
+// A log file stays open for the life of the process.
+func Setup() error {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(envOr("LOG_LEVEL", "info"))); err != nil {
+		return fmt.Errorf("LOG_LEVEL: %w", err)
+	}
+
+	// Stdout rather than stderr: Railway treats stderr as errors
+	var out io.WriteCloser = nopCloser{os.Stdout}
+	if path := os.Getenv("LOG_FILE"); path != "" {
+		maxMB, err := strconv.ParseInt(envOr("LOG_MAX_MB", "100"), 10, 64)
+		if err != nil || maxMB <= 0 {
+			return errors.New("LOG_MAX_MB must be a positive number")
+		}
+		f, err := openRotating(path, maxMB<<20)
+		if err != nil {
+			return err
+		}
+		out = f
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var h slog.Handler
+	switch strings.ToLower(envOr("LOG_FORMAT", "json")) {
+	case "json":
+		h = slog.NewJSONHandler(out, opts)
+	case "text":
+		h = slog.NewTextHandler(out, opts)
+	default:
+		_ = out.Close()
+		return errors.New("LOG_FORMAT must be json or text")
+	}
+
+	slog.SetDefault(slog.New(contextHandler{h}))
+	return nil
+}
+
+// contextHandler adds the request ID from the record's context.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		r.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,214 @@
+// Package meroshare downloads statements from CDSC's Mero Share. It talks to
+// the JSON endpoints behind the Mero Share web app, which CDSC doesn't
+// document, so field names follow what that app sends and receives.
+package meroshare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is the API the Mero Share web app uses.
+const DefaultBaseURL = "https://webbackend.cdsc.com.np/api"
+
+// statementPageSize is how many statement lines are requested at a time.
+const statementPageSize = 200
+
+// Client is a signed-in Mero Share session. It is not safe for concurrent use.
+type Client struct {
+	BaseURL string
+	HTTP    *http.Client
+
+	token string
+	owner ownDetail
+}
+
+// Transaction is one line of the demat transaction statement.
+type Transaction struct {
+	Symbol      string
+	Date        time.Time
+	Credit      int64  // shares received
+	Debit       int64  // shares delivered
+	Description string // such as "ON-CR TD:123 SET:456" for an on-market buy
+}
+
+// Cost is one holding's line in the WACC report.
+type Cost struct {
+	Symbol   string
+	Quantity int64
+	Rate     float64 // weighted average cost per share
+}
+
+// ownDetail identifies the signed-in account's demat.
+type ownDetail struct {
+	BOID       string `json:"boid"`
+	ClientCode string `json:"clientCode"`
+	Demat      string `json:"demat"`
+}
+
+func NewClient() *Client {
+	return &Client{BaseURL: DefaultBaseURL, HTTP: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Login signs in and loads the account's demat details. clientID is the
+// depository participant's Mero Share id, not its DP code.
+func (c *Client) Login(ctx context.Context, clientID int64, username, password string) error {
+	body := map[string]any{"clientId": clientID, "username": username, "password": password}
+	resp, err := c.do(ctx, http.MethodPost, "/meroShare/auth/", body)
+	if err != nil {
+		return fmt.Errorf("login: %w", err)
+	}
+	resp.Body.Close()
+	c.token = resp.Header.Get("Authorization")
+	if c.token == "" {
+		return errors.New("login: no session token in response")
+	}
+
+	if err := c.getJSON(ctx, http.MethodGet, "/meroShare/ownDetail/", nil, &c.owner); err != nil {
+		return fmt.Errorf("own detail: %w", err)
+	}
+	return nil
+}
+
+// Transactions returns the whole transaction statement, oldest first.
+func (c *Client) Transactions(ctx context.Context) ([]Transaction, error) {
+	type line struct {
+		Script             string `json:"script"`
+		TransactionDate    string `json:"transactionDate"`
+		CreditQuantity     string `json:"creditQuantity"`
+		DebitQuantity      string `json:"debitQuantity"`
+		HistoryDescription string `json:"historyDescription"`
+	}
+	var lines []line
+	for page := 1; ; page++ {
+		var resp struct {
+			TotalCount int    `json:"totalCount"`
+			Object     []line `json:"object"`
+		}
+		body := map[string]any{
+			"boid":              c.owner.BOID,
+			"clientCode":        c.owner.ClientCode,
+			"requestTypeScript": false,
+			"page":              page,
+			"size":              statementPageSize,
+		}
+		if err := c.getJSON(ctx, http.MethodPost, "/meroShareView/myTransaction/", body, &resp); err != nil {
+			return nil, fmt.Errorf("transactions page %d: %w", page, err)
+		}
+		lines = append(lines, resp.Object...)
+		if len(resp.Object) < statementPageSize || len(lines) >= resp.TotalCount {
+			break
+		}
+	}
+
+	var txs []Transaction
+	for _, l := range lines {
+		date, err := time.Parse("2006-01-02", l.TransactionDate[:min(len(l.TransactionDate), len("2006-01-02"))])
+		if err != nil {
+			return nil, fmt.Errorf("transaction date %q: %w", l.TransactionDate, err)
+		}
+		txs = append(txs, Transaction{
+			Symbol:      strings.ToUpper(strings.TrimSpace(l.Script)),
+			Date:        date,
+			Credit:      parseQuantity(l.CreditQuantity),
+			Debit:       parseQuantity(l.DebitQuantity),
+			Description: strings.TrimSpace(l.HistoryDescription),
+		})
+	}
+	// The statement lists newest first
+	slices.Reverse(txs)
+	return txs, nil
+}
+
+// WACC returns the weighted average cost report for every scrip in the demat.
+func (c *Client) WACC(ctx context.Context) ([]Cost, error) {
+	var resp struct {
+		Report []struct {
+			Scrip          string  `json:"scrip"`
+			TotalQuantity  float64 `json:"totalQuantity"`
+			AverageBuyRate float64 `json:"averageBuyRate"`
+		} `json:"waccReportResponse"`
+	}
+	body := map[string]any{"demat": []string{c.owner.Demat}, "clientCode": c.owner.ClientCode}
+	if err := c.getJSON(ctx, http.MethodPost, "/myPurchase/waccReport/", body, &resp); err != nil {
+		return nil, fmt.Errorf("wacc report: %w", err)
+	}
+
+	costs := make([]Cost, 0, len(resp.Report))
+	for _, r := range resp.Report {
+		costs = append(costs, Cost{
+			Symbol:   strings.ToUpper(strings.TrimSpace(r.Scrip)),
+			Quantity: int64(r.TotalQuantity),
+			Rate:     r.AverageBuyRate,
+		})
+	}
+	return costs, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, method, path string, body, out any) error {
+	resp, err := c.do(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// do sends a request with the session token, turning any non-2xx reply into
+// an error carrying Mero Share's message.
+func (c *Client) do(ctx context.Context, method, path string, body any) (*http.Response, error) {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, payload)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", c.token)
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	var reply struct {
+		Message string `json:"message"`
+	}
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&reply)
+	if reply.Message != "" {
+		return nil, fmt.Errorf("%s: %s", resp.Status, reply.Message)
+	}
+	return nil, errors.New(resp.Status)
+}
+
+// parseQuantity reads a statement quantity such as "10.0". Mero Share shows
+// "-" for the side a line doesn't touch.
+func parseQuantity(s string) int64 {
+	q, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0
+	}
+	return int64(q)
+}
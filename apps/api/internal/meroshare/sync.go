@@ -0,0 +1,257 @@
+package meroshare
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/secrets"
+)
+
+// settlementLag is how many days before a statement line a matching trade
+// may already be recorded. Statements are dated on settlement, T+2 plus
+// holidays, while trades are usually entered on the trade date.
+const settlementLag = 7
+
+// Config is a Mero Share account and the portfolio its statements go into.
+type Config struct {
+	ClientID        int64 // depository participant's Mero Share id
+	Username        string
+	Password        string
+	PasswordCommand []string // prints the password, such as a keyring lookup
+	PortfolioID     int64    // 0 picks the only real portfolio
+}
+
+// ConfigFromEnv reads MEROSHARE_CLIENT_ID, MEROSHARE_USERNAME,
+// MEROSHARE_PASSWORD and MEROSHARE_PORTFOLIO_ID, taking the password from
+// the keyring when it is stored there. MEROSHARE_PASSWORD_COMMAND can stand
+// in for the password to read it from a password manager. ok is false when
+// no username is set.
+func ConfigFromEnv() (cfg Config, ok bool, err error) {
+	cfg.Username = strings.TrimSpace(os.Getenv("MEROSHARE_USERNAME"))
+	if cfg.Username == "" {
+		return Config{}, false, nil
+	}
+	cfg.ClientID, err = strconv.ParseInt(os.Getenv("MEROSHARE_CLIENT_ID"), 10, 64)
+	if err != nil {
+		return Config{}, true, errors.New("MEROSHARE_CLIENT_ID must be the participant's numeric id")
+	}
+	if id := os.Getenv("MEROSHARE_PORTFOLIO_ID"); id != "" {
+		cfg.PortfolioID, err = strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return Config{}, true, errors.New("MEROSHARE_PORTFOLIO_ID must be a number")
+		}
+	}
+	cfg.Password = secrets.Lookup("MEROSHARE_PASSWORD")
+	cfg.PasswordCommand = strings.Fields(os.Getenv("MEROSHARE_PASSWORD_COMMAND"))
+	if cfg.Password == "" && len(cfg.PasswordCommand) == 0 {
+		return Config{}, true, errors.New("set MEROSHARE_PASSWORD or MEROSHARE_PASSWORD_COMMAND")
+	}
+	return cfg, true, nil
+}
+
+// password returns the configured password, running the password command
+// each time so a rotated secret is picked up without a restart.
+func (c Config) password(ctx context.Context) (string, error) {
+	if len(c.PasswordCommand) == 0 {
+		return c.Password, nil
+	}
+	out, err := exec.CommandContext(ctx, c.PasswordCommand[0], c.PasswordCommand[1:]...).Output() //nolint:gosec // set by the operator
+	if err != nil {
+		return "", fmt.Errorf("password command %s: %w", c.PasswordCommand[0], err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Syncer pulls the statements into a portfolio: on-market buys and sells go
+// through the CSV importer and the WACC report is recorded as each open
+// holding's WACC cost.
+type Syncer struct {
+	cfg        Config
+	client     *Client
+	queries    *sqlc.Queries
+	portfolios *portfolio.PortfolioService
+}
+
+// NewSyncer returns a Syncer for cfg. cfg.PortfolioID must be set and name a
+// portfolio that isn't a paper one.
+func NewSyncer(cfg Config, queries *sqlc.Queries, portfolios *portfolio.PortfolioService) *Syncer {
+	return &Syncer{cfg: cfg, client: NewClient(), queries: queries, portfolios: portfolios}
+}
+
+// Run signs in and syncs both statements.
+func (s *Syncer) Run(ctx context.Context) error {
+	password, err := s.cfg.password(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Login(ctx, s.cfg.ClientID, s.cfg.Username, password); err != nil {
+		return err
+	}
+
+	statement, err := s.client.Transactions(ctx)
+	if err != nil {
+		return err
+	}
+	data, unpriced, err := s.importFile(ctx, statement)
+	if err != nil {
+		return err
+	}
+	result, err := s.portfolios.Import(ctx, s.cfg.PortfolioID, data, ntxv1.ConflictStrategy_CONFLICT_STRATEGY_SKIP)
+	if err != nil {
+		return fmt.Errorf("import statement: %w", err)
+	}
+
+	costs, err := s.client.WACC(ctx)
+	if err != nil {
+		return err
+	}
+	recorded, err := s.recordCosts(ctx, costs)
+	if err != nil {
+		return err
+	}
+
+	slog.Info("meroshare sync finished",
+		"portfolio", s.cfg.PortfolioID, "imported", result.Imported, "skipped", result.Skipped,
+		"unpriced", unpriced, "costs", recorded)
+	return nil
+}
+
+// importFile turns on-market statement lines into an import file. Mero Share
+// doesn't give trade prices, so each trade is priced at the stored close on
+// its statement date and lines without one are left out; the WACC report
+// corrects the holding's cost afterwards. Lines matching a transaction
+// already recorded within settlementLag days are dropped, so trades entered
+// by hand on their trade date aren't imported twice.
+func (s *Syncer) importFile(ctx context.Context, statement []Transaction) (data []byte, unpriced int, err error) {
+	existing, err := s.queries.ListTransactionsByPortfolio(ctx, s.cfg.PortfolioID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"symbol", "type", "quantity", "price", "date"})
+	for _, t := range statement {
+		txType, quantity := onMarketTrade(t)
+		if txType == "" {
+			continue
+		}
+		if i := matchRecorded(existing, t.Symbol, txType, quantity, t.Date); i >= 0 {
+			existing = append(existing[:i], existing[i+1:]...)
+			continue
+		}
+		price, err := s.closeOn(ctx, t.Symbol, t.Date)
+		if err != nil {
+			return nil, 0, err
+		}
+		if price <= 0 {
+			unpriced++
+			continue
+		}
+		_ = w.Write([]string{
+			t.Symbol,
+			txType,
+			strconv.FormatInt(quantity, 10),
+			strconv.FormatFloat(price, 'f', 2, 64),
+			t.Date.Format("2006-01-02"),
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), unpriced, w.Error()
+}
+
+// onMarketTrade reads a statement line as a secondary market buy or sell.
+// Other lines, such as IPO allotments, bonus credits and demat transfers,
+// return an empty type.
+func onMarketTrade(t Transaction) (txType string, quantity int64) {
+	switch {
+	case strings.HasPrefix(t.Description, "ON-CR") && t.Credit > 0:
+		return "BUY", t.Credit
+	case strings.HasPrefix(t.Description, "ON-DR") && t.Debit > 0:
+		return "SELL", t.Debit
+	}
+	return "", 0
+}
+
+// matchRecorded returns the index of a recorded transaction that is likely
+// the same trade as a statement line, or -1.
+func matchRecorded(txs []sqlc.Transaction, symbol, txType string, quantity int64, date time.Time) int {
+	earliest := date.AddDate(0, 0, -settlementLag)
+	for i, tx := range txs {
+		if tx.StockSymbol != symbol || tx.TransactionType != txType || tx.Quantity != quantity {
+			continue
+		}
+		if tx.TransactionDate.Before(earliest) || tx.TransactionDate.After(date) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+// closeOn returns symbol's close on date, or 0 when none is stored.
+func (s *Syncer) closeOn(ctx context.Context, symbol string, date time.Time) (float64, error) {
+	company, err := s.queries.GetCompany(ctx, symbol)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	price, err := s.queries.GetPriceByDate(ctx, sqlc.GetPriceByDateParams{
+		CompanyID:    company.ID,
+		BusinessDate: date.Format("2006-01-02"),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return price.ClosePrice.Float64, nil
+}
+
+// recordCosts stores the WACC report's rate for every open holding it
+// covers, returning how many were recorded.
+func (s *Syncer) recordCosts(ctx context.Context, costs []Cost) (int, error) {
+	holdings, err := s.queries.ListHoldings(ctx, s.cfg.PortfolioID)
+	if err != nil {
+		return 0, err
+	}
+	held := make(map[string]bool, len(holdings))
+	for _, h := range holdings {
+		held[h.StockSymbol] = true
+	}
+
+	recorded := 0
+	for _, c := range costs {
+		if !held[c.Symbol] || c.Rate <= 0 {
+			continue
+		}
+		_, err := s.queries.SetHoldingCost(ctx, sqlc.SetHoldingCostParams{
+			PortfolioID: s.cfg.PortfolioID,
+			StockSymbol: c.Symbol,
+			Source:      "WACC",
+			AvgCost:     c.Rate,
+			Note:        "Mero Share WACC report",
+		})
+		if err != nil {
+			return recorded, err
+		}
+		recorded++
+	}
+	return recorded, nil
+}
@@ -0,0 +1,26 @@
+package nepse
+
+import (
+	"context"
+	"fmt"
+)
+
+type Index struct {
+	Close         float64
+	PreviousClose float64
+	ChangePercent float64
+}
+
+// NepseIndex returns the current value of the NEPSE index.
+func (c *Client) NepseIndex(ctx context.Context) (*Index, error) {
+	idx, err := c.api.NepseIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch nepse index: %w", err)
+	}
+
+	return &Index{
+		Close:         idx.IndexValue,
+		PreviousClose: idx.PreviousClose,
+		ChangePercent: idx.PercentChange,
+	}, nil
+}
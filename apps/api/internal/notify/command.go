@@ -0,0 +1,54 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// commandTimeout bounds how long a notification command may run.
+const commandTimeout = 30 * time.Second
+
+// CommandChannel hands each message to an external program as JSON on its
+// stdin, so deployments can add their own delivery (chat bots, push
+// services) without changes here. A non-zero exit counts as a failure.
+type CommandChannel struct {
+	Command []string // program and arguments
+}
+
+// commandMessage is the JSON a notification command receives. Image data is
+// base64 encoded.
+type commandMessage struct {
+	To      string  `json:"to"`
+	Subject string  `json:"subject"`
+	Body    string  `json:"body"`
+	HTML    string  `json:"html,omitempty"`
+	Images  []Image `json:"images,omitempty"`
+}
+
+func (c *CommandChannel) Send(ctx context.Context, m Message) error {
+	if len(c.Command) == 0 {
+		return errors.New("notify command is empty")
+	}
+	payload, err := json.Marshal(commandMessage(m))
+	if err != nil {
+		return fmt.Errorf("encode message: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, c.Command[0], c.Command[1:]...) //nolint:gosec // set by the operator
+	cmd.Stdin = bytes.NewReader(payload)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("notify command %s: %w: %s", c.Command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
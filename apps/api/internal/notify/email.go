@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+)
+
+// EmailChannel sends email through an SMTP server. Messages with HTML are
+// sent as multipart/related so embedded images display inline.
+type EmailChannel struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (e *EmailChannel) Send(_ context.Context, m Message) error {
+	var auth smtp.Auth
+	if e.Username != "" {
+		auth = smtp.PlainAuth("", e.Username, e.Password, e.Host)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", m.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", m.Subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+
+	if m.HTML == "" {
+		msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+		msg.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	} else if err := writeRelated(&msg, m); err != nil {
+		return fmt.Errorf("build email: %w", err)
+	}
+
+	if err := smtp.SendMail(e.Host+":"+e.Port, auth, e.From, []string{m.To}, msg.Bytes()); err != nil {
+		return fmt.Errorf("send email to %s: %w", m.To, err)
+	}
+	return nil
+}
+
+// writeRelated writes the HTML part followed by each image as an inline part
+// whose Content-ID matches the "cid:" reference in the HTML.
+func writeRelated(buf *bytes.Buffer, m Message) error {
+	mw := multipart.NewWriter(buf)
+	fmt.Fprintf(buf, "Content-Type: multipart/related; boundary=%s\r\n\r\n", mw.Boundary())
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=UTF-8"},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write([]byte(m.HTML)); err != nil {
+		return err
+	}
+
+	for _, img := range m.Images {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {"image/png"},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-ID":                {"<" + img.Name + ">"},
+			"Content-Disposition":       {"inline; filename=" + img.Name + ".png"},
+		})
+		if err != nil {
+			return err
+		}
+		// SMTP limits line length, so the encoding is wrapped at 76 columns
+		encoded := base64.StdEncoding.EncodeToString(img.Data)
+		for len(encoded) > 0 {
+			n := min(len(encoded), 76)
+			if _, err := part.Write([]byte(encoded[:n] + "\r\n")); err != nil {
+				return err
+			}
+			encoded = encoded[n:]
+		}
+	}
+
+	return mw.Close()
+}
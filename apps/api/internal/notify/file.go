@@ -0,0 +1,50 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileChannel saves each message under Dir, as HTML when the message has it
+// and plain text otherwise. Images are inlined as data URIs so the file is
+// self-contained.
+type FileChannel struct {
+	Dir string
+}
+
+func (f *FileChannel) Send(_ context.Context, m Message) error {
+	if err := os.MkdirAll(f.Dir, 0o750); err != nil {
+		return fmt.Errorf("create report dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s-%s", time.Now().Format("20060102-150405"), slug(m.Subject), slug(m.To))
+	content, ext := m.Body, ".txt"
+	if m.HTML != "" {
+		content, ext = m.HTML, ".html"
+		for _, img := range m.Images {
+			uri := "data:image/png;base64," + base64.StdEncoding.EncodeToString(img.Data)
+			content = strings.ReplaceAll(content, "cid:"+img.Name, uri)
+		}
+	}
+
+	path := filepath.Join(f.Dir, name+ext)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		return fmt.Errorf("write report: %w", err)
+	}
+	return nil
+}
+
+// slug keeps letters and digits and turns everything else into dashes.
+func slug(s string) string {
+	return strings.Trim(strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, s), "-")
+}
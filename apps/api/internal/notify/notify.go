@@ -0,0 +1,98 @@
+// Package notify delivers messages to users over the configured channels.
+package notify
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/voidarchive/ntx/internal/secrets"
+)
+
+// Message is a notification addressed to a single user. Body is plain text;
+// HTML is an optional richer rendering that may reference Images by
+// "cid:<name>".
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+	HTML    string
+	Images  []Image
+}
+
+// Image is a PNG embedded in a message's HTML.
+type Image struct {
+	Name string `json:"name"`
+	Data []byte `json:"data"`
+}
+
+// Channel is one way of delivering a message, such as email.
+type Channel interface {
+	Send(ctx context.Context, m Message) error
+}
+
+// Notifier fans each message out to every configured channel.
+type Notifier struct {
+	channels []Channel
+}
+
+// New creates a Notifier that sends through the given channels.
+func New(channels ...Channel) *Notifier {
+	return &Notifier{channels: channels}
+}
+
+// FromEnv always logs messages, emails them when SMTP_HOST is set, saves
+// them as files when REPORT_DIR is set and pipes them to NOTIFY_COMMAND
+// (split on spaces) when that is set. SMTP_PASSWORD is read from the
+// keyring before the environment.
+func FromEnv() *Notifier {
+	channels := []Channel{LogChannel{}}
+	if dir := os.Getenv("REPORT_DIR"); dir != "" {
+		channels = append(channels, &FileChannel{Dir: dir})
+	}
+	if command := strings.Fields(os.Getenv("NOTIFY_COMMAND")); len(command) > 0 {
+		channels = append(channels, &CommandChannel{Command: command})
+	}
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		channels = append(channels, &EmailChannel{
+			Host:     host,
+			Port:     envOr("SMTP_PORT", "587"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: secrets.Lookup("SMTP_PASSWORD"),
+			From:     envOr("SMTP_FROM", os.Getenv("SMTP_USERNAME")),
+		})
+	}
+	return New(channels...)
+}
+
+// Send delivers m on every channel. A failing channel doesn't stop the
+// others; all failures are returned together.
+func (n *Notifier) Send(ctx context.Context, m Message) error {
+	var errs []error
+	for _, c := range n.channels {
+		if err := c.Send(ctx, m); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LogChannel writes messages to the structured log, which is enough for
+// self-hosted setups that read the server output. Bodies can carry holdings
+// and balances, so they are only logged at debug level.
+type LogChannel struct{}
+
+func (LogChannel) Send(ctx context.Context, m Message) error {
+	slog.InfoContext(ctx, "notification", slog.String("to", m.To), slog.String("subject", m.Subject))
+	slog.DebugContext(ctx, "notification body", slog.String("to", m.To), slog.String("body", m.Body))
+	return nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
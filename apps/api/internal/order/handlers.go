@@ -0,0 +1,211 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// CreateOrder journals a limit order placed with the broker.
+func (s *OrderService) CreateOrder(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateOrderRequest],
+) (*connect.Response[ntxv1.CreateOrderResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	// Validate input
+	symbol := strings.ToUpper(strings.TrimSpace(req.Msg.StockSymbol))
+	if symbol == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock_symbol is required"))
+	}
+	if _, err := s.queries.GetCompany(ctx, symbol); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("company not found"))
+	}
+
+	var side string
+	switch req.Msg.Side {
+	case ntxv1.TransactionType_TRANSACTION_TYPE_BUY:
+		side = "BUY"
+	case ntxv1.TransactionType_TRANSACTION_TYPE_SELL:
+		side = "SELL"
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("side is required"))
+	}
+
+	if req.Msg.Quantity <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quantity must be positive"))
+	}
+	if req.Msg.LimitPrice <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("limit_price must be positive"))
+	}
+
+	var expiresOn sql.NullString
+	if req.Msg.ExpiresOn != "" {
+		if _, err := time.Parse("2006-01-02", req.Msg.ExpiresOn); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expires_on must be YYYY-MM-DD"))
+		}
+		if req.Msg.ExpiresOn < worker.BusinessDate(time.Now()) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("expires_on is in the past"))
+		}
+		expiresOn = sql.NullString{String: req.Msg.ExpiresOn, Valid: true}
+	}
+
+	order, err := s.queries.CreateOrder(ctx, sqlc.CreateOrderParams{
+		UserID:      userID,
+		PortfolioID: req.Msg.PortfolioId,
+		StockSymbol: symbol,
+		Side:        side,
+		Quantity:    req.Msg.Quantity,
+		LimitPrice:  req.Msg.LimitPrice,
+		ExpiresOn:   expiresOn,
+		Note:        strings.TrimSpace(req.Msg.Note),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateOrderResponse{Order: orderToProto(order)}), nil
+}
+
+// ListOrders returns the user's orders, newest first, optionally filtered by status.
+func (s *OrderService) ListOrders(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListOrdersRequest],
+) (*connect.Response[ntxv1.ListOrdersResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := s.queries.ListOrdersByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.Order, 0, len(orders))
+	for _, o := range orders {
+		if req.Msg.Status != nil && statusToDB[*req.Msg.Status] != o.Status {
+			continue
+		}
+		result = append(result, orderToProto(o))
+	}
+
+	return connect.NewResponse(&ntxv1.ListOrdersResponse{Orders: result}), nil
+}
+
+// CancelOrder closes an open order without booking a transaction.
+func (s *OrderService) CancelOrder(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CancelOrderRequest],
+) (*connect.Response[ntxv1.CancelOrderResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.queries.CloseOrder(ctx, sqlc.CloseOrderParams{
+		Status: statusCancelled,
+		ID:     req.Msg.OrderId,
+		UserID: userID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, s.notOpen(ctx, req.Msg.OrderId, userID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CancelOrderResponse{Order: orderToProto(order)}), nil
+}
+
+// ExecuteOrder books an open order as a transaction and closes it. A partial
+// fill is recorded by overriding the quantity; the rest of the order is
+// closed with it, so re-enter it if it's still working at the broker.
+func (s *OrderService) ExecuteOrder(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ExecuteOrderRequest],
+) (*connect.Response[ntxv1.ExecuteOrderResponse], error) {
+	userID, err := portfolio.UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.queries.GetOrder(ctx, sqlc.GetOrderParams{
+		ID:     req.Msg.OrderId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("order not found"))
+	}
+	if order.Status != statusOpen {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("order is not open"))
+	}
+
+	txReq := &ntxv1.AddTransactionRequest{
+		PortfolioId:     order.PortfolioID,
+		StockSymbol:     order.StockSymbol,
+		TransactionType: sideFromDB(order.Side),
+		Quantity:        order.Quantity,
+		UnitPrice:       order.LimitPrice,
+		TransactionDate: req.Msg.TransactionDate,
+	}
+	if req.Msg.Quantity != nil {
+		txReq.Quantity = *req.Msg.Quantity
+	}
+	if req.Msg.UnitPrice != nil {
+		txReq.UnitPrice = *req.Msg.UnitPrice
+	}
+	if txReq.TransactionDate == "" {
+		txReq.TransactionDate = worker.BusinessDate(time.Now())
+	}
+
+	tx, err := s.portfolios.AddTransaction(ctx, connect.NewRequest(txReq))
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = s.queries.CloseOrder(ctx, sqlc.CloseOrderParams{
+		Status:        statusExecuted,
+		TransactionID: sql.NullInt64{Int64: tx.Msg.Transaction.Id, Valid: true},
+		ID:            order.ID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ExecuteOrderResponse{
+		Order:       orderToProto(order),
+		Transaction: tx.Msg.Transaction,
+	}), nil
+}
+
+// notOpen explains why an order couldn't be closed.
+func (s *OrderService) notOpen(ctx context.Context, orderID, userID int64) error {
+	_, err := s.queries.GetOrder(ctx, sqlc.GetOrderParams{ID: orderID, UserID: userID})
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, errors.New("order not found"))
+	}
+	return connect.NewError(connect.CodeFailedPrecondition, errors.New("order is not open"))
+}
@@ -0,0 +1,118 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/notify"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// Reminder nudges users about their open orders after the close: orders
+// whose limit was reached during the day and may have filled, orders about
+// to expire, and orders that have just expired.
+type Reminder struct {
+	queries  *sqlc.Queries
+	notifier *notify.Notifier
+}
+
+// NewReminder creates a Reminder.
+func NewReminder(queries *sqlc.Queries, notifier *notify.Notifier) *Reminder {
+	return &Reminder{queries: queries, notifier: notifier}
+}
+
+// Run sends each user with something to act on a single reminder, then
+// marks orders past their expiry date as expired.
+func (r *Reminder) Run(ctx context.Context) error {
+	now := time.Now()
+	today := worker.BusinessDate(now)
+	tomorrow := worker.BusinessDate(now.AddDate(0, 0, 1))
+
+	orders, err := r.queries.ListOpenOrders(ctx)
+	if err != nil {
+		return fmt.Errorf("list open orders: %w", err)
+	}
+
+	lines := make(map[int64][]string)
+	var userIDs []int64
+	for _, o := range orders {
+		line, err := r.check(ctx, o, today, tomorrow)
+		if err != nil {
+			return fmt.Errorf("order %d: %w", o.ID, err)
+		}
+		if line == "" {
+			continue
+		}
+		if _, ok := lines[o.UserID]; !ok {
+			userIDs = append(userIDs, o.UserID)
+		}
+		lines[o.UserID] = append(lines[o.UserID], line)
+	}
+
+	var errs []error
+	if len(userIDs) > 0 {
+		users, err := r.queries.ListUsers(ctx)
+		if err != nil {
+			return fmt.Errorf("list users: %w", err)
+		}
+		emails := make(map[int64]string, len(users))
+		for _, u := range users {
+			emails[u.ID] = u.Email
+		}
+
+		for _, id := range userIDs {
+			err := r.notifier.Send(ctx, notify.Message{
+				To:      emails[id],
+				Subject: "NTX open orders for " + today,
+				Body:    "Open orders needing attention:\n  - " + strings.Join(lines[id], "\n  - ") + "\n",
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("user %d: %w", id, err))
+			}
+		}
+	}
+
+	// Expire only after reminding so the last notice names them
+	_, err = r.queries.ExpireOrders(ctx, sql.NullString{String: today, Valid: true})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("expire orders: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+// check describes what needs attention on o, or returns "" if nothing does.
+func (r *Reminder) check(ctx context.Context, o sqlc.Order, today, tomorrow string) (string, error) {
+	order := fmt.Sprintf("%s %d %s @ Rs.%.2f", o.Side, o.Quantity, o.StockSymbol, o.LimitPrice)
+
+	if o.ExpiresOn.Valid && o.ExpiresOn.String < today {
+		return order + " expired on " + o.ExpiresOn.String, nil
+	}
+
+	price, err := r.queries.GetLatestPriceBySymbol(ctx, o.StockSymbol)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", err
+	}
+	if err == nil && price.BusinessDate == today {
+		if o.Side == "BUY" && price.LowPrice.Valid && price.LowPrice.Float64 <= o.LimitPrice {
+			return fmt.Sprintf("%s may have filled, low was Rs.%.2f; mark it executed if so",
+				order, price.LowPrice.Float64), nil
+		}
+		if o.Side == "SELL" && price.HighPrice.Valid && price.HighPrice.Float64 >= o.LimitPrice {
+			return fmt.Sprintf("%s may have filled, high was Rs.%.2f; mark it executed if so",
+				order, price.HighPrice.Float64), nil
+		}
+	}
+
+	switch o.ExpiresOn.String {
+	case today:
+		return order + " expires today", nil
+	case tomorrow:
+		return order + " expires tomorrow", nil
+	}
+	return "", nil
+}
@@ -0,0 +1,79 @@
+// Package order provides a journal of limit orders placed with a broker.
+package order
+
+import (
+	"time"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// Order statuses as stored in orders.status.
+const (
+	statusOpen      = "OPEN"
+	statusExecuted  = "EXECUTED"
+	statusCancelled = "CANCELLED"
+	statusExpired   = "EXPIRED"
+)
+
+var statusToDB = map[ntxv1.OrderStatus]string{
+	ntxv1.OrderStatus_ORDER_STATUS_OPEN:      statusOpen,
+	ntxv1.OrderStatus_ORDER_STATUS_EXECUTED:  statusExecuted,
+	ntxv1.OrderStatus_ORDER_STATUS_CANCELLED: statusCancelled,
+	ntxv1.OrderStatus_ORDER_STATUS_EXPIRED:   statusExpired,
+}
+
+// OrderService implements the OrderService RPCs.
+type OrderService struct {
+	ntxv1connect.UnimplementedOrderServiceHandler
+	queries    *sqlc.Queries
+	portfolios *portfolio.PortfolioService
+}
+
+// NewOrderService creates a new OrderService. Executed orders are booked
+// through portfolios so they get the same validation as manual entries.
+func NewOrderService(queries *sqlc.Queries, portfolios *portfolio.PortfolioService) *OrderService {
+	return &OrderService{queries: queries, portfolios: portfolios}
+}
+
+func statusFromDB(s string) ntxv1.OrderStatus {
+	for k, v := range statusToDB {
+		if v == s {
+			return k
+		}
+	}
+	return ntxv1.OrderStatus_ORDER_STATUS_UNSPECIFIED
+}
+
+func sideFromDB(s string) ntxv1.TransactionType {
+	if s == "SELL" {
+		return ntxv1.TransactionType_TRANSACTION_TYPE_SELL
+	}
+	return ntxv1.TransactionType_TRANSACTION_TYPE_BUY
+}
+
+func orderToProto(o sqlc.Order) *ntxv1.Order {
+	out := &ntxv1.Order{
+		Id:          o.ID,
+		PortfolioId: o.PortfolioID,
+		StockSymbol: o.StockSymbol,
+		Side:        sideFromDB(o.Side),
+		Quantity:    o.Quantity,
+		LimitPrice:  o.LimitPrice,
+		ExpiresOn:   o.ExpiresOn.String,
+		Status:      statusFromDB(o.Status),
+		Note:        o.Note,
+	}
+	if o.TransactionID.Valid {
+		out.TransactionId = &o.TransactionID.Int64
+	}
+	if o.ClosedAt.Valid {
+		out.ClosedAt = o.ClosedAt.Time.Format(time.RFC3339)
+	}
+	if o.CreatedAt.Valid {
+		out.CreatedAt = o.CreatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
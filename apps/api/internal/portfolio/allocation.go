@@ -0,0 +1,64 @@
+package portfolio
+
+import (
+	"context"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// unknownSector groups symbols that aren't in the companies table.
+const unknownSector = "Unknown"
+
+// SectorValues returns the market value held in each sector at the close of
+// date, replaying transactions up to that day. Callers must have checked
+// that the portfolio belongs to the user.
+func (s *PortfolioService) SectorValues(
+	ctx context.Context,
+	portfolioID int64,
+	date time.Time,
+) (map[string]float64, error) {
+	transactions, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    date.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      date.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	day := date.Format("2006-01-02")
+	l := newLedger()
+	for _, tx := range transactions {
+		if tx.TransactionDate.Format("2006-01-02") > day {
+			break
+		}
+		l.apply(tx)
+	}
+
+	// Prices are ordered by date, so the last close seen per symbol wins
+	closes := make(map[string]float64)
+	for _, p := range prices {
+		if p.ClosePrice > 0 {
+			closes[p.Symbol] = p.ClosePrice
+		}
+	}
+
+	out := make(map[string]float64)
+	for symbol, p := range l.positions {
+		if p.Quantity <= 0 {
+			continue
+		}
+		sector := unknownSector
+		if c, err := s.queries.GetCompany(ctx, symbol); err == nil && c.Sector != "" {
+			sector = c.Sector
+		}
+		out[sector] += float64(p.Quantity) * closes[symbol]
+	}
+	return out, nil
+}
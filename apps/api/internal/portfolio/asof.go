@@ -0,0 +1,121 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// parseAsOf reads an optional YYYY-MM-DD valuation date. The zero time means
+// value the portfolio now.
+func parseAsOf(asOf string, now time.Time) (time.Time, error) {
+	if asOf == "" {
+		return time.Time{}, nil
+	}
+	day, err := time.Parse("2006-01-02", asOf)
+	if err != nil {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, errors.New("as_of must be YYYY-MM-DD"))
+	}
+	if day.After(now) {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, errors.New("as_of is in the future"))
+	}
+	return day, nil
+}
+
+// valueHoldingsOn values the portfolio as of day, or now when day is zero.
+func (s *PortfolioService) valueHoldingsOn(ctx context.Context, portfolioID int64, day time.Time) (*valuation, error) {
+	if day.IsZero() {
+		return s.valueHoldings(ctx, portfolioID)
+	}
+
+	transactions, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows are computed exactly as the holdings table is, from the
+	// transactions up to day.
+	date := day.Format("2006-01-02")
+	end := len(transactions)
+	for i, tx := range transactions {
+		if tx.TransactionDate.Format("2006-01-02") > date {
+			end = i
+			break
+		}
+	}
+
+	var rows []sqlc.Holding
+	for _, h := range ComputeHoldings(transactions[:end]) {
+		if h.Quantity <= 0 {
+			continue
+		}
+		rows = append(rows, sqlc.Holding{
+			PortfolioID:      h.PortfolioID,
+			StockSymbol:      h.StockSymbol,
+			Quantity:         h.Quantity,
+			TotalBuyCost:     h.TotalBuyCost,
+			TotalBuyQuantity: h.TotalBuyQuantity,
+		})
+	}
+	slices.SortFunc(rows, func(a, b sqlc.Holding) int { return strings.Compare(a.StockSymbol, b.StockSymbol) })
+
+	priceMap, err := s.fetchPricesOn(ctx, portfolioID, rows, day)
+	if err != nil {
+		return nil, err
+	}
+	return s.priceHoldings(ctx, portfolioID, rows, priceMap, day)
+}
+
+// fetchPricesOn prices each holding at its last close on or before day, with
+// the day change measured from the close before that.
+func (s *PortfolioService) fetchPricesOn(
+	ctx context.Context,
+	portfolioID int64,
+	holdings []sqlc.Holding,
+	day time.Time,
+) (map[string]stockInfo, error) {
+	closes, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    day.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      day.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	last := make(map[string]float64)
+	previous := make(map[string]float64)
+	for _, c := range closes {
+		if c.ClosePrice <= 0 {
+			continue
+		}
+		previous[c.Symbol] = last[c.Symbol]
+		last[c.Symbol] = c.ClosePrice
+	}
+
+	info := make(map[string]stockInfo, len(holdings))
+	for _, h := range holdings {
+		si := stockInfo{Price: last[h.StockSymbol], Sector: "Unknown"}
+		if prev := previous[h.StockSymbol]; prev > 0 {
+			si.ChangeAmount = si.Price - prev
+			si.ChangePercent = si.ChangeAmount / prev * 100
+		}
+
+		company, err := s.queries.GetCompany(ctx, h.StockSymbol)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		if err == nil {
+			si.CompanyID = company.ID
+			si.Sector = company.Sector
+		}
+		info[h.StockSymbol] = si
+	}
+	return info, nil
+}
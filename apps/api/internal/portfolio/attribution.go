@@ -0,0 +1,148 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// GetAttribution breaks the portfolio's P&L over a period down by holding,
+// separating the return on positions held at the start (price effect) from
+// the return on money added or withdrawn during the period.
+func (s *PortfolioService) GetAttribution(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetAttributionRequest],
+) (*connect.Response[ntxv1.GetAttributionResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	from, to, err := parseDateRange(req.Msg.FromDate, req.Msg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: req.Msg.PortfolioId,
+		FromDate:    from.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      to.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := attribute(transactions, prices, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	return connect.NewResponse(resp), nil
+}
+
+// attribute values each symbol at the close of from and to. Trades on the
+// from date belong to the starting position; trades after it are flows.
+// The return is measured against the starting value plus money put in
+// during the period, so a portfolio funded mid-period still gets a
+// meaningful percentage.
+func attribute(
+	transactions []sqlc.Transaction,
+	prices []sqlc.ListPortfolioClosePricesRow,
+	from, to string,
+) *ntxv1.GetAttributionResponse {
+	startCloses := make(map[string]float64)
+	endCloses := make(map[string]float64)
+	for _, p := range prices {
+		if p.ClosePrice <= 0 {
+			continue
+		}
+		if p.BusinessDate <= from {
+			startCloses[p.Symbol] = p.ClosePrice
+		}
+		endCloses[p.Symbol] = p.ClosePrice
+	}
+
+	start, end := newLedger(), newLedger()
+	flows := make(map[string]float64)
+	var buys float64
+	for _, tx := range transactions {
+		date := tx.TransactionDate.Format("2006-01-02")
+		if date > to {
+			break
+		}
+		end.apply(tx)
+		if date <= from {
+			start.apply(tx)
+			continue
+		}
+
+		amount := float64(tx.Quantity) * tx.UnitPrice
+		if tx.TransactionType == "SELL" {
+			amount = -amount
+		}
+		if amount > 0 {
+			buys += amount
+		}
+		flows[tx.StockSymbol] += amount
+	}
+
+	resp := &ntxv1.GetAttributionResponse{}
+	for symbol, p := range end.positions {
+		var q0 int64
+		if sp, ok := start.positions[symbol]; ok {
+			q0 = sp.Quantity
+		}
+		if q0 == 0 && p.Quantity == 0 && flows[symbol] == 0 {
+			continue
+		}
+
+		p0, p1 := startCloses[symbol], endCloses[symbol]
+		a := &ntxv1.HoldingAttribution{
+			StockSymbol:   symbol,
+			StartQuantity: q0,
+			EndQuantity:   p.Quantity,
+			StartValue:    float64(q0) * p0,
+			EndValue:      float64(p.Quantity) * p1,
+			NetFlow:       flows[symbol],
+		}
+		if q0 != 0 {
+			a.PriceEffect = float64(q0) * (p1 - p0)
+		}
+		a.TotalPnl = a.EndValue - a.StartValue - a.NetFlow
+		a.NewMoneyEffect = a.TotalPnl - a.PriceEffect
+
+		resp.Holdings = append(resp.Holdings, a)
+		resp.StartValue += a.StartValue
+		resp.EndValue += a.EndValue
+		resp.NetFlow += a.NetFlow
+		resp.TotalPnl += a.TotalPnl
+	}
+
+	if base := resp.StartValue + buys; base > 0 {
+		resp.ReturnPercent = (resp.TotalPnl / base) * 100
+		for _, a := range resp.Holdings {
+			a.ContributionPercent = (a.TotalPnl / base) * 100
+		}
+	}
+
+	slices.SortFunc(resp.Holdings, func(a, b *ntxv1.HoldingAttribution) int {
+		return cmp.Compare(b.TotalPnl, a.TotalPnl)
+	})
+	return resp
+}
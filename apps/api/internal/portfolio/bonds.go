@@ -0,0 +1,244 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// debentureFaceValue is the face value of most debentures listed on NEPSE.
+const debentureFaceValue = 1000
+
+// couponDates returns the coupon date on or before on and the one after it.
+// Coupons step back from maturity every 12/perYear months; next is zero once
+// the bond has matured.
+func couponDates(maturity time.Time, perYear int64, on time.Time) (last, next time.Time) {
+	if !on.Before(maturity) {
+		return maturity, time.Time{}
+	}
+	step := int(12 / perYear)
+	next = maturity
+	for k := 1; ; k++ {
+		last = maturity.AddDate(0, -k*step, 0)
+		if !last.After(on) {
+			return last, next
+		}
+		next = last
+	}
+}
+
+// couponPerUnit is one coupon payment on one unit.
+func couponPerUnit(t sqlc.BondTerm) float64 {
+	return t.FaceValue * t.CouponRate / 100 / float64(t.CouponsPerYear)
+}
+
+// accruedPerUnit is the coupon one unit has earned since the last payment,
+// accrued evenly over the days of the coupon period.
+func accruedPerUnit(t sqlc.BondTerm, on time.Time) float64 {
+	maturity, err := time.Parse("2006-01-02", t.MaturityDate)
+	if err != nil {
+		return 0
+	}
+	last, next := couponDates(maturity, t.CouponsPerYear, on)
+	if next.IsZero() {
+		return 0
+	}
+	return couponPerUnit(t) * float64(daysBetween(last, on)) / float64(daysBetween(last, next))
+}
+
+// bondTerms returns the bond terms of a portfolio keyed by symbol.
+func (s *PortfolioService) bondTerms(ctx context.Context, portfolioID int64) (map[string]sqlc.BondTerm, error) {
+	rows, err := s.queries.ListBondTerms(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("list bond terms: %w", err)
+	}
+	terms := make(map[string]sqlc.BondTerm, len(rows))
+	for _, r := range rows {
+		terms[r.StockSymbol] = r
+	}
+	return terms, nil
+}
+
+// SetBondTerms marks a holding as a debenture or bond with the given coupon
+// terms, so it's valued with accrued interest rather than like an equity.
+func (s *PortfolioService) SetBondTerms(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetBondTermsRequest],
+) (*connect.Response[ntxv1.SetBondTermsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	faceValue := req.Msg.FaceValue
+	if faceValue == 0 {
+		faceValue = debentureFaceValue
+	}
+	if faceValue < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("face_value must be positive"))
+	}
+	if req.Msg.CouponRate < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("coupon_rate can't be negative"))
+	}
+	perYear := req.Msg.CouponsPerYear
+	if perYear == 0 {
+		perYear = 2
+	}
+	if perYear != 1 && perYear != 2 && perYear != 4 && perYear != 12 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("coupons_per_year must be 1, 2, 4 or 12"))
+	}
+	if _, err := time.Parse("2006-01-02", req.Msg.MaturityDate); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("maturity_date must be YYYY-MM-DD"))
+	}
+
+	holding, err := s.findHolding(ctx, req.Msg.PortfolioId, req.Msg.StockSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	terms, err := s.queries.SetBondTerms(ctx, sqlc.SetBondTermsParams{
+		PortfolioID:    req.Msg.PortfolioId,
+		StockSymbol:    holding.StockSymbol,
+		FaceValue:      faceValue,
+		CouponRate:     req.Msg.CouponRate,
+		CouponsPerYear: perYear,
+		MaturityDate:   req.Msg.MaturityDate,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.SetBondTermsResponse{Terms: bondTermsToProto(terms)}), nil
+}
+
+// ClearBondTerms drops a holding's bond terms; it's valued as an equity again.
+func (s *PortfolioService) ClearBondTerms(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ClearBondTermsRequest],
+) (*connect.Response[ntxv1.ClearBondTermsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	holding, err := s.findHolding(ctx, req.Msg.PortfolioId, req.Msg.StockSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteBondTerms(ctx, sqlc.DeleteBondTermsParams{
+		PortfolioID: req.Msg.PortfolioId,
+		StockSymbol: holding.StockSymbol,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ClearBondTermsResponse{}), nil
+}
+
+// GetBondSchedule lists the open bond holdings with their accrued interest,
+// next coupon and time to maturity.
+func (s *PortfolioService) GetBondSchedule(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetBondScheduleRequest],
+) (*connect.Response[ntxv1.GetBondScheduleResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if err := s.applyPendingEvents(ctx, req.Msg.PortfolioId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	holdings, err := s.queries.ListHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	quantities := make(map[string]int64, len(holdings))
+	for _, h := range holdings {
+		quantities[h.StockSymbol] = h.Quantity
+	}
+
+	terms, err := s.queries.ListBondTerms(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	today := time.Now()
+	var result []*ntxv1.BondSchedule
+	for _, t := range terms {
+		qty := quantities[t.StockSymbol]
+		if qty <= 0 {
+			continue
+		}
+		maturity, err := time.Parse("2006-01-02", t.MaturityDate)
+		if err != nil {
+			continue
+		}
+
+		last, next := couponDates(maturity, t.CouponsPerYear, today)
+		b := &ntxv1.BondSchedule{
+			Terms:           bondTermsToProto(t),
+			Quantity:        qty,
+			AccruedInterest: accruedPerUnit(t, today) * float64(qty),
+			LastCouponOn:    last.Format("2006-01-02"),
+			DaysToMaturity:  daysBetween(today, maturity),
+			RedemptionValue: t.FaceValue * float64(qty),
+		}
+		if !next.IsZero() {
+			b.NextCouponOn = next.Format("2006-01-02")
+			b.NextCouponAmount = couponPerUnit(t) * float64(qty)
+		}
+		result = append(result, b)
+	}
+
+	return connect.NewResponse(&ntxv1.GetBondScheduleResponse{Bonds: result}), nil
+}
+
+func bondTermsToProto(t sqlc.BondTerm) *ntxv1.BondTerms {
+	out := &ntxv1.BondTerms{
+		StockSymbol:    t.StockSymbol,
+		FaceValue:      t.FaceValue,
+		CouponRate:     t.CouponRate,
+		CouponsPerYear: t.CouponsPerYear,
+		MaturityDate:   t.MaturityDate,
+	}
+	if t.SetAt.Valid {
+		out.SetAt = t.SetAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
@@ -0,0 +1,106 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// GetBonusExpectations predicts the bonus shares each current holding should
+// receive from bonus issues announced in the last req.Days days.
+func (s *PortfolioService) GetBonusExpectations(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetBonusExpectationsRequest],
+) (*connect.Response[ntxv1.GetBonusExpectationsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	days := req.Msg.Days
+	if days <= 0 {
+		days = 365
+	}
+	since := time.Now().AddDate(0, 0, -int(days)).Format("2006-01-02")
+
+	if err := s.applyPendingEvents(ctx, req.Msg.PortfolioId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	actions, err := s.queries.ListRecentCorporateActionsForPortfolio(ctx,
+		sqlc.ListRecentCorporateActionsForPortfolioParams{
+			PortfolioID: req.Msg.PortfolioId,
+			Since:       sql.NullString{String: since, Valid: true},
+		})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	txs, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var result []*ntxv1.BonusExpectation
+	for _, a := range actions {
+		if a.BonusPercentage.Float64 <= 0 || len(a.SubmittedDate.String) < len("2006-01-02") {
+			continue
+		}
+		announced, err := time.Parse("2006-01-02", a.SubmittedDate.String[:len("2006-01-02")])
+		if err != nil {
+			continue
+		}
+		qty := quantityOn(txs, a.Symbol, announced)
+		if qty <= 0 {
+			continue
+		}
+
+		units := float64(qty) * a.BonusPercentage.Float64 / 100
+		whole := math.Floor(units)
+		result = append(result, &ntxv1.BonusExpectation{
+			StockSymbol:      a.Symbol,
+			FiscalYear:       a.FiscalYear,
+			BonusPercentage:  a.BonusPercentage.Float64,
+			AnnouncedOn:      announced.Format("2006-01-02"),
+			EligibleQuantity: qty,
+			ExpectedUnits:    int64(whole),
+			FractionalUnits:  units - whole,
+		})
+	}
+
+	return connect.NewResponse(&ntxv1.GetBonusExpectationsResponse{Expectations: result}), nil
+}
+
+// quantityOn replays txs, which must be in date order, to the units of symbol
+// held at the end of date.
+func quantityOn(txs []sqlc.Transaction, symbol string, date time.Time) int64 {
+	var qty int64
+	for _, tx := range txs {
+		if tx.TransactionDate.After(date) {
+			break
+		}
+		if tx.StockSymbol != symbol {
+			continue
+		}
+		if tx.TransactionType == "BUY" {
+			qty += tx.Quantity
+			continue
+		}
+		qty -= tx.Quantity
+	}
+	return qty
+}
@@ -0,0 +1,304 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// CreateBrokerAccount records a TMS account the user trades through.
+func (s *PortfolioService) CreateBrokerAccount(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateBrokerAccountRequest],
+) (*connect.Response[ntxv1.CreateBrokerAccountResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID := strings.TrimSpace(req.Msg.ClientId)
+	if req.Msg.BrokerNumber <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("broker_number must be positive"))
+	}
+	if clientID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("client_id is required"))
+	}
+
+	accounts, err := s.queries.ListBrokerAccountsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, a := range accounts {
+		if a.BrokerNumber == int64(req.Msg.BrokerNumber) && a.ClientID == clientID {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("broker account already exists"))
+		}
+	}
+
+	account, err := s.queries.CreateBrokerAccount(ctx, sqlc.CreateBrokerAccountParams{
+		UserID:       userID,
+		BrokerNumber: int64(req.Msg.BrokerNumber),
+		ClientID:     clientID,
+		Name:         strings.TrimSpace(req.Msg.Name),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateBrokerAccountResponse{Account: brokerAccountToProto(account)}), nil
+}
+
+// ListBrokerAccounts returns the user's broker accounts by broker number.
+func (s *PortfolioService) ListBrokerAccounts(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListBrokerAccountsRequest],
+) (*connect.Response[ntxv1.ListBrokerAccountsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.queries.ListBrokerAccountsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.BrokerAccount, len(accounts))
+	for i, a := range accounts {
+		result[i] = brokerAccountToProto(a)
+	}
+
+	return connect.NewResponse(&ntxv1.ListBrokerAccountsResponse{Accounts: result}), nil
+}
+
+// DeleteBrokerAccount removes a broker account; its transactions are kept
+// but no longer attributed to a broker.
+func (s *PortfolioService) DeleteBrokerAccount(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteBrokerAccountRequest],
+) (*connect.Response[ntxv1.DeleteBrokerAccountResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteBrokerAccount(ctx, sqlc.DeleteBrokerAccountParams{
+		ID:     req.Msg.AccountId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteBrokerAccountResponse{}), nil
+}
+
+// SetTransactionBroker assigns a transaction to one of the user's broker
+// accounts, or clears the assignment.
+func (s *PortfolioService) SetTransactionBroker(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetTransactionBrokerRequest],
+) (*connect.Response[ntxv1.SetTransactionBrokerResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction to verify ownership
+	tx, err := s.queries.GetTransaction(ctx, req.Msg.TransactionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("transaction not found"))
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     tx.PortfolioID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("not authorized"))
+	}
+
+	if req.Msg.BrokerAccountId == nil {
+		if err := s.queries.ClearTransactionBroker(ctx, tx.ID); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(&ntxv1.SetTransactionBrokerResponse{}), nil
+	}
+
+	if err := s.setTransactionBroker(ctx, userID, tx.ID, *req.Msg.BrokerAccountId); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&ntxv1.SetTransactionBrokerResponse{}), nil
+}
+
+// GetBrokerCommissions estimates the fees paid through each broker account
+// from the trades booked against it.
+func (s *PortfolioService) GetBrokerCommissions(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetBrokerCommissionsRequest],
+) (*connect.Response[ntxv1.GetBrokerCommissionsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var portfolioIDs []int64
+	if req.Msg.PortfolioId != nil {
+		// Verify portfolio belongs to user
+		_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+			ID:     *req.Msg.PortfolioId,
+			UserID: userID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+		}
+		portfolioIDs = append(portfolioIDs, *req.Msg.PortfolioId)
+	}
+	if req.Msg.PortfolioId == nil {
+		portfolios, err := s.queries.ListPortfoliosByUser(ctx, userID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, p := range portfolios {
+			if !p.Paper {
+				portfolioIDs = append(portfolioIDs, p.ID)
+			}
+		}
+	}
+
+	var from, to time.Time
+	if req.Msg.FromDate != "" {
+		from, err = time.Parse("2006-01-02", req.Msg.FromDate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid from_date: %w", err))
+		}
+	}
+	if req.Msg.ToDate != "" {
+		to, err = time.Parse("2006-01-02", req.Msg.ToDate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid to_date: %w", err))
+		}
+	}
+
+	accounts, err := s.queries.ListBrokerAccountsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	byID := make(map[int64]*ntxv1.BrokerCommission, len(accounts))
+	for _, a := range accounts {
+		byID[a.ID] = &ntxv1.BrokerCommission{Account: brokerAccountToProto(a)}
+	}
+
+	for _, pid := range portfolioIDs {
+		transactions, err := s.queries.ListTransactionsChronological(ctx, pid)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		brokers, err := s.transactionBrokers(ctx, pid)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		for _, tx := range transactions {
+			if !from.IsZero() && tx.TransactionDate.Before(from) {
+				continue
+			}
+			if !to.IsZero() && tx.TransactionDate.After(to) {
+				continue
+			}
+
+			b, ok := byID[brokers[tx.ID]]
+			if !ok {
+				b = &ntxv1.BrokerCommission{}
+				byID[brokers[tx.ID]] = b
+			}
+
+			amount := float64(tx.Quantity) * tx.UnitPrice
+			fees := feesFor(amount)
+			b.TradeCount++
+			if tx.TransactionType == "SELL" {
+				b.SellAmount += amount
+			}
+			if tx.TransactionType != "SELL" {
+				b.BuyAmount += amount
+			}
+			b.Commission += fees.Commission
+			b.SebonFee += fees.SebonFee
+			b.DpCharges += fees.DPCharge
+			b.TotalFees += fees.Total()
+		}
+	}
+
+	result := make([]*ntxv1.BrokerCommission, 0, len(byID))
+	for _, b := range byID {
+		result = append(result, b)
+	}
+	// Accounts by broker number, with unassigned trades last
+	slices.SortFunc(result, func(a, b *ntxv1.BrokerCommission) int {
+		if (a.Account == nil) != (b.Account == nil) {
+			if a.Account == nil {
+				return 1
+			}
+			return -1
+		}
+		if c := cmp.Compare(a.GetAccount().GetBrokerNumber(), b.GetAccount().GetBrokerNumber()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.GetAccount().GetClientId(), b.GetAccount().GetClientId())
+	})
+
+	return connect.NewResponse(&ntxv1.GetBrokerCommissionsResponse{Brokers: result}), nil
+}
+
+// setTransactionBroker links a transaction to a broker account after
+// checking the account belongs to the user.
+func (s *PortfolioService) setTransactionBroker(ctx context.Context, userID, transactionID, accountID int64) error {
+	_, err := s.queries.GetBrokerAccount(ctx, sqlc.GetBrokerAccountParams{ID: accountID, UserID: userID})
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, errors.New("broker account not found"))
+	}
+
+	err = s.queries.SetTransactionBroker(ctx, sqlc.SetTransactionBrokerParams{
+		TransactionID:   transactionID,
+		BrokerAccountID: accountID,
+	})
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	return nil
+}
+
+// transactionBrokers maps each transaction of a portfolio that has a broker
+// to its broker account ID.
+func (s *PortfolioService) transactionBrokers(ctx context.Context, portfolioID int64) (map[int64]int64, error) {
+	rows, err := s.queries.ListTransactionBrokersByPortfolio(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		result[r.TransactionID] = r.BrokerAccountID
+	}
+	return result, nil
+}
+
+func brokerAccountToProto(a sqlc.BrokerAccount) *ntxv1.BrokerAccount {
+	return &ntxv1.BrokerAccount{
+		Id:           a.ID,
+		BrokerNumber: convert.SafeInt32(a.BrokerNumber),
+		ClientId:     a.ClientID,
+		Name:         a.Name,
+	}
+}
@@ -0,0 +1,149 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// GetConsolidatedSummary rolls up every portfolio of the user into one view,
+// with a per-portfolio breakdown and a combined tax position for households
+// that file together. Given a profile, only that holder's portfolios count.
+func (s *PortfolioService) GetConsolidatedSummary(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetConsolidatedSummaryRequest],
+) (*connect.Response[ntxv1.GetConsolidatedSummaryResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ProfileId != nil {
+		if err := s.checkProfile(ctx, userID, *req.Msg.ProfileId); err != nil {
+			return nil, err
+		}
+	}
+
+	portfolios, err := s.queries.ListPortfoliosByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	profiles, err := s.portfolioProfiles(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	fyStart := fiscalYearStart(time.Now())
+	summary := &ntxv1.ConsolidatedSummary{}
+	merged := make(map[string]*ntxv1.Holding)
+	invested := make(map[string]float64)
+	var order []string
+	var tax taxTotals
+
+	for _, p := range portfolios {
+		// Paper trades are hypothetical and stay out of real wealth
+		if p.Paper {
+			continue
+		}
+		profileID, assigned := profiles[p.ID]
+		if req.Msg.ProfileId != nil && profileID != *req.Msg.ProfileId {
+			continue
+		}
+
+		v, err := s.valueHoldings(ctx, p.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		breakdown := &ntxv1.PortfolioBreakdown{
+			PortfolioId:       p.ID,
+			PortfolioName:     p.Name,
+			TotalInvested:     v.invested,
+			TotalCurrentValue: v.currentValue,
+			TotalProfitLoss:   v.currentValue - v.invested,
+			DayChangeValue:    v.dayChange,
+		}
+		if assigned {
+			breakdown.ProfileId = &profileID
+		}
+		summary.Portfolios = append(summary.Portfolios, breakdown)
+		summary.TotalInvested += v.invested
+		summary.TotalCurrentValue += v.currentValue
+		summary.DayChangeValue += v.dayChange
+
+		for _, h := range v.holdings {
+			m, ok := merged[h.StockSymbol]
+			if !ok {
+				m = &ntxv1.Holding{
+					StockSymbol:      h.StockSymbol,
+					CurrentPrice:     h.CurrentPrice,
+					Sector:           h.Sector,
+					DayChangePercent: h.DayChangePercent,
+				}
+				merged[h.StockSymbol] = m
+				order = append(order, h.StockSymbol)
+			}
+			m.Quantity += h.Quantity
+			invested[h.StockSymbol] += h.AvgBuyPrice * float64(h.Quantity)
+			m.TotalValue += h.TotalValue
+			m.DayChangeValue += h.DayChangeValue
+		}
+
+		transactions, err := s.queries.ListTransactionsChronological(ctx, p.ID)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		_, disposals := matchLots(transactions)
+		for _, d := range disposals {
+			if d.Sold.Before(fyStart) {
+				continue
+			}
+			tax.add(d)
+		}
+
+		proceeds, err := s.queries.ListRenunciationProceeds(ctx, sqlc.ListRenunciationProceedsParams{
+			PortfolioID:        p.ID,
+			ProceedsReceivedOn: sql.NullString{String: fyStart.Format("2006-01-02"), Valid: true},
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		for _, r := range proceeds {
+			tax.addProceeds(r.Proceeds)
+		}
+	}
+
+	for _, symbol := range order {
+		h := merged[symbol]
+		cost := invested[symbol]
+		h.AvgBuyPrice = cost / float64(h.Quantity)
+		h.ProfitLoss = h.TotalValue - cost
+		if cost > 0 {
+			h.ProfitLossPercent = (h.ProfitLoss / cost) * 100
+		}
+		if summary.TotalCurrentValue > 0 {
+			h.WeightPercent = (h.TotalValue / summary.TotalCurrentValue) * 100
+		}
+		summary.Holdings = append(summary.Holdings, h)
+	}
+
+	for _, b := range summary.Portfolios {
+		if summary.TotalCurrentValue > 0 {
+			b.WeightPercent = (b.TotalCurrentValue / summary.TotalCurrentValue) * 100
+		}
+	}
+
+	summary.TotalProfitLoss = summary.TotalCurrentValue - summary.TotalInvested
+	if summary.TotalInvested > 0 {
+		summary.TotalProfitLossPercent = (summary.TotalProfitLoss / summary.TotalInvested) * 100
+	}
+
+	summary.Tax = tax.summary(fyStart)
+
+	return connect.NewResponse(&ntxv1.GetConsolidatedSummaryResponse{Summary: summary}), nil
+}
@@ -0,0 +1,338 @@
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// costConflictTolerance is how far apart, as a fraction, two costs for the
+// same holding may be before reconciliation flags them. The WACC report
+// folds in fees the raw buys don't, so exact matches are rare.
+const costConflictTolerance = 0.005
+
+// costSources maps the recordable sources to their holding_costs values,
+// most trusted first.
+var costSources = []struct {
+	source ntxv1.CostSource
+	name   string
+}{
+	{ntxv1.CostSource_COST_SOURCE_MANUAL, "MANUAL"},
+	{ntxv1.CostSource_COST_SOURCE_WACC, "WACC"},
+}
+
+// costBasisColumns is the header of the Mero Share WACC report, which
+// ExportCostBasis reproduces so the file can be checked against, and keyed
+// back into, CDSC's own figures.
+var costBasisColumns = []string{"S.N.", "Scrip", "Current Balance", "WACC Rate", "Total Cost"}
+
+func costSourceName(source ntxv1.CostSource) (string, bool) {
+	for _, c := range costSources {
+		if c.source == source {
+			return c.name, true
+		}
+	}
+	return "", false
+}
+
+// effectiveCost picks the average cost a holding is valued at: the most
+// trusted recorded source, or the cost computed from transactions when
+// nothing was recorded.
+func effectiveCost(computed float64, recorded []sqlc.HoldingCost) (float64, ntxv1.CostSource) {
+	for _, c := range costSources {
+		for _, r := range recorded {
+			if r.Source == c.name {
+				return r.AvgCost, c.source
+			}
+		}
+	}
+	return computed, ntxv1.CostSource_COST_SOURCE_TRANSACTIONS
+}
+
+// holdingCosts returns the recorded costs of a portfolio keyed by symbol.
+func (s *PortfolioService) holdingCosts(ctx context.Context, portfolioID int64) (map[string][]sqlc.HoldingCost, error) {
+	rows, err := s.queries.ListHoldingCosts(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("list holding costs: %w", err)
+	}
+	costs := make(map[string][]sqlc.HoldingCost)
+	for _, r := range rows {
+		costs[r.StockSymbol] = append(costs[r.StockSymbol], r)
+	}
+	return costs, nil
+}
+
+// SetHoldingCost records a holding's average cost from one source. Each
+// source keeps its own value, so a WACC figure never replaces a manual one;
+// GetCostReconciliation shows where they disagree.
+func (s *PortfolioService) SetHoldingCost(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetHoldingCostRequest],
+) (*connect.Response[ntxv1.SetHoldingCostResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	source, ok := costSourceName(req.Msg.Source)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source must be WACC or MANUAL"))
+	}
+	if req.Msg.AvgCost <= 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("avg_cost must be positive"))
+	}
+
+	holding, err := s.findHolding(ctx, req.Msg.PortfolioId, req.Msg.StockSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := s.queries.SetHoldingCost(ctx, sqlc.SetHoldingCostParams{
+		PortfolioID: req.Msg.PortfolioId,
+		StockSymbol: holding.StockSymbol,
+		Source:      source,
+		AvgCost:     req.Msg.AvgCost,
+		Note:        strings.TrimSpace(req.Msg.Note),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.SetHoldingCostResponse{Entry: costEntryToProto(cost)}), nil
+}
+
+// ClearHoldingCost drops one source's cost for a holding.
+func (s *PortfolioService) ClearHoldingCost(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ClearHoldingCostRequest],
+) (*connect.Response[ntxv1.ClearHoldingCostResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	source, ok := costSourceName(req.Msg.Source)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("source must be WACC or MANUAL"))
+	}
+
+	holding, err := s.findHolding(ctx, req.Msg.PortfolioId, req.Msg.StockSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteHoldingCost(ctx, sqlc.DeleteHoldingCostParams{
+		PortfolioID: req.Msg.PortfolioId,
+		StockSymbol: holding.StockSymbol,
+		Source:      source,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ClearHoldingCostResponse{}), nil
+}
+
+// GetCostReconciliation lists, for each open holding, the cost computed from
+// transactions next to every recorded cost, and which one is in effect.
+func (s *PortfolioService) GetCostReconciliation(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetCostReconciliationRequest],
+) (*connect.Response[ntxv1.GetCostReconciliationResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if err := s.applyPendingEvents(ctx, req.Msg.PortfolioId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	holdings, err := s.queries.ListHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	costs, err := s.holdingCosts(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var result []*ntxv1.CostReconciliation
+	for _, h := range holdings {
+		computed := 0.0
+		if h.TotalBuyQuantity > 0 {
+			computed = h.TotalBuyCost / float64(h.TotalBuyQuantity)
+		}
+		recorded := costs[h.StockSymbol]
+		cost, source := effectiveCost(computed, recorded)
+
+		r := &ntxv1.CostReconciliation{
+			StockSymbol:     h.StockSymbol,
+			Quantity:        h.Quantity,
+			EffectiveSource: source,
+			EffectiveCost:   cost,
+		}
+		if computed > 0 {
+			r.Entries = append(r.Entries, &ntxv1.CostEntry{
+				Source:  ntxv1.CostSource_COST_SOURCE_TRANSACTIONS,
+				AvgCost: computed,
+			})
+		}
+		for _, c := range costSources {
+			for _, rc := range recorded {
+				if rc.Source == c.name {
+					r.Entries = append(r.Entries, costEntryToProto(rc))
+				}
+			}
+		}
+		r.Conflict = costsConflict(r.Entries)
+
+		if req.Msg.ConflictsOnly && !r.Conflict {
+			continue
+		}
+		result = append(result, r)
+	}
+
+	return connect.NewResponse(&ntxv1.GetCostReconciliationResponse{Holdings: result}), nil
+}
+
+// ExportCostBasis writes the open holdings as a WACC report CSV, each at the
+// cost it is valued at here rather than the one computed from transactions.
+func (s *PortfolioService) ExportCostBasis(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ExportCostBasisRequest],
+) (*connect.Response[ntxv1.ExportCostBasisResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if err := s.applyPendingEvents(ctx, req.Msg.PortfolioId); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	holdings, err := s.queries.ListHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	costs, err := s.holdingCosts(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(costBasisColumns)
+	for i, h := range holdings {
+		computed := 0.0
+		if h.TotalBuyQuantity > 0 {
+			computed = h.TotalBuyCost / float64(h.TotalBuyQuantity)
+		}
+		cost, _ := effectiveCost(computed, costs[h.StockSymbol])
+		_ = w.Write([]string{
+			strconv.Itoa(i + 1),
+			h.StockSymbol,
+			strconv.FormatInt(h.Quantity, 10),
+			strconv.FormatFloat(cost, 'f', 2, 64),
+			strconv.FormatFloat(cost*float64(h.Quantity), 'f', 2, 64),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ExportCostBasisResponse{
+		Filename: "wacc-" + time.Now().Format("2006-01-02") + ".csv",
+		CsvData:  buf.Bytes(),
+	}), nil
+}
+
+// findHolding returns the open holding for symbol, matched case-insensitively
+// so the stored spelling is kept.
+func (s *PortfolioService) findHolding(ctx context.Context, portfolioID int64, symbol string) (sqlc.Holding, error) {
+	if err := s.applyPendingEvents(ctx, portfolioID); err != nil {
+		return sqlc.Holding{}, connect.NewError(connect.CodeInternal, err)
+	}
+	holdings, err := s.queries.ListHoldings(ctx, portfolioID)
+	if err != nil {
+		return sqlc.Holding{}, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, h := range holdings {
+		if strings.EqualFold(h.StockSymbol, strings.TrimSpace(symbol)) {
+			return h, nil
+		}
+	}
+	return sqlc.Holding{}, connect.NewError(connect.CodeNotFound, errors.New("holding not found"))
+}
+
+// costsConflict reports whether the highest and lowest costs differ by more
+// than costConflictTolerance.
+func costsConflict(entries []*ntxv1.CostEntry) bool {
+	if len(entries) < 2 {
+		return false
+	}
+	lo, hi := entries[0].AvgCost, entries[0].AvgCost
+	for _, e := range entries[1:] {
+		lo = min(lo, e.AvgCost)
+		hi = max(hi, e.AvgCost)
+	}
+	return hi/lo-1 > costConflictTolerance
+}
+
+func costEntryToProto(c sqlc.HoldingCost) *ntxv1.CostEntry {
+	out := &ntxv1.CostEntry{AvgCost: c.AvgCost, Note: c.Note}
+	for _, s := range costSources {
+		if s.name == c.Source {
+			out.Source = s.source
+		}
+	}
+	if c.SetAt.Valid {
+		out.SetAt = c.SetAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
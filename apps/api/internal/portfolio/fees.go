@@ -0,0 +1,59 @@
+package portfolio
+
+// Charges on a NEPSE trade, as set by SEBON.
+const (
+	minCommission = 10
+	sebonFeeRate  = 0.00015
+	dpCharge      = 25 // per scrip per trade
+)
+
+// commissionTiers are broker commission rates by trade amount. The rate for
+// the tier an amount falls in applies to the whole amount.
+var commissionTiers = []struct {
+	upTo float64
+	rate float64
+}{
+	{50_000, 0.0036},
+	{500_000, 0.0033},
+	{2_000_000, 0.0031},
+	{10_000_000, 0.0027},
+}
+
+const topCommissionRate = 0.0024
+
+// tradeFees is what a single buy or sell costs on top of the trade amount.
+type tradeFees struct {
+	Commission float64
+	SebonFee   float64
+	DPCharge   float64
+}
+
+func (f tradeFees) Total() float64 {
+	return f.Commission + f.SebonFee + f.DPCharge
+}
+
+// feesFor returns the charges on a trade of the given amount.
+func feesFor(amount float64) tradeFees {
+	if amount <= 0 {
+		return tradeFees{}
+	}
+
+	rate := topCommissionRate
+	for _, t := range commissionTiers {
+		if amount <= t.upTo {
+			rate = t.rate
+			break
+		}
+	}
+
+	return tradeFees{
+		Commission: max(amount*rate, minCommission),
+		SebonFee:   amount * sebonFeeRate,
+		DPCharge:   dpCharge,
+	}
+}
+
+// TradeFees is the total charged on a buy or sell of the given amount.
+func TradeFees(amount float64) float64 {
+	return feesFor(amount).Total()
+}
@@ -0,0 +1,384 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// maxGroupNameLength keeps group names short enough to label a chart slice.
+const maxGroupNameLength = 40
+
+// Member kinds stored in holding_group_members.
+const (
+	memberSector = "sector"
+	memberSymbol = "symbol"
+)
+
+// CreateHoldingGroup adds a custom allocation group for the authenticated user.
+func (s *PortfolioService) CreateHoldingGroup(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateHoldingGroupRequest],
+) (*connect.Response[ntxv1.CreateHoldingGroupResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.checkHoldingGroup(ctx, userID, &ntxv1.HoldingGroup{
+		Name:             req.Msg.Name,
+		Sectors:          req.Msg.Sectors,
+		Symbols:          req.Msg.Symbols,
+		MaxWeightPercent: req.Msg.MaxWeightPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.CreateHoldingGroup(ctx, sqlc.CreateHoldingGroupParams{
+		UserID:           userID,
+		Name:             group.Name,
+		MaxWeightPercent: nullWeight(group.MaxWeightPercent),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	group.Id = row.ID
+	if err := s.addGroupMembers(ctx, group); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateHoldingGroupResponse{Group: group}), nil
+}
+
+// ListHoldingGroups returns the user's groups in name order.
+func (s *PortfolioService) ListHoldingGroups(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListHoldingGroupsRequest],
+) (*connect.Response[ntxv1.ListHoldingGroupsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.loadHoldingGroups(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ListHoldingGroupsResponse{Groups: groups.list}), nil
+}
+
+// UpdateHoldingGroup replaces a group's name, members and limit.
+func (s *PortfolioService) UpdateHoldingGroup(
+	ctx context.Context,
+	req *connect.Request[ntxv1.UpdateHoldingGroupRequest],
+) (*connect.Response[ntxv1.UpdateHoldingGroupResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := s.checkHoldingGroup(ctx, userID, &ntxv1.HoldingGroup{
+		Id:               req.Msg.GroupId,
+		Name:             req.Msg.Name,
+		Sectors:          req.Msg.Sectors,
+		Symbols:          req.Msg.Symbols,
+		MaxWeightPercent: req.Msg.MaxWeightPercent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.queries.UpdateHoldingGroup(ctx, sqlc.UpdateHoldingGroupParams{
+		Name:             group.Name,
+		MaxWeightPercent: nullWeight(group.MaxWeightPercent),
+		ID:               group.Id,
+		UserID:           userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("group not found"))
+	}
+	if err := s.queries.ClearHoldingGroupMembers(ctx, group.Id); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := s.addGroupMembers(ctx, group); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.UpdateHoldingGroupResponse{Group: group}), nil
+}
+
+// DeleteHoldingGroup removes a group; its holdings fall back to their sectors.
+func (s *PortfolioService) DeleteHoldingGroup(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteHoldingGroupRequest],
+) (*connect.Response[ntxv1.DeleteHoldingGroupResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteHoldingGroup(ctx, sqlc.DeleteHoldingGroupParams{
+		ID:     req.Msg.GroupId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteHoldingGroupResponse{}), nil
+}
+
+// GroupHoldings breaks the portfolio's value down by the user's groups, with
+// ungrouped holdings under their sector.
+func (s *PortfolioService) GroupHoldings(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GroupHoldingsRequest],
+) (*connect.Response[ntxv1.GroupHoldingsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	v, err := s.valueHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	groups, err := s.loadHoldingGroups(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.GroupHoldingsResponse{Groups: groups.allocate(v.holdings)}), nil
+}
+
+// holdingGroups is a user's groups indexed for looking up a holding's group.
+type holdingGroups struct {
+	list     []*ntxv1.HoldingGroup
+	bySymbol map[string]*ntxv1.HoldingGroup
+	bySector map[string]*ntxv1.HoldingGroup // keyed by lower-case sector
+}
+
+func (s *PortfolioService) loadHoldingGroups(ctx context.Context, userID int64) (*holdingGroups, error) {
+	rows, err := s.queries.ListHoldingGroupsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.queries.ListHoldingGroupMembersByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &holdingGroups{
+		bySymbol: make(map[string]*ntxv1.HoldingGroup),
+		bySector: make(map[string]*ntxv1.HoldingGroup),
+	}
+	byID := make(map[int64]*ntxv1.HoldingGroup, len(rows))
+	for _, r := range rows {
+		group := &ntxv1.HoldingGroup{Id: r.ID, Name: r.Name}
+		if r.MaxWeightPercent.Valid {
+			group.MaxWeightPercent = &r.MaxWeightPercent.Float64
+		}
+		g.list = append(g.list, group)
+		byID[r.ID] = group
+	}
+	for _, m := range members {
+		group := byID[m.GroupID]
+		switch m.Kind {
+		case memberSector:
+			group.Sectors = append(group.Sectors, m.Value)
+			g.bySector[strings.ToLower(m.Value)] = group
+		case memberSymbol:
+			group.Symbols = append(group.Symbols, m.Value)
+			g.bySymbol[m.Value] = group
+		}
+	}
+	return g, nil
+}
+
+// find returns the group a holding belongs to, or nil.
+func (g *holdingGroups) find(h *ntxv1.Holding) *ntxv1.HoldingGroup {
+	if group, ok := g.bySymbol[h.StockSymbol]; ok {
+		return group
+	}
+	return g.bySector[strings.ToLower(h.Sector)]
+}
+
+// assign fills in each holding's group name.
+func (g *holdingGroups) assign(holdings []*ntxv1.Holding) {
+	for _, h := range holdings {
+		if group := g.find(h); group != nil {
+			h.Group = group.Name
+		}
+	}
+}
+
+// allocate totals holdings per group, or per sector for ungrouped ones,
+// largest first.
+func (g *holdingGroups) allocate(holdings []*ntxv1.Holding) []*ntxv1.GroupAllocation {
+	var total float64
+	slots := make(map[string]*ntxv1.GroupAllocation)
+	var result []*ntxv1.GroupAllocation
+	for _, h := range holdings {
+		total += h.TotalValue
+
+		a := &ntxv1.GroupAllocation{Name: cmp.Or(h.Sector, unknownSector)}
+		key := "sector/" + a.Name
+		if group := g.find(h); group != nil {
+			a = &ntxv1.GroupAllocation{Name: group.Name, GroupId: &group.Id, MaxWeightPercent: group.MaxWeightPercent}
+			key = fmt.Sprintf("group/%d", group.Id)
+		}
+		if _, ok := slots[key]; !ok {
+			slots[key] = a
+			result = append(result, a)
+		}
+		a = slots[key]
+		a.Value += h.TotalValue
+		a.Symbols = append(a.Symbols, h.StockSymbol)
+	}
+
+	for _, a := range result {
+		if total > 0 {
+			a.WeightPercent = a.Value / total * 100
+		}
+		a.OverLimit = a.MaxWeightPercent != nil && a.WeightPercent > *a.MaxWeightPercent
+		slices.Sort(a.Symbols)
+	}
+	slices.SortFunc(result, func(a, b *ntxv1.GroupAllocation) int {
+		return cmp.Or(cmp.Compare(b.Value, a.Value), strings.Compare(a.Name, b.Name))
+	})
+	return result
+}
+
+// concentrationTips warns about groups over their limit.
+func concentrationTips(allocations []*ntxv1.GroupAllocation) []*ntxv1.HealthTip {
+	var tips []*ntxv1.HealthTip
+	for _, a := range allocations {
+		if !a.OverLimit {
+			continue
+		}
+		tips = append(tips, &ntxv1.HealthTip{
+			Message: fmt.Sprintf("%s is %.1f%% of the portfolio, above your %g%% limit.",
+				a.Name, a.WeightPercent, *a.MaxWeightPercent),
+			Type: "WARNING",
+		})
+	}
+	return tips
+}
+
+// checkHoldingGroup validates and normalizes a group, rejecting a name or
+// member already used by another of the user's groups. A sector or symbol
+// may only be in one group, so every holding has a single place in the
+// allocation.
+func (s *PortfolioService) checkHoldingGroup(
+	ctx context.Context,
+	userID int64,
+	group *ntxv1.HoldingGroup,
+) (*ntxv1.HoldingGroup, error) {
+	group.Name = strings.TrimSpace(group.Name)
+	if group.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if len(group.Name) > maxGroupNameLength {
+		err := fmt.Errorf("name is longer than %d characters", maxGroupNameLength)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if w := group.MaxWeightPercent; w != nil && (*w <= 0 || *w > 100) {
+		err := errors.New("max_weight_percent must be above 0 and at most 100")
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	group.Sectors = normalizeMembers(group.Sectors, strings.TrimSpace)
+	group.Symbols = normalizeMembers(group.Symbols, func(s string) string {
+		return strings.ToUpper(strings.TrimSpace(s))
+	})
+	if len(group.Sectors) == 0 && len(group.Symbols) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a group needs a sector or symbol"))
+	}
+
+	existing, err := s.loadHoldingGroups(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, other := range existing.list {
+		if other.Id == group.Id {
+			continue
+		}
+		if strings.EqualFold(other.Name, group.Name) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errors.New("group already exists"))
+		}
+		for _, sector := range group.Sectors {
+			if slices.ContainsFunc(other.Sectors, func(s string) bool { return strings.EqualFold(s, sector) }) {
+				err := fmt.Errorf("sector %s is already in %s", sector, other.Name)
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
+		}
+		for _, symbol := range group.Symbols {
+			if slices.Contains(other.Symbols, symbol) {
+				err := fmt.Errorf("%s is already in %s", symbol, other.Name)
+				return nil, connect.NewError(connect.CodeAlreadyExists, err)
+			}
+		}
+	}
+	return group, nil
+}
+
+func (s *PortfolioService) addGroupMembers(ctx context.Context, group *ntxv1.HoldingGroup) error {
+	add := func(kind string, values []string) error {
+		for _, v := range values {
+			err := s.queries.AddHoldingGroupMember(ctx, sqlc.AddHoldingGroupMemberParams{
+				GroupID: group.Id,
+				Kind:    kind,
+				Value:   v,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(memberSector, group.Sectors); err != nil {
+		return err
+	}
+	return add(memberSymbol, group.Symbols)
+}
+
+// normalizeMembers cleans up member names, dropping blanks and repeats.
+func normalizeMembers(values []string, clean func(string) string) []string {
+	var result []string
+	for _, v := range values {
+		v = clean(v)
+		if v == "" || slices.ContainsFunc(result, func(r string) bool { return strings.EqualFold(r, v) }) {
+			continue
+		}
+		result = append(result, v)
+	}
+	slices.Sort(result)
+	return result
+}
+
+func nullWeight(w *float64) sql.NullFloat64 {
+	if w == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *w, Valid: true}
+}
@@ -0,0 +1,111 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// defaultGrowthMonths is how many months GetGrowthBreakdown returns when the
+// request doesn't say.
+const defaultGrowthMonths = 12
+
+// GetGrowthBreakdown splits each month's change in value into money put in
+// or taken out and what the market did, so deposits don't read as returns.
+func (s *PortfolioService) GetGrowthBreakdown(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetGrowthBreakdownRequest],
+) (*connect.Response[ntxv1.GetGrowthBreakdownResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	months, err := s.monthlyGrowth(ctx, req.Msg.PortfolioId, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	n := int(req.Msg.Months)
+	if n <= 0 {
+		n = defaultGrowthMonths
+	}
+	months = months[max(len(months)-n, 0):]
+
+	resp := &ntxv1.GetGrowthBreakdownResponse{Months: months}
+	for _, m := range months {
+		resp.TotalContributions += m.Contributions
+		resp.TotalMarketGrowth += m.MarketGrowth
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// monthlyGrowth covers every month from the first transaction's to now. Each
+// month ends valued at the latest closes on or before its last day.
+func (s *PortfolioService) monthlyGrowth(
+	ctx context.Context,
+	portfolioID int64,
+	now time.Time,
+) ([]*ntxv1.MonthlyGrowth, error) {
+	txs, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	first := txs[0].TransactionDate
+	start := time.Date(first.Year(), first.Month(), 1, 0, 0, 0, 0, time.UTC)
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    start.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      now.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	contributions := make(map[string]float64)
+	for _, tx := range txs {
+		amount := float64(tx.Quantity) * tx.UnitPrice
+		if tx.TransactionType == "SELL" {
+			amount = -amount
+		}
+		contributions[tx.TransactionDate.Format("2006-01")] += amount
+	}
+
+	r := newReplay(txs, prices)
+	var months []*ntxv1.MonthlyGrowth
+	var previous float64
+	for m := start; !m.After(now); m = m.AddDate(0, 1, 0) {
+		end := m.AddDate(0, 1, -1)
+		if end.After(now) {
+			end = now
+		}
+		value, _ := r.advance(end.Format("2006-01-02"))
+		month := m.Format("2006-01")
+		months = append(months, &ntxv1.MonthlyGrowth{
+			Month:         month,
+			StartValue:    previous,
+			EndValue:      value,
+			Contributions: contributions[month],
+			MarketGrowth:  value - previous - contributions[month],
+		})
+		previous = value
+	}
+	return months, nil
+}
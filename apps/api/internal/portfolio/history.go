@@ -0,0 +1,272 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// priceLookbackDays is how far before the requested range prices are read,
+// so holdings that didn't trade on the first day still have a close to use.
+const priceLookbackDays = 30
+
+// position is a symbol's open quantity and the cost of its open lots.
+type position struct {
+	Quantity int64
+	Cost     float64
+}
+
+// ledger replays transactions in order to give holdings and realized P&L as
+// of any point in time. It keeps lots the way matchLots does, so realized
+// P&L here is the gain the tax report shows for the same sells.
+type ledger struct {
+	lots      map[string][]lot
+	order     []string // symbols in the order first seen
+	positions map[string]*position
+	disposals []disposal
+	realized  float64
+}
+
+func newLedger() *ledger {
+	return &ledger{lots: make(map[string][]lot), positions: make(map[string]*position)}
+}
+
+// apply books a transaction. Transactions must come in lot order: by date,
+// with a day's buys before its sells, as ListTransactionsChronological
+// returns them. A sell consumes lots through consumeLots and realizes its
+// disposals' gains.
+func (l *ledger) apply(tx sqlc.Transaction) {
+	p, ok := l.positions[tx.StockSymbol]
+	if !ok {
+		p = &position{}
+		l.positions[tx.StockSymbol] = p
+		l.order = append(l.order, tx.StockSymbol)
+	}
+
+	if tx.TransactionType == "BUY" {
+		l.lots[tx.StockSymbol] = append(l.lots[tx.StockSymbol], lot{
+			Symbol:    tx.StockSymbol,
+			Quantity:  tx.Quantity,
+			UnitPrice: tx.UnitPrice,
+			Acquired:  tx.TransactionDate,
+			BuyID:     tx.ID,
+		})
+		p.Quantity += tx.Quantity
+		p.Cost += float64(tx.Quantity) * tx.UnitPrice
+		return
+	}
+
+	var taken []lot
+	l.lots[tx.StockSymbol], taken = consumeLots(l.lots[tx.StockSymbol], tx.Quantity, tx.TransactionDate)
+	for _, t := range taken {
+		d := disposal{lot: t, Sold: tx.TransactionDate, SalePrice: tx.UnitPrice, SellID: tx.ID}
+		l.disposals = append(l.disposals, d)
+		l.realized += d.Gain()
+		p.Quantity -= t.Quantity
+		p.Cost -= float64(t.Quantity) * t.UnitPrice
+	}
+}
+
+// openLots returns the lots still held, grouped by symbol in the order the
+// symbols were first seen.
+func (l *ledger) openLots() []lot {
+	var open []lot
+	for _, symbol := range l.order {
+		open = append(open, l.lots[symbol]...)
+	}
+	return open
+}
+
+// value returns the market value and remaining cost of all open positions.
+func (l *ledger) value(closes map[string]float64) (value, cost float64) {
+	for symbol, p := range l.positions {
+		value += float64(p.Quantity) * closes[symbol]
+		cost += p.Cost
+	}
+	return value, cost
+}
+
+// replay carries a ledger and the latest closes forward through a
+// portfolio's transactions and prices, for valuing it at increasing dates.
+// Both slices must be in date order.
+type replay struct {
+	txs       []sqlc.Transaction
+	prices    []sqlc.ListPortfolioClosePricesRow
+	ledger    *ledger
+	closes    map[string]float64
+	nextTx    int
+	nextPrice int
+}
+
+func newReplay(txs []sqlc.Transaction, prices []sqlc.ListPortfolioClosePricesRow) *replay {
+	return &replay{txs: txs, prices: prices, ledger: newLedger(), closes: make(map[string]float64)}
+}
+
+// advance books everything up to the end of date and returns the value and
+// cost of the open positions then.
+func (r *replay) advance(date string) (value, cost float64) {
+	for ; r.nextTx < len(r.txs) && r.txs[r.nextTx].TransactionDate.Format("2006-01-02") <= date; r.nextTx++ {
+		r.ledger.apply(r.txs[r.nextTx])
+	}
+	for ; r.nextPrice < len(r.prices) && r.prices[r.nextPrice].BusinessDate <= date; r.nextPrice++ {
+		if p := r.prices[r.nextPrice]; p.ClosePrice > 0 {
+			r.closes[p.Symbol] = p.ClosePrice
+		}
+	}
+	return r.ledger.value(r.closes)
+}
+
+// GetPortfolioHistory returns the portfolio's value over time, replaying
+// transactions against stored daily closes.
+func (s *PortfolioService) GetPortfolioHistory(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetPortfolioHistoryRequest],
+) (*connect.Response[ntxv1.GetPortfolioHistoryResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	from, to, err := parseDateRange(req.Msg.FromDate, req.Msg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.History(ctx, req.Msg.PortfolioId, from, to)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.GetPortfolioHistoryResponse{
+		Points: downsample(points, req.Msg.Interval),
+	}), nil
+}
+
+// parseDateRange parses optional YYYY-MM-DD bounds, defaulting to the year
+// up to today.
+func parseDateRange(fromDate, toDate string) (from, to time.Time, err error) {
+	to = time.Now()
+	if toDate != "" {
+		to, err = time.Parse("2006-01-02", toDate)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid to_date: %w", err))
+		}
+	}
+	from = to.AddDate(-1, 0, 0)
+	if fromDate != "" {
+		from, err = time.Parse("2006-01-02", fromDate)
+		if err != nil {
+			return from, to, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid from_date: %w", err))
+		}
+	}
+	if from.After(to) {
+		return from, to, connect.NewError(connect.CodeInvalidArgument, errors.New("from_date is after to_date"))
+	}
+	return from, to, nil
+}
+
+// History returns one point per trading day between from and to. Callers
+// must have checked that the portfolio belongs to the user.
+func (s *PortfolioService) History(
+	ctx context.Context,
+	portfolioID int64,
+	from, to time.Time,
+) ([]*ntxv1.PortfolioHistoryPoint, error) {
+	transactions, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    from.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      to.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return replayHistory(transactions, prices, from.Format("2006-01-02")), nil
+}
+
+// replayHistory produces one point per trading day on or after from. Prices
+// must be ordered by date; the latest close seen is carried forward for
+// symbols that didn't trade that day.
+func replayHistory(
+	transactions []sqlc.Transaction,
+	prices []sqlc.ListPortfolioClosePricesRow,
+	from string,
+) []*ntxv1.PortfolioHistoryPoint {
+	l := newLedger()
+	closes := make(map[string]float64)
+	next := 0
+
+	var points []*ntxv1.PortfolioHistoryPoint
+	for i := 0; i < len(prices); {
+		date := prices[i].BusinessDate
+		for ; i < len(prices) && prices[i].BusinessDate == date; i++ {
+			if prices[i].ClosePrice > 0 {
+				closes[prices[i].Symbol] = prices[i].ClosePrice
+			}
+		}
+
+		for ; next < len(transactions) && transactions[next].TransactionDate.Format("2006-01-02") <= date; next++ {
+			l.apply(transactions[next])
+		}
+
+		if date < from {
+			continue
+		}
+
+		value, cost := l.value(closes)
+		points = append(points, &ntxv1.PortfolioHistoryPoint{
+			Date:          date,
+			Value:         value,
+			Cost:          cost,
+			RealizedPnl:   l.realized,
+			UnrealizedPnl: value - cost,
+		})
+	}
+	return points
+}
+
+// downsample keeps the last point of each week or month.
+func downsample(points []*ntxv1.PortfolioHistoryPoint, interval ntxv1.HistoryInterval) []*ntxv1.PortfolioHistoryPoint {
+	var bucket func(date string) string
+	switch interval {
+	case ntxv1.HistoryInterval_HISTORY_INTERVAL_WEEKLY:
+		bucket = func(date string) string {
+			t, _ := time.Parse("2006-01-02", date)
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%02d", year, week)
+		}
+	case ntxv1.HistoryInterval_HISTORY_INTERVAL_MONTHLY:
+		bucket = func(date string) string { return date[:7] }
+	default:
+		return points
+	}
+
+	var out []*ntxv1.PortfolioHistoryPoint
+	for i, p := range points {
+		if i+1 < len(points) && bucket(points[i+1].Date) == bucket(p.Date) {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,311 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// Holding event types recorded in holding_events.
+const (
+	eventAdded   = "ADDED"
+	eventDeleted = "DELETED"
+)
+
+// recordEvent logs a transaction change so the affected holding is refreshed
+// on the next applyPendingEvents call. q is the queries the change was made
+// with, so the event commits or rolls back together with it.
+func recordEvent(ctx context.Context, q *sqlc.Queries, tx sqlc.Transaction, eventType string) error {
+	return q.CreateHoldingEvent(ctx, sqlc.CreateHoldingEventParams{
+		PortfolioID:   tx.PortfolioID,
+		TransactionID: tx.ID,
+		StockSymbol:   tx.StockSymbol,
+		EventType:     eventType,
+	})
+}
+
+// applyPendingEvents recomputes only the holdings touched by unprocessed
+// events. Events stay pending if a refresh fails, so the next call retries.
+func (s *PortfolioService) applyPendingEvents(ctx context.Context, portfolioID int64) error {
+	events, err := s.queries.ListPendingHoldingEvents(ctx, portfolioID)
+	if err != nil {
+		return fmt.Errorf("list holding events: %w", err)
+	}
+
+	refreshed := make(map[string]bool)
+	for _, e := range events {
+		if !refreshed[e.StockSymbol] {
+			if err := s.refreshHolding(ctx, portfolioID, e.StockSymbol); err != nil {
+				return err
+			}
+			refreshed[e.StockSymbol] = true
+		}
+
+		if err := s.queries.MarkHoldingEventProcessed(ctx, e.ID); err != nil {
+			return fmt.Errorf("mark holding event %d: %w", e.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// ComputeHoldings derives holding rows from transactions of any number of
+// portfolios. Each row is the ledger's position: the shares and cost of the
+// lots still open, so live holdings, as_of valuations and history share one
+// cost basis. TotalBuyQuantity is the open quantity the cost is spread over.
+func ComputeHoldings(transactions []sqlc.Transaction) []sqlc.InsertHoldingParams {
+	ledgers := make(map[int64]*ledger)
+	var portfolios []int64
+	for _, tx := range lotOrder(transactions) {
+		l, ok := ledgers[tx.PortfolioID]
+		if !ok {
+			l = newLedger()
+			ledgers[tx.PortfolioID] = l
+			portfolios = append(portfolios, tx.PortfolioID)
+		}
+		l.apply(tx)
+	}
+
+	var rows []sqlc.InsertHoldingParams
+	for _, portfolioID := range portfolios {
+		l := ledgers[portfolioID]
+		for _, symbol := range l.order {
+			p := l.positions[symbol]
+			rows = append(rows, sqlc.InsertHoldingParams{
+				PortfolioID:      portfolioID,
+				StockSymbol:      symbol,
+				Quantity:         p.Quantity,
+				TotalBuyCost:     p.Cost,
+				TotalBuyQuantity: p.Quantity,
+			})
+		}
+	}
+	return rows
+}
+
+// refreshHolding rebuilds a single holding row from its transactions. The row
+// is deleted first so a symbol whose transactions are all gone disappears;
+// both run in one transaction so readers never see it missing.
+func (s *PortfolioService) refreshHolding(ctx context.Context, portfolioID int64, symbol string) error {
+	unlock := s.holdingLocks.lock(fmt.Sprintf("%d/%s", portfolioID, symbol))
+	defer unlock()
+
+	return database.WithTx(ctx, s.db, func(q *sqlc.Queries) error {
+		transactions, err := q.ListSymbolTransactionsChronological(ctx, sqlc.ListSymbolTransactionsChronologicalParams{
+			PortfolioID: portfolioID,
+			StockSymbol: symbol,
+		})
+		if err != nil {
+			return fmt.Errorf("list %s transactions: %w", symbol, err)
+		}
+
+		err = q.DeleteHolding(ctx, sqlc.DeleteHoldingParams{
+			PortfolioID: portfolioID,
+			StockSymbol: symbol,
+		})
+		if err != nil {
+			return fmt.Errorf("delete holding %s: %w", symbol, err)
+		}
+
+		for _, row := range ComputeHoldings(transactions) {
+			if err := q.InsertHolding(ctx, row); err != nil {
+				return fmt.Errorf("refresh holding %s: %w", symbol, err)
+			}
+		}
+		return nil
+	})
+}
+
+// HoldingDrift describes a holding whose stored value disagreed with the
+// value recomputed from transactions.
+type HoldingDrift struct {
+	PortfolioID int64
+	Symbol      string
+	Stored      int64
+	Computed    int64
+}
+
+// RebuildHoldings recomputes every holding from scratch and reports rows
+// whose stored quantity or cost differed from the rebuilt value. The rebuild
+// runs in one transaction, so a failure leaves the old holdings in place.
+func RebuildHoldings(ctx context.Context, db *sql.DB) ([]HoldingDrift, error) {
+	var before, after []sqlc.Holding
+	err := database.WithTx(ctx, db, func(q *sqlc.Queries) error {
+		var err error
+		before, err = q.ListAllHoldings(ctx)
+		if err != nil {
+			return fmt.Errorf("list holdings: %w", err)
+		}
+
+		transactions, err := q.ListAllTransactionsChronological(ctx)
+		if err != nil {
+			return fmt.Errorf("list transactions: %w", err)
+		}
+
+		if err := q.DeleteAllHoldings(ctx); err != nil {
+			return fmt.Errorf("delete holdings: %w", err)
+		}
+		for _, row := range ComputeHoldings(transactions) {
+			if err := q.InsertHolding(ctx, row); err != nil {
+				return fmt.Errorf("rebuild holding %d/%s: %w", row.PortfolioID, row.StockSymbol, err)
+			}
+		}
+		if err := q.MarkAllHoldingEventsProcessed(ctx); err != nil {
+			return fmt.Errorf("mark holding events: %w", err)
+		}
+
+		after, err = q.ListAllHoldings(ctx)
+		if err != nil {
+			return fmt.Errorf("list holdings: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		portfolioID int64
+		symbol      string
+	}
+	stored := make(map[key]sqlc.Holding, len(before))
+	for _, h := range before {
+		stored[key{h.PortfolioID, h.StockSymbol}] = h
+	}
+
+	var drift []HoldingDrift
+	for _, h := range after {
+		k := key{h.PortfolioID, h.StockSymbol}
+		old, ok := stored[k]
+		delete(stored, k)
+		if ok && old.Quantity == h.Quantity && old.TotalBuyCost == h.TotalBuyCost {
+			continue
+		}
+		drift = append(drift, HoldingDrift{
+			PortfolioID: h.PortfolioID,
+			Symbol:      h.StockSymbol,
+			Stored:      old.Quantity,
+			Computed:    h.Quantity,
+		})
+	}
+	for _, old := range stored {
+		drift = append(drift, HoldingDrift{
+			PortfolioID: old.PortfolioID,
+			Symbol:      old.StockSymbol,
+			Stored:      old.Quantity,
+		})
+	}
+
+	return drift, nil
+}
+
+// ListHoldings returns priced holdings filtered, sorted and paged on the
+// server. Sorting happens after valuation because value and P&L depend on
+// live prices that aren't stored with the holding. With as_of set, positions
+// are rebuilt from the transactions up to that date.
+func (s *PortfolioService) ListHoldings(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListHoldingsRequest],
+) (*connect.Response[ntxv1.ListHoldingsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if req.Msg.OnlyGainers && req.Msg.OnlyLosers {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("only_gainers and only_losers are exclusive"))
+	}
+
+	asOf, err := parseAsOf(req.Msg.AsOf, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.valueHoldingsOn(ctx, req.Msg.PortfolioId, asOf)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	groups, err := s.loadHoldingGroups(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	groups.assign(v.holdings)
+
+	holdings := slices.DeleteFunc(v.holdings, func(h *ntxv1.Holding) bool {
+		return !matchesHoldingFilter(h, req.Msg)
+	})
+
+	key := holdingSortKey(req.Msg.SortBy)
+	slices.SortStableFunc(holdings, func(a, b *ntxv1.Holding) int {
+		c := key(a, b)
+		if req.Msg.Descending {
+			return -c
+		}
+		return c
+	})
+
+	total := len(holdings)
+	start := min(max(int(req.Msg.Offset), 0), total)
+	end := total
+	if req.Msg.Limit > 0 {
+		end = min(start+int(req.Msg.Limit), total)
+	}
+
+	return connect.NewResponse(&ntxv1.ListHoldingsResponse{
+		Holdings:   holdings[start:end],
+		TotalCount: convert.SafeInt32(int64(total)),
+	}), nil
+}
+
+func matchesHoldingFilter(h *ntxv1.Holding, req *ntxv1.ListHoldingsRequest) bool {
+	if req.Sector != nil && *req.Sector != "" && !strings.EqualFold(h.Sector, *req.Sector) {
+		return false
+	}
+	if req.MinValue != nil && h.TotalValue < *req.MinValue {
+		return false
+	}
+	if req.OnlyGainers && h.ProfitLoss <= 0 {
+		return false
+	}
+	if req.OnlyLosers && h.ProfitLoss >= 0 {
+		return false
+	}
+	return true
+}
+
+func holdingSortKey(field ntxv1.HoldingSortField) func(a, b *ntxv1.Holding) int {
+	switch field {
+	case ntxv1.HoldingSortField_HOLDING_SORT_FIELD_VALUE:
+		return func(a, b *ntxv1.Holding) int { return cmp.Compare(a.TotalValue, b.TotalValue) }
+	case ntxv1.HoldingSortField_HOLDING_SORT_FIELD_PNL:
+		return func(a, b *ntxv1.Holding) int { return cmp.Compare(a.ProfitLoss, b.ProfitLoss) }
+	case ntxv1.HoldingSortField_HOLDING_SORT_FIELD_PNL_PERCENT:
+		return func(a, b *ntxv1.Holding) int { return cmp.Compare(a.ProfitLossPercent, b.ProfitLossPercent) }
+	case ntxv1.HoldingSortField_HOLDING_SORT_FIELD_DAY_CHANGE:
+		return func(a, b *ntxv1.Holding) int { return cmp.Compare(a.DayChangeValue, b.DayChangeValue) }
+	case ntxv1.HoldingSortField_HOLDING_SORT_FIELD_WEIGHT:
+		return func(a, b *ntxv1.Holding) int { return cmp.Compare(a.WeightPercent, b.WeightPercent) }
+	default:
+		return func(a, b *ntxv1.Holding) int { return strings.Compare(a.StockSymbol, b.StockSymbol) }
+	}
+}
@@ -0,0 +1,351 @@
+package portfolio
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// csvColumns is the expected header of an import file.
+var csvColumns = []string{"symbol", "type", "quantity", "price", "date"}
+
+// importBatchSize is how many rows are parsed or inserted between checks for
+// a cancelled request.
+const importBatchSize = 500
+
+// importRow is a parsed CSV line ready to be inserted.
+type importRow struct {
+	Line int
+	sqlc.CreateTransactionParams
+}
+
+// conflictKey identifies transactions that are likely the same trade entered twice.
+type conflictKey struct {
+	Symbol   string
+	Type     string
+	Date     string
+	Quantity int64
+}
+
+func keyOf(symbol, txType string, date time.Time, quantity int64) conflictKey {
+	return conflictKey{Symbol: symbol, Type: txType, Date: date.Format("2006-01-02"), Quantity: quantity}
+}
+
+// parseTransactionsCSV parses an import file into rows for the given portfolio.
+// It stops early with the context's error once ctx is done.
+func parseTransactionsCSV(ctx context.Context, portfolioID int64, data []byte) ([]importRow, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = len(csvColumns)
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	for i, col := range csvColumns {
+		if !strings.EqualFold(strings.TrimSpace(header[i]), col) {
+			return nil, fmt.Errorf("header column %d: expected %q, got %q", i+1, col, header[i])
+		}
+	}
+
+	var rows []importRow
+	for line := 2; ; line++ {
+		if line%importBatchSize == 0 && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		row, err := parseRecord(portfolioID, record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		row.Line = line
+		rows = append(rows, row)
+	}
+}
+
+func parseRecord(portfolioID int64, record []string) (importRow, error) {
+	symbol := strings.ToUpper(strings.TrimSpace(record[0]))
+	if symbol == "" {
+		return importRow{}, errors.New("symbol is required")
+	}
+
+	txType := strings.ToUpper(strings.TrimSpace(record[1]))
+	if txType != "BUY" && txType != "SELL" {
+		return importRow{}, fmt.Errorf("type must be BUY or SELL, got %q", record[1])
+	}
+
+	quantity, err := strconv.ParseInt(strings.TrimSpace(record[2]), 10, 64)
+	if err != nil || quantity <= 0 {
+		return importRow{}, fmt.Errorf("quantity must be a positive integer, got %q", record[2])
+	}
+
+	price, err := strconv.ParseFloat(strings.TrimSpace(record[3]), 64)
+	if err != nil || math.IsNaN(price) || math.IsInf(price, 0) || price <= 0 {
+		return importRow{}, fmt.Errorf("price must be positive, got %q", record[3])
+	}
+
+	date, err := time.Parse("2006-01-02", strings.TrimSpace(record[4]))
+	if err != nil {
+		return importRow{}, fmt.Errorf("date must be YYYY-MM-DD, got %q", record[4])
+	}
+
+	return importRow{CreateTransactionParams: sqlc.CreateTransactionParams{
+		PortfolioID:     portfolioID,
+		StockSymbol:     symbol,
+		TransactionType: txType,
+		Quantity:        quantity,
+		UnitPrice:       price,
+		TransactionDate: date,
+	}}, nil
+}
+
+// transactionToProto converts a stored transaction to its API representation.
+func transactionToProto(tx sqlc.Transaction) *ntxv1.Transaction {
+	txType := ntxv1.TransactionType_TRANSACTION_TYPE_BUY
+	if tx.TransactionType == "SELL" {
+		txType = ntxv1.TransactionType_TRANSACTION_TYPE_SELL
+	}
+	return &ntxv1.Transaction{
+		Id:              tx.ID,
+		PortfolioId:     tx.PortfolioID,
+		StockSymbol:     tx.StockSymbol,
+		TransactionType: txType,
+		Quantity:        tx.Quantity,
+		UnitPrice:       tx.UnitPrice,
+		TransactionDate: tx.TransactionDate.Format("2006-01-02"),
+	}
+}
+
+// ImportTransactions imports transactions from a CSV file, resolving clashes
+// with existing entries according to the requested conflict strategy.
+func (s *PortfolioService) ImportTransactions(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ImportTransactionsRequest],
+) (*connect.Response[ntxv1.ImportTransactionsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	portfolio, err := s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+	// Imports are real broker history; paper trades are entered one by one
+	if portfolio.Paper {
+		return nil, connect.NewError(connect.CodeFailedPrecondition,
+			errors.New("paper portfolios don't accept imports"))
+	}
+
+	resp, err := s.Import(ctx, req.Msg.PortfolioId, req.Msg.CsvData, req.Msg.ConflictStrategy)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// Import adds the transactions in an import file to a portfolio, resolving
+// clashes with existing entries according to strategy; unspecified means
+// skip. The file goes in as one SQL transaction, so a failure or
+// cancellation part way leaves the portfolio as it was. Callers must have
+// checked that the portfolio belongs to the user and isn't a paper portfolio.
+func (s *PortfolioService) Import(
+	ctx context.Context,
+	portfolioID int64,
+	data []byte,
+	strategy ntxv1.ConflictStrategy,
+) (*ntxv1.ImportTransactionsResponse, error) {
+	rows, err := parseTransactionsCSV(ctx, portfolioID, data)
+	if ctx.Err() != nil {
+		return nil, contextError(ctx.Err())
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	switch strategy {
+	case ntxv1.ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED:
+		strategy = ntxv1.ConflictStrategy_CONFLICT_STRATEGY_SKIP
+	case ntxv1.ConflictStrategy_CONFLICT_STRATEGY_SKIP,
+		ntxv1.ConflictStrategy_CONFLICT_STRATEGY_REPLACE,
+		ntxv1.ConflictStrategy_CONFLICT_STRATEGY_KEEP_BOTH:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown conflict strategy %d", strategy))
+	}
+
+	resp := &ntxv1.ImportTransactionsResponse{}
+	err = database.WithTx(ctx, s.db, func(q *sqlc.Queries) error {
+		return importRows(ctx, q, portfolioID, rows, strategy, resp)
+	})
+	if ctx.Err() != nil {
+		return nil, contextError(ctx.Err())
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Refresh once per touched symbol instead of once per imported row.
+	if err := s.applyPendingEvents(ctx, portfolioID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return resp, nil
+}
+
+// importRows stores rows through q, which is bound to the import's SQL
+// transaction, and tallies the outcome in resp.
+func importRows(
+	ctx context.Context,
+	q *sqlc.Queries,
+	portfolioID int64,
+	rows []importRow,
+	strategy ntxv1.ConflictStrategy,
+	resp *ntxv1.ImportTransactionsResponse,
+) error {
+	existing, err := q.ListTransactionsByPortfolio(ctx, portfolioID)
+	if err != nil {
+		return err
+	}
+	// Each existing transaction can absorb at most one imported row, so a file
+	// with two identical fills against one manual entry still imports one.
+	byKey := make(map[conflictKey][]sqlc.Transaction, len(existing))
+	for _, tx := range existing {
+		key := keyOf(tx.StockSymbol, tx.TransactionType, tx.TransactionDate, tx.Quantity)
+		byKey[key] = append(byKey[key], tx)
+	}
+
+	for i, row := range rows {
+		if i%importBatchSize == 0 && ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		key := keyOf(row.StockSymbol, row.TransactionType, row.TransactionDate, row.Quantity)
+		matches := byKey[key]
+		conflict := len(matches) > 0
+
+		var match sqlc.Transaction
+		if conflict {
+			match, byKey[key] = matches[0], matches[1:]
+			resp.Conflicts = append(resp.Conflicts, &ntxv1.ImportConflict{
+				Line:     convert.SafeInt32(int64(row.Line)),
+				Existing: transactionToProto(match),
+				Imported: transactionToProto(sqlc.Transaction{
+					StockSymbol:     row.StockSymbol,
+					TransactionType: row.TransactionType,
+					Quantity:        row.Quantity,
+					UnitPrice:       row.UnitPrice,
+					TransactionDate: row.TransactionDate,
+				}),
+				Resolution: strategy,
+			})
+		}
+
+		if conflict && strategy == ntxv1.ConflictStrategy_CONFLICT_STRATEGY_SKIP {
+			resp.Skipped++
+			continue
+		}
+
+		if conflict && strategy == ntxv1.ConflictStrategy_CONFLICT_STRATEGY_REPLACE {
+			if err := q.DeleteTransaction(ctx, match.ID); err != nil {
+				return err
+			}
+			if err := recordEvent(ctx, q, match, eventDeleted); err != nil {
+				return err
+			}
+			resp.Replaced++
+		}
+
+		tx, err := q.CreateTransaction(ctx, row.CreateTransactionParams)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", row.Line, err)
+		}
+		if err := recordEvent(ctx, q, tx, eventAdded); err != nil {
+			return err
+		}
+		resp.Imported++
+	}
+	return nil
+}
+
+// ExportTransactions writes the portfolio's transactions as an import file,
+// so they can be carried to another instance and re-imported there.
+func (s *PortfolioService) ExportTransactions(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ExportTransactionsRequest],
+) (*connect.Response[ntxv1.ExportTransactionsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	txs, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(csvColumns)
+	for _, tx := range txs {
+		_ = w.Write([]string{
+			tx.StockSymbol,
+			tx.TransactionType,
+			strconv.FormatInt(tx.Quantity, 10),
+			strconv.FormatFloat(tx.UnitPrice, 'f', -1, 64),
+			tx.TransactionDate.Format("2006-01-02"),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.ExportTransactionsResponse{
+		Filename: "transactions-" + time.Now().Format("2006-01-02") + ".csv",
+		CsvData:  buf.Bytes(),
+	}), nil
+}
+
+// contextError maps a cancelled or expired request to its Connect code.
+func contextError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	return connect.NewError(connect.CodeCanceled, err)
+}
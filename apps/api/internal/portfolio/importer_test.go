@@ -0,0 +1,181 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// benchmarkRows is the size of the import file the benchmarks parse.
+const benchmarkRows = 100_000
+
+func benchmarkCSV(rows int) []byte {
+	var b strings.Builder
+	b.WriteString("symbol,type,quantity,price,date\n")
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range rows {
+		txType := "BUY"
+		if i%3 == 2 {
+			txType = "SELL"
+		}
+		fmt.Fprintf(&b, "SYM%d,%s,%d,%.2f,%s\n", i%50, txType, 10+i%90, 100+float64(i%700)/3, day.AddDate(0, 0, i/100).Format("2006-01-02"))
+	}
+	return []byte(b.String())
+}
+
+func BenchmarkParseTransactionsCSV(b *testing.B) {
+	data := benchmarkCSV(benchmarkRows)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		if _, err := parseTransactionsCSV(context.Background(), 1, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkComputeHoldings(b *testing.B) {
+	rows, err := parseTransactionsCSV(context.Background(), 1, benchmarkCSV(benchmarkRows))
+	if err != nil {
+		b.Fatal(err)
+	}
+	transactions := make([]sqlc.Transaction, len(rows))
+	for i, r := range rows {
+		transactions[i] = sqlc.Transaction{
+			ID:              int64(i + 1),
+			PortfolioID:     r.PortfolioID,
+			StockSymbol:     r.StockSymbol,
+			TransactionType: r.TransactionType,
+			Quantity:        r.Quantity,
+			UnitPrice:       r.UnitPrice,
+			TransactionDate: r.TransactionDate,
+		}
+	}
+	b.ReportAllocs()
+
+	for b.Loop() {
+		ComputeHoldings(transactions)
+	}
+}
+
+// benchmarkService opens a migrated database in a temporary directory and
+// returns a service over it, with ctx signed in as a new user.
+func benchmarkService(b *testing.B) (*PortfolioService, context.Context, int64) {
+	b.Helper()
+
+	db, err := database.OpenDB(filepath.Join(b.TempDir(), "ntx.db"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { _ = db.Close() })
+	if err := database.AutoMigrate(db); err != nil {
+		b.Fatal(err)
+	}
+
+	queries := sqlc.New(db)
+	user, err := queries.CreateUser(context.Background(), sqlc.CreateUserParams{Email: "bench@example.com", PasswordHash: "x"})
+	if err != nil {
+		b.Fatal(err)
+	}
+	ctx := context.WithValue(context.Background(), UserIDKey, user.ID)
+	return NewPortfolioService(db, queries), ctx, user.ID
+}
+
+func BenchmarkImport(b *testing.B) {
+	s, ctx, userID := benchmarkService(b)
+	data := benchmarkCSV(benchmarkRows)
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+
+	for b.Loop() {
+		// A fresh portfolio each time, so no row is skipped as a duplicate.
+		p, err := s.queries.CreatePortfolio(ctx, sqlc.CreatePortfolioParams{UserID: userID, Name: "Bench"})
+		if err != nil {
+			b.Fatal(err)
+		}
+		resp, err := s.Import(ctx, p.ID, data, ntxv1.ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if resp.Imported != benchmarkRows {
+			b.Fatalf("imported %d rows, want %d", resp.Imported, benchmarkRows)
+		}
+	}
+}
+
+// BenchmarkListTransactions pages through an imported portfolio the way the
+// transactions table does.
+func BenchmarkListTransactions(b *testing.B) {
+	s, ctx, userID := benchmarkService(b)
+	p, err := s.queries.CreatePortfolio(ctx, sqlc.CreatePortfolioParams{UserID: userID, Name: "Bench"})
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := s.Import(ctx, p.ID, benchmarkCSV(benchmarkRows), ntxv1.ConflictStrategy_CONFLICT_STRATEGY_UNSPECIFIED); err != nil {
+		b.Fatal(err)
+	}
+	const pageSize = 100
+	b.ReportAllocs()
+
+	for b.Loop() {
+		for offset := int32(0); offset < benchmarkRows; offset += pageSize * 100 {
+			resp, err := s.ListTransactions(ctx, connect.NewRequest(&ntxv1.ListTransactionsRequest{
+				PortfolioId: p.ID,
+				Limit:       pageSize,
+				Offset:      offset,
+			}))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if len(resp.Msg.Transactions) != pageSize {
+				b.Fatalf("page at %d has %d transactions, want %d", offset, len(resp.Msg.Transactions), pageSize)
+			}
+		}
+	}
+}
+
+func FuzzParseRecord(f *testing.F) {
+	f.Add("NABIL", "BUY", "100", "1150.5", "2024-01-15")
+	f.Add(" nica ", "sell", " 10", "820 ", "2023-07-16")
+	f.Add("", "BUY", "1", "1", "2024-01-01")
+	f.Add("UPPER", "BONUS", "10", "100", "2024-01-01")
+	f.Add("UPPER", "BUY", "-5", "100", "2024-01-01")
+	f.Add("UPPER", "BUY", "5", "NaN", "2024-01-01")
+	f.Add("UPPER", "BUY", "5", "Inf", "2024-01-01")
+	f.Add("UPPER", "BUY", "5", "1e400", "2024-01-01")
+	f.Add("UPPER", "BUY", "5", "100", "15/01/2024")
+
+	f.Fuzz(func(t *testing.T, symbol, txType, quantity, price, date string) {
+		row, err := parseRecord(1, []string{symbol, txType, quantity, price, date})
+		if err != nil {
+			return
+		}
+
+		if row.StockSymbol == "" || row.StockSymbol != strings.ToUpper(row.StockSymbol) {
+			t.Errorf("symbol %q is not a non-empty upper-case symbol", row.StockSymbol)
+		}
+		if row.TransactionType != "BUY" && row.TransactionType != "SELL" {
+			t.Errorf("type %q accepted", row.TransactionType)
+		}
+		if row.Quantity <= 0 {
+			t.Errorf("quantity %d accepted", row.Quantity)
+		}
+		if !(row.UnitPrice > 0) || math.IsInf(row.UnitPrice, 0) {
+			t.Errorf("price %v accepted", row.UnitPrice)
+		}
+		if row.PortfolioID != 1 {
+			t.Errorf("portfolio %d, want 1", row.PortfolioID)
+		}
+	})
+}
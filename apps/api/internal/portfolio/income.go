@@ -0,0 +1,83 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// cashDividendPerShare converts a cash dividend, declared as a percent of
+// paid-up value, to rupees per share. Nearly every NEPSE share has a face
+// value of Rs. 100, so the percent is also the amount.
+func cashDividendPerShare(ca sqlc.CorporateAction) float64 {
+	if !ca.CashDividend.Valid || ca.CashDividend.Float64 <= 0 {
+		return 0
+	}
+	return ca.CashDividend.Float64
+}
+
+// GetIncomeSummary projects a year of dividend income from each holding's
+// latest cash dividend, with its yield on cost and at the current price.
+func (s *PortfolioService) GetIncomeSummary(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetIncomeSummaryRequest],
+) (*connect.Response[ntxv1.GetIncomeSummaryResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	v, err := s.valueHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &ntxv1.GetIncomeSummaryResponse{}
+	for _, h := range v.holdings {
+		income := &ntxv1.IncomeHolding{StockSymbol: h.StockSymbol, Quantity: h.Quantity}
+		if ca, err := s.queries.GetLatestCorporateAction(ctx, h.StockSymbol); err == nil {
+			income.FiscalYear = ca.FiscalYear
+			income.DividendPerShare = cashDividendPerShare(ca)
+		}
+		income.AnnualIncome = income.DividendPerShare * float64(h.Quantity)
+		if h.AvgBuyPrice > 0 {
+			income.YieldOnCost = income.DividendPerShare / h.AvgBuyPrice * 100
+		}
+		if h.CurrentPrice > 0 {
+			income.CurrentYield = income.DividendPerShare / h.CurrentPrice * 100
+		}
+
+		resp.AnnualIncome += income.AnnualIncome
+		resp.Holdings = append(resp.Holdings, income)
+	}
+	if v.invested > 0 {
+		resp.YieldOnCost = resp.AnnualIncome / v.invested * 100
+	}
+	if v.currentValue > 0 {
+		resp.CurrentYield = resp.AnnualIncome / v.currentValue * 100
+	}
+
+	slices.SortFunc(resp.Holdings, func(a, b *ntxv1.IncomeHolding) int {
+		if c := cmp.Compare(b.AnnualIncome, a.AnnualIncome); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.StockSymbol, b.StockSymbol)
+	})
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,61 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+)
+
+// listingStatus maps NEPSE's code in companies.status.
+func listingStatus(code string) ntxv1.ListingStatus {
+	switch code {
+	case "A":
+		return ntxv1.ListingStatus_LISTING_STATUS_ACTIVE
+	case "S":
+		return ntxv1.ListingStatus_LISTING_STATUS_SUSPENDED
+	case "D":
+		return ntxv1.ListingStatus_LISTING_STATUS_DELISTED
+	}
+	return ntxv1.ListingStatus_LISTING_STATUS_UNSPECIFIED
+}
+
+// setListingStatus fills in a holding's status. Symbols that aren't listed
+// companies, such as manually priced ones, stay unspecified.
+func (s *PortfolioService) setListingStatus(ctx context.Context, h *ntxv1.Holding) error {
+	row, err := s.queries.GetListingStatus(ctx, h.StockSymbol)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	h.ListingStatus = listingStatus(row.Status)
+	h.DelistedOn = row.DelistedOn.String
+	return nil
+}
+
+// listingTips warns about holdings that can't currently be sold on the
+// exchange.
+func listingTips(holdings []*ntxv1.Holding) []*ntxv1.HealthTip {
+	var tips []*ntxv1.HealthTip
+	for _, h := range holdings {
+		switch h.ListingStatus {
+		case ntxv1.ListingStatus_LISTING_STATUS_SUSPENDED:
+			tips = append(tips, &ntxv1.HealthTip{
+				Symbol:  h.StockSymbol,
+				Message: "Trading is suspended. You can't sell until the suspension lifts.",
+				Type:    "WARNING",
+			})
+		case ntxv1.ListingStatus_LISTING_STATUS_DELISTED:
+			msg := "Delisted. The price shown is the last one traded."
+			if h.DelistedOn != "" {
+				msg = fmt.Sprintf("Delisted since %s. The price shown is the last one traded.", h.DelistedOn)
+			}
+			tips = append(tips, &ntxv1.HealthTip{Symbol: h.StockSymbol, Message: msg, Type: "WARNING"})
+		}
+	}
+	return tips
+}
@@ -0,0 +1,43 @@
+package portfolio
+
+import "sync"
+
+// keyedMutex serializes work per key so unrelated symbols don't contend on
+// a single lock. A key's mutex is dropped once nobody holds or waits on it,
+// so the map doesn't grow with every symbol ever refreshed. The zero value
+// is ready to use.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int // holders and waiters, guarded by keyedMutex.mu
+}
+
+// lock acquires the mutex for key and returns its unlock function.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*keyedLock)
+	}
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mu.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mu.Unlock()
+	}
+}
@@ -0,0 +1,176 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// longTermHoldingDays is how long shares must be held before gains are taxed
+// at the 5% long-term CGT rate instead of 7.5%.
+const longTermHoldingDays = 365
+
+// lot is a parcel of shares bought together and still (partly) held.
+type lot struct {
+	Symbol    string
+	Quantity  int64
+	UnitPrice float64
+	Acquired  time.Time
+	BuyID     int64 // transaction that opened the lot
+}
+
+// disposal is the part of a sell matched against a single lot.
+type disposal struct {
+	lot
+	Sold      time.Time
+	SalePrice float64
+	SellID    int64
+}
+
+// Gain is the profit on the disposed shares before tax and fees.
+func (d disposal) Gain() float64 {
+	return float64(d.Quantity) * (d.SalePrice - d.UnitPrice)
+}
+
+// LongTerm reports whether the shares were held long enough for the lower CGT rate.
+func (d disposal) LongTerm() bool {
+	return daysBetween(d.Acquired, d.Sold) >= longTermHoldingDays
+}
+
+// openLots returns the lots still held after replaying transactions.
+func openLots(transactions []sqlc.Transaction) []lot {
+	open, _ := matchLots(transactions)
+	return open
+}
+
+// matchLots replays transactions through a ledger and returns the lots
+// still held and the disposals made by sells. Sells are first netted against
+// buys made the same day and then consume the oldest lots. FIFO is this app's
+// choice so holding periods stay per lot; brokers and CDSC assess CGT on the
+// weighted average cost, so gains here can differ from their statements.
+func matchLots(transactions []sqlc.Transaction) ([]lot, []disposal) {
+	l := newLedger()
+	for _, tx := range lotOrder(transactions) {
+		l.apply(tx)
+	}
+	return l.openLots(), l.disposals
+}
+
+// lotOrder sorts a copy of transactions by date with each day's buys first,
+// so an intraday sell finds its matching buy even if it was recorded earlier.
+func lotOrder(transactions []sqlc.Transaction) []sqlc.Transaction {
+	sorted := slices.Clone(transactions)
+	slices.SortStableFunc(sorted, func(a, b sqlc.Transaction) int {
+		if c := a.TransactionDate.Compare(b.TransactionDate); c != 0 {
+			return c
+		}
+		return strings.Compare(a.TransactionType, b.TransactionType)
+	})
+	return sorted
+}
+
+// consumeLots removes qty shares sold on date, netting same-day buys from the
+// back of the queue before taking from the front, and returns the remaining
+// lots and the pieces taken. Selling more than is held simply empties the
+// queue; the surplus has no cost basis to track.
+func consumeLots(lots []lot, qty int64, date time.Time) (remaining, taken []lot) {
+	for qty > 0 && len(lots) > 0 && lots[len(lots)-1].Acquired.Equal(date) {
+		last := len(lots) - 1
+		if lots[last].Quantity > qty {
+			piece := lots[last]
+			piece.Quantity = qty
+			taken = append(taken, piece)
+			lots[last].Quantity -= qty
+			return lots, taken
+		}
+		taken = append(taken, lots[last])
+		qty -= lots[last].Quantity
+		lots = lots[:last]
+	}
+
+	for qty > 0 && len(lots) > 0 {
+		if lots[0].Quantity > qty {
+			piece := lots[0]
+			piece.Quantity = qty
+			taken = append(taken, piece)
+			lots[0].Quantity -= qty
+			return lots, taken
+		}
+		taken = append(taken, lots[0])
+		qty -= lots[0].Quantity
+		lots = lots[1:]
+	}
+	return lots, taken
+}
+
+// daysBetween returns the number of whole calendar days from a to b.
+func daysBetween(a, b time.Time) int32 {
+	from := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int32(to.Sub(from).Hours() / 24)
+}
+
+// ListLots returns the open lots of a portfolio with their holding age and
+// long-term CGT eligibility.
+func (s *PortfolioService) ListLots(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ListLotsRequest],
+) (*connect.Response[ntxv1.ListLotsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	transactions, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	today := time.Now()
+	resp := &ntxv1.ListLotsResponse{}
+
+	for _, l := range openLots(transactions) {
+		if req.Msg.StockSymbol != nil && *req.Msg.StockSymbol != "" && l.Symbol != *req.Msg.StockSymbol {
+			continue
+		}
+
+		held := daysBetween(l.Acquired, today)
+		longTerm := held >= longTermHoldingDays
+		daysLeft := max(longTermHoldingDays-held, 0)
+
+		if longTerm {
+			resp.LongTermQuantity += l.Quantity
+		} else {
+			resp.ShortTermQuantity += l.Quantity
+		}
+
+		resp.Lots = append(resp.Lots, &ntxv1.Lot{
+			StockSymbol:    l.Symbol,
+			Quantity:       l.Quantity,
+			UnitPrice:      l.UnitPrice,
+			AcquiredDate:   l.Acquired.Format("2006-01-02"),
+			HoldingDays:    held,
+			LongTermDate:   l.Acquired.AddDate(0, 0, longTermHoldingDays).Format("2006-01-02"),
+			DaysToLongTerm: daysLeft,
+			LongTerm:       longTerm,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
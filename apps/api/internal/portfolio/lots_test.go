@@ -0,0 +1,83 @@
+package portfolio
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func day(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestConsumeLotsNetsSameDayBuy(t *testing.T) {
+	lots := []lot{
+		{Symbol: "NABIL", Quantity: 100, UnitPrice: 1000, Acquired: day("2024-01-10"), BuyID: 1},
+		{Symbol: "NABIL", Quantity: 50, UnitPrice: 1100, Acquired: day("2024-03-05"), BuyID: 2},
+		{Symbol: "NABIL", Quantity: 30, UnitPrice: 1150, Acquired: day("2024-06-01"), BuyID: 3},
+	}
+
+	// 30 of the 70 sold were bought the same day; the other 40 come from the
+	// oldest lot.
+	remaining, taken := consumeLots(lots, 70, day("2024-06-01"))
+
+	wantTaken := []lot{
+		{Symbol: "NABIL", Quantity: 30, UnitPrice: 1150, Acquired: day("2024-06-01"), BuyID: 3},
+		{Symbol: "NABIL", Quantity: 40, UnitPrice: 1000, Acquired: day("2024-01-10"), BuyID: 1},
+	}
+	wantRemaining := []lot{
+		{Symbol: "NABIL", Quantity: 60, UnitPrice: 1000, Acquired: day("2024-01-10"), BuyID: 1},
+		{Symbol: "NABIL", Quantity: 50, UnitPrice: 1100, Acquired: day("2024-03-05"), BuyID: 2},
+	}
+	if !reflect.DeepEqual(taken, wantTaken) {
+		t.Errorf("taken = %+v, want %+v", taken, wantTaken)
+	}
+	if !reflect.DeepEqual(remaining, wantRemaining) {
+		t.Errorf("remaining = %+v, want %+v", remaining, wantRemaining)
+	}
+}
+
+func TestConsumeLotsPartOfSameDayBuy(t *testing.T) {
+	lots := []lot{
+		{Symbol: "NICA", Quantity: 100, UnitPrice: 800, Acquired: day("2024-01-10"), BuyID: 1},
+		{Symbol: "NICA", Quantity: 40, UnitPrice: 820, Acquired: day("2024-02-01"), BuyID: 2},
+	}
+
+	remaining, taken := consumeLots(lots, 25, day("2024-02-01"))
+
+	wantTaken := []lot{{Symbol: "NICA", Quantity: 25, UnitPrice: 820, Acquired: day("2024-02-01"), BuyID: 2}}
+	wantRemaining := []lot{
+		{Symbol: "NICA", Quantity: 100, UnitPrice: 800, Acquired: day("2024-01-10"), BuyID: 1},
+		{Symbol: "NICA", Quantity: 15, UnitPrice: 820, Acquired: day("2024-02-01"), BuyID: 2},
+	}
+	if !reflect.DeepEqual(taken, wantTaken) {
+		t.Errorf("taken = %+v, want %+v", taken, wantTaken)
+	}
+	if !reflect.DeepEqual(remaining, wantRemaining) {
+		t.Errorf("remaining = %+v, want %+v", remaining, wantRemaining)
+	}
+}
+
+func TestConsumeLotsOversell(t *testing.T) {
+	lots := []lot{
+		{Symbol: "UPPER", Quantity: 20, UnitPrice: 300, Acquired: day("2024-01-10"), BuyID: 1},
+		{Symbol: "UPPER", Quantity: 10, UnitPrice: 350, Acquired: day("2024-05-01"), BuyID: 2},
+	}
+
+	remaining, taken := consumeLots(lots, 50, day("2024-05-01"))
+
+	if len(remaining) != 0 {
+		t.Errorf("remaining = %+v, want none", remaining)
+	}
+	var sold int64
+	for _, l := range taken {
+		sold += l.Quantity
+	}
+	if sold != 30 {
+		t.Errorf("took %d shares, want the 30 held", sold)
+	}
+}
@@ -0,0 +1,28 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+// paperFillPrice is the price a paper trade executes at when none is given:
+// the last traded price from the most recent sync, falling back to the close.
+func (s *PortfolioService) paperFillPrice(ctx context.Context, symbol string) (float64, error) {
+	price, err := s.queries.GetLatestPriceBySymbol(ctx, strings.ToUpper(symbol))
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("no market price for %s yet", symbol))
+	}
+	if err != nil {
+		return 0, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if price.LastTradedPrice.Valid {
+		return price.LastTradedPrice.Float64, nil
+	}
+	return price.ClosePrice.Float64, nil
+}
@@ -3,13 +3,17 @@ package portfolio
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 
 	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
 )
 
@@ -21,16 +25,24 @@ const UserIDKey ContextKey = "user_id"
 
 // PortfolioService implements the PortfolioService gRPC service.
 type PortfolioService struct {
+	db      *sql.DB
 	queries *sqlc.Queries
+
+	// holdingLocks guards the delete-and-reinsert in refreshHolding, which
+	// would otherwise race when an import and a summary refresh the same
+	// symbol. It only covers callers sharing this service, so a process
+	// builds one and hands it to both the API and the scheduler.
+	holdingLocks keyedMutex
 }
 
-// NewPortfolioService creates a new PortfolioService.
-func NewPortfolioService(queries *sqlc.Queries) *PortfolioService {
-	return &PortfolioService{queries: queries}
+// NewPortfolioService creates a new PortfolioService. queries must be bound
+// to db.
+func NewPortfolioService(db *sql.DB, queries *sqlc.Queries) *PortfolioService {
+	return &PortfolioService{db: db, queries: queries}
 }
 
-// getUserID extracts user ID from context (set by auth middleware).
-func getUserID(ctx context.Context) (int64, error) {
+// UserID extracts user ID from context (set by auth middleware).
+func UserID(ctx context.Context) (int64, error) {
 	userID, ok := ctx.Value(UserIDKey).(int64)
 	if !ok || userID == 0 {
 		return 0, connect.NewError(connect.CodeUnauthenticated, errors.New("authentication required"))
@@ -43,7 +55,7 @@ func (s *PortfolioService) ListPortfolios(
 	ctx context.Context,
 	_ *connect.Request[ntxv1.ListPortfoliosRequest],
 ) (*connect.Response[ntxv1.ListPortfoliosResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +64,10 @@ func (s *PortfolioService) ListPortfolios(
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	profiles, err := s.portfolioProfiles(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
 
 	result := make([]*ntxv1.Portfolio, len(portfolios))
 	for i, p := range portfolios {
@@ -63,6 +79,10 @@ func (s *PortfolioService) ListPortfolios(
 			Id:        p.ID,
 			Name:      p.Name,
 			CreatedAt: createdAt,
+			Paper:     p.Paper,
+		}
+		if profileID, ok := profiles[p.ID]; ok {
+			result[i].ProfileId = &profileID
 		}
 	}
 
@@ -76,7 +96,7 @@ func (s *PortfolioService) CreatePortfolio(
 	ctx context.Context,
 	req *connect.Request[ntxv1.CreatePortfolioRequest],
 ) (*connect.Response[ntxv1.CreatePortfolioResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -84,15 +104,31 @@ func (s *PortfolioService) CreatePortfolio(
 	if req.Msg.Name == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
 	}
+	if req.Msg.ProfileId != nil {
+		if err := s.checkProfile(ctx, userID, *req.Msg.ProfileId); err != nil {
+			return nil, err
+		}
+	}
 
 	portfolio, err := s.queries.CreatePortfolio(ctx, sqlc.CreatePortfolioParams{
 		UserID: userID,
 		Name:   req.Msg.Name,
+		Paper:  req.Msg.Paper,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	if req.Msg.ProfileId != nil {
+		err := s.queries.SetPortfolioProfile(ctx, sqlc.SetPortfolioProfileParams{
+			PortfolioID: portfolio.ID,
+			ProfileID:   *req.Msg.ProfileId,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
 	createdAt := ""
 	if portfolio.CreatedAt.Valid {
 		createdAt = portfolio.CreatedAt.Time.Format(time.RFC3339)
@@ -103,22 +139,54 @@ func (s *PortfolioService) CreatePortfolio(
 			Id:        portfolio.ID,
 			Name:      portfolio.Name,
 			CreatedAt: createdAt,
+			ProfileId: req.Msg.ProfileId,
+			Paper:     portfolio.Paper,
 		},
 	}), nil
 }
 
+// DeletePortfolio deletes a portfolio. Its transactions, holdings, alerts
+// and the rest go with it through the schema's cascades.
+func (s *PortfolioService) DeletePortfolio(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeletePortfolioRequest],
+) (*connect.Response[ntxv1.DeletePortfolioResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	err = s.queries.DeletePortfolio(ctx, sqlc.DeletePortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeletePortfolioResponse{}), nil
+}
+
 // AddTransaction adds a transaction to a portfolio.
 func (s *PortfolioService) AddTransaction(
 	ctx context.Context,
 	req *connect.Request[ntxv1.AddTransactionRequest],
 ) (*connect.Response[ntxv1.AddTransactionResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify portfolio belongs to user
-	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+	portfolio, err := s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
 		ID:     req.Msg.PortfolioId,
 		UserID: userID,
 	})
@@ -127,33 +195,75 @@ func (s *PortfolioService) AddTransaction(
 	}
 
 	// Validate input
-	if req.Msg.StockSymbol == "" {
+	symbol := strings.ToUpper(strings.TrimSpace(req.Msg.StockSymbol))
+	if symbol == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("stock_symbol is required"))
 	}
+	var transactionType string
+	switch req.Msg.TransactionType {
+	case ntxv1.TransactionType_TRANSACTION_TYPE_BUY:
+		transactionType = "BUY"
+	case ntxv1.TransactionType_TRANSACTION_TYPE_SELL:
+		transactionType = "SELL"
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("transaction_type must be BUY or SELL"))
+	}
 	if req.Msg.Quantity <= 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quantity must be positive"))
 	}
-	if req.Msg.UnitPrice <= 0 {
+	unitPrice := req.Msg.UnitPrice
+	if portfolio.Paper && unitPrice == 0 {
+		unitPrice, err = s.paperFillPrice(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if unitPrice <= 0 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("unit_price must be positive"))
 	}
-
-	transactionType := "BUY"
-	if req.Msg.TransactionType == ntxv1.TransactionType_TRANSACTION_TYPE_SELL {
-		transactionType = "SELL"
+	if req.Msg.BrokerAccountId != nil {
+		_, err := s.queries.GetBrokerAccount(ctx, sqlc.GetBrokerAccountParams{
+			ID:     *req.Msg.BrokerAccountId,
+			UserID: userID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("broker account not found"))
+		}
 	}
 
-	transactionDate, err := time.Parse("2006-01-02", req.Msg.TransactionDate)
-	if err != nil {
-		transactionDate = time.Now()
+	// An empty date means today; a malformed one is rejected rather than
+	// quietly replaced
+	transactionDate := time.Now()
+	if req.Msg.TransactionDate != "" {
+		transactionDate, err = time.Parse("2006-01-02", req.Msg.TransactionDate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("transaction_date must be YYYY-MM-DD"))
+		}
 	}
 
-	tx, err := s.queries.CreateTransaction(ctx, sqlc.CreateTransactionParams{
-		PortfolioID:     req.Msg.PortfolioId,
-		StockSymbol:     req.Msg.StockSymbol,
-		TransactionType: transactionType,
-		Quantity:        req.Msg.Quantity,
-		UnitPrice:       req.Msg.UnitPrice,
-		TransactionDate: transactionDate,
+	var tx sqlc.Transaction
+	err = database.WithTx(ctx, s.db, func(q *sqlc.Queries) error {
+		tx, err = q.CreateTransaction(ctx, sqlc.CreateTransactionParams{
+			PortfolioID:     req.Msg.PortfolioId,
+			StockSymbol:     symbol,
+			TransactionType: transactionType,
+			Quantity:        req.Msg.Quantity,
+			UnitPrice:       unitPrice,
+			TransactionDate: transactionDate,
+		})
+		if err != nil {
+			return err
+		}
+		if err := recordEvent(ctx, q, tx, eventAdded); err != nil {
+			return err
+		}
+		if req.Msg.BrokerAccountId == nil {
+			return nil
+		}
+		return q.SetTransactionBroker(ctx, sqlc.SetTransactionBrokerParams{
+			TransactionID:   tx.ID,
+			BrokerAccountID: *req.Msg.BrokerAccountId,
+		})
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -168,16 +278,18 @@ func (s *PortfolioService) AddTransaction(
 			Quantity:        tx.Quantity,
 			UnitPrice:       tx.UnitPrice,
 			TransactionDate: tx.TransactionDate.Format("2006-01-02"),
+			BrokerAccountId: req.Msg.BrokerAccountId,
 		},
 	}), nil
 }
 
-// ListTransactions returns transactions for a portfolio, optionally filtered by symbol.
+// ListTransactions returns transactions for a portfolio, optionally filtered
+// by symbol, tag or broker account.
 func (s *PortfolioService) ListTransactions(
 	ctx context.Context,
 	req *connect.Request[ntxv1.ListTransactionsRequest],
 ) (*connect.Response[ntxv1.ListTransactionsResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -191,28 +303,55 @@ func (s *PortfolioService) ListTransactions(
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
 	}
 
-	var transactions []sqlc.Transaction
-
+	filter := sqlc.ListTransactionsPageParams{
+		PortfolioID: req.Msg.PortfolioId,
+		Limit:       -1, // no limit in SQLite
+		Offset:      int64(max(req.Msg.Offset, 0)),
+	}
 	if req.Msg.StockSymbol != nil && *req.Msg.StockSymbol != "" {
-		transactions, err = s.queries.ListTransactionsBySymbol(ctx, sqlc.ListTransactionsBySymbolParams{
-			PortfolioID: req.Msg.PortfolioId,
-			StockSymbol: *req.Msg.StockSymbol,
-		})
-	} else {
-		transactions, err = s.queries.ListTransactionsByPortfolio(ctx, req.Msg.PortfolioId)
+		filter.StockSymbol = sql.NullString{String: *req.Msg.StockSymbol, Valid: true}
+	}
+	if req.Msg.TagId != nil {
+		filter.TagID = sql.NullInt64{Int64: *req.Msg.TagId, Valid: true}
+	}
+	if req.Msg.BrokerAccountId != nil {
+		filter.BrokerAccountID = sql.NullInt64{Int64: *req.Msg.BrokerAccountId, Valid: true}
+	}
+	if req.Msg.Limit > 0 {
+		filter.Limit = int64(req.Msg.Limit)
+	}
+
+	transactions, err := s.queries.ListTransactionsPage(ctx, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	total, err := s.queries.CountTransactions(ctx, sqlc.CountTransactionsParams{
+		PortfolioID:     filter.PortfolioID,
+		StockSymbol:     filter.StockSymbol,
+		TagID:           filter.TagID,
+		BrokerAccountID: filter.BrokerAccountID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
+	tags, err := s.transactionTags(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	brokers, err := s.transactionBrokers(ctx, req.Msg.PortfolioId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	result := make([]*ntxv1.Transaction, len(transactions))
-	for i, tx := range transactions {
+	result := make([]*ntxv1.Transaction, 0, len(transactions))
+	for _, tx := range transactions {
+		broker, hasBroker := brokers[tx.ID]
 		txType := ntxv1.TransactionType_TRANSACTION_TYPE_BUY
 		if tx.TransactionType == "SELL" {
 			txType = ntxv1.TransactionType_TRANSACTION_TYPE_SELL
 		}
-		result[i] = &ntxv1.Transaction{
+		t := &ntxv1.Transaction{
 			Id:              tx.ID,
 			PortfolioId:     tx.PortfolioID,
 			StockSymbol:     tx.StockSymbol,
@@ -220,11 +359,18 @@ func (s *PortfolioService) ListTransactions(
 			Quantity:        tx.Quantity,
 			UnitPrice:       tx.UnitPrice,
 			TransactionDate: tx.TransactionDate.Format("2006-01-02"),
+			Intraday:        tx.Intraday,
+			Tags:            tags[tx.ID],
 		}
+		if hasBroker {
+			t.BrokerAccountId = &broker
+		}
+		result = append(result, t)
 	}
 
 	return connect.NewResponse(&ntxv1.ListTransactionsResponse{
 		Transactions: result,
+		TotalCount:   convert.SafeInt32(total),
 	}), nil
 }
 
@@ -233,7 +379,7 @@ func (s *PortfolioService) DeleteTransaction(
 	ctx context.Context,
 	req *connect.Request[ntxv1.DeleteTransactionRequest],
 ) (*connect.Response[ntxv1.DeleteTransactionResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -254,19 +400,26 @@ func (s *PortfolioService) DeleteTransaction(
 	}
 
 	// Delete the transaction
-	if err := s.queries.DeleteTransaction(ctx, req.Msg.TransactionId); err != nil {
+	err = database.WithTx(ctx, s.db, func(q *sqlc.Queries) error {
+		if err := q.DeleteTransaction(ctx, req.Msg.TransactionId); err != nil {
+			return err
+		}
+		return recordEvent(ctx, q, tx, eventDeleted)
+	})
+	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
 	return connect.NewResponse(&ntxv1.DeleteTransactionResponse{}), nil
 }
 
-// GetPortfolioSummary returns the portfolio summary with holdings.
+// GetPortfolioSummary returns the portfolio summary with holdings, valued
+// now or as of an earlier date.
 func (s *PortfolioService) GetPortfolioSummary(
 	ctx context.Context,
 	req *connect.Request[ntxv1.GetPortfolioSummaryRequest],
 ) (*connect.Response[ntxv1.GetPortfolioSummaryResponse], error) {
-	userID, err := getUserID(ctx)
+	userID, err := UserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -280,60 +433,21 @@ func (s *PortfolioService) GetPortfolioSummary(
 		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
 	}
 
-	// Get aggregated holdings
-	holdingsData, err := s.queries.GetHoldingsByPortfolio(ctx, req.Msg.PortfolioId)
+	asOf, err := parseAsOf(req.Msg.AsOf, time.Now())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
-
-	// Fetch current prices for all holdings
-	priceMap, err := s.fetchCurrentPrices(ctx, holdingsData)
+	v, err := s.valueHoldingsOn(ctx, req.Msg.PortfolioId, asOf)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-
-	var holdings []*ntxv1.Holding
-	var totalInvested, totalCurrentValue float64
-
-	for _, h := range holdingsData {
-		qty := h.NetQuantity.Float64
-		if qty <= 0 {
-			continue
-		}
-
-		avgBuyPrice := 0.0
-		if h.TotalBuyQuantity.Float64 > 0 {
-			avgBuyPrice = h.TotalBuyCost.Float64 / h.TotalBuyQuantity.Float64
-		}
-
-		info := priceMap[h.StockSymbol]
-		currentPrice := info.Price
-		totalValue := qty * currentPrice
-		invested := qty * avgBuyPrice
-		profitLoss := totalValue - invested
-		profitLossPercent := 0.0
-		if invested > 0 {
-			profitLossPercent = (profitLoss / invested) * 100
-		}
-
-		dayChangeValue := info.ChangeAmount * qty
-
-		holdings = append(holdings, &ntxv1.Holding{
-			StockSymbol:       h.StockSymbol,
-			Quantity:          int64(qty),
-			AvgBuyPrice:       avgBuyPrice,
-			CurrentPrice:      currentPrice,
-			TotalValue:        totalValue,
-			ProfitLoss:        profitLoss,
-			ProfitLossPercent: profitLossPercent,
-			Sector:            info.Sector,
-			DayChangePercent:  info.ChangePercent,
-			DayChangeValue:    dayChangeValue,
-		})
-
-		totalInvested += invested
-		totalCurrentValue += totalValue
+	holdingsData, priceMap, holdings := v.rows, v.prices, v.holdings
+	totalInvested, totalCurrentValue, totalDayChange := v.invested, v.currentValue, v.dayChange
+	groups, err := s.loadHoldingGroups(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	groups.assign(holdings)
 
 	totalPL := totalCurrentValue - totalInvested
 	totalPLPercent := 0.0
@@ -341,12 +455,17 @@ func (s *PortfolioService) GetPortfolioSummary(
 		totalPLPercent = (totalPL / totalInvested) * 100
 	}
 
+	dayChangePercent := 0.0
+	if previousValue := totalCurrentValue - totalDayChange; previousValue > 0 {
+		dayChangePercent = (totalDayChange / previousValue) * 100
+	}
+
 	// Calculate projected dividend and health tips
 	var projectedDividendTotal float64
 	var healthTips []*ntxv1.HealthTip
 
 	for _, h := range holdingsData {
-		qty := h.NetQuantity.Float64
+		qty := float64(h.Quantity)
 		if qty <= 0 {
 			continue
 		}
@@ -386,27 +505,11 @@ func (s *PortfolioService) GetPortfolioSummary(
 		// Note: GetLatestCorporateAction takes symbol, not ID in our current query
 		ca, err := s.queries.GetLatestCorporateAction(ctx, h.StockSymbol)
 		if err == nil {
-			if ca.CashDividend.Valid && ca.CashDividend.Float64 > 0 {
-				// Assuming cash dividend is % of paid up value (usually 100 for Nepal)
-				// OR it is raw amount per share? In Nepal, it's usually Percent.
-				// If percent, we need PaidUpCapital per share (usually 100).
-				// Let's assume it's percent for now, so (Percent/100) * 100 * Qty = Percent * Qty.
-				// Actually, accurately, cash dividend is usually distributed as % of paid-up capital.
-				// Most stocks have 100 paid-up. So 20% dividend = Rs 20 per share.
-				// So Projected = (CashDividend / 100) * 100 * Qty = CashDividend * Qty.
-				// BUT checking the proto/db, calculate simplified:
-
-				// Let's assume CashDividend is the percentage value (e.g. 15.5 meaning 15.5%)
-				// Standard face value is 100.
-				dividendPerShare := ca.CashDividend.Float64
-				projectedIncome := dividendPerShare * qty
-				projectedDividendTotal += projectedIncome
-
-				// Add tip for high yield if > 20% (?)
-				// Keep it simple for now.
-			}
+			projectedDividendTotal += cashDividendPerShare(ca) * qty
 		}
 	}
+	healthTips = append(healthTips, listingTips(holdings)...)
+	healthTips = append(healthTips, concentrationTips(groups.allocate(holdings))...)
 
 	return connect.NewResponse(&ntxv1.GetPortfolioSummaryResponse{
 		Summary: &ntxv1.PortfolioSummary{
@@ -419,10 +522,159 @@ func (s *PortfolioService) GetPortfolioSummary(
 			TotalProfitLossPercent: totalPLPercent,
 			ProjectedDividend:      projectedDividendTotal,
 			HealthTips:             healthTips,
+			DayChangeValue:         totalDayChange,
+			DayChangePercent:       dayChangePercent,
 		},
 	}), nil
 }
 
+// valuation is a portfolio's holdings priced at the latest market data.
+type valuation struct {
+	rows         []sqlc.Holding
+	prices       map[string]stockInfo
+	holdings     []*ntxv1.Holding
+	invested     float64
+	currentValue float64
+	dayChange    float64
+}
+
+// valueHoldings prices every open holding and computes per-holding and total P&L.
+func (s *PortfolioService) valueHoldings(ctx context.Context, portfolioID int64) (*valuation, error) {
+	if err := s.applyPendingEvents(ctx, portfolioID); err != nil {
+		return nil, err
+	}
+
+	// Get aggregated holdings
+	holdingsData, err := s.queries.ListHoldings(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch current prices for all holdings
+	priceMap, err := s.fetchCurrentPrices(ctx, holdingsData)
+	if err != nil {
+		return nil, err
+	}
+	return s.priceHoldings(ctx, portfolioID, holdingsData, priceMap, time.Now())
+}
+
+// priceHoldings values holding rows at the given prices, with bond interest
+// accrued to day.
+func (s *PortfolioService) priceHoldings(
+	ctx context.Context,
+	portfolioID int64,
+	holdingsData []sqlc.Holding,
+	priceMap map[string]stockInfo,
+	day time.Time,
+) (*valuation, error) {
+	costs, err := s.holdingCosts(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	bonds, err := s.bondTerms(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	var holdings []*ntxv1.Holding
+	var totalInvested, totalCurrentValue, totalDayChange float64
+
+	for _, h := range holdingsData {
+		qty := float64(h.Quantity)
+		if qty <= 0 {
+			continue
+		}
+
+		avgBuyPrice := 0.0
+		if h.TotalBuyQuantity > 0 {
+			avgBuyPrice = h.TotalBuyCost / float64(h.TotalBuyQuantity)
+		}
+		avgBuyPrice, costSource := effectiveCost(avgBuyPrice, costs[h.StockSymbol])
+
+		info := priceMap[h.StockSymbol]
+		currentPrice := info.Price
+		instrument := ntxv1.InstrumentType_INSTRUMENT_TYPE_EQUITY
+		var accrued float64
+		if t, ok := bonds[h.StockSymbol]; ok {
+			instrument = ntxv1.InstrumentType_INSTRUMENT_TYPE_BOND
+			// Debentures rarely trade, so an unpriced one is carried at face value
+			if currentPrice == 0 {
+				currentPrice = t.FaceValue
+			}
+			accrued = accruedPerUnit(t, day) * qty
+		}
+		totalValue := qty*currentPrice + accrued
+		invested := qty * avgBuyPrice
+		profitLoss := totalValue - invested
+		profitLossPercent := 0.0
+		if invested > 0 {
+			profitLossPercent = (profitLoss / invested) * 100
+		}
+
+		dayChangeValue := info.ChangeAmount * qty
+
+		holding := &ntxv1.Holding{
+			StockSymbol:       h.StockSymbol,
+			Quantity:          int64(qty),
+			AvgBuyPrice:       avgBuyPrice,
+			CurrentPrice:      currentPrice,
+			TotalValue:        totalValue,
+			ProfitLoss:        profitLoss,
+			ProfitLossPercent: profitLossPercent,
+			Sector:            info.Sector,
+			DayChangePercent:  info.ChangePercent,
+			DayChangeValue:    dayChangeValue,
+			CostSource:        costSource,
+			InstrumentType:    instrument,
+			AccruedInterest:   accrued,
+		}
+		if err := s.setListingStatus(ctx, holding); err != nil {
+			return nil, err
+		}
+		holdings = append(holdings, holding)
+
+		totalInvested += invested
+		totalCurrentValue += totalValue
+		totalDayChange += dayChangeValue
+	}
+
+	// Weights need the final total, so they're filled in after the loop
+	if totalCurrentValue > 0 {
+		for _, h := range holdings {
+			h.WeightPercent = (h.TotalValue / totalCurrentValue) * 100
+		}
+	}
+
+	return &valuation{
+		rows:         holdingsData,
+		prices:       priceMap,
+		holdings:     holdings,
+		invested:     totalInvested,
+		currentValue: totalCurrentValue,
+		dayChange:    totalDayChange,
+	}, nil
+}
+
+// CurrentValue returns the portfolio's market value and today's change in
+// rupees. Callers must have checked that the portfolio belongs to the user.
+func (s *PortfolioService) CurrentValue(ctx context.Context, portfolioID int64) (value, dayChange float64, err error) {
+	v, err := s.valueHoldings(ctx, portfolioID)
+	if err != nil {
+		return 0, 0, err
+	}
+	return v.currentValue, v.dayChange, nil
+}
+
+// Holdings returns the portfolio's open holdings priced at the latest close.
+// Callers must have checked that the portfolio belongs to the user.
+func (s *PortfolioService) Holdings(ctx context.Context, portfolioID int64) ([]*ntxv1.Holding, error) {
+	v, err := s.valueHoldings(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	return v.holdings, nil
+}
+
 type stockInfo struct {
 	CompanyID     int64
 	Price         float64
@@ -432,7 +684,10 @@ type stockInfo struct {
 }
 
 // fetchCurrentPrices fetches current prices for the given holdings.
-func (s *PortfolioService) fetchCurrentPrices(ctx context.Context, holdings []sqlc.GetHoldingsByPortfolioRow) (map[string]stockInfo, error) {
+func (s *PortfolioService) fetchCurrentPrices(
+	ctx context.Context,
+	holdings []sqlc.Holding,
+) (map[string]stockInfo, error) {
 	info := make(map[string]stockInfo)
 
 	for _, h := range holdings {
@@ -465,6 +720,12 @@ func (s *PortfolioService) fetchCurrentPrices(ctx context.Context, holdings []sq
 			changeAmount = price.ChangeAmount.Float64
 		}
 
+		// Older snapshots only stored the previous close
+		if !price.ChangeAmount.Valid && price.PreviousClose.Valid && price.PreviousClose.Float64 > 0 {
+			changeAmount = currentPrice - price.PreviousClose.Float64
+			changePercent = (changeAmount / price.PreviousClose.Float64) * 100
+		}
+
 		info[h.StockSymbol] = stockInfo{
 			CompanyID:     price.CompanyID,
 			Price:         currentPrice,
@@ -0,0 +1,183 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// boidLength is the length of a CDSC beneficiary owner ID.
+const boidLength = 16
+
+// CreateProfile adds a demat account holder under the authenticated user.
+func (s *PortfolioService) CreateProfile(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateProfileRequest],
+) (*connect.Response[ntxv1.CreateProfileResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.Msg.Name)
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	boid := strings.TrimSpace(req.Msg.Boid)
+	if boid != "" && !validBOID(boid) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("boid must be 16 digits"))
+	}
+
+	profile, err := s.queries.CreateProfile(ctx, sqlc.CreateProfileParams{
+		UserID:       userID,
+		Name:         name,
+		Boid:         boid,
+		Relationship: strings.TrimSpace(req.Msg.Relationship),
+		Minor:        req.Msg.Minor,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateProfileResponse{Profile: profileToProto(profile)}), nil
+}
+
+// ListProfiles returns the user's profiles by name.
+func (s *PortfolioService) ListProfiles(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListProfilesRequest],
+) (*connect.Response[ntxv1.ListProfilesResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := s.queries.ListProfilesByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.Profile, len(profiles))
+	for i, p := range profiles {
+		result[i] = profileToProto(p)
+	}
+
+	return connect.NewResponse(&ntxv1.ListProfilesResponse{Profiles: result}), nil
+}
+
+// DeleteProfile removes a profile, leaving its portfolios unassigned.
+func (s *PortfolioService) DeleteProfile(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteProfileRequest],
+) (*connect.Response[ntxv1.DeleteProfileResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteProfile(ctx, sqlc.DeleteProfileParams{
+		ID:     req.Msg.ProfileId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteProfileResponse{}), nil
+}
+
+// SetPortfolioProfile assigns a portfolio to one of the user's profiles, or
+// clears the assignment.
+func (s *PortfolioService) SetPortfolioProfile(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetPortfolioProfileRequest],
+) (*connect.Response[ntxv1.SetPortfolioProfileResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if req.Msg.ProfileId == nil {
+		if err := s.queries.ClearPortfolioProfile(ctx, req.Msg.PortfolioId); err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(&ntxv1.SetPortfolioProfileResponse{}), nil
+	}
+
+	if err := s.checkProfile(ctx, userID, *req.Msg.ProfileId); err != nil {
+		return nil, err
+	}
+	err = s.queries.SetPortfolioProfile(ctx, sqlc.SetPortfolioProfileParams{
+		PortfolioID: req.Msg.PortfolioId,
+		ProfileID:   *req.Msg.ProfileId,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.SetPortfolioProfileResponse{}), nil
+}
+
+// checkProfile verifies the profile belongs to the user.
+func (s *PortfolioService) checkProfile(ctx context.Context, userID, profileID int64) error {
+	_, err := s.queries.GetProfile(ctx, sqlc.GetProfileParams{ID: profileID, UserID: userID})
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, errors.New("profile not found"))
+	}
+	return nil
+}
+
+// portfolioProfiles maps each of the user's assigned portfolios to its profile.
+func (s *PortfolioService) portfolioProfiles(ctx context.Context, userID int64) (map[int64]int64, error) {
+	rows, err := s.queries.ListPortfolioProfilesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]int64, len(rows))
+	for _, r := range rows {
+		result[r.PortfolioID] = r.ProfileID
+	}
+	return result, nil
+}
+
+func validBOID(boid string) bool {
+	if len(boid) != boidLength {
+		return false
+	}
+	for _, r := range boid {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func profileToProto(p sqlc.Profile) *ntxv1.Profile {
+	out := &ntxv1.Profile{
+		Id:           p.ID,
+		Name:         p.Name,
+		Boid:         p.Boid,
+		Relationship: p.Relationship,
+		Minor:        p.Minor,
+	}
+	if p.CreatedAt.Valid {
+		out.CreatedAt = p.CreatedAt.Time.Format(time.RFC3339)
+	}
+	return out
+}
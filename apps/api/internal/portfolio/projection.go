@@ -0,0 +1,279 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+const (
+	// tradingDaysPerYear approximates NEPSE's five-day week less holidays.
+	tradingDaysPerYear = 240
+
+	defaultSimulations = 2000
+	maxSimulations     = 20000
+	maxHorizonYears    = 30
+
+	// minReturnDays is the shortest price history a holding needs to be
+	// modelled; anything shorter gives meaningless volatility.
+	minReturnDays = 60
+)
+
+var defaultHorizons = []int32{1, 3, 5}
+
+// returnModel is a multivariate normal fit of daily log returns for the
+// modelled holdings.
+type returnModel struct {
+	values []float64   // current value of each modelled holding
+	fixed  float64     // value of holdings left out of the model
+	mean   []float64   // mean daily log return
+	chol   [][]float64 // lower Cholesky factor of the daily covariance
+	days   int
+}
+
+// ProjectPortfolio simulates the portfolio's value at future horizons from
+// the historical volatility and correlation of its holdings.
+func (s *PortfolioService) ProjectPortfolio(
+	ctx context.Context,
+	req *connect.Request[ntxv1.ProjectPortfolioRequest],
+) (*connect.Response[ntxv1.ProjectPortfolioResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	sims := int(req.Msg.Simulations)
+	if sims < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("simulations cannot be negative"))
+	}
+	if sims == 0 {
+		sims = defaultSimulations
+	}
+	sims = min(sims, maxSimulations)
+
+	horizons := req.Msg.HorizonYears
+	if len(horizons) == 0 {
+		horizons = defaultHorizons
+	}
+	for _, h := range horizons {
+		if h < 1 || h > maxHorizonYears {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("horizon_years must be between 1 and %d", maxHorizonYears))
+		}
+	}
+
+	v, err := s.valueHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	now := time.Now()
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: req.Msg.PortfolioId,
+		FromDate:    now.AddDate(-1, 0, 0).Format("2006-01-02"),
+		ToDate:      now.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	model, excluded := fitReturns(v.holdings, prices)
+
+	seed := uint64(now.UnixNano()) //nolint:gosec // only seeds the simulation
+	if req.Msg.Seed != nil {
+		seed = *req.Msg.Seed
+	}
+	rng := rand.New(rand.NewPCG(seed, seed)) //nolint:gosec // simulation, not security
+
+	return connect.NewResponse(&ntxv1.ProjectPortfolioResponse{
+		CurrentValue:    v.currentValue,
+		Bands:           model.simulate(rng, horizons, sims, v.currentValue),
+		HistoryDays:     convert.SafeInt32(int64(model.days)),
+		ExcludedSymbols: excluded,
+	}), nil
+}
+
+// fitReturns estimates daily log-return statistics from the last year of
+// closes. Symbols that miss a day carry their previous close forward, so
+// illiquid scrips show a zero return rather than a gap.
+func fitReturns(holdings []*ntxv1.Holding, prices []sqlc.ListPortfolioClosePricesRow) (*returnModel, []string) {
+	observed := make(map[string]int)
+	for _, p := range prices {
+		if p.ClosePrice > 0 {
+			observed[p.Symbol]++
+		}
+	}
+
+	model := &returnModel{}
+	index := make(map[string]int)
+	var excluded []string
+	for _, h := range holdings {
+		if observed[h.StockSymbol] <= minReturnDays {
+			model.fixed += h.TotalValue
+			excluded = append(excluded, h.StockSymbol)
+			continue
+		}
+		index[h.StockSymbol] = len(model.values)
+		model.values = append(model.values, h.TotalValue)
+	}
+	slices.Sort(excluded)
+
+	n := len(model.values)
+	if n == 0 {
+		return model, excluded
+	}
+
+	// Walk the dates in order, starting once every modelled symbol has a close
+	last := make([]float64, n)
+	seen := 0
+	var returns [][]float64
+	for i := 0; i < len(prices); {
+		date := prices[i].BusinessDate
+		today := slices.Clone(last)
+		for ; i < len(prices) && prices[i].BusinessDate == date; i++ {
+			j, ok := index[prices[i].Symbol]
+			if !ok || prices[i].ClosePrice <= 0 {
+				continue
+			}
+			if today[j] == 0 {
+				seen++
+			}
+			today[j] = prices[i].ClosePrice
+		}
+
+		if seen == n && !slices.Contains(last, 0) {
+			r := make([]float64, n)
+			for j := range r {
+				r[j] = math.Log(today[j] / last[j])
+			}
+			returns = append(returns, r)
+		}
+		last = today
+	}
+
+	model.days = len(returns)
+	model.mean = make([]float64, n)
+	cov := make([][]float64, n)
+	for j := range cov {
+		cov[j] = make([]float64, n)
+	}
+	if model.days < 2 {
+		model.chol = cov
+		return model, excluded
+	}
+
+	for _, r := range returns {
+		for j := range r {
+			model.mean[j] += r[j] / float64(model.days)
+		}
+	}
+	for _, r := range returns {
+		for a := range n {
+			for b := range n {
+				cov[a][b] += (r[a] - model.mean[a]) * (r[b] - model.mean[b]) / float64(model.days-1)
+			}
+		}
+	}
+	model.chol = cholesky(cov)
+
+	return model, excluded
+}
+
+// simulate draws the terminal value of each path directly: with independent
+// daily returns, the sum over T days is normal with mean T·μ and covariance
+// T·Σ, so there's no need to step through every day. Holdings are held, not
+// rebalanced.
+func (m *returnModel) simulate(rng *rand.Rand, horizons []int32, sims int, current float64) []*ntxv1.ProjectionBand {
+	n := len(m.values)
+	z := make([]float64, n)
+	outcomes := make([]float64, sims)
+
+	bands := make([]*ntxv1.ProjectionBand, len(horizons))
+	for hi, years := range horizons {
+		t := float64(years) * tradingDaysPerYear
+		losses := 0
+
+		for p := range outcomes {
+			for k := range z {
+				z[k] = rng.NormFloat64()
+			}
+			value := m.fixed
+			for i := range n {
+				x := t * m.mean[i]
+				for k := 0; k <= i; k++ {
+					x += math.Sqrt(t) * m.chol[i][k] * z[k]
+				}
+				value += m.values[i] * math.Exp(x)
+			}
+			outcomes[p] = value
+			if value < current {
+				losses++
+			}
+		}
+
+		slices.Sort(outcomes)
+		bands[hi] = &ntxv1.ProjectionBand{
+			HorizonYears:      years,
+			P5:                percentile(outcomes, 0.05),
+			P25:               percentile(outcomes, 0.25),
+			P50:               percentile(outcomes, 0.50),
+			P75:               percentile(outcomes, 0.75),
+			P95:               percentile(outcomes, 0.95),
+			ProbabilityOfLoss: float64(losses) / float64(sims),
+		}
+	}
+	return bands
+}
+
+// cholesky factors a covariance matrix. Perfectly correlated or constant
+// series make it singular, so non-positive pivots are clamped to zero.
+func cholesky(a [][]float64) [][]float64 {
+	n := len(a)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for i := range n {
+		for j := 0; j <= i; j++ {
+			sum := a[i][j]
+			for k := range j {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				l[i][i] = math.Sqrt(max(sum, 0))
+				continue
+			}
+			if l[j][j] > 0 {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	return sorted[int(math.Round(p*float64(len(sorted)-1)))]
+}
@@ -0,0 +1,173 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// minBetaDays is the fewest paired daily returns used to estimate a beta;
+// with less history the holding is assumed to move with the market.
+const minBetaDays = 30
+
+// RunScenario applies hypothetical shocks to current holdings and reports
+// the projected value. Sector shocks take precedence over the index shock,
+// which is scaled by each holding's historical beta to NEPSE.
+func (s *PortfolioService) RunScenario(
+	ctx context.Context,
+	req *connect.Request[ntxv1.RunScenarioRequest],
+) (*connect.Response[ntxv1.RunScenarioResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	if req.Msg.IndexChangePercent == nil && len(req.Msg.SectorShocks) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("at least one shock is required"))
+	}
+
+	sectorShocks := make(map[string]float64, len(req.Msg.SectorShocks))
+	for _, shock := range req.Msg.SectorShocks {
+		if shock.ChangePercent < -100 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a price can't fall more than 100%"))
+		}
+		sectorShocks[strings.ToLower(shock.Sector)] = shock.ChangePercent
+	}
+
+	v, err := s.valueHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var betas map[string]float64
+	if req.Msg.IndexChangePercent != nil {
+		betas, err = s.betas(ctx, req.Msg.PortfolioId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	resp := &ntxv1.RunScenarioResponse{}
+	for _, h := range v.holdings {
+		sh := &ntxv1.ScenarioHolding{
+			StockSymbol:  h.StockSymbol,
+			Sector:       h.Sector,
+			CurrentValue: h.TotalValue,
+		}
+
+		shock, ok := sectorShocks[strings.ToLower(h.Sector)]
+		if !ok && req.Msg.IndexChangePercent != nil {
+			b, known := betas[h.StockSymbol]
+			if !known {
+				b = 1
+			}
+			sh.Beta = &b
+			// A high beta can't push a price below zero
+			shock = max(b*req.Msg.GetIndexChangePercent(), -100)
+		}
+
+		sh.ChangePercent = shock
+		sh.ProjectedValue = h.TotalValue * (1 + shock/100)
+
+		resp.Holdings = append(resp.Holdings, sh)
+		resp.CurrentValue += sh.CurrentValue
+		resp.ProjectedValue += sh.ProjectedValue
+	}
+
+	resp.ChangeValue = resp.ProjectedValue - resp.CurrentValue
+	if resp.CurrentValue > 0 {
+		resp.ChangePercent = (resp.ChangeValue / resp.CurrentValue) * 100
+	}
+	resp.ProjectedProfitLoss = resp.ProjectedValue - v.invested
+
+	return connect.NewResponse(resp), nil
+}
+
+// betas estimates each symbol's beta to the NEPSE index from the last year
+// of daily log returns on days both have a close.
+func (s *PortfolioService) betas(ctx context.Context, portfolioID int64) (map[string]float64, error) {
+	now := time.Now()
+	from := now.AddDate(-1, 0, 0).Format("2006-01-02")
+	to := now.Format("2006-01-02")
+
+	index, err := s.queries.ListIndexValues(ctx, sqlc.ListIndexValuesParams{FromDate: from, ToDate: to})
+	if err != nil {
+		return nil, err
+	}
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    from,
+		ToDate:      to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closes := make(map[string]map[string]float64)
+	for _, p := range prices {
+		if p.ClosePrice <= 0 {
+			continue
+		}
+		if closes[p.Symbol] == nil {
+			closes[p.Symbol] = make(map[string]float64)
+		}
+		closes[p.Symbol][p.BusinessDate] = p.ClosePrice
+	}
+
+	out := make(map[string]float64, len(closes))
+	for symbol, byDate := range closes {
+		var stock, market []float64
+		for i := 1; i < len(index); i++ {
+			prev, ok1 := byDate[index[i-1].BusinessDate]
+			cur, ok2 := byDate[index[i].BusinessDate]
+			if !ok1 || !ok2 {
+				continue
+			}
+			stock = append(stock, math.Log(cur/prev))
+			market = append(market, math.Log(index[i].CloseValue/index[i-1].CloseValue))
+		}
+		if len(stock) < minBetaDays {
+			continue
+		}
+		if b, ok := beta(stock, market); ok {
+			out[symbol] = b
+		}
+	}
+	return out, nil
+}
+
+// beta is cov(stock, market) / var(market).
+func beta(stock, market []float64) (float64, bool) {
+	n := float64(len(stock))
+	var ms, mm float64
+	for i := range stock {
+		ms += stock[i] / n
+		mm += market[i] / n
+	}
+
+	var cov, variance float64
+	for i := range stock {
+		cov += (stock[i] - ms) * (market[i] - mm)
+		variance += (market[i] - mm) * (market[i] - mm)
+	}
+	if variance == 0 {
+		return 0, false
+	}
+	return cov / variance, true
+}
@@ -0,0 +1,174 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// CalculatePositionSize sizes a buy so that being stopped out loses no more
+// than the given share of the account, fees on both legs included. The
+// quantity is also capped so the buy fits within the account.
+func (s *PortfolioService) CalculatePositionSize(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CalculatePositionSizeRequest],
+) (*connect.Response[ntxv1.CalculatePositionSizeResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	if req.Msg.PortfolioId != 0 {
+		_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+			ID:     req.Msg.PortfolioId,
+			UserID: userID,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+		}
+	}
+
+	account := req.Msg.AccountSize
+	entry := req.Msg.EntryPrice
+	stop := req.Msg.StopPrice
+	if !positive(account) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("account_size must be positive"))
+	}
+	if !positive(req.Msg.RiskPercent) || req.Msg.RiskPercent > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("risk_percent must be between 0 and 100"))
+	}
+	if !positive(stop) || !positive(entry) || entry <= stop {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("stop_price must be positive and below entry_price"),
+		)
+	}
+
+	risk := account * req.Msg.RiskPercent / 100
+	riskPerShare := entry - stop
+
+	// The fee-free quantities bound the search from above.
+	maxRisk := math.Floor(risk / riskPerShare)
+	maxAccount := math.Floor(account / entry)
+	if min(maxRisk, maxAccount) > maxShares {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("position is too large to size"))
+	}
+
+	affordable := func(q int64) bool {
+		value := float64(q) * entry
+		return value+feesFor(value).Total() <= account
+	}
+	quantity := largestFitting(int64(min(maxRisk, maxAccount)), func(q int64) bool {
+		return affordable(q) && lossAtStop(q, entry, stop) <= risk
+	}, entry, stop)
+	capped := maxAccount < maxRisk || !affordable(quantity+1)
+
+	value := float64(quantity) * entry
+	fees := feesFor(value)
+	resp := &ntxv1.CalculatePositionSizeResponse{
+		Quantity:        quantity,
+		RiskAmount:      risk,
+		RiskPerShare:    riskPerShare,
+		PositionValue:   value,
+		Commission:      fees.Commission,
+		SebonFee:        fees.SebonFee,
+		DpCharge:        fees.DPCharge,
+		TotalCost:       value + fees.Total(),
+		LossAtStop:      lossAtStop(quantity, entry, stop),
+		AccountPercent:  (value + fees.Total()) / account * 100,
+		CappedByAccount: capped,
+	}
+	if quantity > 0 {
+		resp.Draft = &ntxv1.AddTransactionRequest{
+			PortfolioId:     req.Msg.PortfolioId,
+			StockSymbol:     strings.ToUpper(strings.TrimSpace(req.Msg.StockSymbol)),
+			TransactionType: ntxv1.TransactionType_TRANSACTION_TYPE_BUY,
+			Quantity:        quantity,
+			UnitPrice:       entry,
+			TransactionDate: time.Now().Format("2006-01-02"),
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// maxShares bounds a sized position so quantities stay exact as float64.
+const maxShares = 1 << 53
+
+// positive reports whether v is a finite number above zero.
+func positive(v float64) bool {
+	return v > 0 && !math.IsInf(v, 0)
+}
+
+// largestFitting returns the largest quantity in [0, hi] that fits, where
+// fits(0) holds. Commission rates fall at each tier, so a bigger trade can
+// cost less in fees and fits is only monotonic between the quantities where
+// a trade at one of prices crosses a tier. It binary searches those ranges
+// from the top down.
+func largestFitting(hi int64, fits func(int64) bool, prices ...float64) int64 {
+	if hi <= 0 {
+		return 0
+	}
+	breaks := []int64{hi}
+	for _, t := range commissionTiers {
+		for _, p := range prices {
+			if q := lastInTier(t.upTo, p); q < hi {
+				breaks = append(breaks, q)
+			}
+		}
+	}
+	slices.Sort(breaks)
+	breaks = slices.Compact(breaks)
+
+	for i := len(breaks) - 1; i >= 0; i-- {
+		lo, top := int64(0), breaks[i]
+		if i > 0 {
+			lo = breaks[i-1] + 1
+		}
+		if !fits(lo) {
+			continue
+		}
+		for lo < top {
+			mid := top - (top-lo)/2
+			if fits(mid) {
+				lo = mid
+			} else {
+				top = mid - 1
+			}
+		}
+		return lo
+	}
+	return 0
+}
+
+// lastInTier is the largest quantity whose trade at price stays within upTo.
+func lastInTier(upTo, price float64) int64 {
+	q := int64(upTo / price)
+	for float64(q+1)*price <= upTo {
+		q++
+	}
+	for q > 0 && float64(q)*price > upTo {
+		q--
+	}
+	return q
+}
+
+// lossAtStop is what buying quantity at entry and selling at stop costs,
+// counting the fees on both trades.
+func lossAtStop(quantity int64, entry, stop float64) float64 {
+	if quantity <= 0 {
+		return 0
+	}
+	buy := float64(quantity) * entry
+	sell := float64(quantity) * stop
+	return buy - sell + feesFor(buy).Total() + feesFor(sell).Total()
+}
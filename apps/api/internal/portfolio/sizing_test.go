@@ -0,0 +1,32 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+)
+
+// TestLargestFitting checks the search against stepping down one share at a
+// time, across the commission tiers where fees stop increasing.
+func TestLargestFitting(t *testing.T) {
+	for _, tt := range []struct{ account, entry, stop, risk float64 }{
+		{4_887_479, 2636.49, 2474.57, 143_041},
+		{2_446_678, 927.67, 880.34, 114_175},
+		{100_000, 500, 450, 2_000},
+		{60_000, 1000, 990, 60_000},
+		{12_000_000, 240, 230, 250_000},
+	} {
+		fits := func(q int64) bool {
+			value := float64(q) * tt.entry
+			return value+feesFor(value).Total() <= tt.account && lossAtStop(q, tt.entry, tt.stop) <= tt.risk
+		}
+		hi := int64(min(math.Floor(tt.risk/(tt.entry-tt.stop)), math.Floor(tt.account/tt.entry)))
+
+		want := hi
+		for want > 0 && !fits(want) {
+			want--
+		}
+		if got := largestFitting(hi, fits, tt.entry, tt.stop); got != want {
+			t.Errorf("%+v: got %d shares, want %d", tt, got, want)
+		}
+	}
+}
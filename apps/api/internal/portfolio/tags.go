@@ -0,0 +1,358 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/convert"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// maxTagLength keeps tag names short enough to show in a transaction list.
+const maxTagLength = 40
+
+// CreateTag adds a strategy label for the authenticated user.
+func (s *PortfolioService) CreateTag(
+	ctx context.Context,
+	req *connect.Request[ntxv1.CreateTagRequest],
+) (*connect.Response[ntxv1.CreateTagResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := s.checkTagName(ctx, userID, 0, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.queries.CreateTag(ctx, sqlc.CreateTagParams{UserID: userID, Name: name})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.CreateTagResponse{Tag: tagToProto(tag)}), nil
+}
+
+// ListTags returns the user's tags in name order.
+func (s *PortfolioService) ListTags(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.ListTagsRequest],
+) (*connect.Response[ntxv1.ListTagsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.queries.ListTagsByUser(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result := make([]*ntxv1.Tag, len(tags))
+	for i, t := range tags {
+		result[i] = tagToProto(t)
+	}
+
+	return connect.NewResponse(&ntxv1.ListTagsResponse{Tags: result}), nil
+}
+
+// RenameTag changes a tag's name; tagged transactions follow it.
+func (s *PortfolioService) RenameTag(
+	ctx context.Context,
+	req *connect.Request[ntxv1.RenameTagRequest],
+) (*connect.Response[ntxv1.RenameTagResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := s.checkTagName(ctx, userID, req.Msg.TagId, req.Msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.queries.RenameTag(ctx, sqlc.RenameTagParams{
+		Name:   name,
+		ID:     req.Msg.TagId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("tag not found"))
+	}
+
+	return connect.NewResponse(&ntxv1.RenameTagResponse{Tag: tagToProto(tag)}), nil
+}
+
+// DeleteTag removes a tag and untags every transaction carrying it.
+func (s *PortfolioService) DeleteTag(
+	ctx context.Context,
+	req *connect.Request[ntxv1.DeleteTagRequest],
+) (*connect.Response[ntxv1.DeleteTagResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.queries.DeleteTag(ctx, sqlc.DeleteTagParams{
+		ID:     req.Msg.TagId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.DeleteTagResponse{}), nil
+}
+
+// SetTransactionTags replaces the tags on one of the user's transactions.
+func (s *PortfolioService) SetTransactionTags(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SetTransactionTagsRequest],
+) (*connect.Response[ntxv1.SetTransactionTagsResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction to verify ownership
+	tx, err := s.queries.GetTransaction(ctx, req.Msg.TransactionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("transaction not found"))
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     tx.PortfolioID,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, errors.New("not authorized"))
+	}
+
+	var tags []*ntxv1.Tag
+	for _, id := range req.Msg.TagIds {
+		tag, err := s.queries.GetTag(ctx, sqlc.GetTagParams{ID: id, UserID: userID})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("tag %d not found", id))
+		}
+		tags = append(tags, tagToProto(tag))
+	}
+
+	if err := s.queries.ClearTransactionTags(ctx, tx.ID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, t := range tags {
+		err := s.queries.AddTransactionTag(ctx, sqlc.AddTransactionTagParams{
+			TransactionID: tx.ID,
+			TagID:         t.Id,
+		})
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+	}
+
+	slices.SortFunc(tags, func(a, b *ntxv1.Tag) int { return strings.Compare(a.Name, b.Name) })
+	tags = slices.CompactFunc(tags, func(a, b *ntxv1.Tag) bool { return a.Id == b.Id })
+
+	return connect.NewResponse(&ntxv1.SetTransactionTagsResponse{Tags: tags}), nil
+}
+
+// GetTagPerformance reports realized and unrealized P&L per tag. Trades
+// without a tag are grouped under an entry with no tag set.
+func (s *PortfolioService) GetTagPerformance(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetTagPerformanceRequest],
+) (*connect.Response[ntxv1.GetTagPerformanceResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	var from, to time.Time
+	if req.Msg.FromDate != "" {
+		from, err = time.Parse("2006-01-02", req.Msg.FromDate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid from_date: %w", err))
+		}
+	}
+	if req.Msg.ToDate != "" {
+		to, err = time.Parse("2006-01-02", req.Msg.ToDate)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid to_date: %w", err))
+		}
+	}
+
+	transactions, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	tagsByTx, err := s.transactionTags(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	v, err := s.valueHoldings(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	prices := make(map[string]float64, len(v.holdings))
+	for _, h := range v.holdings {
+		prices[h.StockSymbol] = h.CurrentPrice
+	}
+
+	report := tagReport{tagsByTx: tagsByTx, only: req.Msg.TagId, stats: make(map[int64]*tagStats)}
+	open, disposals := matchLots(transactions)
+	for _, d := range disposals {
+		if !from.IsZero() && d.Sold.Before(from) {
+			continue
+		}
+		if !to.IsZero() && d.Sold.After(to) {
+			continue
+		}
+		for _, st := range report.bucketsFor(d.BuyID, d.SellID) {
+			st.tax.add(d)
+		}
+	}
+	for _, l := range open {
+		for _, st := range report.bucketsFor(l.BuyID) {
+			st.out.OpenCost += float64(l.Quantity) * l.UnitPrice
+			st.out.OpenValue += float64(l.Quantity) * prices[l.Symbol]
+		}
+	}
+
+	result := make([]*ntxv1.TagPerformance, 0, len(report.stats))
+	for _, st := range report.stats {
+		p := st.out
+		p.ShortTermGain = st.tax.ShortTermGain
+		p.LongTermGain = st.tax.LongTermGain
+		p.RealizedGain = st.tax.ShortTermGain + st.tax.LongTermGain
+		p.EstimatedTax = st.tax.EstimatedTax
+		p.TradeCount = convert.SafeInt32(int64(len(st.trades)))
+		p.UnrealizedPnl = p.OpenValue - p.OpenCost
+		p.TotalPnl = p.RealizedGain + p.UnrealizedPnl
+		result = append(result, p)
+	}
+	// Tags by name, with untagged trades last
+	slices.SortFunc(result, func(a, b *ntxv1.TagPerformance) int {
+		if (a.Tag == nil) != (b.Tag == nil) {
+			if a.Tag == nil {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(a.GetTag().GetName(), b.GetTag().GetName())
+	})
+
+	return connect.NewResponse(&ntxv1.GetTagPerformanceResponse{Tags: result}), nil
+}
+
+// tagStats accumulates one entry of the tag performance report.
+type tagStats struct {
+	out    *ntxv1.TagPerformance
+	tax    taxTotals
+	trades map[int64]bool // transactions counted toward the entry
+}
+
+// tagReport groups lots and disposals by the tags on their transactions.
+type tagReport struct {
+	tagsByTx map[int64][]*ntxv1.Tag
+	only     *int64 // report just this tag
+	stats    map[int64]*tagStats
+}
+
+// bucketsFor returns the entries a lot or disposal made up of the given
+// transactions counts toward. Key 0 holds trades with no tags at all.
+func (r *tagReport) bucketsFor(txIDs ...int64) []*tagStats {
+	var result []*tagStats
+	tagged := false
+	for _, txID := range txIDs {
+		for _, t := range r.tagsByTx[txID] {
+			tagged = true
+			if r.only != nil && *r.only != t.Id {
+				continue
+			}
+			st := r.entry(t.Id, t)
+			if !slices.Contains(result, st) {
+				result = append(result, st)
+			}
+		}
+	}
+	if !tagged && r.only == nil {
+		result = append(result, r.entry(0, nil))
+	}
+
+	for _, st := range result {
+		for _, txID := range txIDs {
+			st.trades[txID] = true
+		}
+	}
+	return result
+}
+
+func (r *tagReport) entry(id int64, tag *ntxv1.Tag) *tagStats {
+	st, ok := r.stats[id]
+	if !ok {
+		st = &tagStats{out: &ntxv1.TagPerformance{Tag: tag}, trades: make(map[int64]bool)}
+		r.stats[id] = st
+	}
+	return st
+}
+
+// transactionTags returns the tags on each tagged transaction of a portfolio.
+func (s *PortfolioService) transactionTags(ctx context.Context, portfolioID int64) (map[int64][]*ntxv1.Tag, error) {
+	rows, err := s.queries.ListTransactionTagsByPortfolio(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64][]*ntxv1.Tag)
+	for _, r := range rows {
+		result[r.TransactionID] = append(result[r.TransactionID], &ntxv1.Tag{Id: r.TagID, Name: r.Name})
+	}
+	return result, nil
+}
+
+// checkTagName validates and trims a tag name, rejecting one already used by
+// another of the user's tags. Names are compared case-insensitively.
+func (s *PortfolioService) checkTagName(ctx context.Context, userID, tagID int64, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", connect.NewError(connect.CodeInvalidArgument, errors.New("name is required"))
+	}
+	if len(name) > maxTagLength {
+		err := fmt.Errorf("name is longer than %d characters", maxTagLength)
+		return "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	tags, err := s.queries.ListTagsByUser(ctx, userID)
+	if err != nil {
+		return "", connect.NewError(connect.CodeInternal, err)
+	}
+	for _, t := range tags {
+		if t.ID != tagID && strings.EqualFold(t.Name, name) {
+			return "", connect.NewError(connect.CodeAlreadyExists, errors.New("tag already exists"))
+		}
+	}
+	return name, nil
+}
+
+func tagToProto(t sqlc.Tag) *ntxv1.Tag {
+	return &ntxv1.Tag{Id: t.ID, Name: t.Name}
+}
@@ -0,0 +1,211 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// Capital gains tax rates on listed shares for individuals.
+const (
+	shortTermCGTRate = 0.075
+	longTermCGTRate  = 0.05
+)
+
+// shrawanFirst is the July day of 1 Shrawan, the first day of the Nepali
+// fiscal year, by Gregorian year. Add each year as the calendar is published.
+var shrawanFirst = map[int]int{
+	2016: 16, // 2073 BS
+	2017: 16,
+	2018: 17,
+	2019: 17,
+	2020: 16,
+	2021: 16,
+	2022: 17,
+	2023: 17,
+	2024: 16,
+	2025: 17, // 2082 BS
+}
+
+// fiscalYearBegins returns 1 Shrawan of the given Gregorian year. Years
+// missing from shrawanFirst fall back to 16 July.
+func fiscalYearBegins(year int) time.Time {
+	day, ok := shrawanFirst[year]
+	if !ok {
+		day = 16
+	}
+	return time.Date(year, time.July, day, 0, 0, 0, 0, time.UTC)
+}
+
+// fiscalYearStart returns the start of the Nepali fiscal year containing t.
+func fiscalYearStart(t time.Time) time.Time {
+	start := fiscalYearBegins(t.Year())
+	if t.Before(start) {
+		return fiscalYearBegins(t.Year() - 1)
+	}
+	return start
+}
+
+// fiscalYearEnd returns the last day of the fiscal year that begins on start.
+func fiscalYearEnd(start time.Time) time.Time {
+	return fiscalYearBegins(start.Year()+1).AddDate(0, 0, -1)
+}
+
+// taxTotals accumulates realized gains by holding period and the CGT owed on them.
+type taxTotals struct {
+	ShortTermGain float64
+	LongTermGain  float64
+	EstimatedTax  float64
+}
+
+// add books a disposal. Brokers withhold CGT on each profitable sale without
+// netting losses, so losses reduce the reported gain but not the tax.
+func (t *taxTotals) add(d disposal) {
+	if d.LongTerm() {
+		t.LongTermGain += d.Gain()
+	} else {
+		t.ShortTermGain += d.Gain()
+	}
+	t.EstimatedTax += d.Tax()
+}
+
+// Tax is the CGT a broker withholds on the disposal, nothing on a loss.
+func (d disposal) Tax() float64 {
+	gain := d.Gain()
+	if gain <= 0 {
+		return 0
+	}
+	if d.LongTerm() {
+		return gain * longTermCGTRate
+	}
+	return gain * shortTermCGTRate
+}
+
+// addProceeds books money received for renounced rights. The entitlement has
+// no cost basis and is never held long, so it is all short-term gain.
+func (t *taxTotals) addProceeds(amount float64) {
+	t.ShortTermGain += amount
+	t.EstimatedTax += amount * shortTermCGTRate
+}
+
+// summary converts the totals for the API. A zero yearStart leaves the
+// fiscal year out, for totals across years.
+func (t *taxTotals) summary(yearStart time.Time) *ntxv1.TaxSummary {
+	s := &ntxv1.TaxSummary{
+		ShortTermGain: t.ShortTermGain,
+		LongTermGain:  t.LongTermGain,
+		EstimatedTax:  t.EstimatedTax,
+	}
+	if !yearStart.IsZero() {
+		s.FiscalYearStart = yearStart.Format("2006-01-02")
+		s.FiscalYearEnd = fiscalYearEnd(yearStart).Format("2006-01-02")
+	}
+	return s
+}
+
+// GetTaxReport lists a portfolio's realized gains sale by sale with their
+// holding period and CGT, totalled per fiscal year and overall for filing.
+func (s *PortfolioService) GetTaxReport(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetTaxReportRequest],
+) (*connect.Response[ntxv1.GetTaxReportResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	var only time.Time
+	if req.Msg.FiscalYear != "" {
+		day, err := time.Parse("2006-01-02", req.Msg.FiscalYear)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("fiscal_year must be YYYY-MM-DD"))
+		}
+		only = fiscalYearStart(day)
+	}
+	skip := func(year time.Time) bool { return !only.IsZero() && !year.Equal(only) }
+
+	transactions, err := s.queries.ListTransactionsChronological(ctx, req.Msg.PortfolioId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &ntxv1.GetTaxReportResponse{}
+	years := make(map[time.Time]*taxTotals)
+	var total taxTotals
+	_, disposals := matchLots(transactions)
+	for _, d := range disposals {
+		year := fiscalYearStart(d.Sold)
+		if skip(year) {
+			continue
+		}
+		if years[year] == nil {
+			years[year] = &taxTotals{}
+		}
+		years[year].add(d)
+		total.add(d)
+		resp.Gains = append(resp.Gains, &ntxv1.RealizedGain{
+			StockSymbol:     d.Symbol,
+			Quantity:        d.Quantity,
+			AcquiredDate:    d.Acquired.Format("2006-01-02"),
+			SoldDate:        d.Sold.Format("2006-01-02"),
+			UnitCost:        d.UnitPrice,
+			SalePrice:       d.SalePrice,
+			Gain:            d.Gain(),
+			HoldingDays:     daysBetween(d.Acquired, d.Sold),
+			LongTerm:        d.LongTerm(),
+			EstimatedTax:    d.Tax(),
+			FiscalYearStart: year.Format("2006-01-02"),
+		})
+	}
+
+	since := ""
+	if !only.IsZero() {
+		since = only.Format("2006-01-02")
+	}
+	proceeds, err := s.queries.ListRenunciationProceeds(ctx, sqlc.ListRenunciationProceedsParams{
+		PortfolioID:        req.Msg.PortfolioId,
+		ProceedsReceivedOn: sql.NullString{String: since, Valid: true},
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	for _, r := range proceeds {
+		received, err := time.Parse("2006-01-02", r.ProceedsReceivedOn.String)
+		if err != nil {
+			continue
+		}
+		year := fiscalYearStart(received)
+		if skip(year) {
+			continue
+		}
+		if years[year] == nil {
+			years[year] = &taxTotals{}
+		}
+		years[year].addProceeds(r.Proceeds)
+		total.addProceeds(r.Proceeds)
+	}
+
+	for _, year := range slices.SortedFunc(maps.Keys(years), time.Time.Compare) {
+		resp.Years = append(resp.Years, years[year].summary(year))
+	}
+	resp.Total = total.summary(time.Time{})
+
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,29 @@
+package portfolio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalYearStart(t *testing.T) {
+	tests := []struct {
+		day, start, end string
+	}{
+		{"2023-07-16", "2022-07-17", "2023-07-16"},
+		{"2023-07-17", "2023-07-17", "2024-07-15"},
+		{"2024-07-15", "2023-07-17", "2024-07-15"},
+		{"2024-07-16", "2024-07-16", "2025-07-16"},
+		{"2025-01-01", "2024-07-16", "2025-07-16"},
+		{"2025-07-17", "2025-07-17", "2026-07-15"}, // next year not in the table yet
+	}
+	for _, tt := range tests {
+		day, _ := time.Parse("2006-01-02", tt.day)
+		start := fiscalYearStart(day)
+		if got := start.Format("2006-01-02"); got != tt.start {
+			t.Errorf("fiscalYearStart(%s) = %s, want %s", tt.day, got, tt.start)
+		}
+		if got := fiscalYearEnd(start).Format("2006-01-02"); got != tt.end {
+			t.Errorf("fiscalYearEnd(%s) = %s, want %s", start.Format("2006-01-02"), got, tt.end)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package portfolio
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// GetTimeline merges the portfolio's transactions, dividends, corporate
+// actions, triggered alerts and notes into one feed, a month at a time.
+func (s *PortfolioService) GetTimeline(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetTimelineRequest],
+) (*connect.Response[ntxv1.GetTimelineResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	month := req.Msg.Month
+	if month != "" {
+		if _, err := time.Parse("2006-01", month); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("month must be YYYY-MM"))
+		}
+	}
+
+	symbol := strings.ToUpper(strings.TrimSpace(req.Msg.GetStockSymbol()))
+	events, err := s.timelineEvents(ctx, userID, req.Msg.PortfolioId, symbol)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var months []string
+	for _, e := range events {
+		months = append(months, e.Date[:len("2006-01")])
+	}
+	slices.Sort(months)
+	months = slices.Compact(months)
+	if month == "" && len(months) > 0 {
+		month = months[len(months)-1]
+	}
+
+	resp := &ntxv1.GetTimelineResponse{Month: month}
+	for _, e := range events {
+		if strings.HasPrefix(e.Date, month) {
+			resp.Events = append(resp.Events, e)
+		}
+	}
+	for _, m := range months {
+		if m < month {
+			resp.PreviousMonth = m
+		}
+		if m > month && resp.NextMonth == "" {
+			resp.NextMonth = m
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// timelineEvents gathers every event for the portfolio, or for one symbol in
+// it, oldest first. Corporate actions only count while shares were held, and
+// an alert shows only its latest trigger since earlier ones aren't kept.
+func (s *PortfolioService) timelineEvents(
+	ctx context.Context,
+	userID, portfolioID int64,
+	symbol string,
+) ([]*ntxv1.TimelineEvent, error) {
+	txs, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []*ntxv1.TimelineEvent
+	symbols := make(map[string]bool)
+	txSymbols := make(map[int64]string)
+	for _, tx := range txs {
+		if symbol != "" && tx.StockSymbol != symbol {
+			continue
+		}
+		symbols[tx.StockSymbol] = true
+		txSymbols[tx.ID] = tx.StockSymbol
+
+		verb := "Bought"
+		if tx.TransactionType == "SELL" {
+			verb = "Sold"
+		}
+		events = append(events, &ntxv1.TimelineEvent{
+			Date:        tx.TransactionDate.Format("2006-01-02"),
+			Kind:        ntxv1.TimelineEventKind_TIMELINE_EVENT_KIND_TRANSACTION,
+			StockSymbol: tx.StockSymbol,
+			Title:       fmt.Sprintf("%s %d %s at Rs.%.2f", verb, tx.Quantity, tx.StockSymbol, tx.UnitPrice),
+			RefId:       tx.ID,
+		})
+	}
+
+	for _, sym := range slices.Sorted(maps.Keys(symbols)) {
+		actions, err := s.queries.GetCorporateActionsBySymbol(ctx, sym)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range actions {
+			events = append(events, actionEvents(txs, sym, a)...)
+		}
+	}
+
+	alerts, err := s.queries.ListAlertsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range alerts {
+		if !a.TriggeredAt.Valid {
+			continue
+		}
+		inPortfolio := a.PortfolioID.Valid && a.PortfolioID.Int64 == portfolioID
+		if !inPortfolio && !symbols[a.StockSymbol.String] {
+			continue
+		}
+		if symbol != "" && a.StockSymbol.String != symbol {
+			continue
+		}
+		events = append(events, &ntxv1.TimelineEvent{
+			Date:        a.TriggeredAt.Time.Format("2006-01-02"),
+			Kind:        ntxv1.TimelineEventKind_TIMELINE_EVENT_KIND_ALERT,
+			StockSymbol: a.StockSymbol.String,
+			Title:       cmp.Or(a.LastMessage.String, strings.ToLower(a.AlertType)+" alert triggered"),
+			RefId:       a.ID,
+		})
+	}
+
+	notes, err := s.queries.ListNotesByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range notes {
+		noteSymbol := n.StockSymbol.String
+		if n.TransactionID.Valid && txSymbols[n.TransactionID.Int64] != "" {
+			noteSymbol = txSymbols[n.TransactionID.Int64]
+		}
+		if !symbols[noteSymbol] || len(n.NoteDate) < len("2006-01-02") {
+			continue
+		}
+		events = append(events, &ntxv1.TimelineEvent{
+			Date:        n.NoteDate[:len("2006-01-02")],
+			Kind:        ntxv1.TimelineEventKind_TIMELINE_EVENT_KIND_NOTE,
+			StockSymbol: noteSymbol,
+			Title:       cmp.Or(n.Title, "Note"),
+			Detail:      n.Body,
+			RefId:       n.ID,
+		})
+	}
+
+	slices.SortStableFunc(events, func(a, b *ntxv1.TimelineEvent) int {
+		return cmp.Or(strings.Compare(a.Date, b.Date), cmp.Compare(a.Kind, b.Kind))
+	})
+	return events, nil
+}
+
+// actionEvents turns a corporate action into a dividend event and a bonus or
+// right issue event, sized to the shares held on the announcement date.
+func actionEvents(txs []sqlc.Transaction, symbol string, a sqlc.CorporateAction) []*ntxv1.TimelineEvent {
+	if len(a.SubmittedDate.String) < len("2006-01-02") {
+		return nil
+	}
+	date := a.SubmittedDate.String[:len("2006-01-02")]
+	announced, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil
+	}
+	qty := quantityOn(txs, symbol, announced)
+	if qty <= 0 {
+		return nil
+	}
+
+	var events []*ntxv1.TimelineEvent
+	if perShare := cashDividendPerShare(a); perShare > 0 {
+		events = append(events, &ntxv1.TimelineEvent{
+			Date:        date,
+			Kind:        ntxv1.TimelineEventKind_TIMELINE_EVENT_KIND_DIVIDEND,
+			StockSymbol: symbol,
+			Title:       fmt.Sprintf("%.2f%% cash dividend, FY %s", a.CashDividend.Float64, a.FiscalYear),
+			Detail:      fmt.Sprintf("About Rs.%.2f on %d shares", perShare*float64(qty), qty),
+		})
+	}
+
+	var parts []string
+	if a.BonusPercentage.Float64 > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f%% bonus", a.BonusPercentage.Float64))
+	}
+	if a.RightPercentage.Float64 > 0 {
+		parts = append(parts, fmt.Sprintf("%.2f%% right shares", a.RightPercentage.Float64))
+	}
+	if len(parts) > 0 {
+		events = append(events, &ntxv1.TimelineEvent{
+			Date:        date,
+			Kind:        ntxv1.TimelineEventKind_TIMELINE_EVENT_KIND_CORPORATE_ACTION,
+			StockSymbol: symbol,
+			Title:       fmt.Sprintf("%s, FY %s", strings.Join(parts, ", "), a.FiscalYear),
+			Detail:      fmt.Sprintf("%d shares held", qty),
+		})
+	}
+	return events
+}
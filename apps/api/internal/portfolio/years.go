@@ -0,0 +1,161 @@
+package portfolio
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// GetYearComparison sets the portfolio's fiscal years side by side, from the
+// year of its first transaction to the current one.
+func (s *PortfolioService) GetYearComparison(
+	ctx context.Context,
+	req *connect.Request[ntxv1.GetYearComparisonRequest],
+) (*connect.Response[ntxv1.GetYearComparisonResponse], error) {
+	userID, err := UserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify portfolio belongs to user
+	_, err = s.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:     req.Msg.PortfolioId,
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, errors.New("portfolio not found"))
+	}
+
+	years, err := s.fiscalYears(ctx, req.Msg.PortfolioId, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&ntxv1.GetYearComparisonResponse{Years: years}), nil
+}
+
+// fiscalYears builds one summary per fiscal year. Year-end positions are
+// valued at each symbol's latest close on or before the year's end.
+func (s *PortfolioService) fiscalYears(
+	ctx context.Context,
+	portfolioID int64,
+	now time.Time,
+) ([]*ntxv1.FiscalYearSummary, error) {
+	txs, err := s.queries.ListTransactionsChronological(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	first := fiscalYearStart(txs[0].TransactionDate)
+	var years []*ntxv1.FiscalYearSummary
+	byStart := make(map[string]*ntxv1.FiscalYearSummary)
+	tax := make(map[string]*taxTotals)
+	for start := first; !start.After(now); start = fiscalYearBegins(start.Year() + 1) {
+		end := fiscalYearEnd(start)
+		if end.After(now) {
+			end = now
+		}
+		y := &ntxv1.FiscalYearSummary{
+			StartDate: start.Format("2006-01-02"),
+			EndDate:   end.Format("2006-01-02"),
+		}
+		years = append(years, y)
+		byStart[y.StartDate] = y
+		tax[y.StartDate] = &taxTotals{}
+	}
+	yearOf := func(t time.Time) string { return fiscalYearStart(t).Format("2006-01-02") }
+
+	for _, tx := range txs {
+		y, ok := byStart[yearOf(tx.TransactionDate)]
+		if !ok {
+			continue
+		}
+		amount := float64(tx.Quantity) * tx.UnitPrice
+		if tx.TransactionType == "SELL" {
+			amount = -amount
+		}
+		y.NetContributions += amount
+	}
+
+	prices, err := s.queries.ListPortfolioClosePrices(ctx, sqlc.ListPortfolioClosePricesParams{
+		PortfolioID: portfolioID,
+		FromDate:    first.AddDate(0, 0, -priceLookbackDays).Format("2006-01-02"),
+		ToDate:      now.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := newReplay(txs, prices)
+	var realizedBefore float64
+	for _, y := range years {
+		y.Value, y.Cost = r.advance(y.EndDate)
+		y.UnrealizedGain = y.Value - y.Cost
+		y.RealizedGain = r.ledger.realized - realizedBefore
+		realizedBefore = r.ledger.realized
+	}
+
+	_, disposals := matchLots(txs)
+	for _, d := range disposals {
+		if t, ok := tax[yearOf(d.Sold)]; ok {
+			t.add(d)
+		}
+	}
+	proceeds, err := s.queries.ListRenunciationProceeds(ctx, sqlc.ListRenunciationProceedsParams{
+		PortfolioID:        portfolioID,
+		ProceedsReceivedOn: sql.NullString{String: first.Format("2006-01-02"), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range proceeds {
+		received, err := time.Parse("2006-01-02", r.ProceedsReceivedOn.String)
+		if err != nil {
+			continue
+		}
+		if t, ok := tax[yearOf(received)]; ok {
+			t.addProceeds(r.Proceeds)
+		}
+	}
+	for _, y := range years {
+		y.EstimatedTax = tax[y.StartDate].EstimatedTax
+	}
+
+	symbols := make(map[string]bool)
+	for _, tx := range txs {
+		symbols[tx.StockSymbol] = true
+	}
+	for _, symbol := range slices.Sorted(maps.Keys(symbols)) {
+		actions, err := s.queries.GetCorporateActionsBySymbol(ctx, symbol)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range actions {
+			if len(a.SubmittedDate.String) < len("2006-01-02") {
+				continue
+			}
+			announced, err := time.Parse("2006-01-02", a.SubmittedDate.String[:len("2006-01-02")])
+			if err != nil {
+				continue
+			}
+			y, ok := byStart[yearOf(announced)]
+			if !ok {
+				continue
+			}
+			qty := max(quantityOn(txs, symbol, announced), 0)
+			y.DividendIncome += cashDividendPerShare(a) * float64(qty)
+		}
+	}
+
+	return years, nil
+}
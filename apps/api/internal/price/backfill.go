@@ -0,0 +1,50 @@
+package price
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+)
+
+// GetBackfillStatus reports the latest backfill run, with an ETA for its
+// current phase extrapolated from the pace so far.
+func (s *PriceService) GetBackfillStatus(
+	ctx context.Context,
+	_ *connect.Request[ntxv1.GetBackfillStatusRequest],
+) (*connect.Response[ntxv1.GetBackfillStatusResponse], error) {
+	run, err := s.queries.GetLatestBackfillRun(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return connect.NewResponse(&ntxv1.GetBackfillStatusResponse{}), nil
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	out := &ntxv1.BackfillRun{
+		Id:             run.ID,
+		Status:         run.Status,
+		Phase:          run.Phase,
+		Symbol:         run.Symbol,
+		CompaniesDone:  run.CompaniesDone,
+		CompaniesTotal: run.CompaniesTotal,
+		RowsFetched:    run.RowsFetched,
+		StartedAt:      run.StartedAt.Format(time.RFC3339),
+		UpdatedAt:      run.UpdatedAt.Format(time.RFC3339),
+		Error:          run.Error,
+	}
+	if run.FinishedAt.Valid {
+		out.FinishedAt = run.FinishedAt.Time.Format(time.RFC3339)
+	}
+	if run.Status == "running" && run.PhaseStartedAt.Valid && run.CompaniesDone > 0 {
+		elapsed := run.UpdatedAt.Sub(run.PhaseStartedAt.Time)
+		remaining := elapsed / time.Duration(run.CompaniesDone) * time.Duration(run.CompaniesTotal-run.CompaniesDone)
+		out.EtaSeconds = int64(remaining.Seconds())
+	}
+
+	return connect.NewResponse(&ntxv1.GetBackfillStatusResponse{Run: out}), nil
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"connectrpc.com/connect"
 
 	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/worker"
 )
 
 func (s *PriceService) GetPrice(
@@ -82,3 +84,31 @@ func (s *PriceService) ListLatestPrices(
 		Prices: pricesToProto(prices),
 	}), nil
 }
+
+// SyncPrices pulls live prices from NEPSE on demand. Unlike the other price
+// RPCs it writes to the database, so the auth interceptor protects it.
+func (s *PriceService) SyncPrices(
+	ctx context.Context,
+	req *connect.Request[ntxv1.SyncPricesRequest],
+) (*connect.Response[ntxv1.SyncPricesResponse], error) {
+	businessDate := worker.BusinessDate(time.Now())
+	results, err := s.worker.SyncPrices(ctx, businessDate, req.Msg.Symbols)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	out := make([]*ntxv1.SymbolSyncResult, len(results))
+	for i, r := range results {
+		out[i] = &ntxv1.SymbolSyncResult{
+			Symbol:    r.Symbol,
+			Updated:   r.Updated,
+			Unchanged: r.Unchanged,
+			Error:     r.Err,
+		}
+	}
+
+	return connect.NewResponse(&ntxv1.SyncPricesResponse{
+		BusinessDate: businessDate,
+		Results:      out,
+	}), nil
+}
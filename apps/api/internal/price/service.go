@@ -6,16 +6,19 @@ import (
 
 	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
 	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/convert"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/worker"
 )
 
 type PriceService struct {
 	ntxv1connect.UnimplementedPriceServiceHandler
 	queries *sqlc.Queries
+	worker  *worker.Worker
 }
 
-func NewPriceService(queries *sqlc.Queries) *PriceService {
-	return &PriceService{queries: queries}
+func NewPriceService(queries *sqlc.Queries, w *worker.Worker) *PriceService {
+	return &PriceService{queries: queries, worker: w}
 }
 
 func priceToProto(p sqlc.Price) *ntxv1.Price {
@@ -63,14 +66,6 @@ func nullInt32(ni sql.NullInt64) *int32 {
 	if !ni.Valid {
 		return nil
 	}
-	v := safeInt32(ni.Int64)
+	v := convert.SafeInt32(ni.Int64)
 	return &v
 }
-
-func safeInt32(v int64) int32 {
-	const maxInt32 = 1<<31 - 1
-	if v > maxInt32 {
-		return maxInt32
-	}
-	return int32(v) //nolint:gosec // bounds checked above
-}
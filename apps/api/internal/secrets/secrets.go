@@ -0,0 +1,96 @@
+// Package secrets keeps credentials in the OS keyring instead of plain
+// environment variables. A secret is named after the variable it replaces,
+// such as SMTP_PASSWORD, and that variable is still read when the keyring
+// has no entry or there is no keyring, as on most servers.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// service groups ntx's entries in the keyring.
+const service = "ntx"
+
+// keyringTimeout bounds a keyring call, which can hang waiting for an
+// unlock prompt when no desktop session is running.
+const keyringTimeout = 5 * time.Second
+
+var ErrNotFound = errors.New("secret not found")
+
+// Lookup returns the secret called name from the keyring, or the
+// environment variable of the same name when the keyring can't supply it.
+func Lookup(name string) string {
+	if v, err := Get(name); err == nil {
+		return v
+	}
+	return os.Getenv(name)
+}
+
+// Get reads name from the keyring, returning ErrNotFound when it isn't
+// stored there.
+func Get(name string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), keyringTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "security", "find-generic-password", "-s", service, "-a", name, "-w")
+	case "windows":
+		return "", errors.ErrUnsupported
+	default:
+		cmd = exec.CommandContext(ctx, "secret-tool", "lookup", "service", service, "account", name)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	// secret-tool exits 1 silently for a missing entry; security says so
+	var exitErr *exec.ExitError
+	missing := stderr.Len() == 0 || strings.Contains(stderr.String(), "could not be found")
+	if errors.As(err, &exitErr) && missing {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", keyringError(err, stderr.Bytes())
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// Set stores value under name, replacing any earlier value. On macOS the
+// value is passed to security(1) as an argument, since it can't read one
+// from stdin, so it is briefly visible to other local users.
+func Set(name, value string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), keyringTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.CommandContext(ctx, "security", "add-generic-password", "-U", "-s", service, "-a", name, "-w", value)
+	case "windows":
+		return errors.ErrUnsupported
+	default:
+		cmd = exec.CommandContext(ctx, "secret-tool", "store", "--label", service+" "+name, "service", service, "account", name)
+		cmd.Stdin = strings.NewReader(value)
+	}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return keyringError(err, out)
+	}
+	return nil
+}
+
+// keyringError adds what the keyring tool printed, if anything, to err.
+func keyringError(err error, output []byte) error {
+	if msg := strings.TrimSpace(string(output)); msg != "" {
+		return fmt.Errorf("keyring: %w: %s", err, msg)
+	}
+	return fmt.Errorf("keyring: %w", err)
+}
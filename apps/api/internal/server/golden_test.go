@@ -0,0 +1,113 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/server/servertest"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// TestImportGolden imports testdata/import.csv and compares holdings and the
+// tax report against golden files, so a change to the importer or the cost
+// basis engine shows up as a diff. Run with -update to accept new output.
+func TestImportGolden(t *testing.T) {
+	s := servertest.New(t)
+	fixtureCompanies(s)
+	s.Price(1, "2024-09-01", 1080, 1072)
+	s.Price(2, "2024-09-01", 690, 701.5)
+	s.Price(3, "2024-09-01", 362, 355)
+
+	ctx := context.Background()
+	c, id, imported := importFixture(t, s)
+	checkGolden(t, "import", imported)
+
+	holdings, err := c.Portfolio.ListHoldings(ctx, connect.NewRequest(&ntxv1.ListHoldingsRequest{PortfolioId: id}))
+	if err != nil {
+		t.Fatalf("list holdings: %v", err)
+	}
+	checkGolden(t, "holdings", holdings.Msg)
+
+	tax, err := c.Portfolio.GetTaxReport(ctx, connect.NewRequest(&ntxv1.GetTaxReportRequest{PortfolioId: id}))
+	if err != nil {
+		t.Fatalf("tax report: %v", err)
+	}
+	checkGolden(t, "tax", tax.Msg)
+}
+
+// fixtureCompanies lists the companies traded in testdata/import.csv.
+func fixtureCompanies(s *servertest.Server) {
+	s.Company(1, "NABIL", "Commercial Banks")
+	s.Company(2, "NICA", "Commercial Banks")
+	s.Company(3, "UPPER", "Hydro Power")
+}
+
+// importFixture signs in a new user and imports testdata/import.csv into a
+// fresh portfolio.
+func importFixture(t *testing.T, s *servertest.Server) (*servertest.Client, int64, *ntxv1.ImportTransactionsResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	c := s.Login("golden@example.com", "correct-horse")
+	created, err := c.Portfolio.CreatePortfolio(ctx, connect.NewRequest(&ntxv1.CreatePortfolioRequest{Name: "Golden"}))
+	if err != nil {
+		t.Fatalf("create portfolio: %v", err)
+	}
+	id := created.Msg.Portfolio.Id
+
+	data, err := os.ReadFile(filepath.Join("testdata", "import.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := c.Portfolio.ImportTransactions(ctx, connect.NewRequest(&ntxv1.ImportTransactionsRequest{
+		PortfolioId: id,
+		CsvData:     data,
+	}))
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	return c, id, imported.Msg
+}
+
+// checkGolden compares msg as indented JSON with testdata/<name>.golden.json.
+func checkGolden(t *testing.T, name string, msg proto.Message) {
+	t.Helper()
+
+	// protojson varies its whitespace between runs, so reindent it.
+	raw, err := protojson.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got bytes.Buffer
+	if err := json.Indent(&got, raw, "", "  "); err != nil {
+		t.Fatal(err)
+	}
+	got.WriteByte('\n')
+
+	path := filepath.Join("testdata", name+".golden.json")
+	if *update {
+		if err := os.WriteFile(path, got.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if !bytes.Equal(got.Bytes(), want) {
+		t.Errorf("%s differs from %s (run with -update to accept):\n%s", name, path, got.String())
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// metricsHandler serves the market sync SLO and storage figures in the
+// Prometheus text format.
+func metricsHandler(queries *sqlc.Queries) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report, err := worker.SyncSLO(r.Context(), queries, time.Now())
+		if err != nil {
+			slog.ErrorContext(r.Context(), "sync slo failed", slog.Any("err", err))
+			http.Error(w, "metrics unavailable", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		gauge := func(name, help string, value float64) {
+			fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n%s %s\n",
+				name, help, name, name, strconv.FormatFloat(value, 'f', -1, 64))
+		}
+
+		fmt.Fprintf(w, "# HELP ntx_sync_runs Daily sync cycles in the last 7 days by result.\n")
+		fmt.Fprintf(w, "# TYPE ntx_sync_runs gauge\n")
+		fmt.Fprintf(w, "ntx_sync_runs{result=\"success\"} %d\n", report.Runs-report.Failures)
+		fmt.Fprintf(w, "ntx_sync_runs{result=\"failure\"} %d\n", report.Failures)
+		gauge("ntx_sync_slo_target", "Share of sync cycles expected to succeed.", report.Target)
+		gauge("ntx_sync_success_ratio", "Share of sync cycles that succeeded in the last 7 days.",
+			report.SuccessRatio())
+		gauge("ntx_sync_error_budget_remaining", "Unused share of the sync error budget; negative once overspent.",
+			report.BudgetRemaining())
+		if !report.LastGood.IsZero() {
+			gauge("ntx_sync_last_success_timestamp_seconds", "Unix time the last successful sync finished.",
+				float64(report.LastGood.Unix()))
+		}
+		if report.LastRun != nil {
+			gauge("ntx_sync_last_prices_skipped", "Symbols whose price the last sync could not store.",
+				float64(report.LastRun.PricesSkipped))
+		}
+		if size, err := queries.DatabaseSize(r.Context()); err == nil {
+			gauge("ntx_db_size_bytes", "Size of the SQLite database file.", float64(size))
+		}
+		gauge("ntx_price_retention_days", "Days of daily prices kept; 0 keeps them forever.",
+			float64(worker.PriceRetentionDays()))
+	}
+}
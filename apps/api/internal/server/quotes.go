@@ -0,0 +1,82 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// Public quotes are limited hard since anyone can call them.
+const (
+	quoteRequestsPerMinute = 20
+	quoteBurst             = 5
+	maxQuoteSymbols        = 20
+)
+
+// quote is one symbol's latest market data. It carries nothing about any
+// user, so the endpoint is safe to share.
+type quote struct {
+	Symbol        string  `json:"symbol"`
+	BusinessDate  string  `json:"business_date"`
+	LastPrice     float64 `json:"last_price"`
+	PreviousClose float64 `json:"previous_close"`
+	Change        float64 `json:"change"`
+	ChangePercent float64 `json:"change_percent"`
+	Volume        int64   `json:"volume"`
+}
+
+// quotesHandler serves the latest quotes for ?symbols=NABIL,HDL. Symbols
+// without prices are left out.
+func quotesHandler(queries *sqlc.Queries) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var symbols []string
+		for s := range strings.SplitSeq(r.URL.Query().Get("symbols"), ",") {
+			if s = strings.ToUpper(strings.TrimSpace(s)); s != "" {
+				symbols = append(symbols, s)
+			}
+		}
+		if len(symbols) == 0 {
+			http.Error(w, "symbols required", http.StatusBadRequest)
+			return
+		}
+		if len(symbols) > maxQuoteSymbols {
+			http.Error(w, "too many symbols", http.StatusBadRequest)
+			return
+		}
+
+		quotes := []quote{}
+		for _, symbol := range symbols {
+			p, err := queries.GetLatestPriceBySymbol(r.Context(), symbol)
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			if err != nil {
+				slog.ErrorContext(r.Context(), "quote lookup failed", slog.String("symbol", symbol), slog.Any("err", err))
+				http.Error(w, "quotes unavailable", http.StatusInternalServerError)
+				return
+			}
+			last := p.LastTradedPrice.Float64
+			if !p.LastTradedPrice.Valid {
+				last = p.ClosePrice.Float64
+			}
+			quotes = append(quotes, quote{
+				Symbol:        symbol,
+				BusinessDate:  p.BusinessDate,
+				LastPrice:     last,
+				PreviousClose: p.PreviousClose.Float64,
+				Change:        p.ChangeAmount.Float64,
+				ChangePercent: p.ChangePercent.Float64,
+				Volume:        p.Volume.Int64,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=60")
+		_ = json.NewEncoder(w).Encode(map[string]any{"quotes": quotes})
+	}
+}
@@ -1,53 +1,119 @@
 package server
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
 	"net/http"
+	"runtime/debug"
 
 	"connectrpc.com/connect"
 	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/alert"
+	"github.com/voidarchive/ntx/internal/application"
 	"github.com/voidarchive/ntx/internal/auth"
 	"github.com/voidarchive/ntx/internal/company"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/flags"
+	"github.com/voidarchive/ntx/internal/journal"
+	"github.com/voidarchive/ntx/internal/order"
 	"github.com/voidarchive/ntx/internal/portfolio"
 	"github.com/voidarchive/ntx/internal/price"
+	"github.com/voidarchive/ntx/internal/webapp"
+	"github.com/voidarchive/ntx/internal/webui"
+	"github.com/voidarchive/ntx/internal/worker"
 )
 
-func registerRoutes(mux *http.ServeMux, queries *sqlc.Queries) {
+func registerRoutes(mux *http.ServeMux, db *sql.DB, queries *sqlc.Queries, w *worker.Worker, portfolioService *portfolio.PortfolioService) {
 	// Create auth service (needed for both login and middleware)
-	authService := auth.NewAuthService(queries)
+	authService := auth.NewAuthService(db, queries)
 	authInterceptor := auth.NewAuthInterceptor(authService)
 
-	// Create interceptors slice
-	interceptors := connect.WithInterceptors(authInterceptor)
+	// A panicking handler answers with an Internal error and logs its stack,
+	// rather than dropping the connection.
+	recoverPanics := connect.WithRecover(func(ctx context.Context, spec connect.Spec, _ http.Header, r any) error {
+		slog.ErrorContext(ctx, "handler panic",
+			"procedure", spec.Procedure,
+			"panic", r,
+			"stack", string(debug.Stack()),
+		)
+		return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+	})
+	opts := connect.WithHandlerOptions(connect.WithInterceptors(authInterceptor), recoverPanics)
 
 	// Public services (no auth required, but interceptor skips these)
 	companyPath, companyHandler := ntxv1connect.NewCompanyServiceHandler(
 		company.NewCompanyService(queries),
-		interceptors,
+		opts,
 	)
 	mux.Handle(companyPath, companyHandler)
 
 	pricePath, priceHandler := ntxv1connect.NewPriceServiceHandler(
-		price.NewPriceService(queries),
-		interceptors,
+		price.NewPriceService(queries, w),
+		opts,
 	)
 	mux.Handle(pricePath, priceHandler)
 
 	// Auth service (login is public)
 	authPath, authHandler := ntxv1connect.NewAuthServiceHandler(
 		authService,
-		interceptors,
+		opts,
 	)
 	mux.Handle(authPath, authHandler)
 
 	// Protected services
 	portfolioPath, portfolioHandler := ntxv1connect.NewPortfolioServiceHandler(
-		portfolio.NewPortfolioService(queries),
-		interceptors,
+		portfolioService,
+		opts,
 	)
 	mux.Handle(portfolioPath, portfolioHandler)
 
+	alertPath, alertHandler := ntxv1connect.NewAlertServiceHandler(
+		alert.NewAlertService(queries),
+		opts,
+	)
+	mux.Handle(alertPath, alertHandler)
+
+	orderPath, orderHandler := ntxv1connect.NewOrderServiceHandler(
+		order.NewOrderService(queries, portfolioService),
+		opts,
+	)
+	mux.Handle(orderPath, orderHandler)
+
+	applicationPath, applicationHandler := ntxv1connect.NewApplicationServiceHandler(
+		application.NewApplicationService(queries, portfolioService),
+		opts,
+	)
+	mux.Handle(applicationPath, applicationHandler)
+
+	journalPath, journalHandler := ntxv1connect.NewJournalServiceHandler(
+		journal.NewJournalService(queries),
+		opts,
+	)
+	mux.Handle(journalPath, journalHandler)
+
+	mux.HandleFunc("GET /api/widget", widgetHandler(queries, authService, portfolioService))
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
+	if flags.WebUI.Enabled() {
+		webui.New(queries, authService, portfolioService).Register(mux)
+	}
+	if flags.PublicQuotes.Enabled() {
+		limiter := newRateLimiter(quoteRequestsPerMinute, quoteBurst)
+		mux.HandleFunc("GET /api/quotes", limiter.limit(quotesHandler(queries)))
+	}
+	if flags.Metrics.Enabled() {
+		mux.HandleFunc("/metrics", metricsHandler(queries))
+	}
+	if flags.WebClient.Enabled() {
+		// Every path the API doesn't claim falls through to the client
+		handler, ok := webapp.Handler()
+		if !ok {
+			slog.Warn("web-client is on but this binary was built without an embedded client")
+			return
+		}
+		mux.Handle("/", handler)
+	}
 }
@@ -3,6 +3,9 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
 	"log/slog"
 	"net/http"
 	"os"
@@ -15,16 +18,16 @@ import (
 	"github.com/rs/cors"
 
 	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/logging"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/worker"
 )
 
 type Server struct {
 	*http.Server
 }
 
-func NewServer(queries *sqlc.Queries) *Server {
-	mux := http.NewServeMux()
-	registerRoutes(mux, queries)
-
+func NewServer(db *sql.DB, queries *sqlc.Queries, w *worker.Worker, portfolios *portfolio.PortfolioService) *Server {
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
@@ -33,7 +36,7 @@ func NewServer(queries *sqlc.Queries) *Server {
 	return &Server{
 		Server: &http.Server{
 			Addr:         ":" + port,
-			Handler:      withCORS(loggingMiddleware(mux)),
+			Handler:      withCORS(loggingMiddleware(NewHandler(db, queries, w, portfolios))),
 			ReadTimeout:  15 * time.Second,
 			WriteTimeout: 15 * time.Second,
 			IdleTimeout:  60 * time.Second,
@@ -41,6 +44,15 @@ func NewServer(queries *sqlc.Queries) *Server {
 	}
 }
 
+// NewHandler returns the Connect routes without CORS or request logging, for
+// embedding the API in another server or a test. portfolios is shared with
+// anything else in the process that changes holdings, such as the scheduler.
+func NewHandler(db *sql.DB, queries *sqlc.Queries, w *worker.Worker, portfolios *portfolio.PortfolioService) http.Handler {
+	mux := http.NewServeMux()
+	registerRoutes(mux, db, queries, w, portfolios)
+	return mux
+}
+
 func (s *Server) Start() error {
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
@@ -51,18 +63,50 @@ func (s *Server) Start() error {
 	return s.ListenAndServe()
 }
 
+// loggingMiddleware tags each request with an ID, reusing the caller's
+// X-Request-ID when given, so handler logs and the access log line correlate.
 func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		next.ServeHTTP(w, r)
-		slog.Info("request",
+		id := r.Header.Get("X-Request-ID")
+		if id == "" {
+			id = newRequestID()
+		}
+		w.Header().Set("X-Request-ID", id)
+		ctx := logging.WithRequestID(r.Context(), id)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+		slog.InfoContext(ctx, "request",
 			"method", r.Method,
 			"path", r.URL.Path,
+			"status", rec.status,
 			"duration", time.Since(start),
 		)
 	})
 }
 
+// statusRecorder captures the response status for the access log.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 func (s *Server) gracefulShutdown(done <-chan os.Signal) {
 	<-done
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -0,0 +1,98 @@
+package server_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/server/servertest"
+)
+
+func TestListPortfoliosIsScopedToUser(t *testing.T) {
+	s := servertest.New(t)
+	ctx := context.Background()
+
+	alice := s.Login("alice@example.com", "correct-horse")
+	bob := s.Login("bob@example.com", "battery-staple")
+
+	created, err := alice.Portfolio.CreatePortfolio(ctx, connect.NewRequest(&ntxv1.CreatePortfolioRequest{Name: "Long term"}))
+	if err != nil {
+		t.Fatalf("create portfolio: %v", err)
+	}
+
+	resp, err := alice.Portfolio.ListPortfolios(ctx, connect.NewRequest(&ntxv1.ListPortfoliosRequest{}))
+	if err != nil {
+		t.Fatalf("list portfolios: %v", err)
+	}
+	if len(resp.Msg.Portfolios) != 1 {
+		t.Fatalf("got %d portfolios, want 1", len(resp.Msg.Portfolios))
+	}
+	if got := resp.Msg.Portfolios[0]; got.Id != created.Msg.Portfolio.Id || got.Name != "Long term" {
+		t.Errorf("got portfolio %d %q, want %d %q", got.Id, got.Name, created.Msg.Portfolio.Id, "Long term")
+	}
+
+	resp, err = bob.Portfolio.ListPortfolios(ctx, connect.NewRequest(&ntxv1.ListPortfoliosRequest{}))
+	if err != nil {
+		t.Fatalf("list portfolios as another user: %v", err)
+	}
+	if len(resp.Msg.Portfolios) != 0 {
+		t.Errorf("another user sees %d portfolios, want 0", len(resp.Msg.Portfolios))
+	}
+}
+
+func TestRPCsRequireLogin(t *testing.T) {
+	s := servertest.New(t)
+
+	client := ntxv1connect.NewPortfolioServiceClient(http.DefaultClient, s.URL)
+	_, err := client.ListPortfolios(context.Background(), connect.NewRequest(&ntxv1.ListPortfoliosRequest{}))
+	if code := connect.CodeOf(err); code != connect.CodeUnauthenticated {
+		t.Errorf("got %v, want %v", code, connect.CodeUnauthenticated)
+	}
+}
+
+// TestAsOfTodayMatchesLive checks that valuing the portfolio as of today
+// gives the same holdings and summary as the live path, which reads the
+// holdings table instead of replaying transactions.
+func TestAsOfTodayMatchesLive(t *testing.T) {
+	s := servertest.New(t)
+	fixtureCompanies(s)
+	today := time.Now().UTC()
+	for id, closes := range map[int64][2]float64{1: {1072, 1080}, 2: {701.5, 690}, 3: {355, 362}} {
+		s.Price(id, today.AddDate(0, 0, -1).Format("2006-01-02"), closes[0], closes[0])
+		s.Price(id, today.Format("2006-01-02"), closes[1], closes[0])
+	}
+
+	ctx := context.Background()
+	c, id, _ := importFixture(t, s)
+	asOf := today.Format("2006-01-02")
+
+	live, err := c.Portfolio.ListHoldings(ctx, connect.NewRequest(&ntxv1.ListHoldingsRequest{PortfolioId: id}))
+	if err != nil {
+		t.Fatalf("list holdings: %v", err)
+	}
+	dated, err := c.Portfolio.ListHoldings(ctx, connect.NewRequest(&ntxv1.ListHoldingsRequest{PortfolioId: id, AsOf: asOf}))
+	if err != nil {
+		t.Fatalf("list holdings as of today: %v", err)
+	}
+	if !proto.Equal(live.Msg, dated.Msg) {
+		t.Errorf("holdings as of today differ from live:\nlive:  %v\nas of: %v", live.Msg, dated.Msg)
+	}
+
+	liveSummary, err := c.Portfolio.GetPortfolioSummary(ctx, connect.NewRequest(&ntxv1.GetPortfolioSummaryRequest{PortfolioId: id}))
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	datedSummary, err := c.Portfolio.GetPortfolioSummary(ctx, connect.NewRequest(&ntxv1.GetPortfolioSummaryRequest{PortfolioId: id, AsOf: asOf}))
+	if err != nil {
+		t.Fatalf("summary as of today: %v", err)
+	}
+	if !proto.Equal(liveSummary.Msg, datedSummary.Msg) {
+		t.Errorf("summary as of today differs from live:\nlive:  %v\nas of: %v", liveSummary.Msg, datedSummary.Msg)
+	}
+}
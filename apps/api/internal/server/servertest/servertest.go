@@ -0,0 +1,137 @@
+// Package servertest runs the API in-process against a temporary SQLite
+// database so tests can drive it through the generated Connect clients.
+package servertest
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/gen/go/ntx/v1/ntxv1connect"
+	"github.com/voidarchive/ntx/internal/database"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+	"github.com/voidarchive/ntx/internal/server"
+	"github.com/voidarchive/ntx/internal/worker"
+)
+
+// Server is a running API backed by its own database. RPCs that call NEPSE,
+// such as SyncPrices, are not available.
+type Server struct {
+	tb      testing.TB
+	URL     string
+	DB      *sql.DB
+	Queries *sqlc.Queries
+}
+
+// New starts a server on a fresh, migrated database. Both are torn down when
+// the test ends.
+func New(tb testing.TB) *Server {
+	tb.Helper()
+
+	db, err := database.OpenDB(filepath.Join(tb.TempDir(), "ntx.db"))
+	if err != nil {
+		tb.Fatalf("open database: %v", err)
+	}
+	tb.Cleanup(func() { _ = db.Close() })
+	if err := database.AutoMigrate(db); err != nil {
+		tb.Fatalf("migrate: %v", err)
+	}
+
+	queries := sqlc.New(db)
+	ts := httptest.NewServer(server.NewHandler(db, queries, worker.New(nil, queries), portfolio.NewPortfolioService(db, queries)))
+	tb.Cleanup(ts.Close)
+
+	return &Server{tb: tb, URL: ts.URL, DB: db, Queries: queries}
+}
+
+// Company adds a listed company so its symbol can be traded and priced.
+func (s *Server) Company(id int64, symbol, sector string) {
+	s.tb.Helper()
+
+	err := s.Queries.UpsertCompany(context.Background(), sqlc.UpsertCompanyParams{
+		ID:             id,
+		Name:           symbol,
+		Symbol:         symbol,
+		Status:         "A",
+		Sector:         sector,
+		InstrumentType: "Equity",
+	})
+	if err != nil {
+		s.tb.Fatalf("add company %s: %v", symbol, err)
+	}
+}
+
+// Price records a day's close for a company, with prevClose driving the day change.
+func (s *Server) Price(companyID int64, date string, closePrice, prevClose float64) {
+	s.tb.Helper()
+
+	err := s.Queries.UpsertPrice(context.Background(), sqlc.UpsertPriceParams{
+		CompanyID:       companyID,
+		BusinessDate:    date,
+		ClosePrice:      sql.NullFloat64{Float64: closePrice, Valid: true},
+		LastTradedPrice: sql.NullFloat64{Float64: closePrice, Valid: true},
+		PreviousClose:   sql.NullFloat64{Float64: prevClose, Valid: true},
+		ChangeAmount:    sql.NullFloat64{Float64: closePrice - prevClose, Valid: true},
+		ChangePercent:   sql.NullFloat64{Float64: (closePrice - prevClose) / prevClose * 100, Valid: true},
+	})
+	if err != nil {
+		s.tb.Fatalf("add price %d %s: %v", companyID, date, err)
+	}
+}
+
+// Login registers a user and returns a client signed in as them.
+func (s *Server) Login(email, password string) *Client {
+	s.tb.Helper()
+
+	ctx := context.Background()
+	auth := ntxv1connect.NewAuthServiceClient(http.DefaultClient, s.URL)
+	_, err := auth.Register(ctx, connect.NewRequest(&ntxv1.RegisterRequest{Email: email, Password: password}))
+	if err != nil {
+		s.tb.Fatalf("register %s: %v", email, err)
+	}
+	resp, err := auth.Login(ctx, connect.NewRequest(&ntxv1.LoginRequest{Email: email, Password: password}))
+	if err != nil {
+		s.tb.Fatalf("login %s: %v", email, err)
+	}
+
+	opts := connect.WithInterceptors(bearer(resp.Msg.Token))
+	return &Client{
+		UserID:      resp.Msg.UserId,
+		Portfolio:   ntxv1connect.NewPortfolioServiceClient(http.DefaultClient, s.URL, opts),
+		Alert:       ntxv1connect.NewAlertServiceClient(http.DefaultClient, s.URL, opts),
+		Order:       ntxv1connect.NewOrderServiceClient(http.DefaultClient, s.URL, opts),
+		Application: ntxv1connect.NewApplicationServiceClient(http.DefaultClient, s.URL, opts),
+		Journal:     ntxv1connect.NewJournalServiceClient(http.DefaultClient, s.URL, opts),
+		Company:     ntxv1connect.NewCompanyServiceClient(http.DefaultClient, s.URL, opts),
+		Price:       ntxv1connect.NewPriceServiceClient(http.DefaultClient, s.URL, opts),
+	}
+}
+
+// Client holds service clients that authenticate as one user.
+type Client struct {
+	UserID      int64
+	Portfolio   ntxv1connect.PortfolioServiceClient
+	Alert       ntxv1connect.AlertServiceClient
+	Order       ntxv1connect.OrderServiceClient
+	Application ntxv1connect.ApplicationServiceClient
+	Journal     ntxv1connect.JournalServiceClient
+	Company     ntxv1connect.CompanyServiceClient
+	Price       ntxv1connect.PriceServiceClient
+}
+
+// bearer sets the session token on every request.
+func bearer(token string) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	}
+}
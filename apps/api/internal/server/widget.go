@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/auth"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// widgetSummary is the whole /api/widget response, kept small enough for a
+// home-screen widget or status-bar script to poll.
+type widgetSummary struct {
+	Value            float64      `json:"value"`
+	DayChange        float64      `json:"day_change"`
+	DayChangePercent float64      `json:"day_change_percent"`
+	TopMover         *widgetMover `json:"top_mover,omitempty"`
+	AsOf             string       `json:"as_of"`
+}
+
+type widgetMover struct {
+	Symbol        string  `json:"symbol"`
+	ChangePercent float64 `json:"change_percent"`
+}
+
+// widgetHandler totals the user's real portfolios. It authenticates with a
+// widget token rather than a session, given as a bearer token or, for apps
+// that can only set a URL, the token query parameter.
+func widgetHandler(
+	queries *sqlc.Queries,
+	authService *auth.AuthService,
+	portfolios *portfolio.PortfolioService,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if token == "" {
+			token = r.URL.Query().Get("token")
+		}
+		userID, ok := authService.ValidateWidgetToken(r.Context(), token)
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		summary, err := summarizeForWidget(r.Context(), queries, portfolios, userID)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "widget summary failed", slog.Any("err", err))
+			http.Error(w, "summary unavailable", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(summary)
+	}
+}
+
+// summarizeForWidget adds up every non-paper portfolio. The top mover is the
+// holding with the largest move either way.
+func summarizeForWidget(
+	ctx context.Context,
+	queries *sqlc.Queries,
+	portfolios *portfolio.PortfolioService,
+	userID int64,
+) (*widgetSummary, error) {
+	list, err := queries.ListPortfoliosByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, portfolio.UserIDKey, userID)
+	summary := &widgetSummary{AsOf: time.Now().Format(time.RFC3339)}
+	for _, p := range list {
+		if p.Paper {
+			continue
+		}
+		holdings, err := portfolios.Holdings(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, h := range holdings {
+			summary.Value += h.TotalValue
+			summary.DayChange += h.DayChangeValue
+			if summary.TopMover == nil || math.Abs(h.DayChangePercent) > math.Abs(summary.TopMover.ChangePercent) {
+				summary.TopMover = &widgetMover{Symbol: h.StockSymbol, ChangePercent: h.DayChangePercent}
+			}
+		}
+	}
+	if previous := summary.Value - summary.DayChange; previous > 0 {
+		summary.DayChangePercent = summary.DayChange / previous * 100
+	}
+	return summary, nil
+}
@@ -0,0 +1,74 @@
+// Package webapp serves a compiled single-page web client embedded in the
+// binary, so one ntx binary can provide both the API and the UI. The client
+// is copied into dist by `make web-embed` before building; without it the
+// package embeds nothing and Handler reports so.
+package webapp
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+)
+
+//go:embed all:dist
+var dist embed.FS
+
+// immutablePrefixes hold content-hashed assets whose names change whenever
+// their content does, so browsers may cache them forever.
+var immutablePrefixes = []string{"_app/immutable/", "assets/"}
+
+type spa struct {
+	files fs.FS
+}
+
+// Handler returns a handler for the embedded client, or false when the
+// binary was built without one.
+func Handler() (http.Handler, bool) {
+	files, err := fs.Sub(dist, "dist")
+	if err != nil {
+		return nil, false
+	}
+	if _, err := fs.Stat(files, "index.html"); err != nil {
+		return nil, false
+	}
+	return &spa{files: files}, true
+}
+
+// ServeHTTP serves the file at the request path. Paths without a file are
+// client-side routes and get index.html, except ones that look like a
+// missing asset, which 404 so a broken build doesn't render as a blank page.
+func (s *spa) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean(r.URL.Path), "/")
+	if info, err := fs.Stat(s.files, name); err != nil || info.IsDir() {
+		if path.Ext(name) != "" {
+			http.NotFound(w, r)
+			return
+		}
+		name = "index.html"
+	}
+
+	w.Header().Set("Cache-Control", cacheControl(name))
+	http.ServeFileFS(w, r, s.files, name)
+}
+
+// cacheControl lets hashed assets be cached indefinitely while index.html is
+// revalidated, so a new build is picked up on the next load.
+func cacheControl(name string) string {
+	for _, prefix := range immutablePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return "public, max-age=31536000, immutable"
+		}
+	}
+	if name == "index.html" {
+		return "no-cache"
+	}
+	return "public, max-age=3600"
+}
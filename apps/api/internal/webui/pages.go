@@ -0,0 +1,198 @@
+package webui
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// chartDays is how many trading days the symbol chart covers.
+const chartDays = 180
+
+// Chart dimensions in SVG user units; the SVG scales to the page width.
+const (
+	chartWidth  = 600
+	chartHeight = 200
+)
+
+// chart is a closing-price line ready for an SVG polyline.
+type chart struct {
+	Points    string
+	Width     int
+	Height    int
+	Low, High float64
+	From, To  string
+}
+
+func (u *UI) portfolioPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	list, err := u.portfolios.ListPortfolios(ctx, connect.NewRequest(&ntxv1.ListPortfoliosRequest{}))
+	if err != nil {
+		u.fail(w, r, err)
+		return
+	}
+
+	data := map[string]any{"Title": "Portfolio", "Portfolios": list.Msg.Portfolios}
+	if len(list.Msg.Portfolios) == 0 {
+		u.render(w, r, "portfolio.html", data)
+		return
+	}
+
+	portfolioID := list.Msg.Portfolios[0].Id
+	if v := r.URL.Query().Get("portfolio"); v != "" {
+		portfolioID, _ = strconv.ParseInt(v, 10, 64)
+	}
+	summary, err := u.portfolios.GetPortfolioSummary(ctx, connect.NewRequest(&ntxv1.GetPortfolioSummaryRequest{
+		PortfolioId: portfolioID,
+	}))
+	if err != nil {
+		u.fail(w, r, err)
+		return
+	}
+
+	data["Summary"] = summary.Msg.Summary
+	u.render(w, r, "portfolio.html", data)
+}
+
+// symbolPage shows a price chart and, given ?portfolio=, the holding and its
+// transactions.
+func (u *UI) symbolPage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	symbol := strings.ToUpper(r.PathValue("symbol"))
+	data := map[string]any{"Title": symbol, "Symbol": symbol}
+
+	if v := r.URL.Query().Get("portfolio"); v != "" {
+		portfolioID, _ := strconv.ParseInt(v, 10, 64)
+		summary, err := u.portfolios.GetPortfolioSummary(ctx, connect.NewRequest(&ntxv1.GetPortfolioSummaryRequest{
+			PortfolioId: portfolioID,
+		}))
+		if err != nil {
+			u.fail(w, r, err)
+			return
+		}
+		data["Summary"] = summary.Msg.Summary
+
+		// Transactions keep the symbol as entered, so match the holding first
+		stored := symbol
+		for _, h := range summary.Msg.Summary.Holdings {
+			if strings.EqualFold(h.StockSymbol, symbol) {
+				data["Holding"] = h
+				stored = h.StockSymbol
+			}
+		}
+		txs, err := u.portfolios.ListTransactions(ctx, connect.NewRequest(&ntxv1.ListTransactionsRequest{
+			PortfolioId: portfolioID,
+			StockSymbol: &stored,
+		}))
+		if err != nil {
+			u.fail(w, r, err)
+			return
+		}
+		data["Transactions"] = txs.Msg.Transactions
+	}
+
+	company, err := u.queries.GetCompany(ctx, symbol)
+	if err == nil {
+		data["Company"] = company
+		prices, err := u.queries.ListPricesByCompany(ctx, sqlc.ListPricesByCompanyParams{
+			CompanyID: company.ID,
+			Limit:     chartDays,
+			Offset:    0,
+		})
+		if err != nil {
+			u.fail(w, r, err)
+			return
+		}
+		if c, ok := closingChart(prices); ok {
+			data["Chart"] = c
+		}
+	}
+
+	u.render(w, r, "symbol.html", data)
+}
+
+// closingChart plots closing prices, which ListPricesByCompany returns newest
+// first. It needs at least two closes.
+func closingChart(prices []sqlc.Price) (chart, bool) {
+	var dates []string
+	var closes []float64
+	for _, p := range slices.Backward(prices) {
+		if !p.ClosePrice.Valid {
+			continue
+		}
+		dates = append(dates, p.BusinessDate)
+		closes = append(closes, p.ClosePrice.Float64)
+	}
+	if len(closes) < 2 {
+		return chart{}, false
+	}
+
+	c := chart{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Low:    slices.Min(closes),
+		High:   slices.Max(closes),
+		From:   dates[0],
+		To:     dates[len(dates)-1],
+	}
+	span := c.High - c.Low
+	points := make([]string, len(closes))
+	for i, v := range closes {
+		x := float64(i) / float64(len(closes)-1) * chartWidth
+		y := float64(chartHeight) / 2
+		if span > 0 {
+			y = (c.High - v) / span * chartHeight
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	c.Points = strings.Join(points, " ")
+	return c, true
+}
+
+func amount(tx *ntxv1.Transaction) float64 {
+	return float64(tx.Quantity) * tx.UnitPrice
+}
+
+func side(t ntxv1.TransactionType) string {
+	if t == ntxv1.TransactionType_TRANSACTION_TYPE_SELL {
+		return "SELL"
+	}
+	return "BUY"
+}
+
+// listing labels holdings that can't trade normally; active ones get none.
+func listing(status ntxv1.ListingStatus) string {
+	switch status {
+	case ntxv1.ListingStatus_LISTING_STATUS_SUSPENDED:
+		return "suspended"
+	case ntxv1.ListingStatus_LISTING_STATUS_DELISTED:
+		return "delisted"
+	}
+	return ""
+}
+
+func formatMoney(v float64) string {
+	return fmt.Sprintf("Rs.%.2f", v)
+}
+
+func formatPercent(v float64) string {
+	return fmt.Sprintf("%+.2f%%", v)
+}
+
+// tone is the CSS class for a gain or loss.
+func tone(v float64) string {
+	switch {
+	case v > 0:
+		return "up"
+	case v < 0:
+		return "down"
+	}
+	return ""
+}
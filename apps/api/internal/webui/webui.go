@@ -0,0 +1,154 @@
+// Package webui serves a few server-rendered portfolio pages so holdings can
+// be checked from a phone browser without the TUI or the web app.
+package webui
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+
+	ntxv1 "github.com/voidarchive/ntx/gen/go/ntx/v1"
+	"github.com/voidarchive/ntx/internal/auth"
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+	"github.com/voidarchive/ntx/internal/portfolio"
+)
+
+// sessionCookie holds the same session token the API hands out on login.
+const sessionCookie = "ntx_session"
+
+// sessionMaxAge matches the lifetime of an AuthService session.
+const sessionMaxAge = 7 * 24 * time.Hour
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+// UI renders the pages. It calls the services directly rather than going
+// through Connect, with the signed-in user set on the context.
+type UI struct {
+	queries    *sqlc.Queries
+	auth       *auth.AuthService
+	portfolios *portfolio.PortfolioService
+	pages      map[string]*template.Template
+}
+
+// New creates a UI, parsing the embedded templates.
+func New(queries *sqlc.Queries, authService *auth.AuthService, portfolios *portfolio.PortfolioService) *UI {
+	funcs := template.FuncMap{
+		"amount":  amount,
+		"listing": listing,
+		"money":   formatMoney,
+		"percent": formatPercent,
+		"side":    side,
+		"tone":    tone,
+	}
+	pages := make(map[string]*template.Template)
+	for _, name := range []string{"login.html", "portfolio.html", "symbol.html"} {
+		pages[name] = template.Must(template.New(name).Funcs(funcs).
+			ParseFS(templateFS, "templates/layout.html", "templates/"+name))
+	}
+	return &UI{queries: queries, auth: authService, portfolios: portfolios, pages: pages}
+}
+
+// Register mounts the pages under /ui/.
+func (u *UI) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /ui/login", u.loginPage)
+	mux.HandleFunc("POST /ui/login", u.login)
+	mux.HandleFunc("POST /ui/logout", u.logout)
+	mux.Handle("GET /ui/{$}", u.requireSession(u.portfolioPage))
+	mux.Handle("GET /ui/symbol/{symbol}", u.requireSession(u.symbolPage))
+}
+
+// requireSession redirects to the login page unless the request carries a
+// valid session cookie.
+func (u *UI) requireSession(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(sessionCookie)
+		if err != nil {
+			http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+			return
+		}
+		userID, ok := u.auth.ValidateToken(cookie.Value)
+		if !ok {
+			http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+			return
+		}
+		ctx := context.WithValue(r.Context(), portfolio.UserIDKey, userID)
+		next(w, r.WithContext(ctx))
+	})
+}
+
+func (u *UI) loginPage(w http.ResponseWriter, r *http.Request) {
+	u.render(w, r, "login.html", map[string]any{"Title": "Sign in"})
+}
+
+func (u *UI) login(w http.ResponseWriter, r *http.Request) {
+	req := connect.NewRequest(&ntxv1.LoginRequest{
+		Email:    r.PostFormValue("email"),
+		Password: r.PostFormValue("password"),
+		OtpCode:  r.PostFormValue("otp_code"),
+	})
+	req.Header().Set("User-Agent", r.UserAgent())
+	resp, err := u.auth.Login(r.Context(), req)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		u.render(w, r, "login.html", map[string]any{
+			"Title":     "Sign in",
+			"Email":     r.PostFormValue("email"),
+			"NeedsCode": r.PostFormValue("otp_code") != "",
+			"Error":     "Wrong email, password or code.",
+		})
+		return
+	}
+	if resp.Msg.OtpRequired {
+		u.render(w, r, "login.html", map[string]any{
+			"Title":     "Sign in",
+			"Email":     r.PostFormValue("email"),
+			"NeedsCode": true,
+		})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Value:    resp.Msg.Token,
+		Path:     "/ui/",
+		MaxAge:   int(sessionMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, "/ui/", http.StatusSeeOther)
+}
+
+func (u *UI) logout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookie); err == nil {
+		u.auth.Revoke(cookie.Value)
+	}
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Path: "/ui/", MaxAge: -1})
+	http.Redirect(w, r, "/ui/login", http.StatusSeeOther)
+}
+
+func (u *UI) render(w http.ResponseWriter, r *http.Request, page string, data map[string]any) {
+	_, data["SignedIn"] = r.Context().Value(portfolio.UserIDKey).(int64)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := u.pages[page].ExecuteTemplate(w, "layout", data); err != nil {
+		slog.ErrorContext(r.Context(), "render page", "page", page, "error", err)
+	}
+}
+
+// fail reports a service error as a plain-text response.
+func (u *UI) fail(w http.ResponseWriter, r *http.Request, err error) {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeNotFound {
+		http.Error(w, connectErr.Message(), http.StatusNotFound)
+		return
+	}
+	slog.ErrorContext(r.Context(), "web ui", "path", r.URL.Path, "error", err)
+	http.Error(w, "Something went wrong", http.StatusInternalServerError)
+}
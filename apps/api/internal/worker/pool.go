@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// Defaults for per-company fetches, overridable with SYNC_PARALLEL and
+// SYNC_RATE (requests per second).
+const (
+	defaultParallel = 5
+	defaultRate     = 10
+)
+
+// fetchPool runs per-company NEPSE requests concurrently while keeping a
+// shared request rate, so a full-market sync is quick without hammering the
+// source.
+type fetchPool struct {
+	parallel int
+	interval time.Duration
+}
+
+func poolFromEnv() fetchPool {
+	p := fetchPool{parallel: defaultParallel, interval: time.Second / defaultRate}
+	if n, err := strconv.Atoi(os.Getenv("SYNC_PARALLEL")); err == nil && n > 0 {
+		p.parallel = n
+	}
+	if n, err := strconv.Atoi(os.Getenv("SYNC_RATE")); err == nil && n > 0 {
+		p.interval = time.Second / time.Duration(n)
+	}
+	return p
+}
+
+// run calls fetch for every company. An error from fetch is fatal: companies
+// not yet started are dropped and the error is returned once the running
+// fetches finish. Per-company failures that shouldn't stop the sync must be
+// handled inside fetch.
+func (p fetchPool) run(
+	ctx context.Context,
+	companies []sqlc.ListCompaniesRow,
+	fetch func(ctx context.Context, c sqlc.ListCompaniesRow) error,
+) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.parallel)
+	for _, c := range companies {
+		select {
+		case <-ctx.Done():
+		case <-ticker.C:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fetch(ctx, c); err != nil {
+				cancel(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return context.Cause(ctx)
+}
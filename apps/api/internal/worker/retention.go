@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+// keepSyncRuns is how many sync runs are kept; far more than the SLO window needs.
+const keepSyncRuns = 500
+
+// PriceRetentionDays is how many days of daily prices are kept, from
+// PRICE_RETENTION_DAYS. Zero, the default, keeps them forever.
+func PriceRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("PRICE_RETENTION_DAYS"))
+	if err != nil || days < 0 {
+		return 0
+	}
+	return days
+}
+
+// Prune deletes market data past its retention and holding events that
+// have already been applied.
+func Prune(ctx context.Context, queries *sqlc.Queries, now time.Time) error {
+	if days := PriceRetentionDays(); days > 0 {
+		cutoff := BusinessDate(now.AddDate(0, 0, -days))
+		n, err := queries.DeletePricesBefore(ctx, cutoff)
+		if err != nil {
+			return fmt.Errorf("prune prices: %w", err)
+		}
+		slog.InfoContext(ctx, "prices pruned", slog.String("before", cutoff), slog.Int64("rows", n))
+	}
+
+	if _, err := queries.PruneSyncRuns(ctx, keepSyncRuns); err != nil {
+		return fmt.Errorf("prune sync runs: %w", err)
+	}
+
+	n, err := queries.PruneHoldingEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("prune holding events: %w", err)
+	}
+	slog.InfoContext(ctx, "holding events pruned", slog.Int64("rows", n))
+	return nil
+}
@@ -2,15 +2,26 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"time"
 
 	"github.com/robfig/cron/v3"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
 )
 
 type Scheduler struct {
-	c      *cron.Cron
-	worker *Worker
+	c          *cron.Cron
+	worker     *Worker
+	afterClose []job
+	weekly     []job
+}
+
+// job is extra work run once the end-of-day sync has stored fresh prices.
+type job struct {
+	name string
+	run  func(ctx context.Context) error
 }
 
 func NewScheduler(worker *Worker) (*Scheduler, error) {
@@ -26,6 +37,18 @@ func NewScheduler(worker *Worker) (*Scheduler, error) {
 	return &Scheduler{c: c, worker: worker}, nil
 }
 
+// AfterClose registers a job to run after the end-of-day price sync. It must
+// be called before Start.
+func (s *Scheduler) AfterClose(name string, run func(ctx context.Context) error) {
+	s.afterClose = append(s.afterClose, job{name: name, run: run})
+}
+
+// Weekly registers a job to run after Thursday's close, the last trading day
+// of the NEPSE week. It must be called before Start.
+func (s *Scheduler) Weekly(name string, run func(ctx context.Context) error) {
+	s.weekly = append(s.weekly, job{name: name, run: run})
+}
+
 func (s *Scheduler) Start(ctx context.Context) error {
 	spec := "0 5 15 * * 0-4"
 
@@ -34,32 +57,23 @@ func (s *Scheduler) Start(ctx context.Context) error {
 		defer cancel()
 
 		start := time.Now()
-		slog.Info("companies sync started", slog.Time("start", start))
-		if err := s.worker.SyncCompanies(jobCtx); err != nil {
-			slog.Error("companies sync failed", slog.Any("err", err))
+		synced, skipped, err := s.syncMarket(jobCtx)
+		s.recordRun(ctx, start, synced, skipped, err)
+		if err != nil {
 			return
 		}
-		slog.Info("companies sync finished", slog.Duration("took", time.Since(start)))
 
-		// Sync fundamentals after companies
-		start = time.Now()
-		slog.Info("fundamentals sync started", slog.Time("start", start))
-		if err := s.worker.SyncFundamentals(jobCtx); err != nil {
-			slog.Error("fundamentals sync failed", slog.Any("err", err))
-			return
-		}
-		slog.Info("fundamentals sync finished", slog.Duration("took", time.Since(start)))
-
-		// Sync prices
-		start = time.Now()
-		loc, _ := time.LoadLocation("Asia/Kathmandu")
-		businessDate := time.Now().In(loc).Format("2006-01-02")
-		slog.Info("prices sync started", slog.Time("start", start), slog.String("date", businessDate))
-		if err := s.worker.SyncPrices(jobCtx, businessDate); err != nil {
-			slog.Error("prices sync failed", slog.Any("err", err))
-			return
-		}
-		slog.Info("prices sync finished", slog.Duration("took", time.Since(start)))
+		runJobs(jobCtx, s.afterClose)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Runs after the daily job so it sees Thursday's prices
+	_, err = s.c.AddFunc("0 30 15 * * 4", func() {
+		jobCtx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+		defer cancel()
+		runJobs(jobCtx, s.weekly)
 	})
 	if err != nil {
 		return err
@@ -68,6 +82,101 @@ func (s *Scheduler) Start(ctx context.Context) error {
 	return nil
 }
 
+// syncMarket refreshes companies, fundamentals, prices and the index for
+// today, returning how many symbols' prices were stored and skipped.
+func (s *Scheduler) syncMarket(ctx context.Context) (synced, skipped int, err error) {
+	start := time.Now()
+	slog.Info("companies sync started", slog.Time("start", start))
+	if err := s.worker.SyncCompanies(ctx); err != nil {
+		slog.Error("companies sync failed", slog.Any("err", err))
+		return 0, 0, fmt.Errorf("companies: %w", err)
+	}
+	slog.Info("companies sync finished", slog.Duration("took", time.Since(start)))
+
+	// Sync fundamentals after companies
+	start = time.Now()
+	slog.Info("fundamentals sync started", slog.Time("start", start))
+	if err := s.worker.SyncFundamentals(ctx); err != nil {
+		slog.Error("fundamentals sync failed", slog.Any("err", err))
+		return 0, 0, fmt.Errorf("fundamentals: %w", err)
+	}
+	slog.Info("fundamentals sync finished", slog.Duration("took", time.Since(start)))
+
+	// Sync prices
+	start = time.Now()
+	businessDate := BusinessDate(start)
+	slog.Info("prices sync started", slog.Time("start", start), slog.String("date", businessDate))
+	results, err := s.worker.SyncPrices(ctx, businessDate, nil)
+	if err != nil {
+		slog.Error("prices sync failed", slog.Any("err", err))
+		return 0, 0, fmt.Errorf("prices: %w", err)
+	}
+	unchanged := 0
+	for _, r := range results {
+		if !r.Updated {
+			skipped++
+		}
+		if r.Unchanged {
+			unchanged++
+		}
+	}
+	synced = len(results) - skipped
+	slog.Info("prices sync finished",
+		slog.Duration("took", time.Since(start)), slog.Int("skipped", skipped), slog.Int("unchanged", unchanged))
+
+	// Index is only used for comparisons, so a failure doesn't fail the sync
+	if err := s.worker.SyncIndex(ctx, businessDate); err != nil {
+		slog.Error("index sync failed", slog.Any("err", err))
+	}
+	return synced, skipped, nil
+}
+
+// recordRun stores the outcome of a sync cycle for the SLO and warns once
+// the week's error budget is spent.
+func (s *Scheduler) recordRun(ctx context.Context, start time.Time, synced, skipped int, syncErr error) {
+	run := sqlc.CreateSyncRunParams{
+		StartedAt:     start,
+		FinishedAt:    time.Now(),
+		Success:       syncErr == nil,
+		PricesSynced:  int64(synced),
+		PricesSkipped: int64(skipped),
+	}
+	if syncErr != nil {
+		run.Error = syncErr.Error()
+	}
+	if err := s.worker.queries.CreateSyncRun(ctx, run); err != nil {
+		slog.Error("record sync run failed", slog.Any("err", err))
+		return
+	}
+
+	report, err := SyncSLO(ctx, s.worker.queries, time.Now())
+	if err != nil {
+		slog.Error("sync slo failed", slog.Any("err", err))
+		return
+	}
+	if report.BudgetRemaining() <= 0 {
+		slog.Warn("sync error budget exhausted",
+			slog.Float64("success_ratio", report.SuccessRatio()),
+			slog.Float64("target", report.Target),
+			slog.Int("failures", report.Failures),
+			slog.Int("runs", report.Runs),
+		)
+	}
+}
+
+// runJobs runs each job in order; a failing job is logged and doesn't stop
+// the rest.
+func runJobs(ctx context.Context, jobs []job) {
+	for _, j := range jobs {
+		start := time.Now()
+		if err := j.run(ctx); err != nil {
+			slog.ErrorContext(ctx, j.name+" failed", slog.Any("err", err))
+			continue
+		}
+		slog.InfoContext(ctx, j.name+" finished", slog.Duration("took", time.Since(start)))
+	}
+}
+
 func (s *Scheduler) Stop(ctx context.Context) error {
 	stopCtx := s.c.Stop()
 	select {
@@ -0,0 +1,87 @@
+package worker
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/voidarchive/ntx/internal/database/sqlc"
+)
+
+const (
+	// sloWindow is how far back sync runs count toward the SLO.
+	sloWindow = 7 * 24 * time.Hour
+
+	// defaultSLOTarget is the share of daily sync cycles expected to succeed,
+	// overridable with SYNC_SLO_TARGET.
+	defaultSLOTarget = 0.99
+
+	// maxWindowRuns bounds the lookup; a week holds far fewer daily runs.
+	maxWindowRuns = 100
+)
+
+// SLOReport summarises the daily sync cycles inside the SLO window.
+type SLOReport struct {
+	Target   float64
+	Runs     int
+	Failures int
+	LastRun  *sqlc.SyncRun
+	LastGood time.Time
+}
+
+// SuccessRatio is the share of runs that succeeded, 1 with no runs.
+func (r SLOReport) SuccessRatio() float64 {
+	if r.Runs == 0 {
+		return 1
+	}
+	return float64(r.Runs-r.Failures) / float64(r.Runs)
+}
+
+// BudgetRemaining is the unused share of the error budget: 1 with no
+// failures, 0 once failures reach the budget and negative beyond it.
+func (r SLOReport) BudgetRemaining() float64 {
+	allowed := (1 - r.Target) * float64(r.Runs)
+	if r.Failures == 0 {
+		return 1
+	}
+	if allowed == 0 {
+		return -float64(r.Failures)
+	}
+	return 1 - float64(r.Failures)/allowed
+}
+
+// SyncSLO reports the sync success rate over the past week.
+func SyncSLO(ctx context.Context, queries *sqlc.Queries, now time.Time) (SLOReport, error) {
+	report := SLOReport{Target: sloTarget()}
+
+	runs, err := queries.ListRecentSyncRuns(ctx, maxWindowRuns)
+	if err != nil {
+		return report, err
+	}
+
+	if len(runs) > 0 {
+		report.LastRun = &runs[0]
+	}
+	for _, run := range runs {
+		if run.Success && run.FinishedAt.After(report.LastGood) {
+			report.LastGood = run.FinishedAt
+		}
+		if now.Sub(run.StartedAt) > sloWindow {
+			continue
+		}
+		report.Runs++
+		if !run.Success {
+			report.Failures++
+		}
+	}
+	return report, nil
+}
+
+func sloTarget() float64 {
+	target, err := strconv.ParseFloat(os.Getenv("SYNC_SLO_TARGET"), 64)
+	if err != nil || target <= 0 || target >= 1 {
+		return defaultSLOTarget
+	}
+	return target
+}
@@ -5,20 +5,30 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
+	"github.com/voidarchive/ntx/internal/convert"
 	"github.com/voidarchive/ntx/internal/database/sqlc"
 	"github.com/voidarchive/ntx/internal/nepse"
 )
 
+// StatusDelisted is NEPSE's code for a delisted company in companies.status.
+// Active is "A" and suspended "S".
+const StatusDelisted = "D"
+
 type Worker struct {
 	nepse   *nepse.Client
 	queries *sqlc.Queries
+	pool    fetchPool
 }
 
 func New(client *nepse.Client, queries *sqlc.Queries) *Worker {
 	return &Worker{
 		nepse:   client,
 		queries: queries,
+		pool:    poolFromEnv(),
 	}
 }
 
@@ -42,10 +52,22 @@ func (w *Worker) SyncCompanies(ctx context.Context) error {
 		if err := w.queries.UpsertCompany(ctx, params); err != nil {
 			return fmt.Errorf("upsert company %q: %w", c.Symbol, err)
 		}
+		if err := RecordListingStatus(ctx, w.queries, c.ID, c.Status, BusinessDate(time.Now())); err != nil {
+			return fmt.Errorf("listing status %q: %w", c.Symbol, err)
+		}
 	}
 	return nil
 }
 
+// RecordListingStatus dates a delisting the first time a sync sees it, and
+// forgets the date if the company is relisted.
+func RecordListingStatus(ctx context.Context, queries *sqlc.Queries, companyID int64, status, today string) error {
+	if status != StatusDelisted {
+		return queries.ClearDelisting(ctx, companyID)
+	}
+	return queries.RecordDelisting(ctx, sqlc.RecordDelistingParams{CompanyID: companyID, DelistedOn: today})
+}
+
 func (w *Worker) SyncFundamentals(ctx context.Context) error {
 	companies, err := w.queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
 		Limit:  1000,
@@ -55,12 +77,12 @@ func (w *Worker) SyncFundamentals(ctx context.Context) error {
 		return fmt.Errorf("list companies: %w", err)
 	}
 
-	for _, c := range companies {
-		fundamentals, err := w.nepse.Fundamentals(ctx, safeInt32(c.ID))
+	return w.pool.run(ctx, companies, func(ctx context.Context, c sqlc.ListCompaniesRow) error {
+		fundamentals, err := w.nepse.Fundamentals(ctx, convert.SafeInt32(c.ID))
 		if err != nil {
 			// Log and continue - don't fail entire sync for one company
-			fmt.Printf("skip fundamentals for %s: %v\n", c.Symbol, err)
-			continue
+			slog.Warn("skip fundamentals", "symbol", c.Symbol, "error", err)
+			return nil
 		}
 
 		for _, f := range fundamentals {
@@ -78,36 +100,94 @@ func (w *Worker) SyncFundamentals(ctx context.Context) error {
 				return fmt.Errorf("upsert fundamental for %s: %w", c.Symbol, err)
 			}
 		}
+		return nil
+	})
+}
+
+// PriceSyncResult reports the outcome of a price sync for one symbol.
+// Unchanged means the stored row already matched the feed and no write was
+// needed; the price is still current, so Updated is set too.
+type PriceSyncResult struct {
+	Symbol    string
+	Updated   bool
+	Unchanged bool
+	Err       string
+}
+
+// BusinessDate returns the NEPSE trading date for t.
+func BusinessDate(t time.Time) string {
+	loc, err := time.LoadLocation("Asia/Kathmandu")
+	if err != nil {
+		return t.Format("2006-01-02")
 	}
-	return nil
+	return t.In(loc).Format("2006-01-02")
 }
 
-func (w *Worker) SyncPrices(ctx context.Context, businessDate string) error {
+// SyncPrices stores live prices for the given symbols, or for every listed
+// company when symbols is empty. A failing symbol is reported in its result
+// rather than aborting the rest of the sync.
+func (w *Worker) SyncPrices(ctx context.Context, businessDate string, symbols []string) ([]PriceSyncResult, error) {
 	// Build symbol -> company ID map
 	companies, err := w.queries.ListCompanies(ctx, sqlc.ListCompaniesParams{
 		Limit:  1000,
 		Offset: 0,
 	})
 	if err != nil {
-		return fmt.Errorf("list companies: %w", err)
+		return nil, fmt.Errorf("list companies: %w", err)
 	}
 
 	symbolToID := make(map[string]int64, len(companies))
+	delisted := make(map[string]bool)
 	for _, c := range companies {
 		symbolToID[c.Symbol] = c.ID
+		if c.Status == StatusDelisted {
+			delisted[c.Symbol] = true
+		}
+	}
+
+	// After hours most rows don't move between refreshes; skip rewriting them
+	// to keep SQLite write and WAL churn down.
+	existing, err := w.queries.ListPricesByDate(ctx, businessDate)
+	if err != nil {
+		return nil, fmt.Errorf("list stored prices: %w", err)
+	}
+	stored := make(map[int64]sqlc.Price, len(existing))
+	for _, p := range existing {
+		stored[p.CompanyID] = p
 	}
 
 	// Use LiveMarket - TodaysPrices requires auth that go-nepse doesn't support
 	prices, err := w.nepse.LiveMarket(ctx)
 	if err != nil {
-		return fmt.Errorf("fetch prices: %w", err)
+		return nil, fmt.Errorf("fetch prices: %w", err)
+	}
+
+	wanted := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		wanted[strings.ToUpper(s)] = true
 	}
 
-	// Upsert prices
+	// Delisted companies no longer trade; their last price stays as it is
+	var results []PriceSyncResult
+	for s := range wanted {
+		if delisted[s] {
+			results = append(results, PriceSyncResult{Symbol: s, Err: "delisted"})
+			delete(wanted, s)
+		}
+	}
 	for _, p := range prices {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+		if (len(symbols) > 0 && !wanted[p.Symbol]) || delisted[p.Symbol] {
+			continue
+		}
+		delete(wanted, p.Symbol)
+
 		companyID, ok := symbolToID[p.Symbol]
 		if !ok {
-			continue // Skip unknown symbols
+			results = append(results, PriceSyncResult{Symbol: p.Symbol, Err: "unknown company"})
+			continue
 		}
 
 		params := sqlc.UpsertPriceParams{
@@ -125,11 +205,54 @@ func (w *Worker) SyncPrices(ctx context.Context, businessDate string) error {
 			Turnover:        nullFloat64(p.Turnover),
 			Trades:          nullInt64(int64(p.Trades)),
 		}
+		if prev, ok := stored[companyID]; ok && samePrice(prev, params) {
+			results = append(results, PriceSyncResult{Symbol: p.Symbol, Updated: true, Unchanged: true})
+			continue
+		}
 		if err := w.queries.UpsertPrice(ctx, params); err != nil {
-			return fmt.Errorf("upsert price for %s: %w", p.Symbol, err)
+			results = append(results, PriceSyncResult{Symbol: p.Symbol, Err: err.Error()})
+			continue
 		}
+		results = append(results, PriceSyncResult{Symbol: p.Symbol, Updated: true})
 	}
-	return nil
+
+	// Requested symbols that never appeared in the live feed
+	for s := range wanted {
+		results = append(results, PriceSyncResult{Symbol: s, Err: "not traded today"})
+	}
+
+	return results, nil
+}
+
+// samePrice reports whether a stored row already holds the values an upsert
+// would write.
+func samePrice(p sqlc.Price, u sqlc.UpsertPriceParams) bool {
+	return p.OpenPrice == u.OpenPrice &&
+		p.HighPrice == u.HighPrice &&
+		p.LowPrice == u.LowPrice &&
+		p.ClosePrice == u.ClosePrice &&
+		p.LastTradedPrice == u.LastTradedPrice &&
+		p.PreviousClose == u.PreviousClose &&
+		p.ChangeAmount == u.ChangeAmount &&
+		p.ChangePercent == u.ChangePercent &&
+		p.Volume == u.Volume &&
+		p.Turnover == u.Turnover &&
+		p.Trades == u.Trades
+}
+
+// SyncIndex stores the NEPSE index close so portfolio returns can be
+// compared against the market.
+func (w *Worker) SyncIndex(ctx context.Context, businessDate string) error {
+	idx, err := w.nepse.NepseIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	return w.queries.UpsertIndexValue(ctx, sqlc.UpsertIndexValueParams{
+		BusinessDate:  businessDate,
+		CloseValue:    idx.Close,
+		ChangePercent: nullFloat64(idx.ChangePercent),
+	})
 }
 
 func (w *Worker) SyncOwnership(ctx context.Context) error {
@@ -141,11 +264,11 @@ func (w *Worker) SyncOwnership(ctx context.Context) error {
 		return fmt.Errorf("list companies: %w", err)
 	}
 
-	for _, c := range companies {
-		ownership, err := w.nepse.SecurityDetail(ctx, safeInt32(c.ID))
+	return w.pool.run(ctx, companies, func(ctx context.Context, c sqlc.ListCompaniesRow) error {
+		ownership, err := w.nepse.SecurityDetail(ctx, convert.SafeInt32(c.ID))
 		if err != nil {
-			fmt.Printf("skip ownership for %s: %v\n", c.Symbol, err)
-			continue
+			slog.Warn("skip ownership", "symbol", c.Symbol, "error", err)
+			return nil
 		}
 
 		params := sqlc.UpsertOwnershipParams{
@@ -159,8 +282,8 @@ func (w *Worker) SyncOwnership(ctx context.Context) error {
 		if err := w.queries.UpsertOwnership(ctx, params); err != nil {
 			return fmt.Errorf("upsert ownership for %s: %w", c.Symbol, err)
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func (w *Worker) SyncDividends(ctx context.Context) error {
@@ -172,11 +295,11 @@ func (w *Worker) SyncDividends(ctx context.Context) error {
 		return fmt.Errorf("list companies: %w", err)
 	}
 
-	for _, c := range companies {
-		dividends, err := w.nepse.Dividends(ctx, safeInt32(c.ID))
+	return w.pool.run(ctx, companies, func(ctx context.Context, c sqlc.ListCompaniesRow) error {
+		dividends, err := w.nepse.Dividends(ctx, convert.SafeInt32(c.ID))
 		if err != nil {
-			fmt.Printf("skip dividends for %s: %v\n", c.Symbol, err)
-			continue
+			slog.Warn("skip dividends", "symbol", c.Symbol, "error", err)
+			return nil
 		}
 
 		for _, d := range dividends {
@@ -192,8 +315,8 @@ func (w *Worker) SyncDividends(ctx context.Context) error {
 				return fmt.Errorf("upsert dividend for %s: %w", c.Symbol, err)
 			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
 
 func nullString(s string) sql.NullString {
@@ -223,11 +346,3 @@ func nullInt64(i int64) sql.NullInt64 {
 	}
 	return sql.NullInt64{Int64: i, Valid: true}
 }
-
-func safeInt32(v int64) int32 {
-	const maxInt32 = 1<<31 - 1
-	if v > maxInt32 {
-		return maxInt32
-	}
-	return int32(v) //nolint:gosec // bounds checked above
-}
@@ -7,3 +7,4 @@ export { default as RatingsRadar } from './RatingsRadar.svelte';
 export { default as OwnershipPieChart } from './OwnershipPieChart.svelte';
 export { default as SectorPeers } from './SectorPeers.svelte';
 export { default as SectorChart } from './SectorChart.svelte';
+export { default as GrowthChart } from './GrowthChart.svelte';
@@ -0,0 +1,308 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/alert.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file ntx/v1/alert.proto.
+ */
+export declare const file_ntx_v1_alert: GenFile;
+
+/**
+ * @generated from message ntx.v1.Alert
+ */
+export declare type Alert = Message<"ntx.v1.Alert"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: ntx.v1.AlertType type = 2;
+   */
+  type: AlertType;
+
+  /**
+   * @generated from field: optional int64 portfolio_id = 3;
+   */
+  portfolioId?: bigint;
+
+  /**
+   * @generated from field: optional string stock_symbol = 4;
+   */
+  stockSymbol?: string;
+
+  /**
+   * @generated from field: double threshold = 5;
+   */
+  threshold: number;
+
+  /**
+   * @generated from field: int32 window_days = 6;
+   */
+  windowDays: number;
+
+  /**
+   * @generated from field: bool active = 7;
+   */
+  active: boolean;
+
+  /**
+   * empty if never triggered
+   *
+   * @generated from field: string triggered_at = 8;
+   */
+  triggeredAt: string;
+
+  /**
+   * @generated from field: string last_message = 9;
+   */
+  lastMessage: string;
+
+  /**
+   * @generated from field: string created_at = 10;
+   */
+  createdAt: string;
+
+  /**
+   * highest close seen, for trailing stops
+   *
+   * @generated from field: optional double peak_price = 11;
+   */
+  peakPrice?: number;
+};
+
+/**
+ * Describes the message ntx.v1.Alert.
+ * Use `create(AlertSchema)` to create a new message.
+ */
+export declare const AlertSchema: GenMessage<Alert>;
+
+/**
+ * @generated from message ntx.v1.CreateAlertRequest
+ */
+export declare type CreateAlertRequest = Message<"ntx.v1.CreateAlertRequest"> & {
+  /**
+   * @generated from field: ntx.v1.AlertType type = 1;
+   */
+  type: AlertType;
+
+  /**
+   * @generated from field: optional int64 portfolio_id = 2;
+   */
+  portfolioId?: bigint;
+
+  /**
+   * @generated from field: optional string stock_symbol = 3;
+   */
+  stockSymbol?: string;
+
+  /**
+   * @generated from field: double threshold = 4;
+   */
+  threshold: number;
+
+  /**
+   * defaults to 30 for index underperformance and 20 for volume spikes
+   *
+   * @generated from field: int32 window_days = 5;
+   */
+  windowDays: number;
+};
+
+/**
+ * Describes the message ntx.v1.CreateAlertRequest.
+ * Use `create(CreateAlertRequestSchema)` to create a new message.
+ */
+export declare const CreateAlertRequestSchema: GenMessage<CreateAlertRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateAlertResponse
+ */
+export declare type CreateAlertResponse = Message<"ntx.v1.CreateAlertResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Alert alert = 1;
+   */
+  alert?: Alert;
+};
+
+/**
+ * Describes the message ntx.v1.CreateAlertResponse.
+ * Use `create(CreateAlertResponseSchema)` to create a new message.
+ */
+export declare const CreateAlertResponseSchema: GenMessage<CreateAlertResponse>;
+
+/**
+ * @generated from message ntx.v1.ListAlertsRequest
+ */
+export declare type ListAlertsRequest = Message<"ntx.v1.ListAlertsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListAlertsRequest.
+ * Use `create(ListAlertsRequestSchema)` to create a new message.
+ */
+export declare const ListAlertsRequestSchema: GenMessage<ListAlertsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListAlertsResponse
+ */
+export declare type ListAlertsResponse = Message<"ntx.v1.ListAlertsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Alert alerts = 1;
+   */
+  alerts: Alert[];
+};
+
+/**
+ * Describes the message ntx.v1.ListAlertsResponse.
+ * Use `create(ListAlertsResponseSchema)` to create a new message.
+ */
+export declare const ListAlertsResponseSchema: GenMessage<ListAlertsResponse>;
+
+/**
+ * @generated from message ntx.v1.DeleteAlertRequest
+ */
+export declare type DeleteAlertRequest = Message<"ntx.v1.DeleteAlertRequest"> & {
+  /**
+   * @generated from field: int64 alert_id = 1;
+   */
+  alertId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteAlertRequest.
+ * Use `create(DeleteAlertRequestSchema)` to create a new message.
+ */
+export declare const DeleteAlertRequestSchema: GenMessage<DeleteAlertRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteAlertResponse
+ */
+export declare type DeleteAlertResponse = Message<"ntx.v1.DeleteAlertResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteAlertResponse.
+ * Use `create(DeleteAlertResponseSchema)` to create a new message.
+ */
+export declare const DeleteAlertResponseSchema: GenMessage<DeleteAlertResponse>;
+
+/**
+ * @generated from enum ntx.v1.AlertType
+ */
+export enum AlertType {
+  /**
+   * @generated from enum value: ALERT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * stock_symbol, threshold in Rs.
+   *
+   * @generated from enum value: ALERT_TYPE_PRICE_ABOVE = 1;
+   */
+  PRICE_ABOVE = 1,
+
+  /**
+   * stock_symbol, threshold in Rs.
+   *
+   * @generated from enum value: ALERT_TYPE_PRICE_BELOW = 2;
+   */
+  PRICE_BELOW = 2,
+
+  /**
+   * portfolio_id, threshold in Rs.
+   *
+   * @generated from enum value: ALERT_TYPE_DAY_LOSS = 3;
+   */
+  DAY_LOSS = 3,
+
+  /**
+   * portfolio_id, threshold in % from peak
+   *
+   * @generated from enum value: ALERT_TYPE_DRAWDOWN = 4;
+   */
+  DRAWDOWN = 4,
+
+  /**
+   * portfolio_id, threshold in percentage points behind the NEPSE index
+   * over window_days
+   *
+   * @generated from enum value: ALERT_TYPE_INDEX_UNDERPERFORMANCE = 5;
+   */
+  INDEX_UNDERPERFORMANCE = 5,
+
+  /**
+   * stock_symbol, threshold as a multiple of the average volume over the
+   * previous window_days trading days
+   *
+   * @generated from enum value: ALERT_TYPE_VOLUME_SPIKE = 6;
+   */
+  VOLUME_SPIKE = 6,
+
+  /**
+   * stock_symbol, threshold in Rs.
+   *
+   * @generated from enum value: ALERT_TYPE_TURNOVER_ABOVE = 7;
+   */
+  TURNOVER_ABOVE = 7,
+
+  /**
+   * portfolio_id and stock_symbol, threshold in % relative to the weighted
+   * average cost of the holding
+   *
+   * @generated from enum value: ALERT_TYPE_ABOVE_WAC = 8;
+   */
+  ABOVE_WAC = 8,
+
+  /**
+   * @generated from enum value: ALERT_TYPE_BELOW_WAC = 9;
+   */
+  BELOW_WAC = 9,
+
+  /**
+   * stock_symbol, threshold in % below the highest close since creation
+   *
+   * @generated from enum value: ALERT_TYPE_TRAILING_STOP = 10;
+   */
+  TRAILING_STOP = 10,
+}
+
+/**
+ * Describes the enum ntx.v1.AlertType.
+ */
+export declare const AlertTypeSchema: GenEnum<AlertType>;
+
+/**
+ * @generated from service ntx.v1.AlertService
+ */
+export declare const AlertService: GenService<{
+  /**
+   * @generated from rpc ntx.v1.AlertService.CreateAlert
+   */
+  createAlert: {
+    methodKind: "unary";
+    input: typeof CreateAlertRequestSchema;
+    output: typeof CreateAlertResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AlertService.ListAlerts
+   */
+  listAlerts: {
+    methodKind: "unary";
+    input: typeof ListAlertsRequestSchema;
+    output: typeof ListAlertsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AlertService.DeleteAlert
+   */
+  deleteAlert: {
+    methodKind: "unary";
+    input: typeof DeleteAlertRequestSchema;
+    output: typeof DeleteAlertResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,79 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/alert.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+
+/**
+ * Describes the file ntx/v1/alert.proto.
+ */
+export const file_ntx_v1_alert = /*@__PURE__*/
+  fileDesc("ChJudHgvdjEvYWxlcnQucHJvdG8SBm50eC52MSKsAgoFQWxlcnQSCgoCaWQYASABKAMSHwoEdHlwZRgCIAEoDjIRLm50eC52MS5BbGVydFR5cGUSGQoMcG9ydGZvbGlvX2lkGAMgASgDSACIAQESGQoMc3RvY2tfc3ltYm9sGAQgASgJSAGIAQESEQoJdGhyZXNob2xkGAUgASgBEhMKC3dpbmRvd19kYXlzGAYgASgFEg4KBmFjdGl2ZRgHIAEoCBIUCgx0cmlnZ2VyZWRfYXQYCCABKAkSFAoMbGFzdF9tZXNzYWdlGAkgASgJEhIKCmNyZWF0ZWRfYXQYCiABKAkSFwoKcGVha19wcmljZRgLIAEoAUgCiAEBQg8KDV9wb3J0Zm9saW9faWRCDwoNX3N0b2NrX3N5bWJvbEINCgtfcGVha19wcmljZSK1AQoSQ3JlYXRlQWxlcnRSZXF1ZXN0Eh8KBHR5cGUYASABKA4yES5udHgudjEuQWxlcnRUeXBlEhkKDHBvcnRmb2xpb19pZBgCIAEoA0gAiAEBEhkKDHN0b2NrX3N5bWJvbBgDIAEoCUgBiAEBEhEKCXRocmVzaG9sZBgEIAEoARITCgt3aW5kb3dfZGF5cxgFIAEoBUIPCg1fcG9ydGZvbGlvX2lkQg8KDV9zdG9ja19zeW1ib2wiMwoTQ3JlYXRlQWxlcnRSZXNwb25zZRIcCgVhbGVydBgBIAEoCzINLm50eC52MS5BbGVydCITChFMaXN0QWxlcnRzUmVxdWVzdCIzChJMaXN0QWxlcnRzUmVzcG9uc2USHQoGYWxlcnRzGAEgAygLMg0ubnR4LnYxLkFsZXJ0IiYKEkRlbGV0ZUFsZXJ0UmVxdWVzdBIQCghhbGVydF9pZBgBIAEoAyIVChNEZWxldGVBbGVydFJlc3BvbnNlKsYCCglBbGVydFR5cGUSGgoWQUxFUlRfVFlQRV9VTlNQRUNJRklFRBAAEhoKFkFMRVJUX1RZUEVfUFJJQ0VfQUJPVkUQARIaChZBTEVSVF9UWVBFX1BSSUNFX0JFTE9XEAISFwoTQUxFUlRfVFlQRV9EQVlfTE9TUxADEhcKE0FMRVJUX1RZUEVfRFJBV0RPV04QBBIlCiFBTEVSVF9UWVBFX0lOREVYX1VOREVSUEVSRk9STUFOQ0UQBRIbChdBTEVSVF9UWVBFX1ZPTFVNRV9TUElLRRAGEh0KGUFMRVJUX1RZUEVfVFVSTk9WRVJfQUJPVkUQBxIYChRBTEVSVF9UWVBFX0FCT1ZFX1dBQxAIEhgKFEFMRVJUX1RZUEVfQkVMT1dfV0FDEAkSHAoYQUxFUlRfVFlQRV9UUkFJTElOR19TVE9QEAoy4wEKDEFsZXJ0U2VydmljZRJGCgtDcmVhdGVBbGVydBIaLm50eC52MS5DcmVhdGVBbGVydFJlcXVlc3QaGy5udHgudjEuQ3JlYXRlQWxlcnRSZXNwb25zZRJDCgpMaXN0QWxlcnRzEhkubnR4LnYxLkxpc3RBbGVydHNSZXF1ZXN0GhoubnR4LnYxLkxpc3RBbGVydHNSZXNwb25zZRJGCgtEZWxldGVBbGVydBIaLm50eC52MS5EZWxldGVBbGVydFJlcXVlc3QaGy5udHgudjEuRGVsZXRlQWxlcnRSZXNwb25zZUIwWi5naXRodWIuY29tL3ZvaWRhcmNoaXZlL250eC9nZW4vZ28vbnR4L3YxO250eHYxYgZwcm90bzM");
+
+/**
+ * Describes the message ntx.v1.Alert.
+ * Use `create(AlertSchema)` to create a new message.
+ */
+export const AlertSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 0);
+
+/**
+ * Describes the message ntx.v1.CreateAlertRequest.
+ * Use `create(CreateAlertRequestSchema)` to create a new message.
+ */
+export const CreateAlertRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 1);
+
+/**
+ * Describes the message ntx.v1.CreateAlertResponse.
+ * Use `create(CreateAlertResponseSchema)` to create a new message.
+ */
+export const CreateAlertResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 2);
+
+/**
+ * Describes the message ntx.v1.ListAlertsRequest.
+ * Use `create(ListAlertsRequestSchema)` to create a new message.
+ */
+export const ListAlertsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 3);
+
+/**
+ * Describes the message ntx.v1.ListAlertsResponse.
+ * Use `create(ListAlertsResponseSchema)` to create a new message.
+ */
+export const ListAlertsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 4);
+
+/**
+ * Describes the message ntx.v1.DeleteAlertRequest.
+ * Use `create(DeleteAlertRequestSchema)` to create a new message.
+ */
+export const DeleteAlertRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 5);
+
+/**
+ * Describes the message ntx.v1.DeleteAlertResponse.
+ * Use `create(DeleteAlertResponseSchema)` to create a new message.
+ */
+export const DeleteAlertResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_alert, 6);
+
+/**
+ * Describes the enum ntx.v1.AlertType.
+ */
+export const AlertTypeSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_alert, 0);
+
+/**
+ * @generated from enum ntx.v1.AlertType
+ */
+export const AlertType = /*@__PURE__*/
+  tsEnum(AlertTypeSchema);
+
+/**
+ * @generated from service ntx.v1.AlertService
+ */
+export const AlertService = /*@__PURE__*/
+  serviceDesc(file_ntx_v1_alert, 0);
+
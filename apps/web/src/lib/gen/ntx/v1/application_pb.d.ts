@@ -0,0 +1,637 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/application.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+import type { Transaction } from "./portfolio_pb";
+
+/**
+ * Describes the file ntx/v1/application.proto.
+ */
+export declare const file_ntx_v1_application: GenFile;
+
+/**
+ * @generated from message ntx.v1.ShareApplication
+ */
+export declare type ShareApplication = Message<"ntx.v1.ShareApplication"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 portfolio_id = 2;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 3;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: ntx.v1.IssueType issue_type = 4;
+   */
+  issueType: IssueType;
+
+  /**
+   * @generated from field: int64 units = 5;
+   */
+  units: bigint;
+
+  /**
+   * @generated from field: double price_per_unit = 6;
+   */
+  pricePerUnit: number;
+
+  /**
+   * units times price while pending, else 0
+   *
+   * @generated from field: double amount_blocked = 7;
+   */
+  amountBlocked: number;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string applied_on = 8;
+   */
+  appliedOn: string;
+
+  /**
+   * YYYY-MM-DD, empty if not announced
+   *
+   * @generated from field: string expected_allotment_on = 9;
+   */
+  expectedAllotmentOn: string;
+
+  /**
+   * @generated from field: ntx.v1.ApplicationStatus status = 10;
+   */
+  status: ApplicationStatus;
+
+  /**
+   * @generated from field: int64 allotted_units = 11;
+   */
+  allottedUnits: bigint;
+
+  /**
+   * unblocked after allotment or withdrawal
+   *
+   * @generated from field: double amount_released = 12;
+   */
+  amountReleased: number;
+
+  /**
+   * the booked allotment
+   *
+   * @generated from field: optional int64 transaction_id = 13;
+   */
+  transactionId?: bigint;
+
+  /**
+   * @generated from field: string note = 14;
+   */
+  note: string;
+
+  /**
+   * @generated from field: string closed_at = 15;
+   */
+  closedAt: string;
+
+  /**
+   * @generated from field: string created_at = 16;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.ShareApplication.
+ * Use `create(ShareApplicationSchema)` to create a new message.
+ */
+export declare const ShareApplicationSchema: GenMessage<ShareApplication>;
+
+/**
+ * @generated from message ntx.v1.CreateApplicationRequest
+ */
+export declare type CreateApplicationRequest = Message<"ntx.v1.CreateApplicationRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: ntx.v1.IssueType issue_type = 3;
+   */
+  issueType: IssueType;
+
+  /**
+   * @generated from field: int64 units = 4;
+   */
+  units: bigint;
+
+  /**
+   * defaults to the Rs.100 face value
+   *
+   * @generated from field: double price_per_unit = 5;
+   */
+  pricePerUnit: number;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string applied_on = 6;
+   */
+  appliedOn: string;
+
+  /**
+   * @generated from field: string expected_allotment_on = 7;
+   */
+  expectedAllotmentOn: string;
+
+  /**
+   * @generated from field: string note = 8;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message ntx.v1.CreateApplicationRequest.
+ * Use `create(CreateApplicationRequestSchema)` to create a new message.
+ */
+export declare const CreateApplicationRequestSchema: GenMessage<CreateApplicationRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateApplicationResponse
+ */
+export declare type CreateApplicationResponse = Message<"ntx.v1.CreateApplicationResponse"> & {
+  /**
+   * @generated from field: ntx.v1.ShareApplication application = 1;
+   */
+  application?: ShareApplication;
+};
+
+/**
+ * Describes the message ntx.v1.CreateApplicationResponse.
+ * Use `create(CreateApplicationResponseSchema)` to create a new message.
+ */
+export declare const CreateApplicationResponseSchema: GenMessage<CreateApplicationResponse>;
+
+/**
+ * @generated from message ntx.v1.ListApplicationsRequest
+ */
+export declare type ListApplicationsRequest = Message<"ntx.v1.ListApplicationsRequest"> & {
+  /**
+   * @generated from field: optional ntx.v1.ApplicationStatus status = 1;
+   */
+  status?: ApplicationStatus;
+};
+
+/**
+ * Describes the message ntx.v1.ListApplicationsRequest.
+ * Use `create(ListApplicationsRequestSchema)` to create a new message.
+ */
+export declare const ListApplicationsRequestSchema: GenMessage<ListApplicationsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListApplicationsResponse
+ */
+export declare type ListApplicationsResponse = Message<"ntx.v1.ListApplicationsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.ShareApplication applications = 1;
+   */
+  applications: ShareApplication[];
+
+  /**
+   * across every pending application
+   *
+   * @generated from field: double total_blocked = 2;
+   */
+  totalBlocked: number;
+};
+
+/**
+ * Describes the message ntx.v1.ListApplicationsResponse.
+ * Use `create(ListApplicationsResponseSchema)` to create a new message.
+ */
+export declare const ListApplicationsResponseSchema: GenMessage<ListApplicationsResponse>;
+
+/**
+ * @generated from message ntx.v1.WithdrawApplicationRequest
+ */
+export declare type WithdrawApplicationRequest = Message<"ntx.v1.WithdrawApplicationRequest"> & {
+  /**
+   * @generated from field: int64 application_id = 1;
+   */
+  applicationId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.WithdrawApplicationRequest.
+ * Use `create(WithdrawApplicationRequestSchema)` to create a new message.
+ */
+export declare const WithdrawApplicationRequestSchema: GenMessage<WithdrawApplicationRequest>;
+
+/**
+ * @generated from message ntx.v1.WithdrawApplicationResponse
+ */
+export declare type WithdrawApplicationResponse = Message<"ntx.v1.WithdrawApplicationResponse"> & {
+  /**
+   * @generated from field: ntx.v1.ShareApplication application = 1;
+   */
+  application?: ShareApplication;
+};
+
+/**
+ * Describes the message ntx.v1.WithdrawApplicationResponse.
+ * Use `create(WithdrawApplicationResponseSchema)` to create a new message.
+ */
+export declare const WithdrawApplicationResponseSchema: GenMessage<WithdrawApplicationResponse>;
+
+/**
+ * AllotApplicationRequest records the allotment result. Allotted units are
+ * booked as a buy at the issue price; zero closes the application as not
+ * allotted.
+ *
+ * @generated from message ntx.v1.AllotApplicationRequest
+ */
+export declare type AllotApplicationRequest = Message<"ntx.v1.AllotApplicationRequest"> & {
+  /**
+   * @generated from field: int64 application_id = 1;
+   */
+  applicationId: bigint;
+
+  /**
+   * @generated from field: int64 allotted_units = 2;
+   */
+  allottedUnits: bigint;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string allotted_on = 3;
+   */
+  allottedOn: string;
+};
+
+/**
+ * Describes the message ntx.v1.AllotApplicationRequest.
+ * Use `create(AllotApplicationRequestSchema)` to create a new message.
+ */
+export declare const AllotApplicationRequestSchema: GenMessage<AllotApplicationRequest>;
+
+/**
+ * @generated from message ntx.v1.AllotApplicationResponse
+ */
+export declare type AllotApplicationResponse = Message<"ntx.v1.AllotApplicationResponse"> & {
+  /**
+   * @generated from field: ntx.v1.ShareApplication application = 1;
+   */
+  application?: ShareApplication;
+
+  /**
+   * unset when nothing was allotted
+   *
+   * @generated from field: ntx.v1.Transaction transaction = 2;
+   */
+  transaction?: Transaction;
+};
+
+/**
+ * Describes the message ntx.v1.AllotApplicationResponse.
+ * Use `create(AllotApplicationResponseSchema)` to create a new message.
+ */
+export declare const AllotApplicationResponseSchema: GenMessage<AllotApplicationResponse>;
+
+/**
+ * RightRenunciation is rights entitlement given up instead of subscribed.
+ * Unsubscribed rights are auctioned and the premium paid to the holder;
+ * renounced units carry no cost basis, so the proceeds are realized gain.
+ *
+ * @generated from message ntx.v1.RightRenunciation
+ */
+export declare type RightRenunciation = Message<"ntx.v1.RightRenunciation"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 portfolio_id = 2;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 3;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 units = 4;
+   */
+  units: bigint;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string renounced_on = 5;
+   */
+  renouncedOn: string;
+
+  /**
+   * @generated from field: double proceeds = 6;
+   */
+  proceeds: number;
+
+  /**
+   * empty until the auction settles
+   *
+   * @generated from field: string proceeds_received_on = 7;
+   */
+  proceedsReceivedOn: string;
+
+  /**
+   * @generated from field: string note = 8;
+   */
+  note: string;
+
+  /**
+   * @generated from field: string created_at = 9;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.RightRenunciation.
+ * Use `create(RightRenunciationSchema)` to create a new message.
+ */
+export declare const RightRenunciationSchema: GenMessage<RightRenunciation>;
+
+/**
+ * RenounceRightsRequest records units of a rights issue not subscribed. For
+ * a partial subscription, apply for the rest with CreateApplication.
+ *
+ * @generated from message ntx.v1.RenounceRightsRequest
+ */
+export declare type RenounceRightsRequest = Message<"ntx.v1.RenounceRightsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 units = 3;
+   */
+  units: bigint;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string renounced_on = 4;
+   */
+  renouncedOn: string;
+
+  /**
+   * @generated from field: string note = 5;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message ntx.v1.RenounceRightsRequest.
+ * Use `create(RenounceRightsRequestSchema)` to create a new message.
+ */
+export declare const RenounceRightsRequestSchema: GenMessage<RenounceRightsRequest>;
+
+/**
+ * @generated from message ntx.v1.RenounceRightsResponse
+ */
+export declare type RenounceRightsResponse = Message<"ntx.v1.RenounceRightsResponse"> & {
+  /**
+   * @generated from field: ntx.v1.RightRenunciation renunciation = 1;
+   */
+  renunciation?: RightRenunciation;
+};
+
+/**
+ * Describes the message ntx.v1.RenounceRightsResponse.
+ * Use `create(RenounceRightsResponseSchema)` to create a new message.
+ */
+export declare const RenounceRightsResponseSchema: GenMessage<RenounceRightsResponse>;
+
+/**
+ * @generated from message ntx.v1.ListRenunciationsRequest
+ */
+export declare type ListRenunciationsRequest = Message<"ntx.v1.ListRenunciationsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListRenunciationsRequest.
+ * Use `create(ListRenunciationsRequestSchema)` to create a new message.
+ */
+export declare const ListRenunciationsRequestSchema: GenMessage<ListRenunciationsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListRenunciationsResponse
+ */
+export declare type ListRenunciationsResponse = Message<"ntx.v1.ListRenunciationsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.RightRenunciation renunciations = 1;
+   */
+  renunciations: RightRenunciation[];
+};
+
+/**
+ * Describes the message ntx.v1.ListRenunciationsResponse.
+ * Use `create(ListRenunciationsResponseSchema)` to create a new message.
+ */
+export declare const ListRenunciationsResponseSchema: GenMessage<ListRenunciationsResponse>;
+
+/**
+ * @generated from message ntx.v1.SetRenunciationProceedsRequest
+ */
+export declare type SetRenunciationProceedsRequest = Message<"ntx.v1.SetRenunciationProceedsRequest"> & {
+  /**
+   * @generated from field: int64 renunciation_id = 1;
+   */
+  renunciationId: bigint;
+
+  /**
+   * @generated from field: double proceeds = 2;
+   */
+  proceeds: number;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string received_on = 3;
+   */
+  receivedOn: string;
+};
+
+/**
+ * Describes the message ntx.v1.SetRenunciationProceedsRequest.
+ * Use `create(SetRenunciationProceedsRequestSchema)` to create a new message.
+ */
+export declare const SetRenunciationProceedsRequestSchema: GenMessage<SetRenunciationProceedsRequest>;
+
+/**
+ * @generated from message ntx.v1.SetRenunciationProceedsResponse
+ */
+export declare type SetRenunciationProceedsResponse = Message<"ntx.v1.SetRenunciationProceedsResponse"> & {
+  /**
+   * @generated from field: ntx.v1.RightRenunciation renunciation = 1;
+   */
+  renunciation?: RightRenunciation;
+};
+
+/**
+ * Describes the message ntx.v1.SetRenunciationProceedsResponse.
+ * Use `create(SetRenunciationProceedsResponseSchema)` to create a new message.
+ */
+export declare const SetRenunciationProceedsResponseSchema: GenMessage<SetRenunciationProceedsResponse>;
+
+/**
+ * @generated from enum ntx.v1.IssueType
+ */
+export enum IssueType {
+  /**
+   * @generated from enum value: ISSUE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ISSUE_TYPE_IPO = 1;
+   */
+  IPO = 1,
+
+  /**
+   * @generated from enum value: ISSUE_TYPE_FPO = 2;
+   */
+  FPO = 2,
+
+  /**
+   * @generated from enum value: ISSUE_TYPE_RIGHT = 3;
+   */
+  RIGHT = 3,
+}
+
+/**
+ * Describes the enum ntx.v1.IssueType.
+ */
+export declare const IssueTypeSchema: GenEnum<IssueType>;
+
+/**
+ * @generated from enum ntx.v1.ApplicationStatus
+ */
+export enum ApplicationStatus {
+  /**
+   * @generated from enum value: APPLICATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * amount still blocked
+   *
+   * @generated from enum value: APPLICATION_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: APPLICATION_STATUS_ALLOTTED = 2;
+   */
+  ALLOTTED = 2,
+
+  /**
+   * @generated from enum value: APPLICATION_STATUS_NOT_ALLOTTED = 3;
+   */
+  NOT_ALLOTTED = 3,
+
+  /**
+   * @generated from enum value: APPLICATION_STATUS_WITHDRAWN = 4;
+   */
+  WITHDRAWN = 4,
+}
+
+/**
+ * Describes the enum ntx.v1.ApplicationStatus.
+ */
+export declare const ApplicationStatusSchema: GenEnum<ApplicationStatus>;
+
+/**
+ * ApplicationService tracks share applications made through ASBA, where the
+ * bank blocks the amount until allotment, so blocked funds are visible and
+ * allotted units land in the portfolio.
+ *
+ * @generated from service ntx.v1.ApplicationService
+ */
+export declare const ApplicationService: GenService<{
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.CreateApplication
+   */
+  createApplication: {
+    methodKind: "unary";
+    input: typeof CreateApplicationRequestSchema;
+    output: typeof CreateApplicationResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.ListApplications
+   */
+  listApplications: {
+    methodKind: "unary";
+    input: typeof ListApplicationsRequestSchema;
+    output: typeof ListApplicationsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.WithdrawApplication
+   */
+  withdrawApplication: {
+    methodKind: "unary";
+    input: typeof WithdrawApplicationRequestSchema;
+    output: typeof WithdrawApplicationResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.AllotApplication
+   */
+  allotApplication: {
+    methodKind: "unary";
+    input: typeof AllotApplicationRequestSchema;
+    output: typeof AllotApplicationResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.RenounceRights
+   */
+  renounceRights: {
+    methodKind: "unary";
+    input: typeof RenounceRightsRequestSchema;
+    output: typeof RenounceRightsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.ListRenunciations
+   */
+  listRenunciations: {
+    methodKind: "unary";
+    input: typeof ListRenunciationsRequestSchema;
+    output: typeof ListRenunciationsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.ApplicationService.SetRenunciationProceeds
+   */
+  setRenunciationProceeds: {
+    methodKind: "unary";
+    input: typeof SetRenunciationProceedsRequestSchema;
+    output: typeof SetRenunciationProceedsResponseSchema;
+  },
+}>;
+
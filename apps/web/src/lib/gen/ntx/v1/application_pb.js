@@ -0,0 +1,159 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/application.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_ntx_v1_portfolio } from "./portfolio_pb";
+
+/**
+ * Describes the file ntx/v1/application.proto.
+ */
+export const file_ntx_v1_application = /*@__PURE__*/
+  fileDesc("ChhudHgvdjEvYXBwbGljYXRpb24ucHJvdG8SBm50eC52MSKkAwoQU2hhcmVBcHBsaWNhdGlvbhIKCgJpZBgBIAEoAxIUCgxwb3J0Zm9saW9faWQYAiABKAMSFAoMc3RvY2tfc3ltYm9sGAMgASgJEiUKCmlzc3VlX3R5cGUYBCABKA4yES5udHgudjEuSXNzdWVUeXBlEg0KBXVuaXRzGAUgASgDEhYKDnByaWNlX3Blcl91bml0GAYgASgBEhYKDmFtb3VudF9ibG9ja2VkGAcgASgBEhIKCmFwcGxpZWRfb24YCCABKAkSHQoVZXhwZWN0ZWRfYWxsb3RtZW50X29uGAkgASgJEikKBnN0YXR1cxgKIAEoDjIZLm50eC52MS5BcHBsaWNhdGlvblN0YXR1cxIWCg5hbGxvdHRlZF91bml0cxgLIAEoAxIXCg9hbW91bnRfcmVsZWFzZWQYDCABKAESGwoOdHJhbnNhY3Rpb25faWQYDSABKANIAIgBARIMCgRub3RlGA4gASgJEhEKCWNsb3NlZF9hdBgPIAEoCRISCgpjcmVhdGVkX2F0GBAgASgJQhEKD190cmFuc2FjdGlvbl9pZCLVAQoYQ3JlYXRlQXBwbGljYXRpb25SZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIUCgxzdG9ja19zeW1ib2wYAiABKAkSJQoKaXNzdWVfdHlwZRgDIAEoDjIRLm50eC52MS5Jc3N1ZVR5cGUSDQoFdW5pdHMYBCABKAMSFgoOcHJpY2VfcGVyX3VuaXQYBSABKAESEgoKYXBwbGllZF9vbhgGIAEoCRIdChVleHBlY3RlZF9hbGxvdG1lbnRfb24YByABKAkSDAoEbm90ZRgIIAEoCSJKChlDcmVhdGVBcHBsaWNhdGlvblJlc3BvbnNlEi0KC2FwcGxpY2F0aW9uGAEgASgLMhgubnR4LnYxLlNoYXJlQXBwbGljYXRpb24iVAoXTGlzdEFwcGxpY2F0aW9uc1JlcXVlc3QSLgoGc3RhdHVzGAEgASgOMhkubnR4LnYxLkFwcGxpY2F0aW9uU3RhdHVzSACIAQFCCQoHX3N0YXR1cyJhChhMaXN0QXBwbGljYXRpb25zUmVzcG9uc2USLgoMYXBwbGljYXRpb25zGAEgAygLMhgubnR4LnYxLlNoYXJlQXBwbGljYXRpb24SFQoNdG90YWxfYmxvY2tlZBgCIAEoASI0ChpXaXRoZHJhd0FwcGxpY2F0aW9uUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoAyJMChtXaXRoZHJhd0FwcGxpY2F0aW9uUmVzcG9uc2USLQoLYXBwbGljYXRpb24YASABKAsyGC5udHgudjEuU2hhcmVBcHBsaWNhdGlvbiJeChdBbGxvdEFwcGxpY2F0aW9uUmVxdWVzdBIWCg5hcHBsaWNhdGlvbl9pZBgBIAEoAxIWCg5hbGxvdHRlZF91bml0cxgCIAEoAxITCgthbGxvdHRlZF9vbhgDIAEoCSJzChhBbGxvdEFwcGxpY2F0aW9uUmVzcG9uc2USLQoLYXBwbGljYXRpb24YASABKAsyGC5udHgudjEuU2hhcmVBcHBsaWNhdGlvbhIoCgt0cmFuc2FjdGlvbhgCIAEoCzITLm50eC52MS5UcmFuc2FjdGlvbiLCAQoRUmlnaHRSZW51bmNpYXRpb24SCgoCaWQYASABKAMSFAoMcG9ydGZvbGlvX2lkGAIgASgDEhQKDHN0b2NrX3N5bWJvbBgDIAEoCRINCgV1bml0cxgEIAEoAxIUCgxyZW5vdW5jZWRfb24YBSABKAkSEAoIcHJvY2VlZHMYBiABKAESHAoUcHJvY2VlZHNfcmVjZWl2ZWRfb24YByABKAkSDAoEbm90ZRgIIAEoCRISCgpjcmVhdGVkX2F0GAkgASgJInYKFVJlbm91bmNlUmlnaHRzUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSFAoMc3RvY2tfc3ltYm9sGAIgASgJEg0KBXVuaXRzGAMgASgDEhQKDHJlbm91bmNlZF9vbhgEIAEoCRIMCgRub3RlGAUgASgJIkkKFlJlbm91bmNlUmlnaHRzUmVzcG9uc2USLwoMcmVudW5jaWF0aW9uGAEgASgLMhkubnR4LnYxLlJpZ2h0UmVudW5jaWF0aW9uIhoKGExpc3RSZW51bmNpYXRpb25zUmVxdWVzdCJNChlMaXN0UmVudW5jaWF0aW9uc1Jlc3BvbnNlEjAKDXJlbnVuY2lhdGlvbnMYASADKAsyGS5udHgudjEuUmlnaHRSZW51bmNpYXRpb24iYAoeU2V0UmVudW5jaWF0aW9uUHJvY2VlZHNSZXF1ZXN0EhcKD3JlbnVuY2lhdGlvbl9pZBgBIAEoAxIQCghwcm9jZWVkcxgCIAEoARITCgtyZWNlaXZlZF9vbhgDIAEoCSJSCh9TZXRSZW51bmNpYXRpb25Qcm9jZWVkc1Jlc3BvbnNlEi8KDHJlbnVuY2lhdGlvbhgBIAEoCzIZLm50eC52MS5SaWdodFJlbnVuY2lhdGlvbiplCglJc3N1ZVR5cGUSGgoWSVNTVUVfVFlQRV9VTlNQRUNJRklFRBAAEhIKDklTU1VFX1RZUEVfSVBPEAESEgoOSVNTVUVfVFlQRV9GUE8QAhIUChBJU1NVRV9UWVBFX1JJR0hUEAMqvwEKEUFwcGxpY2F0aW9uU3RhdHVzEiIKHkFQUExJQ0FUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh4KGkFQUExJQ0FUSU9OX1NUQVRVU19QRU5ESU5HEAESHwobQVBQTElDQVRJT05fU1RBVFVTX0FMTE9UVEVEEAISIwofQVBQTElDQVRJT05fU1RBVFVTX05PVF9BTExPVFRFRBADEiAKHEFQUExJQ0FUSU9OX1NUQVRVU19XSVRIRFJBV04QBDKTBQoSQXBwbGljYXRpb25TZXJ2aWNlElgKEUNyZWF0ZUFwcGxpY2F0aW9uEiAubnR4LnYxLkNyZWF0ZUFwcGxpY2F0aW9uUmVxdWVzdBohLm50eC52MS5DcmVhdGVBcHBsaWNhdGlvblJlc3BvbnNlElUKEExpc3RBcHBsaWNhdGlvbnMSHy5udHgudjEuTGlzdEFwcGxpY2F0aW9uc1JlcXVlc3QaIC5udHgudjEuTGlzdEFwcGxpY2F0aW9uc1Jlc3BvbnNlEl4KE1dpdGhkcmF3QXBwbGljYXRpb24SIi5udHgudjEuV2l0aGRyYXdBcHBsaWNhdGlvblJlcXVlc3QaIy5udHgudjEuV2l0aGRyYXdBcHBsaWNhdGlvblJlc3BvbnNlElUKEEFsbG90QXBwbGljYXRpb24SHy5udHgudjEuQWxsb3RBcHBsaWNhdGlvblJlcXVlc3QaIC5udHgudjEuQWxsb3RBcHBsaWNhdGlvblJlc3BvbnNlEk8KDlJlbm91bmNlUmlnaHRzEh0ubnR4LnYxLlJlbm91bmNlUmlnaHRzUmVxdWVzdBoeLm50eC52MS5SZW5vdW5jZVJpZ2h0c1Jlc3BvbnNlElgKEUxpc3RSZW51bmNpYXRpb25zEiAubnR4LnYxLkxpc3RSZW51bmNpYXRpb25zUmVxdWVzdBohLm50eC52MS5MaXN0UmVudW5jaWF0aW9uc1Jlc3BvbnNlEmoKF1NldFJlbnVuY2lhdGlvblByb2NlZWRzEiYubnR4LnYxLlNldFJlbnVuY2lhdGlvblByb2NlZWRzUmVxdWVzdBonLm50eC52MS5TZXRSZW51bmNpYXRpb25Qcm9jZWVkc1Jlc3BvbnNlQjBaLmdpdGh1Yi5jb20vdm9pZGFyY2hpdmUvbnR4L2dlbi9nby9udHgvdjE7bnR4djFiBnByb3RvMw", [file_ntx_v1_portfolio]);
+
+/**
+ * Describes the message ntx.v1.ShareApplication.
+ * Use `create(ShareApplicationSchema)` to create a new message.
+ */
+export const ShareApplicationSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 0);
+
+/**
+ * Describes the message ntx.v1.CreateApplicationRequest.
+ * Use `create(CreateApplicationRequestSchema)` to create a new message.
+ */
+export const CreateApplicationRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 1);
+
+/**
+ * Describes the message ntx.v1.CreateApplicationResponse.
+ * Use `create(CreateApplicationResponseSchema)` to create a new message.
+ */
+export const CreateApplicationResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 2);
+
+/**
+ * Describes the message ntx.v1.ListApplicationsRequest.
+ * Use `create(ListApplicationsRequestSchema)` to create a new message.
+ */
+export const ListApplicationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 3);
+
+/**
+ * Describes the message ntx.v1.ListApplicationsResponse.
+ * Use `create(ListApplicationsResponseSchema)` to create a new message.
+ */
+export const ListApplicationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 4);
+
+/**
+ * Describes the message ntx.v1.WithdrawApplicationRequest.
+ * Use `create(WithdrawApplicationRequestSchema)` to create a new message.
+ */
+export const WithdrawApplicationRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 5);
+
+/**
+ * Describes the message ntx.v1.WithdrawApplicationResponse.
+ * Use `create(WithdrawApplicationResponseSchema)` to create a new message.
+ */
+export const WithdrawApplicationResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 6);
+
+/**
+ * Describes the message ntx.v1.AllotApplicationRequest.
+ * Use `create(AllotApplicationRequestSchema)` to create a new message.
+ */
+export const AllotApplicationRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 7);
+
+/**
+ * Describes the message ntx.v1.AllotApplicationResponse.
+ * Use `create(AllotApplicationResponseSchema)` to create a new message.
+ */
+export const AllotApplicationResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 8);
+
+/**
+ * Describes the message ntx.v1.RightRenunciation.
+ * Use `create(RightRenunciationSchema)` to create a new message.
+ */
+export const RightRenunciationSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 9);
+
+/**
+ * Describes the message ntx.v1.RenounceRightsRequest.
+ * Use `create(RenounceRightsRequestSchema)` to create a new message.
+ */
+export const RenounceRightsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 10);
+
+/**
+ * Describes the message ntx.v1.RenounceRightsResponse.
+ * Use `create(RenounceRightsResponseSchema)` to create a new message.
+ */
+export const RenounceRightsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 11);
+
+/**
+ * Describes the message ntx.v1.ListRenunciationsRequest.
+ * Use `create(ListRenunciationsRequestSchema)` to create a new message.
+ */
+export const ListRenunciationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 12);
+
+/**
+ * Describes the message ntx.v1.ListRenunciationsResponse.
+ * Use `create(ListRenunciationsResponseSchema)` to create a new message.
+ */
+export const ListRenunciationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 13);
+
+/**
+ * Describes the message ntx.v1.SetRenunciationProceedsRequest.
+ * Use `create(SetRenunciationProceedsRequestSchema)` to create a new message.
+ */
+export const SetRenunciationProceedsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 14);
+
+/**
+ * Describes the message ntx.v1.SetRenunciationProceedsResponse.
+ * Use `create(SetRenunciationProceedsResponseSchema)` to create a new message.
+ */
+export const SetRenunciationProceedsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_application, 15);
+
+/**
+ * Describes the enum ntx.v1.IssueType.
+ */
+export const IssueTypeSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_application, 0);
+
+/**
+ * @generated from enum ntx.v1.IssueType
+ */
+export const IssueType = /*@__PURE__*/
+  tsEnum(IssueTypeSchema);
+
+/**
+ * Describes the enum ntx.v1.ApplicationStatus.
+ */
+export const ApplicationStatusSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_application, 1);
+
+/**
+ * @generated from enum ntx.v1.ApplicationStatus
+ */
+export const ApplicationStatus = /*@__PURE__*/
+  tsEnum(ApplicationStatusSchema);
+
+/**
+ * ApplicationService tracks share applications made through ASBA, where the
+ * bank blocks the amount until allotment, so blocked funds are visible and
+ * allotted units land in the portfolio.
+ *
+ * @generated from service ntx.v1.ApplicationService
+ */
+export const ApplicationService = /*@__PURE__*/
+  serviceDesc(file_ntx_v1_application, 0);
+
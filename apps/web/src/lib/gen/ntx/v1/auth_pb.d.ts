@@ -23,6 +23,13 @@ export declare type LoginRequest = Message<"ntx.v1.LoginRequest"> & {
    * @generated from field: string password = 2;
    */
   password: string;
+
+  /**
+   * authenticator code or recovery code, if 2FA is on
+   *
+   * @generated from field: string otp_code = 3;
+   */
+  otpCode: string;
 };
 
 /**
@@ -44,6 +51,14 @@ export declare type LoginResponse = Message<"ntx.v1.LoginResponse"> & {
    * @generated from field: int64 user_id = 2;
    */
   userId: bigint;
+
+  /**
+   * Set with an empty token when the password was right but 2FA is on and
+   * otp_code was not given; retry the login with it.
+   *
+   * @generated from field: bool otp_required = 3;
+   */
+  otpRequired: boolean;
 };
 
 /**
@@ -89,6 +104,268 @@ export declare type RegisterResponse = Message<"ntx.v1.RegisterResponse"> & {
  */
 export declare const RegisterResponseSchema: GenMessage<RegisterResponse>;
 
+/**
+ * @generated from message ntx.v1.CreateWidgetTokenRequest
+ */
+export declare type CreateWidgetTokenRequest = Message<"ntx.v1.CreateWidgetTokenRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.CreateWidgetTokenRequest.
+ * Use `create(CreateWidgetTokenRequestSchema)` to create a new message.
+ */
+export declare const CreateWidgetTokenRequestSchema: GenMessage<CreateWidgetTokenRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateWidgetTokenResponse
+ */
+export declare type CreateWidgetTokenResponse = Message<"ntx.v1.CreateWidgetTokenResponse"> & {
+  /**
+   * shown once; only its hash is stored
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message ntx.v1.CreateWidgetTokenResponse.
+ * Use `create(CreateWidgetTokenResponseSchema)` to create a new message.
+ */
+export declare const CreateWidgetTokenResponseSchema: GenMessage<CreateWidgetTokenResponse>;
+
+/**
+ * @generated from message ntx.v1.RevokeWidgetTokenRequest
+ */
+export declare type RevokeWidgetTokenRequest = Message<"ntx.v1.RevokeWidgetTokenRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.RevokeWidgetTokenRequest.
+ * Use `create(RevokeWidgetTokenRequestSchema)` to create a new message.
+ */
+export declare const RevokeWidgetTokenRequestSchema: GenMessage<RevokeWidgetTokenRequest>;
+
+/**
+ * @generated from message ntx.v1.RevokeWidgetTokenResponse
+ */
+export declare type RevokeWidgetTokenResponse = Message<"ntx.v1.RevokeWidgetTokenResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.RevokeWidgetTokenResponse.
+ * Use `create(RevokeWidgetTokenResponseSchema)` to create a new message.
+ */
+export declare const RevokeWidgetTokenResponseSchema: GenMessage<RevokeWidgetTokenResponse>;
+
+/**
+ * A signed-in device. Sessions are identified by id; the token itself is never
+ * listed.
+ *
+ * @generated from message ntx.v1.Session
+ */
+export declare type Session = Message<"ntx.v1.Session"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string user_agent = 2;
+   */
+  userAgent: string;
+
+  /**
+   * @generated from field: string ip_address = 3;
+   */
+  ipAddress: string;
+
+  /**
+   * @generated from field: string created_at = 4;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string last_seen_at = 5;
+   */
+  lastSeenAt: string;
+
+  /**
+   * @generated from field: string expires_at = 6;
+   */
+  expiresAt: string;
+
+  /**
+   * the session making this request
+   *
+   * @generated from field: bool current = 7;
+   */
+  current: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export declare const SessionSchema: GenMessage<Session>;
+
+/**
+ * @generated from message ntx.v1.ListSessionsRequest
+ */
+export declare type ListSessionsRequest = Message<"ntx.v1.ListSessionsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export declare const ListSessionsRequestSchema: GenMessage<ListSessionsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListSessionsResponse
+ */
+export declare type ListSessionsResponse = Message<"ntx.v1.ListSessionsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Session sessions = 1;
+   */
+  sessions: Session[];
+};
+
+/**
+ * Describes the message ntx.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export declare const ListSessionsResponseSchema: GenMessage<ListSessionsResponse>;
+
+/**
+ * @generated from message ntx.v1.RevokeSessionRequest
+ */
+export declare type RevokeSessionRequest = Message<"ntx.v1.RevokeSessionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message ntx.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export declare const RevokeSessionRequestSchema: GenMessage<RevokeSessionRequest>;
+
+/**
+ * @generated from message ntx.v1.RevokeSessionResponse
+ */
+export declare type RevokeSessionResponse = Message<"ntx.v1.RevokeSessionResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export declare const RevokeSessionResponseSchema: GenMessage<RevokeSessionResponse>;
+
+/**
+ * @generated from message ntx.v1.BeginTotpEnrollmentRequest
+ */
+export declare type BeginTotpEnrollmentRequest = Message<"ntx.v1.BeginTotpEnrollmentRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.BeginTotpEnrollmentRequest.
+ * Use `create(BeginTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export declare const BeginTotpEnrollmentRequestSchema: GenMessage<BeginTotpEnrollmentRequest>;
+
+/**
+ * @generated from message ntx.v1.BeginTotpEnrollmentResponse
+ */
+export declare type BeginTotpEnrollmentResponse = Message<"ntx.v1.BeginTotpEnrollmentResponse"> & {
+  /**
+   * base32, for manual entry
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * for QR codes
+   *
+   * @generated from field: string otpauth_url = 2;
+   */
+  otpauthUrl: string;
+};
+
+/**
+ * Describes the message ntx.v1.BeginTotpEnrollmentResponse.
+ * Use `create(BeginTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export declare const BeginTotpEnrollmentResponseSchema: GenMessage<BeginTotpEnrollmentResponse>;
+
+/**
+ * @generated from message ntx.v1.ConfirmTotpEnrollmentRequest
+ */
+export declare type ConfirmTotpEnrollmentRequest = Message<"ntx.v1.ConfirmTotpEnrollmentRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message ntx.v1.ConfirmTotpEnrollmentRequest.
+ * Use `create(ConfirmTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export declare const ConfirmTotpEnrollmentRequestSchema: GenMessage<ConfirmTotpEnrollmentRequest>;
+
+/**
+ * @generated from message ntx.v1.ConfirmTotpEnrollmentResponse
+ */
+export declare type ConfirmTotpEnrollmentResponse = Message<"ntx.v1.ConfirmTotpEnrollmentResponse"> & {
+  /**
+   * shown once; only hashes are stored
+   *
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message ntx.v1.ConfirmTotpEnrollmentResponse.
+ * Use `create(ConfirmTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export declare const ConfirmTotpEnrollmentResponseSchema: GenMessage<ConfirmTotpEnrollmentResponse>;
+
+/**
+ * @generated from message ntx.v1.DisableTotpRequest
+ */
+export declare type DisableTotpRequest = Message<"ntx.v1.DisableTotpRequest"> & {
+  /**
+   * authenticator code or recovery code
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message ntx.v1.DisableTotpRequest.
+ * Use `create(DisableTotpRequestSchema)` to create a new message.
+ */
+export declare const DisableTotpRequestSchema: GenMessage<DisableTotpRequest>;
+
+/**
+ * @generated from message ntx.v1.DisableTotpResponse
+ */
+export declare type DisableTotpResponse = Message<"ntx.v1.DisableTotpResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DisableTotpResponse.
+ * Use `create(DisableTotpResponseSchema)` to create a new message.
+ */
+export declare const DisableTotpResponseSchema: GenMessage<DisableTotpResponse>;
+
 /**
  * @generated from service ntx.v1.AuthService
  */
@@ -109,5 +386,61 @@ export declare const AuthService: GenService<{
     input: typeof RegisterRequestSchema;
     output: typeof RegisterResponseSchema;
   },
+  /**
+   * @generated from rpc ntx.v1.AuthService.CreateWidgetToken
+   */
+  createWidgetToken: {
+    methodKind: "unary";
+    input: typeof CreateWidgetTokenRequestSchema;
+    output: typeof CreateWidgetTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.RevokeWidgetToken
+   */
+  revokeWidgetToken: {
+    methodKind: "unary";
+    input: typeof RevokeWidgetTokenRequestSchema;
+    output: typeof RevokeWidgetTokenResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.ListSessions
+   */
+  listSessions: {
+    methodKind: "unary";
+    input: typeof ListSessionsRequestSchema;
+    output: typeof ListSessionsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.RevokeSession
+   */
+  revokeSession: {
+    methodKind: "unary";
+    input: typeof RevokeSessionRequestSchema;
+    output: typeof RevokeSessionResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.BeginTotpEnrollment
+   */
+  beginTotpEnrollment: {
+    methodKind: "unary";
+    input: typeof BeginTotpEnrollmentRequestSchema;
+    output: typeof BeginTotpEnrollmentResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.ConfirmTotpEnrollment
+   */
+  confirmTotpEnrollment: {
+    methodKind: "unary";
+    input: typeof ConfirmTotpEnrollmentRequestSchema;
+    output: typeof ConfirmTotpEnrollmentResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.AuthService.DisableTotp
+   */
+  disableTotp: {
+    methodKind: "unary";
+    input: typeof DisableTotpRequestSchema;
+    output: typeof DisableTotpResponseSchema;
+  },
 }>;
 
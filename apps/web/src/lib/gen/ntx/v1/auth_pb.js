@@ -8,7 +8,7 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1
  * Describes the file ntx/v1/auth.proto.
  */
 export const file_ntx_v1_auth = /*@__PURE__*/
-  fileDesc("ChFudHgvdjEvYXV0aC5wcm90bxIGbnR4LnYxIi8KDExvZ2luUmVxdWVzdBINCgVlbWFpbBgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIvCg1Mb2dpblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEg8KB3VzZXJfaWQYAiABKAMiMgoPUmVnaXN0ZXJSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIiMKEFJlZ2lzdGVyUmVzcG9uc2USDwoHdXNlcl9pZBgBIAEoAzKCAQoLQXV0aFNlcnZpY2USNAoFTG9naW4SFC5udHgudjEuTG9naW5SZXF1ZXN0GhUubnR4LnYxLkxvZ2luUmVzcG9uc2USPQoIUmVnaXN0ZXISFy5udHgudjEuUmVnaXN0ZXJSZXF1ZXN0GhgubnR4LnYxLlJlZ2lzdGVyUmVzcG9uc2VCMFouZ2l0aHViLmNvbS92b2lkYXJjaGl2ZS9udHgvZ2VuL2dvL250eC92MTtudHh2MWIGcHJvdG8z");
+  fileDesc("ChFudHgvdjEvYXV0aC5wcm90bxIGbnR4LnYxIkEKDExvZ2luUmVxdWVzdBINCgVlbWFpbBgBIAEoCRIQCghwYXNzd29yZBgCIAEoCRIQCghvdHBfY29kZRgDIAEoCSJFCg1Mb2dpblJlc3BvbnNlEg0KBXRva2VuGAEgASgJEg8KB3VzZXJfaWQYAiABKAMSFAoMb3RwX3JlcXVpcmVkGAMgASgIIjIKD1JlZ2lzdGVyUmVxdWVzdBINCgVlbWFpbBgBIAEoCRIQCghwYXNzd29yZBgCIAEoCSIjChBSZWdpc3RlclJlc3BvbnNlEg8KB3VzZXJfaWQYASABKAMiGgoYQ3JlYXRlV2lkZ2V0VG9rZW5SZXF1ZXN0IioKGUNyZWF0ZVdpZGdldFRva2VuUmVzcG9uc2USDQoFdG9rZW4YASABKAkiGgoYUmV2b2tlV2lkZ2V0VG9rZW5SZXF1ZXN0IhsKGVJldm9rZVdpZGdldFRva2VuUmVzcG9uc2UijAEKB1Nlc3Npb24SCgoCaWQYASABKAkSEgoKdXNlcl9hZ2VudBgCIAEoCRISCgppcF9hZGRyZXNzGAMgASgJEhIKCmNyZWF0ZWRfYXQYBCABKAkSFAoMbGFzdF9zZWVuX2F0GAUgASgJEhIKCmV4cGlyZXNfYXQYBiABKAkSDwoHY3VycmVudBgHIAEoCCIVChNMaXN0U2Vzc2lvbnNSZXF1ZXN0IjkKFExpc3RTZXNzaW9uc1Jlc3BvbnNlEiEKCHNlc3Npb25zGAEgAygLMg8ubnR4LnYxLlNlc3Npb24iIgoUUmV2b2tlU2Vzc2lvblJlcXVlc3QSCgoCaWQYASABKAkiFwoVUmV2b2tlU2Vzc2lvblJlc3BvbnNlIhwKGkJlZ2luVG90cEVucm9sbG1lbnRSZXF1ZXN0IkIKG0JlZ2luVG90cEVucm9sbG1lbnRSZXNwb25zZRIOCgZzZWNyZXQYASABKAkSEwoLb3RwYXV0aF91cmwYAiABKAkiLAocQ29uZmlybVRvdHBFbnJvbGxtZW50UmVxdWVzdBIMCgRjb2RlGAEgASgJIjcKHUNvbmZpcm1Ub3RwRW5yb2xsbWVudFJlc3BvbnNlEhYKDnJlY292ZXJ5X2NvZGVzGAEgAygJIiIKEkRpc2FibGVUb3RwUmVxdWVzdBIMCgRjb2RlGAEgASgJIhUKE0Rpc2FibGVUb3RwUmVzcG9uc2Uy3QUKC0F1dGhTZXJ2aWNlEjQKBUxvZ2luEhQubnR4LnYxLkxvZ2luUmVxdWVzdBoVLm50eC52MS5Mb2dpblJlc3BvbnNlEj0KCFJlZ2lzdGVyEhcubnR4LnYxLlJlZ2lzdGVyUmVxdWVzdBoYLm50eC52MS5SZWdpc3RlclJlc3BvbnNlElgKEUNyZWF0ZVdpZGdldFRva2VuEiAubnR4LnYxLkNyZWF0ZVdpZGdldFRva2VuUmVxdWVzdBohLm50eC52MS5DcmVhdGVXaWRnZXRUb2tlblJlc3BvbnNlElgKEVJldm9rZVdpZGdldFRva2VuEiAubnR4LnYxLlJldm9rZVdpZGdldFRva2VuUmVxdWVzdBohLm50eC52MS5SZXZva2VXaWRnZXRUb2tlblJlc3BvbnNlEkkKDExpc3RTZXNzaW9ucxIbLm50eC52MS5MaXN0U2Vzc2lvbnNSZXF1ZXN0GhwubnR4LnYxLkxpc3RTZXNzaW9uc1Jlc3BvbnNlEkwKDVJldm9rZVNlc3Npb24SHC5udHgudjEuUmV2b2tlU2Vzc2lvblJlcXVlc3QaHS5udHgudjEuUmV2b2tlU2Vzc2lvblJlc3BvbnNlEl4KE0JlZ2luVG90cEVucm9sbG1lbnQSIi5udHgudjEuQmVnaW5Ub3RwRW5yb2xsbWVudFJlcXVlc3QaIy5udHgudjEuQmVnaW5Ub3RwRW5yb2xsbWVudFJlc3BvbnNlEmQKFUNvbmZpcm1Ub3RwRW5yb2xsbWVudBIkLm50eC52MS5Db25maXJtVG90cEVucm9sbG1lbnRSZXF1ZXN0GiUubnR4LnYxLkNvbmZpcm1Ub3RwRW5yb2xsbWVudFJlc3BvbnNlEkYKC0Rpc2FibGVUb3RwEhoubnR4LnYxLkRpc2FibGVUb3RwUmVxdWVzdBobLm50eC52MS5EaXNhYmxlVG90cFJlc3BvbnNlQjBaLmdpdGh1Yi5jb20vdm9pZGFyY2hpdmUvbnR4L2dlbi9nby9udHgvdjE7bnR4djFiBnByb3RvMw");
 
 /**
  * Describes the message ntx.v1.LoginRequest.
@@ -38,6 +38,111 @@ export const RegisterRequestSchema = /*@__PURE__*/
 export const RegisterResponseSchema = /*@__PURE__*/
   messageDesc(file_ntx_v1_auth, 3);
 
+/**
+ * Describes the message ntx.v1.CreateWidgetTokenRequest.
+ * Use `create(CreateWidgetTokenRequestSchema)` to create a new message.
+ */
+export const CreateWidgetTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 4);
+
+/**
+ * Describes the message ntx.v1.CreateWidgetTokenResponse.
+ * Use `create(CreateWidgetTokenResponseSchema)` to create a new message.
+ */
+export const CreateWidgetTokenResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 5);
+
+/**
+ * Describes the message ntx.v1.RevokeWidgetTokenRequest.
+ * Use `create(RevokeWidgetTokenRequestSchema)` to create a new message.
+ */
+export const RevokeWidgetTokenRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 6);
+
+/**
+ * Describes the message ntx.v1.RevokeWidgetTokenResponse.
+ * Use `create(RevokeWidgetTokenResponseSchema)` to create a new message.
+ */
+export const RevokeWidgetTokenResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 7);
+
+/**
+ * Describes the message ntx.v1.Session.
+ * Use `create(SessionSchema)` to create a new message.
+ */
+export const SessionSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 8);
+
+/**
+ * Describes the message ntx.v1.ListSessionsRequest.
+ * Use `create(ListSessionsRequestSchema)` to create a new message.
+ */
+export const ListSessionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 9);
+
+/**
+ * Describes the message ntx.v1.ListSessionsResponse.
+ * Use `create(ListSessionsResponseSchema)` to create a new message.
+ */
+export const ListSessionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 10);
+
+/**
+ * Describes the message ntx.v1.RevokeSessionRequest.
+ * Use `create(RevokeSessionRequestSchema)` to create a new message.
+ */
+export const RevokeSessionRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 11);
+
+/**
+ * Describes the message ntx.v1.RevokeSessionResponse.
+ * Use `create(RevokeSessionResponseSchema)` to create a new message.
+ */
+export const RevokeSessionResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 12);
+
+/**
+ * Describes the message ntx.v1.BeginTotpEnrollmentRequest.
+ * Use `create(BeginTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export const BeginTotpEnrollmentRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 13);
+
+/**
+ * Describes the message ntx.v1.BeginTotpEnrollmentResponse.
+ * Use `create(BeginTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export const BeginTotpEnrollmentResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 14);
+
+/**
+ * Describes the message ntx.v1.ConfirmTotpEnrollmentRequest.
+ * Use `create(ConfirmTotpEnrollmentRequestSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrollmentRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 15);
+
+/**
+ * Describes the message ntx.v1.ConfirmTotpEnrollmentResponse.
+ * Use `create(ConfirmTotpEnrollmentResponseSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrollmentResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 16);
+
+/**
+ * Describes the message ntx.v1.DisableTotpRequest.
+ * Use `create(DisableTotpRequestSchema)` to create a new message.
+ */
+export const DisableTotpRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 17);
+
+/**
+ * Describes the message ntx.v1.DisableTotpResponse.
+ * Use `create(DisableTotpResponseSchema)` to create a new message.
+ */
+export const DisableTotpResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_auth, 18);
+
 /**
  * @generated from service ntx.v1.AuthService
  */
@@ -405,6 +405,39 @@ export enum Sector {
  */
 export declare const SectorSchema: GenEnum<Sector>;
 
+/**
+ * Trading status on NEPSE. Suspended scrips can't be traded until the
+ * suspension lifts; delisted ones no longer trade at all.
+ *
+ * @generated from enum ntx.v1.ListingStatus
+ */
+export enum ListingStatus {
+  /**
+   * @generated from enum value: LISTING_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: LISTING_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * @generated from enum value: LISTING_STATUS_SUSPENDED = 2;
+   */
+  SUSPENDED = 2,
+
+  /**
+   * @generated from enum value: LISTING_STATUS_DELISTED = 3;
+   */
+  DELISTED = 3,
+}
+
+/**
+ * Describes the enum ntx.v1.ListingStatus.
+ */
+export declare const ListingStatusSchema: GenEnum<ListingStatus>;
+
 /**
  * @generated from enum ntx.v1.InstrumentType
  */
@@ -8,7 +8,7 @@ import { enumDesc, fileDesc, messageDesc, tsEnum } from "@bufbuild/protobuf/code
  * Describes the file ntx/v1/common.proto.
  */
 export const file_ntx_v1_common = /*@__PURE__*/
-  fileDesc("ChNudHgvdjEvY29tbW9uLnByb3RvEgZudHgudjEimQIKB0NvbXBhbnkSCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIOCgZzeW1ib2wYAyABKAkSJQoGc3RhdHVzGAQgASgOMhUubnR4LnYxLkNvbXBhbnlTdGF0dXMSEgoFZW1haWwYBSABKAlIAIgBARIUCgd3ZWJzaXRlGAYgASgJSAGIAQESHgoGc2VjdG9yGAcgASgOMg4ubnR4LnYxLlNlY3RvchIvCg9pbnN0cnVtZW50X3R5cGUYCCABKA4yFi5udHgudjEuSW5zdHJ1bWVudFR5cGUSGgoNbGlzdGVkX3NoYXJlcxgJIAEoA0gCiAEBQggKBl9lbWFpbEIKCghfd2Vic2l0ZUIQCg5fbGlzdGVkX3NoYXJlcyKqAgoLRnVuZGFtZW50YWwSCgoCaWQYASABKAMSEgoKY29tcGFueV9pZBgCIAEoAxITCgtmaXNjYWxfeWVhchgDIAEoCRIUCgdxdWFydGVyGAQgASgJSACIAQESEAoDZXBzGAUgASgBSAGIAQESFQoIcGVfcmF0aW8YBiABKAFIAogBARIXCgpib29rX3ZhbHVlGAcgASgBSAOIAQESHAoPcGFpZF91cF9jYXBpdGFsGAggASgBSASIAQESGgoNcHJvZml0X2Ftb3VudBgJIAEoAUgFiAEBQgoKCF9xdWFydGVyQgYKBF9lcHNCCwoJX3BlX3JhdGlvQg0KC19ib29rX3ZhbHVlQhIKEF9wYWlkX3VwX2NhcGl0YWxCEAoOX3Byb2ZpdF9hbW91bnQirAMKBVByaWNlEgoKAmlkGAEgASgDEhIKCmNvbXBhbnlfaWQYAiABKAMSFQoNYnVzaW5lc3NfZGF0ZRgDIAEoCRIRCgRvcGVuGAQgASgBSACIAQESEQoEaGlnaBgFIAEoAUgBiAEBEhAKA2xvdxgGIAEoAUgCiAEBEhIKBWNsb3NlGAcgASgBSAOIAQESEAoDbHRwGAggASgBSASIAQESGwoOcHJldmlvdXNfY2xvc2UYCSABKAFIBYgBARITCgZjaGFuZ2UYCiABKAFIBogBARIbCg5jaGFuZ2VfcGVyY2VudBgLIAEoAUgHiAEBEhMKBnZvbHVtZRgMIAEoA0gIiAEBEhUKCHR1cm5vdmVyGA0gASgBSAmIAQESEwoGdHJhZGVzGA4gASgFSAqIAQFCBwoFX29wZW5CBwoFX2hpZ2hCBgoEX2xvd0IICgZfY2xvc2VCBgoEX2x0cEIRCg9fcHJldmlvdXNfY2xvc2VCCQoHX2NoYW5nZUIRCg9fY2hhbmdlX3BlcmNlbnRCCQoHX3ZvbHVtZUILCglfdHVybm92ZXJCCQoHX3RyYWRlcyKsAQoJT3duZXJzaGlwEhIKCmNvbXBhbnlfaWQYASABKAMSFQoNbGlzdGVkX3NoYXJlcxgCIAEoAxIVCg1wdWJsaWNfc2hhcmVzGAMgASgDEhYKDnB1YmxpY19wZXJjZW50GAQgASgBEhcKD3Byb21vdGVyX3NoYXJlcxgFIAEoAxIYChBwcm9tb3Rlcl9wZXJjZW50GAYgASgBEhIKCnVwZGF0ZWRfYXQYByABKAki2gEKD0NvcnBvcmF0ZUFjdGlvbhIKCgJpZBgBIAEoAxISCgpjb21wYW55X2lkGAIgASgDEhMKC2Zpc2NhbF95ZWFyGAMgASgJEhgKEGJvbnVzX3BlcmNlbnRhZ2UYBCABKAESHQoQcmlnaHRfcGVyY2VudGFnZRgFIAEoAUgAiAEBEhoKDWNhc2hfZGl2aWRlbmQYBiABKAFIAYgBARIWCg5zdWJtaXR0ZWRfZGF0ZRgHIAEoCUITChFfcmlnaHRfcGVyY2VudGFnZUIQCg5fY2FzaF9kaXZpZGVuZCqFAQoNQ29tcGFueVN0YXR1cxIeChpDT01QQU5ZX1NUQVRVU19VTlNQRUNJRklFRBAAEhkKFUNPTVBBTllfU1RBVFVTX0FDVElWRRABEhwKGENPTVBBTllfU1RBVFVTX1NVU1BFTkRFRBACEhsKF0NPTVBBTllfU1RBVFVTX0RFTElTVEVEEAMq2QIKBlNlY3RvchIWChJTRUNUT1JfVU5TUEVDSUZJRUQQABIaChZTRUNUT1JfQ09NTUVSQ0lBTF9CQU5LEAESGwoXU0VDVE9SX0RFVkVMT1BNRU5UX0JBTksQAhISCg5TRUNUT1JfRklOQU5DRRADEhcKE1NFQ1RPUl9NSUNST0ZJTkFOQ0UQBBIZChVTRUNUT1JfTElGRV9JTlNVUkFOQ0UQBRIdChlTRUNUT1JfTk9OX0xJRkVfSU5TVVJBTkNFEAYSFQoRU0VDVE9SX0hZRFJPUE9XRVIQBxIYChRTRUNUT1JfTUFOVUZBQ1RVUklORxAIEhAKDFNFQ1RPUl9IT1RFTBAJEhIKDlNFQ1RPUl9UUkFESU5HEAoSFQoRU0VDVE9SX0lOVkVTVE1FTlQQCxIWChJTRUNUT1JfTVVUVUFMX0ZVTkQQDBIRCg1TRUNUT1JfT1RIRVJTEA0qiAEKDkluc3RydW1lbnRUeXBlEh8KG0lOU1RSVU1FTlRfVFlQRV9VTlNQRUNJRklFRBAAEhoKFklOU1RSVU1FTlRfVFlQRV9FUVVJVFkQARIYChRJTlNUUlVNRU5UX1RZUEVfQk9ORBACEh8KG0lOU1RSVU1FTlRfVFlQRV9NVVRVQUxfRlVORBADQjBaLmdpdGh1Yi5jb20vdm9pZGFyY2hpdmUvbnR4L2dlbi9nby9udHgvdjE7bnR4djFiBnByb3RvMw");
+  fileDesc("ChNudHgvdjEvY29tbW9uLnByb3RvEgZudHgudjEimQIKB0NvbXBhbnkSCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIOCgZzeW1ib2wYAyABKAkSJQoGc3RhdHVzGAQgASgOMhUubnR4LnYxLkNvbXBhbnlTdGF0dXMSEgoFZW1haWwYBSABKAlIAIgBARIUCgd3ZWJzaXRlGAYgASgJSAGIAQESHgoGc2VjdG9yGAcgASgOMg4ubnR4LnYxLlNlY3RvchIvCg9pbnN0cnVtZW50X3R5cGUYCCABKA4yFi5udHgudjEuSW5zdHJ1bWVudFR5cGUSGgoNbGlzdGVkX3NoYXJlcxgJIAEoA0gCiAEBQggKBl9lbWFpbEIKCghfd2Vic2l0ZUIQCg5fbGlzdGVkX3NoYXJlcyKqAgoLRnVuZGFtZW50YWwSCgoCaWQYASABKAMSEgoKY29tcGFueV9pZBgCIAEoAxITCgtmaXNjYWxfeWVhchgDIAEoCRIUCgdxdWFydGVyGAQgASgJSACIAQESEAoDZXBzGAUgASgBSAGIAQESFQoIcGVfcmF0aW8YBiABKAFIAogBARIXCgpib29rX3ZhbHVlGAcgASgBSAOIAQESHAoPcGFpZF91cF9jYXBpdGFsGAggASgBSASIAQESGgoNcHJvZml0X2Ftb3VudBgJIAEoAUgFiAEBQgoKCF9xdWFydGVyQgYKBF9lcHNCCwoJX3BlX3JhdGlvQg0KC19ib29rX3ZhbHVlQhIKEF9wYWlkX3VwX2NhcGl0YWxCEAoOX3Byb2ZpdF9hbW91bnQirAMKBVByaWNlEgoKAmlkGAEgASgDEhIKCmNvbXBhbnlfaWQYAiABKAMSFQoNYnVzaW5lc3NfZGF0ZRgDIAEoCRIRCgRvcGVuGAQgASgBSACIAQESEQoEaGlnaBgFIAEoAUgBiAEBEhAKA2xvdxgGIAEoAUgCiAEBEhIKBWNsb3NlGAcgASgBSAOIAQESEAoDbHRwGAggASgBSASIAQESGwoOcHJldmlvdXNfY2xvc2UYCSABKAFIBYgBARITCgZjaGFuZ2UYCiABKAFIBogBARIbCg5jaGFuZ2VfcGVyY2VudBgLIAEoAUgHiAEBEhMKBnZvbHVtZRgMIAEoA0gIiAEBEhUKCHR1cm5vdmVyGA0gASgBSAmIAQESEwoGdHJhZGVzGA4gASgFSAqIAQFCBwoFX29wZW5CBwoFX2hpZ2hCBgoEX2xvd0IICgZfY2xvc2VCBgoEX2x0cEIRCg9fcHJldmlvdXNfY2xvc2VCCQoHX2NoYW5nZUIRCg9fY2hhbmdlX3BlcmNlbnRCCQoHX3ZvbHVtZUILCglfdHVybm92ZXJCCQoHX3RyYWRlcyKsAQoJT3duZXJzaGlwEhIKCmNvbXBhbnlfaWQYASABKAMSFQoNbGlzdGVkX3NoYXJlcxgCIAEoAxIVCg1wdWJsaWNfc2hhcmVzGAMgASgDEhYKDnB1YmxpY19wZXJjZW50GAQgASgBEhcKD3Byb21vdGVyX3NoYXJlcxgFIAEoAxIYChBwcm9tb3Rlcl9wZXJjZW50GAYgASgBEhIKCnVwZGF0ZWRfYXQYByABKAki2gEKD0NvcnBvcmF0ZUFjdGlvbhIKCgJpZBgBIAEoAxISCgpjb21wYW55X2lkGAIgASgDEhMKC2Zpc2NhbF95ZWFyGAMgASgJEhgKEGJvbnVzX3BlcmNlbnRhZ2UYBCABKAESHQoQcmlnaHRfcGVyY2VudGFnZRgFIAEoAUgAiAEBEhoKDWNhc2hfZGl2aWRlbmQYBiABKAFIAYgBARIWCg5zdWJtaXR0ZWRfZGF0ZRgHIAEoCUITChFfcmlnaHRfcGVyY2VudGFnZUIQCg5fY2FzaF9kaXZpZGVuZCqFAQoNQ29tcGFueVN0YXR1cxIeChpDT01QQU5ZX1NUQVRVU19VTlNQRUNJRklFRBAAEhkKFUNPTVBBTllfU1RBVFVTX0FDVElWRRABEhwKGENPTVBBTllfU1RBVFVTX1NVU1BFTkRFRBACEhsKF0NPTVBBTllfU1RBVFVTX0RFTElTVEVEEAMq2QIKBlNlY3RvchIWChJTRUNUT1JfVU5TUEVDSUZJRUQQABIaChZTRUNUT1JfQ09NTUVSQ0lBTF9CQU5LEAESGwoXU0VDVE9SX0RFVkVMT1BNRU5UX0JBTksQAhISCg5TRUNUT1JfRklOQU5DRRADEhcKE1NFQ1RPUl9NSUNST0ZJTkFOQ0UQBBIZChVTRUNUT1JfTElGRV9JTlNVUkFOQ0UQBRIdChlTRUNUT1JfTk9OX0xJRkVfSU5TVVJBTkNFEAYSFQoRU0VDVE9SX0hZRFJPUE9XRVIQBxIYChRTRUNUT1JfTUFOVUZBQ1RVUklORxAIEhAKDFNFQ1RPUl9IT1RFTBAJEhIKDlNFQ1RPUl9UUkFESU5HEAoSFQoRU0VDVE9SX0lOVkVTVE1FTlQQCxIWChJTRUNUT1JfTVVUVUFMX0ZVTkQQDBIRCg1TRUNUT1JfT1RIRVJTEA0qhQEKDUxpc3RpbmdTdGF0dXMSHgoaTElTVElOR19TVEFUVVNfVU5TUEVDSUZJRUQQABIZChVMSVNUSU5HX1NUQVRVU19BQ1RJVkUQARIcChhMSVNUSU5HX1NUQVRVU19TVVNQRU5ERUQQAhIbChdMSVNUSU5HX1NUQVRVU19ERUxJU1RFRBADKogBCg5JbnN0cnVtZW50VHlwZRIfChtJTlNUUlVNRU5UX1RZUEVfVU5TUEVDSUZJRUQQABIaChZJTlNUUlVNRU5UX1RZUEVfRVFVSVRZEAESGAoUSU5TVFJVTUVOVF9UWVBFX0JPTkQQAhIfChtJTlNUUlVNRU5UX1RZUEVfTVVUVUFMX0ZVTkQQA0IwWi5naXRodWIuY29tL3ZvaWRhcmNoaXZlL250eC9nZW4vZ28vbnR4L3YxO250eHYxYgZwcm90bzM");
 
 /**
  * Describes the message ntx.v1.Company.
@@ -69,11 +69,26 @@ export const SectorSchema = /*@__PURE__*/
 export const Sector = /*@__PURE__*/
   tsEnum(SectorSchema);
 
+/**
+ * Describes the enum ntx.v1.ListingStatus.
+ */
+export const ListingStatusSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_common, 2);
+
+/**
+ * Trading status on NEPSE. Suspended scrips can't be traded until the
+ * suspension lifts; delisted ones no longer trade at all.
+ *
+ * @generated from enum ntx.v1.ListingStatus
+ */
+export const ListingStatus = /*@__PURE__*/
+  tsEnum(ListingStatusSchema);
+
 /**
  * Describes the enum ntx.v1.InstrumentType.
  */
 export const InstrumentTypeSchema = /*@__PURE__*/
-  enumDesc(file_ntx_v1_common, 2);
+  enumDesc(file_ntx_v1_common, 3);
 
 /**
  * @generated from enum ntx.v1.InstrumentType
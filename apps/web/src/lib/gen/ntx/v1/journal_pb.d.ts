@@ -0,0 +1,351 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/journal.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file ntx/v1/journal.proto.
+ */
+export declare const file_ntx_v1_journal: GenFile;
+
+/**
+ * @generated from message ntx.v1.Note
+ */
+export declare type Note = Message<"ntx.v1.Note"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string date = 2;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * markdown
+   *
+   * @generated from field: string body = 4;
+   */
+  body: string;
+
+  /**
+   * @generated from field: optional string stock_symbol = 5;
+   */
+  stockSymbol?: string;
+
+  /**
+   * @generated from field: optional int64 transaction_id = 6;
+   */
+  transactionId?: bigint;
+
+  /**
+   * @generated from field: string created_at = 7;
+   */
+  createdAt: string;
+
+  /**
+   * @generated from field: string updated_at = 8;
+   */
+  updatedAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.Note.
+ * Use `create(NoteSchema)` to create a new message.
+ */
+export declare const NoteSchema: GenMessage<Note>;
+
+/**
+ * @generated from message ntx.v1.CreateNoteRequest
+ */
+export declare type CreateNoteRequest = Message<"ntx.v1.CreateNoteRequest"> & {
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  /**
+   * @generated from field: optional string stock_symbol = 4;
+   */
+  stockSymbol?: string;
+
+  /**
+   * Linking a transaction also links its symbol
+   *
+   * @generated from field: optional int64 transaction_id = 5;
+   */
+  transactionId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.CreateNoteRequest.
+ * Use `create(CreateNoteRequestSchema)` to create a new message.
+ */
+export declare const CreateNoteRequestSchema: GenMessage<CreateNoteRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateNoteResponse
+ */
+export declare type CreateNoteResponse = Message<"ntx.v1.CreateNoteResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Note note = 1;
+   */
+  note?: Note;
+};
+
+/**
+ * Describes the message ntx.v1.CreateNoteResponse.
+ * Use `create(CreateNoteResponseSchema)` to create a new message.
+ */
+export declare const CreateNoteResponseSchema: GenMessage<CreateNoteResponse>;
+
+/**
+ * UpdateNoteRequest replaces every field of the note.
+ *
+ * @generated from message ntx.v1.UpdateNoteRequest
+ */
+export declare type UpdateNoteRequest = Message<"ntx.v1.UpdateNoteRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+
+  /**
+   * @generated from field: string date = 2;
+   */
+  date: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string body = 4;
+   */
+  body: string;
+
+  /**
+   * @generated from field: optional string stock_symbol = 5;
+   */
+  stockSymbol?: string;
+
+  /**
+   * @generated from field: optional int64 transaction_id = 6;
+   */
+  transactionId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.UpdateNoteRequest.
+ * Use `create(UpdateNoteRequestSchema)` to create a new message.
+ */
+export declare const UpdateNoteRequestSchema: GenMessage<UpdateNoteRequest>;
+
+/**
+ * @generated from message ntx.v1.UpdateNoteResponse
+ */
+export declare type UpdateNoteResponse = Message<"ntx.v1.UpdateNoteResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Note note = 1;
+   */
+  note?: Note;
+};
+
+/**
+ * Describes the message ntx.v1.UpdateNoteResponse.
+ * Use `create(UpdateNoteResponseSchema)` to create a new message.
+ */
+export declare const UpdateNoteResponseSchema: GenMessage<UpdateNoteResponse>;
+
+/**
+ * @generated from message ntx.v1.ListNotesRequest
+ */
+export declare type ListNotesRequest = Message<"ntx.v1.ListNotesRequest"> & {
+  /**
+   * @generated from field: optional string stock_symbol = 1;
+   */
+  stockSymbol?: string;
+
+  /**
+   * @generated from field: optional int64 transaction_id = 2;
+   */
+  transactionId?: bigint;
+
+  /**
+   * case-insensitive match on title and body
+   *
+   * @generated from field: string query = 3;
+   */
+  query: string;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string from_date = 4;
+   */
+  fromDate: string;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string to_date = 5;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.ListNotesRequest.
+ * Use `create(ListNotesRequestSchema)` to create a new message.
+ */
+export declare const ListNotesRequestSchema: GenMessage<ListNotesRequest>;
+
+/**
+ * @generated from message ntx.v1.ListNotesResponse
+ */
+export declare type ListNotesResponse = Message<"ntx.v1.ListNotesResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Note notes = 1;
+   */
+  notes: Note[];
+};
+
+/**
+ * Describes the message ntx.v1.ListNotesResponse.
+ * Use `create(ListNotesResponseSchema)` to create a new message.
+ */
+export declare const ListNotesResponseSchema: GenMessage<ListNotesResponse>;
+
+/**
+ * @generated from message ntx.v1.DeleteNoteRequest
+ */
+export declare type DeleteNoteRequest = Message<"ntx.v1.DeleteNoteRequest"> & {
+  /**
+   * @generated from field: int64 note_id = 1;
+   */
+  noteId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteNoteRequest.
+ * Use `create(DeleteNoteRequestSchema)` to create a new message.
+ */
+export declare const DeleteNoteRequestSchema: GenMessage<DeleteNoteRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteNoteResponse
+ */
+export declare type DeleteNoteResponse = Message<"ntx.v1.DeleteNoteResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteNoteResponse.
+ * Use `create(DeleteNoteResponseSchema)` to create a new message.
+ */
+export declare const DeleteNoteResponseSchema: GenMessage<DeleteNoteResponse>;
+
+/**
+ * @generated from message ntx.v1.ExportNotesRequest
+ */
+export declare type ExportNotesRequest = Message<"ntx.v1.ExportNotesRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ExportNotesRequest.
+ * Use `create(ExportNotesRequestSchema)` to create a new message.
+ */
+export declare const ExportNotesRequestSchema: GenMessage<ExportNotesRequest>;
+
+/**
+ * ExportNotesResponse is every note as one markdown document, oldest first.
+ *
+ * @generated from message ntx.v1.ExportNotesResponse
+ */
+export declare type ExportNotesResponse = Message<"ntx.v1.ExportNotesResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes markdown = 2;
+   */
+  markdown: Uint8Array;
+};
+
+/**
+ * Describes the message ntx.v1.ExportNotesResponse.
+ * Use `create(ExportNotesResponseSchema)` to create a new message.
+ */
+export declare const ExportNotesResponseSchema: GenMessage<ExportNotesResponse>;
+
+/**
+ * JournalService keeps dated trading notes written in markdown.
+ *
+ * @generated from service ntx.v1.JournalService
+ */
+export declare const JournalService: GenService<{
+  /**
+   * @generated from rpc ntx.v1.JournalService.CreateNote
+   */
+  createNote: {
+    methodKind: "unary";
+    input: typeof CreateNoteRequestSchema;
+    output: typeof CreateNoteResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.JournalService.UpdateNote
+   */
+  updateNote: {
+    methodKind: "unary";
+    input: typeof UpdateNoteRequestSchema;
+    output: typeof UpdateNoteResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.JournalService.ListNotes
+   */
+  listNotes: {
+    methodKind: "unary";
+    input: typeof ListNotesRequestSchema;
+    output: typeof ListNotesResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.JournalService.DeleteNote
+   */
+  deleteNote: {
+    methodKind: "unary";
+    input: typeof DeleteNoteRequestSchema;
+    output: typeof DeleteNoteResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.JournalService.ExportNotes
+   */
+  exportNotes: {
+    methodKind: "unary";
+    input: typeof ExportNotesRequestSchema;
+    output: typeof ExportNotesResponseSchema;
+  },
+}>;
+
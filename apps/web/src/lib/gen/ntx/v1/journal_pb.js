@@ -0,0 +1,97 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/journal.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+
+/**
+ * Describes the file ntx/v1/journal.proto.
+ */
+export const file_ntx_v1_journal = /*@__PURE__*/
+  fileDesc("ChRudHgvdjEvam91cm5hbC5wcm90bxIGbnR4LnYxIsEBCgROb3RlEgoKAmlkGAEgASgDEgwKBGRhdGUYAiABKAkSDQoFdGl0bGUYAyABKAkSDAoEYm9keRgEIAEoCRIZCgxzdG9ja19zeW1ib2wYBSABKAlIAIgBARIbCg50cmFuc2FjdGlvbl9pZBgGIAEoA0gBiAEBEhIKCmNyZWF0ZWRfYXQYByABKAkSEgoKdXBkYXRlZF9hdBgIIAEoCUIPCg1fc3RvY2tfc3ltYm9sQhEKD190cmFuc2FjdGlvbl9pZCKaAQoRQ3JlYXRlTm90ZVJlcXVlc3QSDAoEZGF0ZRgBIAEoCRINCgV0aXRsZRgCIAEoCRIMCgRib2R5GAMgASgJEhkKDHN0b2NrX3N5bWJvbBgEIAEoCUgAiAEBEhsKDnRyYW5zYWN0aW9uX2lkGAUgASgDSAGIAQFCDwoNX3N0b2NrX3N5bWJvbEIRCg9fdHJhbnNhY3Rpb25faWQiMAoSQ3JlYXRlTm90ZVJlc3BvbnNlEhoKBG5vdGUYASABKAsyDC5udHgudjEuTm90ZSKrAQoRVXBkYXRlTm90ZVJlcXVlc3QSDwoHbm90ZV9pZBgBIAEoAxIMCgRkYXRlGAIgASgJEg0KBXRpdGxlGAMgASgJEgwKBGJvZHkYBCABKAkSGQoMc3RvY2tfc3ltYm9sGAUgASgJSACIAQESGwoOdHJhbnNhY3Rpb25faWQYBiABKANIAYgBAUIPCg1fc3RvY2tfc3ltYm9sQhEKD190cmFuc2FjdGlvbl9pZCIwChJVcGRhdGVOb3RlUmVzcG9uc2USGgoEbm90ZRgBIAEoCzIMLm50eC52MS5Ob3RlIqEBChBMaXN0Tm90ZXNSZXF1ZXN0EhkKDHN0b2NrX3N5bWJvbBgBIAEoCUgAiAEBEhsKDnRyYW5zYWN0aW9uX2lkGAIgASgDSAGIAQESDQoFcXVlcnkYAyABKAkSEQoJZnJvbV9kYXRlGAQgASgJEg8KB3RvX2RhdGUYBSABKAlCDwoNX3N0b2NrX3N5bWJvbEIRCg9fdHJhbnNhY3Rpb25faWQiMAoRTGlzdE5vdGVzUmVzcG9uc2USGwoFbm90ZXMYASADKAsyDC5udHgudjEuTm90ZSIkChFEZWxldGVOb3RlUmVxdWVzdBIPCgdub3RlX2lkGAEgASgDIhQKEkRlbGV0ZU5vdGVSZXNwb25zZSIUChJFeHBvcnROb3Rlc1JlcXVlc3QiOQoTRXhwb3J0Tm90ZXNSZXNwb25zZRIQCghmaWxlbmFtZRgBIAEoCRIQCghtYXJrZG93bhgCIAEoDDLpAgoOSm91cm5hbFNlcnZpY2USQwoKQ3JlYXRlTm90ZRIZLm50eC52MS5DcmVhdGVOb3RlUmVxdWVzdBoaLm50eC52MS5DcmVhdGVOb3RlUmVzcG9uc2USQwoKVXBkYXRlTm90ZRIZLm50eC52MS5VcGRhdGVOb3RlUmVxdWVzdBoaLm50eC52MS5VcGRhdGVOb3RlUmVzcG9uc2USQAoJTGlzdE5vdGVzEhgubnR4LnYxLkxpc3ROb3Rlc1JlcXVlc3QaGS5udHgudjEuTGlzdE5vdGVzUmVzcG9uc2USQwoKRGVsZXRlTm90ZRIZLm50eC52MS5EZWxldGVOb3RlUmVxdWVzdBoaLm50eC52MS5EZWxldGVOb3RlUmVzcG9uc2USRgoLRXhwb3J0Tm90ZXMSGi5udHgudjEuRXhwb3J0Tm90ZXNSZXF1ZXN0GhsubnR4LnYxLkV4cG9ydE5vdGVzUmVzcG9uc2VCMFouZ2l0aHViLmNvbS92b2lkYXJjaGl2ZS9udHgvZ2VuL2dvL250eC92MTtudHh2MWIGcHJvdG8z");
+
+/**
+ * Describes the message ntx.v1.Note.
+ * Use `create(NoteSchema)` to create a new message.
+ */
+export const NoteSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 0);
+
+/**
+ * Describes the message ntx.v1.CreateNoteRequest.
+ * Use `create(CreateNoteRequestSchema)` to create a new message.
+ */
+export const CreateNoteRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 1);
+
+/**
+ * Describes the message ntx.v1.CreateNoteResponse.
+ * Use `create(CreateNoteResponseSchema)` to create a new message.
+ */
+export const CreateNoteResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 2);
+
+/**
+ * Describes the message ntx.v1.UpdateNoteRequest.
+ * Use `create(UpdateNoteRequestSchema)` to create a new message.
+ */
+export const UpdateNoteRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 3);
+
+/**
+ * Describes the message ntx.v1.UpdateNoteResponse.
+ * Use `create(UpdateNoteResponseSchema)` to create a new message.
+ */
+export const UpdateNoteResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 4);
+
+/**
+ * Describes the message ntx.v1.ListNotesRequest.
+ * Use `create(ListNotesRequestSchema)` to create a new message.
+ */
+export const ListNotesRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 5);
+
+/**
+ * Describes the message ntx.v1.ListNotesResponse.
+ * Use `create(ListNotesResponseSchema)` to create a new message.
+ */
+export const ListNotesResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 6);
+
+/**
+ * Describes the message ntx.v1.DeleteNoteRequest.
+ * Use `create(DeleteNoteRequestSchema)` to create a new message.
+ */
+export const DeleteNoteRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 7);
+
+/**
+ * Describes the message ntx.v1.DeleteNoteResponse.
+ * Use `create(DeleteNoteResponseSchema)` to create a new message.
+ */
+export const DeleteNoteResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 8);
+
+/**
+ * Describes the message ntx.v1.ExportNotesRequest.
+ * Use `create(ExportNotesRequestSchema)` to create a new message.
+ */
+export const ExportNotesRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 9);
+
+/**
+ * Describes the message ntx.v1.ExportNotesResponse.
+ * Use `create(ExportNotesResponseSchema)` to create a new message.
+ */
+export const ExportNotesResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_journal, 10);
+
+/**
+ * JournalService keeps dated trading notes written in markdown.
+ *
+ * @generated from service ntx.v1.JournalService
+ */
+export const JournalService = /*@__PURE__*/
+  serviceDesc(file_ntx_v1_journal, 0);
+
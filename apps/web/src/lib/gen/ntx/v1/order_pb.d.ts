@@ -0,0 +1,349 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/order.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import type { Message } from "@bufbuild/protobuf";
+import type { Transaction, TransactionType } from "./portfolio_pb";
+
+/**
+ * Describes the file ntx/v1/order.proto.
+ */
+export declare const file_ntx_v1_order: GenFile;
+
+/**
+ * @generated from message ntx.v1.Order
+ */
+export declare type Order = Message<"ntx.v1.Order"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: int64 portfolio_id = 2;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 3;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: ntx.v1.TransactionType side = 4;
+   */
+  side: TransactionType;
+
+  /**
+   * @generated from field: int64 quantity = 5;
+   */
+  quantity: bigint;
+
+  /**
+   * @generated from field: double limit_price = 6;
+   */
+  limitPrice: number;
+
+  /**
+   * YYYY-MM-DD, empty for good-till-cancelled
+   *
+   * @generated from field: string expires_on = 7;
+   */
+  expiresOn: string;
+
+  /**
+   * @generated from field: ntx.v1.OrderStatus status = 8;
+   */
+  status: OrderStatus;
+
+  /**
+   * @generated from field: string note = 9;
+   */
+  note: string;
+
+  /**
+   * set once executed
+   *
+   * @generated from field: optional int64 transaction_id = 10;
+   */
+  transactionId?: bigint;
+
+  /**
+   * @generated from field: string closed_at = 11;
+   */
+  closedAt: string;
+
+  /**
+   * @generated from field: string created_at = 12;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.Order.
+ * Use `create(OrderSchema)` to create a new message.
+ */
+export declare const OrderSchema: GenMessage<Order>;
+
+/**
+ * @generated from message ntx.v1.CreateOrderRequest
+ */
+export declare type CreateOrderRequest = Message<"ntx.v1.CreateOrderRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: ntx.v1.TransactionType side = 3;
+   */
+  side: TransactionType;
+
+  /**
+   * @generated from field: int64 quantity = 4;
+   */
+  quantity: bigint;
+
+  /**
+   * @generated from field: double limit_price = 5;
+   */
+  limitPrice: number;
+
+  /**
+   * YYYY-MM-DD, empty for good-till-cancelled
+   *
+   * @generated from field: string expires_on = 6;
+   */
+  expiresOn: string;
+
+  /**
+   * @generated from field: string note = 7;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message ntx.v1.CreateOrderRequest.
+ * Use `create(CreateOrderRequestSchema)` to create a new message.
+ */
+export declare const CreateOrderRequestSchema: GenMessage<CreateOrderRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateOrderResponse
+ */
+export declare type CreateOrderResponse = Message<"ntx.v1.CreateOrderResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Order order = 1;
+   */
+  order?: Order;
+};
+
+/**
+ * Describes the message ntx.v1.CreateOrderResponse.
+ * Use `create(CreateOrderResponseSchema)` to create a new message.
+ */
+export declare const CreateOrderResponseSchema: GenMessage<CreateOrderResponse>;
+
+/**
+ * @generated from message ntx.v1.ListOrdersRequest
+ */
+export declare type ListOrdersRequest = Message<"ntx.v1.ListOrdersRequest"> & {
+  /**
+   * @generated from field: optional ntx.v1.OrderStatus status = 1;
+   */
+  status?: OrderStatus;
+};
+
+/**
+ * Describes the message ntx.v1.ListOrdersRequest.
+ * Use `create(ListOrdersRequestSchema)` to create a new message.
+ */
+export declare const ListOrdersRequestSchema: GenMessage<ListOrdersRequest>;
+
+/**
+ * @generated from message ntx.v1.ListOrdersResponse
+ */
+export declare type ListOrdersResponse = Message<"ntx.v1.ListOrdersResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Order orders = 1;
+   */
+  orders: Order[];
+};
+
+/**
+ * Describes the message ntx.v1.ListOrdersResponse.
+ * Use `create(ListOrdersResponseSchema)` to create a new message.
+ */
+export declare const ListOrdersResponseSchema: GenMessage<ListOrdersResponse>;
+
+/**
+ * @generated from message ntx.v1.CancelOrderRequest
+ */
+export declare type CancelOrderRequest = Message<"ntx.v1.CancelOrderRequest"> & {
+  /**
+   * @generated from field: int64 order_id = 1;
+   */
+  orderId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.CancelOrderRequest.
+ * Use `create(CancelOrderRequestSchema)` to create a new message.
+ */
+export declare const CancelOrderRequestSchema: GenMessage<CancelOrderRequest>;
+
+/**
+ * @generated from message ntx.v1.CancelOrderResponse
+ */
+export declare type CancelOrderResponse = Message<"ntx.v1.CancelOrderResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Order order = 1;
+   */
+  order?: Order;
+};
+
+/**
+ * Describes the message ntx.v1.CancelOrderResponse.
+ * Use `create(CancelOrderResponseSchema)` to create a new message.
+ */
+export declare const CancelOrderResponseSchema: GenMessage<CancelOrderResponse>;
+
+/**
+ * ExecuteOrderRequest books an open order as a transaction. Fields left
+ * unset default to the order's quantity and limit price, and today.
+ *
+ * @generated from message ntx.v1.ExecuteOrderRequest
+ */
+export declare type ExecuteOrderRequest = Message<"ntx.v1.ExecuteOrderRequest"> & {
+  /**
+   * @generated from field: int64 order_id = 1;
+   */
+  orderId: bigint;
+
+  /**
+   * @generated from field: optional int64 quantity = 2;
+   */
+  quantity?: bigint;
+
+  /**
+   * @generated from field: optional double unit_price = 3;
+   */
+  unitPrice?: number;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string transaction_date = 4;
+   */
+  transactionDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.ExecuteOrderRequest.
+ * Use `create(ExecuteOrderRequestSchema)` to create a new message.
+ */
+export declare const ExecuteOrderRequestSchema: GenMessage<ExecuteOrderRequest>;
+
+/**
+ * @generated from message ntx.v1.ExecuteOrderResponse
+ */
+export declare type ExecuteOrderResponse = Message<"ntx.v1.ExecuteOrderResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Order order = 1;
+   */
+  order?: Order;
+
+  /**
+   * @generated from field: ntx.v1.Transaction transaction = 2;
+   */
+  transaction?: Transaction;
+};
+
+/**
+ * Describes the message ntx.v1.ExecuteOrderResponse.
+ * Use `create(ExecuteOrderResponseSchema)` to create a new message.
+ */
+export declare const ExecuteOrderResponseSchema: GenMessage<ExecuteOrderResponse>;
+
+/**
+ * @generated from enum ntx.v1.OrderStatus
+ */
+export enum OrderStatus {
+  /**
+   * @generated from enum value: ORDER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORDER_STATUS_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: ORDER_STATUS_EXECUTED = 2;
+   */
+  EXECUTED = 2,
+
+  /**
+   * @generated from enum value: ORDER_STATUS_CANCELLED = 3;
+   */
+  CANCELLED = 3,
+
+  /**
+   * @generated from enum value: ORDER_STATUS_EXPIRED = 4;
+   */
+  EXPIRED = 4,
+}
+
+/**
+ * Describes the enum ntx.v1.OrderStatus.
+ */
+export declare const OrderStatusSchema: GenEnum<OrderStatus>;
+
+/**
+ * OrderService is a journal of limit orders placed with a broker, so open
+ * orders aren't forgotten and fills can be booked as transactions.
+ *
+ * @generated from service ntx.v1.OrderService
+ */
+export declare const OrderService: GenService<{
+  /**
+   * @generated from rpc ntx.v1.OrderService.CreateOrder
+   */
+  createOrder: {
+    methodKind: "unary";
+    input: typeof CreateOrderRequestSchema;
+    output: typeof CreateOrderResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.OrderService.ListOrders
+   */
+  listOrders: {
+    methodKind: "unary";
+    input: typeof ListOrdersRequestSchema;
+    output: typeof ListOrdersResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.OrderService.CancelOrder
+   */
+  cancelOrder: {
+    methodKind: "unary";
+    input: typeof CancelOrderRequestSchema;
+    output: typeof CancelOrderResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.OrderService.ExecuteOrder
+   */
+  executeOrder: {
+    methodKind: "unary";
+    input: typeof ExecuteOrderRequestSchema;
+    output: typeof ExecuteOrderResponseSchema;
+  },
+}>;
+
@@ -0,0 +1,97 @@
+// @generated by protoc-gen-es v2.2.3
+// @generated from file ntx/v1/order.proto (package ntx.v1, syntax proto3)
+/* eslint-disable */
+
+import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_ntx_v1_portfolio } from "./portfolio_pb";
+
+/**
+ * Describes the file ntx/v1/order.proto.
+ */
+export const file_ntx_v1_order = /*@__PURE__*/
+  fileDesc("ChJudHgvdjEvb3JkZXIucHJvdG8SBm50eC52MSKrAgoFT3JkZXISCgoCaWQYASABKAMSFAoMcG9ydGZvbGlvX2lkGAIgASgDEhQKDHN0b2NrX3N5bWJvbBgDIAEoCRIlCgRzaWRlGAQgASgOMhcubnR4LnYxLlRyYW5zYWN0aW9uVHlwZRIQCghxdWFudGl0eRgFIAEoAxITCgtsaW1pdF9wcmljZRgGIAEoARISCgpleHBpcmVzX29uGAcgASgJEiMKBnN0YXR1cxgIIAEoDjITLm50eC52MS5PcmRlclN0YXR1cxIMCgRub3RlGAkgASgJEhsKDnRyYW5zYWN0aW9uX2lkGAogASgDSACIAQESEQoJY2xvc2VkX2F0GAsgASgJEhIKCmNyZWF0ZWRfYXQYDCABKAlCEQoPX3RyYW5zYWN0aW9uX2lkIrABChJDcmVhdGVPcmRlclJlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhQKDHN0b2NrX3N5bWJvbBgCIAEoCRIlCgRzaWRlGAMgASgOMhcubnR4LnYxLlRyYW5zYWN0aW9uVHlwZRIQCghxdWFudGl0eRgEIAEoAxITCgtsaW1pdF9wcmljZRgFIAEoARISCgpleHBpcmVzX29uGAYgASgJEgwKBG5vdGUYByABKAkiMwoTQ3JlYXRlT3JkZXJSZXNwb25zZRIcCgVvcmRlchgBIAEoCzINLm50eC52MS5PcmRlciJIChFMaXN0T3JkZXJzUmVxdWVzdBIoCgZzdGF0dXMYASABKA4yEy5udHgudjEuT3JkZXJTdGF0dXNIAIgBAUIJCgdfc3RhdHVzIjMKEkxpc3RPcmRlcnNSZXNwb25zZRIdCgZvcmRlcnMYASADKAsyDS5udHgudjEuT3JkZXIiJgoSQ2FuY2VsT3JkZXJSZXF1ZXN0EhAKCG9yZGVyX2lkGAEgASgDIjMKE0NhbmNlbE9yZGVyUmVzcG9uc2USHAoFb3JkZXIYASABKAsyDS5udHgudjEuT3JkZXIijQEKE0V4ZWN1dGVPcmRlclJlcXVlc3QSEAoIb3JkZXJfaWQYASABKAMSFQoIcXVhbnRpdHkYAiABKANIAIgBARIXCgp1bml0X3ByaWNlGAMgASgBSAGIAQESGAoQdHJhbnNhY3Rpb25fZGF0ZRgEIAEoCUILCglfcXVhbnRpdHlCDQoLX3VuaXRfcHJpY2UiXgoURXhlY3V0ZU9yZGVyUmVzcG9uc2USHAoFb3JkZXIYASABKAsyDS5udHgudjEuT3JkZXISKAoLdHJhbnNhY3Rpb24YAiABKAsyEy5udHgudjEuVHJhbnNhY3Rpb24qkwEKC09yZGVyU3RhdHVzEhwKGE9SREVSX1NUQVRVU19VTlNQRUNJRklFRBAAEhUKEU9SREVSX1NUQVRVU19PUEVOEAESGQoVT1JERVJfU1RBVFVTX0VYRUNVVEVEEAISGgoWT1JERVJfU1RBVFVTX0NBTkNFTExFRBADEhgKFE9SREVSX1NUQVRVU19FWFBJUkVEEAQyrgIKDE9yZGVyU2VydmljZRJGCgtDcmVhdGVPcmRlchIaLm50eC52MS5DcmVhdGVPcmRlclJlcXVlc3QaGy5udHgudjEuQ3JlYXRlT3JkZXJSZXNwb25zZRJDCgpMaXN0T3JkZXJzEhkubnR4LnYxLkxpc3RPcmRlcnNSZXF1ZXN0GhoubnR4LnYxLkxpc3RPcmRlcnNSZXNwb25zZRJGCgtDYW5jZWxPcmRlchIaLm50eC52MS5DYW5jZWxPcmRlclJlcXVlc3QaGy5udHgudjEuQ2FuY2VsT3JkZXJSZXNwb25zZRJJCgxFeGVjdXRlT3JkZXISGy5udHgudjEuRXhlY3V0ZU9yZGVyUmVxdWVzdBocLm50eC52MS5FeGVjdXRlT3JkZXJSZXNwb25zZUIwWi5naXRodWIuY29tL3ZvaWRhcmNoaXZlL250eC9nZW4vZ28vbnR4L3YxO250eHYxYgZwcm90bzM", [file_ntx_v1_portfolio]);
+
+/**
+ * Describes the message ntx.v1.Order.
+ * Use `create(OrderSchema)` to create a new message.
+ */
+export const OrderSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 0);
+
+/**
+ * Describes the message ntx.v1.CreateOrderRequest.
+ * Use `create(CreateOrderRequestSchema)` to create a new message.
+ */
+export const CreateOrderRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 1);
+
+/**
+ * Describes the message ntx.v1.CreateOrderResponse.
+ * Use `create(CreateOrderResponseSchema)` to create a new message.
+ */
+export const CreateOrderResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 2);
+
+/**
+ * Describes the message ntx.v1.ListOrdersRequest.
+ * Use `create(ListOrdersRequestSchema)` to create a new message.
+ */
+export const ListOrdersRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 3);
+
+/**
+ * Describes the message ntx.v1.ListOrdersResponse.
+ * Use `create(ListOrdersResponseSchema)` to create a new message.
+ */
+export const ListOrdersResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 4);
+
+/**
+ * Describes the message ntx.v1.CancelOrderRequest.
+ * Use `create(CancelOrderRequestSchema)` to create a new message.
+ */
+export const CancelOrderRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 5);
+
+/**
+ * Describes the message ntx.v1.CancelOrderResponse.
+ * Use `create(CancelOrderResponseSchema)` to create a new message.
+ */
+export const CancelOrderResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 6);
+
+/**
+ * Describes the message ntx.v1.ExecuteOrderRequest.
+ * Use `create(ExecuteOrderRequestSchema)` to create a new message.
+ */
+export const ExecuteOrderRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 7);
+
+/**
+ * Describes the message ntx.v1.ExecuteOrderResponse.
+ * Use `create(ExecuteOrderResponseSchema)` to create a new message.
+ */
+export const ExecuteOrderResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_order, 8);
+
+/**
+ * Describes the enum ntx.v1.OrderStatus.
+ */
+export const OrderStatusSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_order, 0);
+
+/**
+ * @generated from enum ntx.v1.OrderStatus
+ */
+export const OrderStatus = /*@__PURE__*/
+  tsEnum(OrderStatusSchema);
+
+/**
+ * OrderService is a journal of limit orders placed with a broker, so open
+ * orders aren't forgotten and fills can be booked as transactions.
+ *
+ * @generated from service ntx.v1.OrderService
+ */
+export const OrderService = /*@__PURE__*/
+  serviceDesc(file_ntx_v1_order, 0);
+
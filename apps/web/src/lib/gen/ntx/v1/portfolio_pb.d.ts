@@ -4,6 +4,7 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
 import type { Message } from "@bufbuild/protobuf";
+import type { InstrumentType, ListingStatus } from "./common_pb";
 
 /**
  * Describes the file ntx/v1/portfolio.proto.
@@ -28,6 +29,20 @@ export declare type Portfolio = Message<"ntx.v1.Portfolio"> & {
    * @generated from field: string created_at = 3;
    */
   createdAt: string;
+
+  /**
+   * demat holder the portfolio belongs to
+   *
+   * @generated from field: optional int64 profile_id = 4;
+   */
+  profileId?: bigint;
+
+  /**
+   * hypothetical trades, kept out of consolidated totals
+   *
+   * @generated from field: bool paper = 5;
+   */
+  paper: boolean;
 };
 
 /**
@@ -72,6 +87,16 @@ export declare type CreatePortfolioRequest = Message<"ntx.v1.CreatePortfolioRequ
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * @generated from field: optional int64 profile_id = 2;
+   */
+  profileId?: bigint;
+
+  /**
+   * @generated from field: bool paper = 3;
+   */
+  paper: boolean;
 };
 
 /**
@@ -96,6 +121,37 @@ export declare type CreatePortfolioResponse = Message<"ntx.v1.CreatePortfolioRes
  */
 export declare const CreatePortfolioResponseSchema: GenMessage<CreatePortfolioResponse>;
 
+/**
+ * Deletes the portfolio with its transactions, holdings and everything else
+ * recorded against it.
+ *
+ * @generated from message ntx.v1.DeletePortfolioRequest
+ */
+export declare type DeletePortfolioRequest = Message<"ntx.v1.DeletePortfolioRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeletePortfolioRequest.
+ * Use `create(DeletePortfolioRequestSchema)` to create a new message.
+ */
+export declare const DeletePortfolioRequestSchema: GenMessage<DeletePortfolioRequest>;
+
+/**
+ * @generated from message ntx.v1.DeletePortfolioResponse
+ */
+export declare type DeletePortfolioResponse = Message<"ntx.v1.DeletePortfolioResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeletePortfolioResponse.
+ * Use `create(DeletePortfolioResponseSchema)` to create a new message.
+ */
+export declare const DeletePortfolioResponseSchema: GenMessage<DeletePortfolioResponse>;
+
 /**
  * @generated from message ntx.v1.Transaction
  */
@@ -134,6 +190,23 @@ export declare type Transaction = Message<"ntx.v1.Transaction"> & {
    * @generated from field: string transaction_date = 7;
    */
   transactionDate: string;
+
+  /**
+   * bought and sold on the same day
+   *
+   * @generated from field: bool intraday = 8;
+   */
+  intraday: boolean;
+
+  /**
+   * @generated from field: repeated ntx.v1.Tag tags = 9;
+   */
+  tags: Tag[];
+
+  /**
+   * @generated from field: optional int64 broker_account_id = 10;
+   */
+  brokerAccountId?: bigint;
 };
 
 /**
@@ -167,6 +240,8 @@ export declare type AddTransactionRequest = Message<"ntx.v1.AddTransactionReques
   quantity: bigint;
 
   /**
+   * paper portfolios fill at the latest price when 0
+   *
    * @generated from field: double unit_price = 5;
    */
   unitPrice: number;
@@ -175,6 +250,11 @@ export declare type AddTransactionRequest = Message<"ntx.v1.AddTransactionReques
    * @generated from field: string transaction_date = 6;
    */
   transactionDate: string;
+
+  /**
+   * @generated from field: optional int64 broker_account_id = 7;
+   */
+  brokerAccountId?: bigint;
 };
 
 /**
@@ -212,6 +292,28 @@ export declare type ListTransactionsRequest = Message<"ntx.v1.ListTransactionsRe
    * @generated from field: optional string stock_symbol = 2;
    */
   stockSymbol?: string;
+
+  /**
+   * @generated from field: optional int64 tag_id = 3;
+   */
+  tagId?: bigint;
+
+  /**
+   * @generated from field: optional int64 broker_account_id = 4;
+   */
+  brokerAccountId?: bigint;
+
+  /**
+   * 0 returns every match
+   *
+   * @generated from field: int32 limit = 5;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 6;
+   */
+  offset: number;
 };
 
 /**
@@ -228,6 +330,13 @@ export declare type ListTransactionsResponse = Message<"ntx.v1.ListTransactionsR
    * @generated from field: repeated ntx.v1.Transaction transactions = 1;
    */
   transactions: Transaction[];
+
+  /**
+   * matches before limit/offset
+   *
+   * @generated from field: int32 total_count = 2;
+   */
+  totalCount: number;
 };
 
 /**
@@ -317,6 +426,51 @@ export declare type Holding = Message<"ntx.v1.Holding"> & {
    * @generated from field: double day_change_value = 10;
    */
   dayChangeValue: number;
+
+  /**
+   * share of total portfolio value
+   *
+   * @generated from field: double weight_percent = 11;
+   */
+  weightPercent: number;
+
+  /**
+   * where avg_buy_price came from
+   *
+   * @generated from field: ntx.v1.CostSource cost_source = 12;
+   */
+  costSource: CostSource;
+
+  /**
+   * @generated from field: ntx.v1.InstrumentType instrument_type = 13;
+   */
+  instrumentType: InstrumentType;
+
+  /**
+   * Coupon earned since the last payment; included in total_value.
+   *
+   * @generated from field: double accrued_interest = 14;
+   */
+  accruedInterest: number;
+
+  /**
+   * @generated from field: ntx.v1.ListingStatus listing_status = 15;
+   */
+  listingStatus: ListingStatus;
+
+  /**
+   * first sync that saw the delisting
+   *
+   * @generated from field: string delisted_on = 16;
+   */
+  delistedOn: string;
+
+  /**
+   * the user's holding group, empty if none
+   *
+   * @generated from field: string group = 17;
+   */
+  group: string;
 };
 
 /**
@@ -373,6 +527,18 @@ export declare type PortfolioSummary = Message<"ntx.v1.PortfolioSummary"> & {
    * @generated from field: repeated ntx.v1.HealthTip health_tips = 9;
    */
   healthTips: HealthTip[];
+
+  /**
+   * @generated from field: double day_change_value = 10;
+   */
+  dayChangeValue: number;
+
+  /**
+   * relative to the previous close value
+   *
+   * @generated from field: double day_change_percent = 11;
+   */
+  dayChangePercent: number;
 };
 
 /**
@@ -417,6 +583,13 @@ export declare type GetPortfolioSummaryRequest = Message<"ntx.v1.GetPortfolioSum
    * @generated from field: int64 portfolio_id = 1;
    */
   portfolioId: bigint;
+
+  /**
+   * YYYY-MM-DD, empty for now
+   *
+   * @generated from field: string as_of = 2;
+   */
+  asOf: string;
 };
 
 /**
@@ -442,81 +615,3935 @@ export declare type GetPortfolioSummaryResponse = Message<"ntx.v1.GetPortfolioSu
 export declare const GetPortfolioSummaryResponseSchema: GenMessage<GetPortfolioSummaryResponse>;
 
 /**
- * @generated from enum ntx.v1.TransactionType
+ * @generated from message ntx.v1.ListHoldingsRequest
  */
-export enum TransactionType {
+export declare type ListHoldingsRequest = Message<"ntx.v1.ListHoldingsRequest"> & {
   /**
-   * @generated from enum value: TRANSACTION_TYPE_UNSPECIFIED = 0;
+   * @generated from field: int64 portfolio_id = 1;
    */
-  UNSPECIFIED = 0,
+  portfolioId: bigint;
 
   /**
-   * @generated from enum value: TRANSACTION_TYPE_BUY = 1;
+   * @generated from field: ntx.v1.HoldingSortField sort_by = 2;
    */
-  BUY = 1,
+  sortBy: HoldingSortField;
 
   /**
-   * @generated from enum value: TRANSACTION_TYPE_SELL = 2;
+   * @generated from field: bool descending = 3;
    */
-  SELL = 2,
-}
+  descending: boolean;
+
+  /**
+   * @generated from field: optional string sector = 4;
+   */
+  sector?: string;
+
+  /**
+   * @generated from field: optional double min_value = 5;
+   */
+  minValue?: number;
+
+  /**
+   * @generated from field: bool only_gainers = 6;
+   */
+  onlyGainers: boolean;
+
+  /**
+   * @generated from field: bool only_losers = 7;
+   */
+  onlyLosers: boolean;
+
+  /**
+   * 0 returns every match
+   *
+   * @generated from field: int32 limit = 8;
+   */
+  limit: number;
+
+  /**
+   * @generated from field: int32 offset = 9;
+   */
+  offset: number;
+
+  /**
+   * YYYY-MM-DD, empty for now
+   *
+   * @generated from field: string as_of = 10;
+   */
+  asOf: string;
+};
 
 /**
- * Describes the enum ntx.v1.TransactionType.
+ * Describes the message ntx.v1.ListHoldingsRequest.
+ * Use `create(ListHoldingsRequestSchema)` to create a new message.
  */
-export declare const TransactionTypeSchema: GenEnum<TransactionType>;
+export declare const ListHoldingsRequestSchema: GenMessage<ListHoldingsRequest>;
 
 /**
- * @generated from service ntx.v1.PortfolioService
+ * @generated from message ntx.v1.ListHoldingsResponse
  */
-export declare const PortfolioService: GenService<{
+export declare type ListHoldingsResponse = Message<"ntx.v1.ListHoldingsResponse"> & {
   /**
-   * @generated from rpc ntx.v1.PortfolioService.ListPortfolios
+   * @generated from field: repeated ntx.v1.Holding holdings = 1;
    */
-  listPortfolios: {
-    methodKind: "unary";
-    input: typeof ListPortfoliosRequestSchema;
-    output: typeof ListPortfoliosResponseSchema;
-  },
+  holdings: Holding[];
+
   /**
-   * @generated from rpc ntx.v1.PortfolioService.CreatePortfolio
+   * matches before limit/offset
+   *
+   * @generated from field: int32 total_count = 2;
    */
-  createPortfolio: {
-    methodKind: "unary";
-    input: typeof CreatePortfolioRequestSchema;
-    output: typeof CreatePortfolioResponseSchema;
-  },
+  totalCount: number;
+};
+
+/**
+ * Describes the message ntx.v1.ListHoldingsResponse.
+ * Use `create(ListHoldingsResponseSchema)` to create a new message.
+ */
+export declare const ListHoldingsResponseSchema: GenMessage<ListHoldingsResponse>;
+
+/**
+ * @generated from message ntx.v1.Lot
+ */
+export declare type Lot = Message<"ntx.v1.Lot"> & {
   /**
-   * @generated from rpc ntx.v1.PortfolioService.AddTransaction
+   * @generated from field: string stock_symbol = 1;
    */
-  addTransaction: {
-    methodKind: "unary";
-    input: typeof AddTransactionRequestSchema;
-    output: typeof AddTransactionResponseSchema;
-  },
+  stockSymbol: string;
+
   /**
-   * @generated from rpc ntx.v1.PortfolioService.ListTransactions
+   * @generated from field: int64 quantity = 2;
    */
-  listTransactions: {
-    methodKind: "unary";
-    input: typeof ListTransactionsRequestSchema;
-    output: typeof ListTransactionsResponseSchema;
-  },
+  quantity: bigint;
+
   /**
-   * @generated from rpc ntx.v1.PortfolioService.DeleteTransaction
+   * @generated from field: double unit_price = 3;
    */
-  deleteTransaction: {
-    methodKind: "unary";
-    input: typeof DeleteTransactionRequestSchema;
-    output: typeof DeleteTransactionResponseSchema;
-  },
+  unitPrice: number;
+
   /**
-   * @generated from rpc ntx.v1.PortfolioService.GetPortfolioSummary
+   * @generated from field: string acquired_date = 4;
    */
-  getPortfolioSummary: {
-    methodKind: "unary";
-    input: typeof GetPortfolioSummaryRequestSchema;
-    output: typeof GetPortfolioSummaryResponseSchema;
+  acquiredDate: string;
+
+  /**
+   * @generated from field: int32 holding_days = 5;
+   */
+  holdingDays: number;
+
+  /**
+   * first day gains qualify for the 5% CGT rate
+   *
+   * @generated from field: string long_term_date = 6;
+   */
+  longTermDate: string;
+
+  /**
+   * @generated from field: int32 days_to_long_term = 7;
+   */
+  daysToLongTerm: number;
+
+  /**
+   * @generated from field: bool long_term = 8;
+   */
+  longTerm: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.Lot.
+ * Use `create(LotSchema)` to create a new message.
+ */
+export declare const LotSchema: GenMessage<Lot>;
+
+/**
+ * @generated from message ntx.v1.ListLotsRequest
+ */
+export declare type ListLotsRequest = Message<"ntx.v1.ListLotsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: optional string stock_symbol = 2;
+   */
+  stockSymbol?: string;
+};
+
+/**
+ * Describes the message ntx.v1.ListLotsRequest.
+ * Use `create(ListLotsRequestSchema)` to create a new message.
+ */
+export declare const ListLotsRequestSchema: GenMessage<ListLotsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListLotsResponse
+ */
+export declare type ListLotsResponse = Message<"ntx.v1.ListLotsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Lot lots = 1;
+   */
+  lots: Lot[];
+
+  /**
+   * sellable at the 5% long-term rate
+   *
+   * @generated from field: int64 long_term_quantity = 2;
+   */
+  longTermQuantity: bigint;
+
+  /**
+   * @generated from field: int64 short_term_quantity = 3;
+   */
+  shortTermQuantity: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.ListLotsResponse.
+ * Use `create(ListLotsResponseSchema)` to create a new message.
+ */
+export declare const ListLotsResponseSchema: GenMessage<ListLotsResponse>;
+
+/**
+ * @generated from message ntx.v1.ImportConflict
+ */
+export declare type ImportConflict = Message<"ntx.v1.ImportConflict"> & {
+  /**
+   * @generated from field: int32 line = 1;
+   */
+  line: number;
+
+  /**
+   * @generated from field: ntx.v1.Transaction existing = 2;
+   */
+  existing?: Transaction;
+
+  /**
+   * @generated from field: ntx.v1.Transaction imported = 3;
+   */
+  imported?: Transaction;
+
+  /**
+   * @generated from field: ntx.v1.ConflictStrategy resolution = 4;
+   */
+  resolution: ConflictStrategy;
+};
+
+/**
+ * Describes the message ntx.v1.ImportConflict.
+ * Use `create(ImportConflictSchema)` to create a new message.
+ */
+export declare const ImportConflictSchema: GenMessage<ImportConflict>;
+
+/**
+ * @generated from message ntx.v1.ImportTransactionsRequest
+ */
+export declare type ImportTransactionsRequest = Message<"ntx.v1.ImportTransactionsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * CSV with header: symbol,type,quantity,price,date
+   *
+   * @generated from field: bytes csv_data = 2;
+   */
+  csvData: Uint8Array;
+
+  /**
+   * unspecified means skip
+   *
+   * @generated from field: ntx.v1.ConflictStrategy conflict_strategy = 3;
+   */
+  conflictStrategy: ConflictStrategy;
+};
+
+/**
+ * Describes the message ntx.v1.ImportTransactionsRequest.
+ * Use `create(ImportTransactionsRequestSchema)` to create a new message.
+ */
+export declare const ImportTransactionsRequestSchema: GenMessage<ImportTransactionsRequest>;
+
+/**
+ * @generated from message ntx.v1.ImportTransactionsResponse
+ */
+export declare type ImportTransactionsResponse = Message<"ntx.v1.ImportTransactionsResponse"> & {
+  /**
+   * @generated from field: int32 imported = 1;
+   */
+  imported: number;
+
+  /**
+   * @generated from field: int32 skipped = 2;
+   */
+  skipped: number;
+
+  /**
+   * @generated from field: int32 replaced = 3;
+   */
+  replaced: number;
+
+  /**
+   * @generated from field: repeated ntx.v1.ImportConflict conflicts = 4;
+   */
+  conflicts: ImportConflict[];
+};
+
+/**
+ * Describes the message ntx.v1.ImportTransactionsResponse.
+ * Use `create(ImportTransactionsResponseSchema)` to create a new message.
+ */
+export declare const ImportTransactionsResponseSchema: GenMessage<ImportTransactionsResponse>;
+
+/**
+ * @generated from message ntx.v1.ExportTransactionsRequest
+ */
+export declare type ExportTransactionsRequest = Message<"ntx.v1.ExportTransactionsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.ExportTransactionsRequest.
+ * Use `create(ExportTransactionsRequestSchema)` to create a new message.
+ */
+export declare const ExportTransactionsRequestSchema: GenMessage<ExportTransactionsRequest>;
+
+/**
+ * ExportTransactionsResponse is every transaction in the import file layout,
+ * oldest first. Importing it into another instance with the skip strategy
+ * adds only the trades that instance is missing.
+ *
+ * @generated from message ntx.v1.ExportTransactionsResponse
+ */
+export declare type ExportTransactionsResponse = Message<"ntx.v1.ExportTransactionsResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes csv_data = 2;
+   */
+  csvData: Uint8Array;
+};
+
+/**
+ * Describes the message ntx.v1.ExportTransactionsResponse.
+ * Use `create(ExportTransactionsResponseSchema)` to create a new message.
+ */
+export declare const ExportTransactionsResponseSchema: GenMessage<ExportTransactionsResponse>;
+
+/**
+ * @generated from message ntx.v1.PortfolioHistoryPoint
+ */
+export declare type PortfolioHistoryPoint = Message<"ntx.v1.PortfolioHistoryPoint"> & {
+  /**
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: double value = 2;
+   */
+  value: number;
+
+  /**
+   * @generated from field: double cost = 3;
+   */
+  cost: number;
+
+  /**
+   * @generated from field: double realized_pnl = 4;
+   */
+  realizedPnl: number;
+
+  /**
+   * @generated from field: double unrealized_pnl = 5;
+   */
+  unrealizedPnl: number;
+};
+
+/**
+ * Describes the message ntx.v1.PortfolioHistoryPoint.
+ * Use `create(PortfolioHistoryPointSchema)` to create a new message.
+ */
+export declare const PortfolioHistoryPointSchema: GenMessage<PortfolioHistoryPoint>;
+
+/**
+ * @generated from message ntx.v1.GetPortfolioHistoryRequest
+ */
+export declare type GetPortfolioHistoryRequest = Message<"ntx.v1.GetPortfolioHistoryRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * YYYY-MM-DD, defaults to one year ago
+   *
+   * @generated from field: string from_date = 2;
+   */
+  fromDate: string;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string to_date = 3;
+   */
+  toDate: string;
+
+  /**
+   * @generated from field: ntx.v1.HistoryInterval interval = 4;
+   */
+  interval: HistoryInterval;
+};
+
+/**
+ * Describes the message ntx.v1.GetPortfolioHistoryRequest.
+ * Use `create(GetPortfolioHistoryRequestSchema)` to create a new message.
+ */
+export declare const GetPortfolioHistoryRequestSchema: GenMessage<GetPortfolioHistoryRequest>;
+
+/**
+ * @generated from message ntx.v1.GetPortfolioHistoryResponse
+ */
+export declare type GetPortfolioHistoryResponse = Message<"ntx.v1.GetPortfolioHistoryResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.PortfolioHistoryPoint points = 1;
+   */
+  points: PortfolioHistoryPoint[];
+};
+
+/**
+ * Describes the message ntx.v1.GetPortfolioHistoryResponse.
+ * Use `create(GetPortfolioHistoryResponseSchema)` to create a new message.
+ */
+export declare const GetPortfolioHistoryResponseSchema: GenMessage<GetPortfolioHistoryResponse>;
+
+/**
+ * @generated from message ntx.v1.PortfolioBreakdown
+ */
+export declare type PortfolioBreakdown = Message<"ntx.v1.PortfolioBreakdown"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string portfolio_name = 2;
+   */
+  portfolioName: string;
+
+  /**
+   * @generated from field: double total_invested = 3;
+   */
+  totalInvested: number;
+
+  /**
+   * @generated from field: double total_current_value = 4;
+   */
+  totalCurrentValue: number;
+
+  /**
+   * @generated from field: double total_profit_loss = 5;
+   */
+  totalProfitLoss: number;
+
+  /**
+   * @generated from field: double day_change_value = 6;
+   */
+  dayChangeValue: number;
+
+  /**
+   * @generated from field: double weight_percent = 7;
+   */
+  weightPercent: number;
+
+  /**
+   * @generated from field: optional int64 profile_id = 8;
+   */
+  profileId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.PortfolioBreakdown.
+ * Use `create(PortfolioBreakdownSchema)` to create a new message.
+ */
+export declare const PortfolioBreakdownSchema: GenMessage<PortfolioBreakdown>;
+
+/**
+ * @generated from message ntx.v1.TaxSummary
+ */
+export declare type TaxSummary = Message<"ntx.v1.TaxSummary"> & {
+  /**
+   * 1 Shrawan
+   *
+   * @generated from field: string fiscal_year_start = 1;
+   */
+  fiscalYearStart: string;
+
+  /**
+   * @generated from field: double short_term_gain = 2;
+   */
+  shortTermGain: number;
+
+  /**
+   * @generated from field: double long_term_gain = 3;
+   */
+  longTermGain: number;
+
+  /**
+   * @generated from field: double estimated_tax = 4;
+   */
+  estimatedTax: number;
+
+  /**
+   * the day before the next 1 Shrawan
+   *
+   * @generated from field: string fiscal_year_end = 5;
+   */
+  fiscalYearEnd: string;
+};
+
+/**
+ * Describes the message ntx.v1.TaxSummary.
+ * Use `create(TaxSummarySchema)` to create a new message.
+ */
+export declare const TaxSummarySchema: GenMessage<TaxSummary>;
+
+/**
+ * @generated from message ntx.v1.ConsolidatedSummary
+ */
+export declare type ConsolidatedSummary = Message<"ntx.v1.ConsolidatedSummary"> & {
+  /**
+   * @generated from field: repeated ntx.v1.PortfolioBreakdown portfolios = 1;
+   */
+  portfolios: PortfolioBreakdown[];
+
+  /**
+   * merged by symbol across portfolios
+   *
+   * @generated from field: repeated ntx.v1.Holding holdings = 2;
+   */
+  holdings: Holding[];
+
+  /**
+   * @generated from field: double total_invested = 3;
+   */
+  totalInvested: number;
+
+  /**
+   * @generated from field: double total_current_value = 4;
+   */
+  totalCurrentValue: number;
+
+  /**
+   * @generated from field: double total_profit_loss = 5;
+   */
+  totalProfitLoss: number;
+
+  /**
+   * @generated from field: double total_profit_loss_percent = 6;
+   */
+  totalProfitLossPercent: number;
+
+  /**
+   * @generated from field: double day_change_value = 7;
+   */
+  dayChangeValue: number;
+
+  /**
+   * realized gains in the current fiscal year
+   *
+   * @generated from field: ntx.v1.TaxSummary tax = 8;
+   */
+  tax?: TaxSummary;
+};
+
+/**
+ * Describes the message ntx.v1.ConsolidatedSummary.
+ * Use `create(ConsolidatedSummarySchema)` to create a new message.
+ */
+export declare const ConsolidatedSummarySchema: GenMessage<ConsolidatedSummary>;
+
+/**
+ * @generated from message ntx.v1.GetConsolidatedSummaryRequest
+ */
+export declare type GetConsolidatedSummaryRequest = Message<"ntx.v1.GetConsolidatedSummaryRequest"> & {
+  /**
+   * Only roll up this profile's portfolios; every portfolio when unset
+   *
+   * @generated from field: optional int64 profile_id = 1;
+   */
+  profileId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.GetConsolidatedSummaryRequest.
+ * Use `create(GetConsolidatedSummaryRequestSchema)` to create a new message.
+ */
+export declare const GetConsolidatedSummaryRequestSchema: GenMessage<GetConsolidatedSummaryRequest>;
+
+/**
+ * @generated from message ntx.v1.GetConsolidatedSummaryResponse
+ */
+export declare type GetConsolidatedSummaryResponse = Message<"ntx.v1.GetConsolidatedSummaryResponse"> & {
+  /**
+   * @generated from field: ntx.v1.ConsolidatedSummary summary = 1;
+   */
+  summary?: ConsolidatedSummary;
+};
+
+/**
+ * Describes the message ntx.v1.GetConsolidatedSummaryResponse.
+ * Use `create(GetConsolidatedSummaryResponseSchema)` to create a new message.
+ */
+export declare const GetConsolidatedSummaryResponseSchema: GenMessage<GetConsolidatedSummaryResponse>;
+
+/**
+ * @generated from message ntx.v1.HoldingAttribution
+ */
+export declare type HoldingAttribution = Message<"ntx.v1.HoldingAttribution"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 start_quantity = 2;
+   */
+  startQuantity: bigint;
+
+  /**
+   * @generated from field: int64 end_quantity = 3;
+   */
+  endQuantity: bigint;
+
+  /**
+   * @generated from field: double start_value = 4;
+   */
+  startValue: number;
+
+  /**
+   * @generated from field: double end_value = 5;
+   */
+  endValue: number;
+
+  /**
+   * buys minus sells within the period
+   *
+   * @generated from field: double net_flow = 6;
+   */
+  netFlow: number;
+
+  /**
+   * P&L on the quantity held at the start
+   *
+   * @generated from field: double price_effect = 7;
+   */
+  priceEffect: number;
+
+  /**
+   * P&L on trades made within the period
+   *
+   * @generated from field: double new_money_effect = 8;
+   */
+  newMoneyEffect: number;
+
+  /**
+   * @generated from field: double total_pnl = 9;
+   */
+  totalPnl: number;
+
+  /**
+   * share of the portfolio return, in points
+   *
+   * @generated from field: double contribution_percent = 10;
+   */
+  contributionPercent: number;
+};
+
+/**
+ * Describes the message ntx.v1.HoldingAttribution.
+ * Use `create(HoldingAttributionSchema)` to create a new message.
+ */
+export declare const HoldingAttributionSchema: GenMessage<HoldingAttribution>;
+
+/**
+ * @generated from message ntx.v1.GetAttributionRequest
+ */
+export declare type GetAttributionRequest = Message<"ntx.v1.GetAttributionRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * YYYY-MM-DD, defaults to one year before to_date
+   *
+   * @generated from field: string from_date = 2;
+   */
+  fromDate: string;
+
+  /**
+   * YYYY-MM-DD, defaults to today
+   *
+   * @generated from field: string to_date = 3;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetAttributionRequest.
+ * Use `create(GetAttributionRequestSchema)` to create a new message.
+ */
+export declare const GetAttributionRequestSchema: GenMessage<GetAttributionRequest>;
+
+/**
+ * @generated from message ntx.v1.GetAttributionResponse
+ */
+export declare type GetAttributionResponse = Message<"ntx.v1.GetAttributionResponse"> & {
+  /**
+   * largest contribution first
+   *
+   * @generated from field: repeated ntx.v1.HoldingAttribution holdings = 1;
+   */
+  holdings: HoldingAttribution[];
+
+  /**
+   * @generated from field: double start_value = 2;
+   */
+  startValue: number;
+
+  /**
+   * @generated from field: double end_value = 3;
+   */
+  endValue: number;
+
+  /**
+   * @generated from field: double net_flow = 4;
+   */
+  netFlow: number;
+
+  /**
+   * @generated from field: double total_pnl = 5;
+   */
+  totalPnl: number;
+
+  /**
+   * @generated from field: double return_percent = 6;
+   */
+  returnPercent: number;
+};
+
+/**
+ * Describes the message ntx.v1.GetAttributionResponse.
+ * Use `create(GetAttributionResponseSchema)` to create a new message.
+ */
+export declare const GetAttributionResponseSchema: GenMessage<GetAttributionResponse>;
+
+/**
+ * @generated from message ntx.v1.ProjectPortfolioRequest
+ */
+export declare type ProjectPortfolioRequest = Message<"ntx.v1.ProjectPortfolioRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * defaults to 2000, capped at 20000
+   *
+   * @generated from field: int32 simulations = 2;
+   */
+  simulations: number;
+
+  /**
+   * defaults to 1, 3 and 5
+   *
+   * @generated from field: repeated int32 horizon_years = 3;
+   */
+  horizonYears: number[];
+
+  /**
+   * for reproducible runs
+   *
+   * @generated from field: optional uint64 seed = 4;
+   */
+  seed?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.ProjectPortfolioRequest.
+ * Use `create(ProjectPortfolioRequestSchema)` to create a new message.
+ */
+export declare const ProjectPortfolioRequestSchema: GenMessage<ProjectPortfolioRequest>;
+
+/**
+ * ProjectionBand is the distribution of simulated portfolio values at one
+ * horizon.
+ *
+ * @generated from message ntx.v1.ProjectionBand
+ */
+export declare type ProjectionBand = Message<"ntx.v1.ProjectionBand"> & {
+  /**
+   * @generated from field: int32 horizon_years = 1;
+   */
+  horizonYears: number;
+
+  /**
+   * @generated from field: double p5 = 2;
+   */
+  p5: number;
+
+  /**
+   * @generated from field: double p25 = 3;
+   */
+  p25: number;
+
+  /**
+   * @generated from field: double p50 = 4;
+   */
+  p50: number;
+
+  /**
+   * @generated from field: double p75 = 5;
+   */
+  p75: number;
+
+  /**
+   * @generated from field: double p95 = 6;
+   */
+  p95: number;
+
+  /**
+   * share of paths ending below current value
+   *
+   * @generated from field: double probability_of_loss = 7;
+   */
+  probabilityOfLoss: number;
+};
+
+/**
+ * Describes the message ntx.v1.ProjectionBand.
+ * Use `create(ProjectionBandSchema)` to create a new message.
+ */
+export declare const ProjectionBandSchema: GenMessage<ProjectionBand>;
+
+/**
+ * @generated from message ntx.v1.ProjectPortfolioResponse
+ */
+export declare type ProjectPortfolioResponse = Message<"ntx.v1.ProjectPortfolioResponse"> & {
+  /**
+   * @generated from field: double current_value = 1;
+   */
+  currentValue: number;
+
+  /**
+   * @generated from field: repeated ntx.v1.ProjectionBand bands = 2;
+   */
+  bands: ProjectionBand[];
+
+  /**
+   * trading days of returns the model was fitted on
+   *
+   * @generated from field: int32 history_days = 3;
+   */
+  historyDays: number;
+
+  /**
+   * holdings left at their current value for lack of price history
+   *
+   * @generated from field: repeated string excluded_symbols = 4;
+   */
+  excludedSymbols: string[];
+};
+
+/**
+ * Describes the message ntx.v1.ProjectPortfolioResponse.
+ * Use `create(ProjectPortfolioResponseSchema)` to create a new message.
+ */
+export declare const ProjectPortfolioResponseSchema: GenMessage<ProjectPortfolioResponse>;
+
+/**
+ * @generated from message ntx.v1.SectorShock
+ */
+export declare type SectorShock = Message<"ntx.v1.SectorShock"> & {
+  /**
+   * @generated from field: string sector = 1;
+   */
+  sector: string;
+
+  /**
+   * e.g. -20 for a 20% fall
+   *
+   * @generated from field: double change_percent = 2;
+   */
+  changePercent: number;
+};
+
+/**
+ * Describes the message ntx.v1.SectorShock.
+ * Use `create(SectorShockSchema)` to create a new message.
+ */
+export declare const SectorShockSchema: GenMessage<SectorShock>;
+
+/**
+ * @generated from message ntx.v1.RunScenarioRequest
+ */
+export declare type RunScenarioRequest = Message<"ntx.v1.RunScenarioRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * Market move applied to each holding scaled by its beta to NEPSE.
+   * Holdings in a shocked sector use the sector shock instead.
+   *
+   * @generated from field: optional double index_change_percent = 2;
+   */
+  indexChangePercent?: number;
+
+  /**
+   * @generated from field: repeated ntx.v1.SectorShock sector_shocks = 3;
+   */
+  sectorShocks: SectorShock[];
+};
+
+/**
+ * Describes the message ntx.v1.RunScenarioRequest.
+ * Use `create(RunScenarioRequestSchema)` to create a new message.
+ */
+export declare const RunScenarioRequestSchema: GenMessage<RunScenarioRequest>;
+
+/**
+ * @generated from message ntx.v1.ScenarioHolding
+ */
+export declare type ScenarioHolding = Message<"ntx.v1.ScenarioHolding"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: string sector = 2;
+   */
+  sector: string;
+
+  /**
+   * @generated from field: double current_value = 3;
+   */
+  currentValue: number;
+
+  /**
+   * @generated from field: double projected_value = 4;
+   */
+  projectedValue: number;
+
+  /**
+   * @generated from field: double change_percent = 5;
+   */
+  changePercent: number;
+
+  /**
+   * set when the index shock was applied
+   *
+   * @generated from field: optional double beta = 6;
+   */
+  beta?: number;
+};
+
+/**
+ * Describes the message ntx.v1.ScenarioHolding.
+ * Use `create(ScenarioHoldingSchema)` to create a new message.
+ */
+export declare const ScenarioHoldingSchema: GenMessage<ScenarioHolding>;
+
+/**
+ * @generated from message ntx.v1.RunScenarioResponse
+ */
+export declare type RunScenarioResponse = Message<"ntx.v1.RunScenarioResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.ScenarioHolding holdings = 1;
+   */
+  holdings: ScenarioHolding[];
+
+  /**
+   * @generated from field: double current_value = 2;
+   */
+  currentValue: number;
+
+  /**
+   * @generated from field: double projected_value = 3;
+   */
+  projectedValue: number;
+
+  /**
+   * @generated from field: double change_value = 4;
+   */
+  changeValue: number;
+
+  /**
+   * @generated from field: double change_percent = 5;
+   */
+  changePercent: number;
+
+  /**
+   * against total invested
+   *
+   * @generated from field: double projected_profit_loss = 6;
+   */
+  projectedProfitLoss: number;
+};
+
+/**
+ * Describes the message ntx.v1.RunScenarioResponse.
+ * Use `create(RunScenarioResponseSchema)` to create a new message.
+ */
+export declare const RunScenarioResponseSchema: GenMessage<RunScenarioResponse>;
+
+/**
+ * @generated from message ntx.v1.CalculatePositionSizeRequest
+ */
+export declare type CalculatePositionSizeRequest = Message<"ntx.v1.CalculatePositionSizeRequest"> & {
+  /**
+   * @generated from field: double account_size = 1;
+   */
+  accountSize: number;
+
+  /**
+   * share of the account to lose if stopped out
+   *
+   * @generated from field: double risk_percent = 2;
+   */
+  riskPercent: number;
+
+  /**
+   * @generated from field: double entry_price = 3;
+   */
+  entryPrice: number;
+
+  /**
+   * must be below entry
+   *
+   * @generated from field: double stop_price = 4;
+   */
+  stopPrice: number;
+
+  /**
+   * Optional, used to fill in the transaction draft
+   *
+   * @generated from field: int64 portfolio_id = 5;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 6;
+   */
+  stockSymbol: string;
+};
+
+/**
+ * Describes the message ntx.v1.CalculatePositionSizeRequest.
+ * Use `create(CalculatePositionSizeRequestSchema)` to create a new message.
+ */
+export declare const CalculatePositionSizeRequestSchema: GenMessage<CalculatePositionSizeRequest>;
+
+/**
+ * @generated from message ntx.v1.CalculatePositionSizeResponse
+ */
+export declare type CalculatePositionSizeResponse = Message<"ntx.v1.CalculatePositionSizeResponse"> & {
+  /**
+   * @generated from field: int64 quantity = 1;
+   */
+  quantity: bigint;
+
+  /**
+   * the most the trade may lose
+   *
+   * @generated from field: double risk_amount = 2;
+   */
+  riskAmount: number;
+
+  /**
+   * entry minus stop
+   *
+   * @generated from field: double risk_per_share = 3;
+   */
+  riskPerShare: number;
+
+  /**
+   * quantity x entry
+   *
+   * @generated from field: double position_value = 4;
+   */
+  positionValue: number;
+
+  /**
+   * @generated from field: double commission = 5;
+   */
+  commission: number;
+
+  /**
+   * @generated from field: double sebon_fee = 6;
+   */
+  sebonFee: number;
+
+  /**
+   * @generated from field: double dp_charge = 7;
+   */
+  dpCharge: number;
+
+  /**
+   * position value plus fees
+   *
+   * @generated from field: double total_cost = 8;
+   */
+  totalCost: number;
+
+  /**
+   * including buy and sell fees
+   *
+   * @generated from field: double loss_at_stop = 9;
+   */
+  lossAtStop: number;
+
+  /**
+   * total cost as a share of the account
+   *
+   * @generated from field: double account_percent = 10;
+   */
+  accountPercent: number;
+
+  /**
+   * quantity was reduced to fit the account
+   *
+   * @generated from field: bool capped_by_account = 11;
+   */
+  cappedByAccount: boolean;
+
+  /**
+   * ready to submit as a BUY
+   *
+   * @generated from field: ntx.v1.AddTransactionRequest draft = 12;
+   */
+  draft?: AddTransactionRequest;
+};
+
+/**
+ * Describes the message ntx.v1.CalculatePositionSizeResponse.
+ * Use `create(CalculatePositionSizeResponseSchema)` to create a new message.
+ */
+export declare const CalculatePositionSizeResponseSchema: GenMessage<CalculatePositionSizeResponse>;
+
+/**
+ * Tag is a user-defined strategy label such as "IPO flip" or "dividend play".
+ *
+ * @generated from message ntx.v1.Tag
+ */
+export declare type Tag = Message<"ntx.v1.Tag"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ntx.v1.Tag.
+ * Use `create(TagSchema)` to create a new message.
+ */
+export declare const TagSchema: GenMessage<Tag>;
+
+/**
+ * @generated from message ntx.v1.CreateTagRequest
+ */
+export declare type CreateTagRequest = Message<"ntx.v1.CreateTagRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ntx.v1.CreateTagRequest.
+ * Use `create(CreateTagRequestSchema)` to create a new message.
+ */
+export declare const CreateTagRequestSchema: GenMessage<CreateTagRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateTagResponse
+ */
+export declare type CreateTagResponse = Message<"ntx.v1.CreateTagResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Tag tag = 1;
+   */
+  tag?: Tag;
+};
+
+/**
+ * Describes the message ntx.v1.CreateTagResponse.
+ * Use `create(CreateTagResponseSchema)` to create a new message.
+ */
+export declare const CreateTagResponseSchema: GenMessage<CreateTagResponse>;
+
+/**
+ * @generated from message ntx.v1.ListTagsRequest
+ */
+export declare type ListTagsRequest = Message<"ntx.v1.ListTagsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListTagsRequest.
+ * Use `create(ListTagsRequestSchema)` to create a new message.
+ */
+export declare const ListTagsRequestSchema: GenMessage<ListTagsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListTagsResponse
+ */
+export declare type ListTagsResponse = Message<"ntx.v1.ListTagsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Tag tags = 1;
+   */
+  tags: Tag[];
+};
+
+/**
+ * Describes the message ntx.v1.ListTagsResponse.
+ * Use `create(ListTagsResponseSchema)` to create a new message.
+ */
+export declare const ListTagsResponseSchema: GenMessage<ListTagsResponse>;
+
+/**
+ * @generated from message ntx.v1.RenameTagRequest
+ */
+export declare type RenameTagRequest = Message<"ntx.v1.RenameTagRequest"> & {
+  /**
+   * @generated from field: int64 tag_id = 1;
+   */
+  tagId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ntx.v1.RenameTagRequest.
+ * Use `create(RenameTagRequestSchema)` to create a new message.
+ */
+export declare const RenameTagRequestSchema: GenMessage<RenameTagRequest>;
+
+/**
+ * @generated from message ntx.v1.RenameTagResponse
+ */
+export declare type RenameTagResponse = Message<"ntx.v1.RenameTagResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Tag tag = 1;
+   */
+  tag?: Tag;
+};
+
+/**
+ * Describes the message ntx.v1.RenameTagResponse.
+ * Use `create(RenameTagResponseSchema)` to create a new message.
+ */
+export declare const RenameTagResponseSchema: GenMessage<RenameTagResponse>;
+
+/**
+ * @generated from message ntx.v1.DeleteTagRequest
+ */
+export declare type DeleteTagRequest = Message<"ntx.v1.DeleteTagRequest"> & {
+  /**
+   * @generated from field: int64 tag_id = 1;
+   */
+  tagId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteTagRequest.
+ * Use `create(DeleteTagRequestSchema)` to create a new message.
+ */
+export declare const DeleteTagRequestSchema: GenMessage<DeleteTagRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteTagResponse
+ */
+export declare type DeleteTagResponse = Message<"ntx.v1.DeleteTagResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteTagResponse.
+ * Use `create(DeleteTagResponseSchema)` to create a new message.
+ */
+export declare const DeleteTagResponseSchema: GenMessage<DeleteTagResponse>;
+
+/**
+ * SetTransactionTagsRequest replaces the tags on a transaction; an empty
+ * list clears them.
+ *
+ * @generated from message ntx.v1.SetTransactionTagsRequest
+ */
+export declare type SetTransactionTagsRequest = Message<"ntx.v1.SetTransactionTagsRequest"> & {
+  /**
+   * @generated from field: int64 transaction_id = 1;
+   */
+  transactionId: bigint;
+
+  /**
+   * @generated from field: repeated int64 tag_ids = 2;
+   */
+  tagIds: bigint[];
+};
+
+/**
+ * Describes the message ntx.v1.SetTransactionTagsRequest.
+ * Use `create(SetTransactionTagsRequestSchema)` to create a new message.
+ */
+export declare const SetTransactionTagsRequestSchema: GenMessage<SetTransactionTagsRequest>;
+
+/**
+ * @generated from message ntx.v1.SetTransactionTagsResponse
+ */
+export declare type SetTransactionTagsResponse = Message<"ntx.v1.SetTransactionTagsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Tag tags = 1;
+   */
+  tags: Tag[];
+};
+
+/**
+ * Describes the message ntx.v1.SetTransactionTagsResponse.
+ * Use `create(SetTransactionTagsResponseSchema)` to create a new message.
+ */
+export declare const SetTransactionTagsResponseSchema: GenMessage<SetTransactionTagsResponse>;
+
+/**
+ * @generated from message ntx.v1.TagPerformance
+ */
+export declare type TagPerformance = Message<"ntx.v1.TagPerformance"> & {
+  /**
+   * unset for trades without a tag
+   *
+   * @generated from field: ntx.v1.Tag tag = 1;
+   */
+  tag?: Tag;
+
+  /**
+   * @generated from field: int32 trade_count = 2;
+   */
+  tradeCount: number;
+
+  /**
+   * @generated from field: double realized_gain = 3;
+   */
+  realizedGain: number;
+
+  /**
+   * @generated from field: double short_term_gain = 4;
+   */
+  shortTermGain: number;
+
+  /**
+   * @generated from field: double long_term_gain = 5;
+   */
+  longTermGain: number;
+
+  /**
+   * @generated from field: double estimated_tax = 6;
+   */
+  estimatedTax: number;
+
+  /**
+   * cost of lots still held
+   *
+   * @generated from field: double open_cost = 7;
+   */
+  openCost: number;
+
+  /**
+   * @generated from field: double open_value = 8;
+   */
+  openValue: number;
+
+  /**
+   * @generated from field: double unrealized_pnl = 9;
+   */
+  unrealizedPnl: number;
+
+  /**
+   * @generated from field: double total_pnl = 10;
+   */
+  totalPnl: number;
+};
+
+/**
+ * Describes the message ntx.v1.TagPerformance.
+ * Use `create(TagPerformanceSchema)` to create a new message.
+ */
+export declare const TagPerformanceSchema: GenMessage<TagPerformance>;
+
+/**
+ * @generated from message ntx.v1.GetTagPerformanceRequest
+ */
+export declare type GetTagPerformanceRequest = Message<"ntx.v1.GetTagPerformanceRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * only report this tag
+   *
+   * @generated from field: optional int64 tag_id = 2;
+   */
+  tagId?: bigint;
+
+  /**
+   * Limit realized gains to sales in this range; empty means all time
+   *
+   * YYYY-MM-DD
+   *
+   * @generated from field: string from_date = 3;
+   */
+  fromDate: string;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string to_date = 4;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetTagPerformanceRequest.
+ * Use `create(GetTagPerformanceRequestSchema)` to create a new message.
+ */
+export declare const GetTagPerformanceRequestSchema: GenMessage<GetTagPerformanceRequest>;
+
+/**
+ * Each sale counts toward the tags on both its buy and sell, so a trade with
+ * several tags is reported under each of them.
+ *
+ * @generated from message ntx.v1.GetTagPerformanceResponse
+ */
+export declare type GetTagPerformanceResponse = Message<"ntx.v1.GetTagPerformanceResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.TagPerformance tags = 1;
+   */
+  tags: TagPerformance[];
+};
+
+/**
+ * Describes the message ntx.v1.GetTagPerformanceResponse.
+ * Use `create(GetTagPerformanceResponseSchema)` to create a new message.
+ */
+export declare const GetTagPerformanceResponseSchema: GenMessage<GetTagPerformanceResponse>;
+
+/**
+ * BrokerAccount is a trading account with a TMS broker.
+ *
+ * @generated from message ntx.v1.BrokerAccount
+ */
+export declare type BrokerAccount = Message<"ntx.v1.BrokerAccount"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * NEPSE member number, e.g. 58
+   *
+   * @generated from field: int32 broker_number = 2;
+   */
+  brokerNumber: number;
+
+  /**
+   * @generated from field: string client_id = 3;
+   */
+  clientId: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ntx.v1.BrokerAccount.
+ * Use `create(BrokerAccountSchema)` to create a new message.
+ */
+export declare const BrokerAccountSchema: GenMessage<BrokerAccount>;
+
+/**
+ * @generated from message ntx.v1.CreateBrokerAccountRequest
+ */
+export declare type CreateBrokerAccountRequest = Message<"ntx.v1.CreateBrokerAccountRequest"> & {
+  /**
+   * @generated from field: int32 broker_number = 1;
+   */
+  brokerNumber: number;
+
+  /**
+   * @generated from field: string client_id = 2;
+   */
+  clientId: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message ntx.v1.CreateBrokerAccountRequest.
+ * Use `create(CreateBrokerAccountRequestSchema)` to create a new message.
+ */
+export declare const CreateBrokerAccountRequestSchema: GenMessage<CreateBrokerAccountRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateBrokerAccountResponse
+ */
+export declare type CreateBrokerAccountResponse = Message<"ntx.v1.CreateBrokerAccountResponse"> & {
+  /**
+   * @generated from field: ntx.v1.BrokerAccount account = 1;
+   */
+  account?: BrokerAccount;
+};
+
+/**
+ * Describes the message ntx.v1.CreateBrokerAccountResponse.
+ * Use `create(CreateBrokerAccountResponseSchema)` to create a new message.
+ */
+export declare const CreateBrokerAccountResponseSchema: GenMessage<CreateBrokerAccountResponse>;
+
+/**
+ * @generated from message ntx.v1.ListBrokerAccountsRequest
+ */
+export declare type ListBrokerAccountsRequest = Message<"ntx.v1.ListBrokerAccountsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListBrokerAccountsRequest.
+ * Use `create(ListBrokerAccountsRequestSchema)` to create a new message.
+ */
+export declare const ListBrokerAccountsRequestSchema: GenMessage<ListBrokerAccountsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListBrokerAccountsResponse
+ */
+export declare type ListBrokerAccountsResponse = Message<"ntx.v1.ListBrokerAccountsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.BrokerAccount accounts = 1;
+   */
+  accounts: BrokerAccount[];
+};
+
+/**
+ * Describes the message ntx.v1.ListBrokerAccountsResponse.
+ * Use `create(ListBrokerAccountsResponseSchema)` to create a new message.
+ */
+export declare const ListBrokerAccountsResponseSchema: GenMessage<ListBrokerAccountsResponse>;
+
+/**
+ * @generated from message ntx.v1.DeleteBrokerAccountRequest
+ */
+export declare type DeleteBrokerAccountRequest = Message<"ntx.v1.DeleteBrokerAccountRequest"> & {
+  /**
+   * @generated from field: int64 account_id = 1;
+   */
+  accountId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteBrokerAccountRequest.
+ * Use `create(DeleteBrokerAccountRequestSchema)` to create a new message.
+ */
+export declare const DeleteBrokerAccountRequestSchema: GenMessage<DeleteBrokerAccountRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteBrokerAccountResponse
+ */
+export declare type DeleteBrokerAccountResponse = Message<"ntx.v1.DeleteBrokerAccountResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteBrokerAccountResponse.
+ * Use `create(DeleteBrokerAccountResponseSchema)` to create a new message.
+ */
+export declare const DeleteBrokerAccountResponseSchema: GenMessage<DeleteBrokerAccountResponse>;
+
+/**
+ * @generated from message ntx.v1.SetTransactionBrokerRequest
+ */
+export declare type SetTransactionBrokerRequest = Message<"ntx.v1.SetTransactionBrokerRequest"> & {
+  /**
+   * @generated from field: int64 transaction_id = 1;
+   */
+  transactionId: bigint;
+
+  /**
+   * unset clears the broker
+   *
+   * @generated from field: optional int64 broker_account_id = 2;
+   */
+  brokerAccountId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.SetTransactionBrokerRequest.
+ * Use `create(SetTransactionBrokerRequestSchema)` to create a new message.
+ */
+export declare const SetTransactionBrokerRequestSchema: GenMessage<SetTransactionBrokerRequest>;
+
+/**
+ * @generated from message ntx.v1.SetTransactionBrokerResponse
+ */
+export declare type SetTransactionBrokerResponse = Message<"ntx.v1.SetTransactionBrokerResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.SetTransactionBrokerResponse.
+ * Use `create(SetTransactionBrokerResponseSchema)` to create a new message.
+ */
+export declare const SetTransactionBrokerResponseSchema: GenMessage<SetTransactionBrokerResponse>;
+
+/**
+ * BrokerCommission totals the charges paid through one broker account,
+ * estimated from the SEBON fee schedule.
+ *
+ * @generated from message ntx.v1.BrokerCommission
+ */
+export declare type BrokerCommission = Message<"ntx.v1.BrokerCommission"> & {
+  /**
+   * unset for transactions without a broker
+   *
+   * @generated from field: ntx.v1.BrokerAccount account = 1;
+   */
+  account?: BrokerAccount;
+
+  /**
+   * @generated from field: int32 trade_count = 2;
+   */
+  tradeCount: number;
+
+  /**
+   * @generated from field: double buy_amount = 3;
+   */
+  buyAmount: number;
+
+  /**
+   * @generated from field: double sell_amount = 4;
+   */
+  sellAmount: number;
+
+  /**
+   * @generated from field: double commission = 5;
+   */
+  commission: number;
+
+  /**
+   * @generated from field: double sebon_fee = 6;
+   */
+  sebonFee: number;
+
+  /**
+   * @generated from field: double dp_charges = 7;
+   */
+  dpCharges: number;
+
+  /**
+   * @generated from field: double total_fees = 8;
+   */
+  totalFees: number;
+};
+
+/**
+ * Describes the message ntx.v1.BrokerCommission.
+ * Use `create(BrokerCommissionSchema)` to create a new message.
+ */
+export declare const BrokerCommissionSchema: GenMessage<BrokerCommission>;
+
+/**
+ * @generated from message ntx.v1.GetBrokerCommissionsRequest
+ */
+export declare type GetBrokerCommissionsRequest = Message<"ntx.v1.GetBrokerCommissionsRequest"> & {
+  /**
+   * every portfolio when unset
+   *
+   * @generated from field: optional int64 portfolio_id = 1;
+   */
+  portfolioId?: bigint;
+
+  /**
+   * YYYY-MM-DD, empty means all time
+   *
+   * @generated from field: string from_date = 2;
+   */
+  fromDate: string;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string to_date = 3;
+   */
+  toDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetBrokerCommissionsRequest.
+ * Use `create(GetBrokerCommissionsRequestSchema)` to create a new message.
+ */
+export declare const GetBrokerCommissionsRequestSchema: GenMessage<GetBrokerCommissionsRequest>;
+
+/**
+ * @generated from message ntx.v1.GetBrokerCommissionsResponse
+ */
+export declare type GetBrokerCommissionsResponse = Message<"ntx.v1.GetBrokerCommissionsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.BrokerCommission brokers = 1;
+   */
+  brokers: BrokerCommission[];
+};
+
+/**
+ * Describes the message ntx.v1.GetBrokerCommissionsResponse.
+ * Use `create(GetBrokerCommissionsResponseSchema)` to create a new message.
+ */
+export declare const GetBrokerCommissionsResponseSchema: GenMessage<GetBrokerCommissionsResponse>;
+
+/**
+ * Profile is a demat account holder managed under the login, such as a
+ * family member or a minor whose account a parent operates.
+ *
+ * @generated from message ntx.v1.Profile
+ */
+export declare type Profile = Message<"ntx.v1.Profile"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * 16-digit CDSC beneficiary owner ID
+   *
+   * @generated from field: string boid = 3;
+   */
+  boid: string;
+
+  /**
+   * @generated from field: string relationship = 4;
+   */
+  relationship: string;
+
+  /**
+   * @generated from field: bool minor = 5;
+   */
+  minor: boolean;
+
+  /**
+   * @generated from field: string created_at = 6;
+   */
+  createdAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.Profile.
+ * Use `create(ProfileSchema)` to create a new message.
+ */
+export declare const ProfileSchema: GenMessage<Profile>;
+
+/**
+ * @generated from message ntx.v1.CreateProfileRequest
+ */
+export declare type CreateProfileRequest = Message<"ntx.v1.CreateProfileRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string boid = 2;
+   */
+  boid: string;
+
+  /**
+   * @generated from field: string relationship = 3;
+   */
+  relationship: string;
+
+  /**
+   * @generated from field: bool minor = 4;
+   */
+  minor: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.CreateProfileRequest.
+ * Use `create(CreateProfileRequestSchema)` to create a new message.
+ */
+export declare const CreateProfileRequestSchema: GenMessage<CreateProfileRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateProfileResponse
+ */
+export declare type CreateProfileResponse = Message<"ntx.v1.CreateProfileResponse"> & {
+  /**
+   * @generated from field: ntx.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+};
+
+/**
+ * Describes the message ntx.v1.CreateProfileResponse.
+ * Use `create(CreateProfileResponseSchema)` to create a new message.
+ */
+export declare const CreateProfileResponseSchema: GenMessage<CreateProfileResponse>;
+
+/**
+ * @generated from message ntx.v1.ListProfilesRequest
+ */
+export declare type ListProfilesRequest = Message<"ntx.v1.ListProfilesRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListProfilesRequest.
+ * Use `create(ListProfilesRequestSchema)` to create a new message.
+ */
+export declare const ListProfilesRequestSchema: GenMessage<ListProfilesRequest>;
+
+/**
+ * @generated from message ntx.v1.ListProfilesResponse
+ */
+export declare type ListProfilesResponse = Message<"ntx.v1.ListProfilesResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.Profile profiles = 1;
+   */
+  profiles: Profile[];
+};
+
+/**
+ * Describes the message ntx.v1.ListProfilesResponse.
+ * Use `create(ListProfilesResponseSchema)` to create a new message.
+ */
+export declare const ListProfilesResponseSchema: GenMessage<ListProfilesResponse>;
+
+/**
+ * DeleteProfileRequest removes a profile; its portfolios are kept and
+ * become unassigned.
+ *
+ * @generated from message ntx.v1.DeleteProfileRequest
+ */
+export declare type DeleteProfileRequest = Message<"ntx.v1.DeleteProfileRequest"> & {
+  /**
+   * @generated from field: int64 profile_id = 1;
+   */
+  profileId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteProfileRequest.
+ * Use `create(DeleteProfileRequestSchema)` to create a new message.
+ */
+export declare const DeleteProfileRequestSchema: GenMessage<DeleteProfileRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteProfileResponse
+ */
+export declare type DeleteProfileResponse = Message<"ntx.v1.DeleteProfileResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteProfileResponse.
+ * Use `create(DeleteProfileResponseSchema)` to create a new message.
+ */
+export declare const DeleteProfileResponseSchema: GenMessage<DeleteProfileResponse>;
+
+/**
+ * @generated from message ntx.v1.SetPortfolioProfileRequest
+ */
+export declare type SetPortfolioProfileRequest = Message<"ntx.v1.SetPortfolioProfileRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * unset clears the profile
+   *
+   * @generated from field: optional int64 profile_id = 2;
+   */
+  profileId?: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.SetPortfolioProfileRequest.
+ * Use `create(SetPortfolioProfileRequestSchema)` to create a new message.
+ */
+export declare const SetPortfolioProfileRequestSchema: GenMessage<SetPortfolioProfileRequest>;
+
+/**
+ * @generated from message ntx.v1.SetPortfolioProfileResponse
+ */
+export declare type SetPortfolioProfileResponse = Message<"ntx.v1.SetPortfolioProfileResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.SetPortfolioProfileResponse.
+ * Use `create(SetPortfolioProfileResponseSchema)` to create a new message.
+ */
+export declare const SetPortfolioProfileResponseSchema: GenMessage<SetPortfolioProfileResponse>;
+
+/**
+ * @generated from message ntx.v1.CostEntry
+ */
+export declare type CostEntry = Message<"ntx.v1.CostEntry"> & {
+  /**
+   * @generated from field: ntx.v1.CostSource source = 1;
+   */
+  source: CostSource;
+
+  /**
+   * @generated from field: double avg_cost = 2;
+   */
+  avgCost: number;
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note: string;
+
+  /**
+   * empty for the computed cost
+   *
+   * @generated from field: string set_at = 4;
+   */
+  setAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.CostEntry.
+ * Use `create(CostEntrySchema)` to create a new message.
+ */
+export declare const CostEntrySchema: GenMessage<CostEntry>;
+
+/**
+ * @generated from message ntx.v1.SetHoldingCostRequest
+ */
+export declare type SetHoldingCostRequest = Message<"ntx.v1.SetHoldingCostRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * WACC or MANUAL
+   *
+   * @generated from field: ntx.v1.CostSource source = 3;
+   */
+  source: CostSource;
+
+  /**
+   * @generated from field: double avg_cost = 4;
+   */
+  avgCost: number;
+
+  /**
+   * @generated from field: string note = 5;
+   */
+  note: string;
+};
+
+/**
+ * Describes the message ntx.v1.SetHoldingCostRequest.
+ * Use `create(SetHoldingCostRequestSchema)` to create a new message.
+ */
+export declare const SetHoldingCostRequestSchema: GenMessage<SetHoldingCostRequest>;
+
+/**
+ * @generated from message ntx.v1.SetHoldingCostResponse
+ */
+export declare type SetHoldingCostResponse = Message<"ntx.v1.SetHoldingCostResponse"> & {
+  /**
+   * @generated from field: ntx.v1.CostEntry entry = 1;
+   */
+  entry?: CostEntry;
+};
+
+/**
+ * Describes the message ntx.v1.SetHoldingCostResponse.
+ * Use `create(SetHoldingCostResponseSchema)` to create a new message.
+ */
+export declare const SetHoldingCostResponseSchema: GenMessage<SetHoldingCostResponse>;
+
+/**
+ * @generated from message ntx.v1.ClearHoldingCostRequest
+ */
+export declare type ClearHoldingCostRequest = Message<"ntx.v1.ClearHoldingCostRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: ntx.v1.CostSource source = 3;
+   */
+  source: CostSource;
+};
+
+/**
+ * Describes the message ntx.v1.ClearHoldingCostRequest.
+ * Use `create(ClearHoldingCostRequestSchema)` to create a new message.
+ */
+export declare const ClearHoldingCostRequestSchema: GenMessage<ClearHoldingCostRequest>;
+
+/**
+ * @generated from message ntx.v1.ClearHoldingCostResponse
+ */
+export declare type ClearHoldingCostResponse = Message<"ntx.v1.ClearHoldingCostResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ClearHoldingCostResponse.
+ * Use `create(ClearHoldingCostResponseSchema)` to create a new message.
+ */
+export declare const ClearHoldingCostResponseSchema: GenMessage<ClearHoldingCostResponse>;
+
+/**
+ * CostReconciliation lists every cost recorded for one holding and which
+ * one is in effect.
+ *
+ * @generated from message ntx.v1.CostReconciliation
+ */
+export declare type CostReconciliation = Message<"ntx.v1.CostReconciliation"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 quantity = 2;
+   */
+  quantity: bigint;
+
+  /**
+   * @generated from field: ntx.v1.CostSource effective_source = 3;
+   */
+  effectiveSource: CostSource;
+
+  /**
+   * @generated from field: double effective_cost = 4;
+   */
+  effectiveCost: number;
+
+  /**
+   * the computed cost first
+   *
+   * @generated from field: repeated ntx.v1.CostEntry entries = 5;
+   */
+  entries: CostEntry[];
+
+  /**
+   * sources differ by more than 0.5%
+   *
+   * @generated from field: bool conflict = 6;
+   */
+  conflict: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.CostReconciliation.
+ * Use `create(CostReconciliationSchema)` to create a new message.
+ */
+export declare const CostReconciliationSchema: GenMessage<CostReconciliation>;
+
+/**
+ * @generated from message ntx.v1.GetCostReconciliationRequest
+ */
+export declare type GetCostReconciliationRequest = Message<"ntx.v1.GetCostReconciliationRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: bool conflicts_only = 2;
+   */
+  conflictsOnly: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.GetCostReconciliationRequest.
+ * Use `create(GetCostReconciliationRequestSchema)` to create a new message.
+ */
+export declare const GetCostReconciliationRequestSchema: GenMessage<GetCostReconciliationRequest>;
+
+/**
+ * @generated from message ntx.v1.GetCostReconciliationResponse
+ */
+export declare type GetCostReconciliationResponse = Message<"ntx.v1.GetCostReconciliationResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.CostReconciliation holdings = 1;
+   */
+  holdings: CostReconciliation[];
+};
+
+/**
+ * Describes the message ntx.v1.GetCostReconciliationResponse.
+ * Use `create(GetCostReconciliationResponseSchema)` to create a new message.
+ */
+export declare const GetCostReconciliationResponseSchema: GenMessage<GetCostReconciliationResponse>;
+
+/**
+ * @generated from message ntx.v1.ExportCostBasisRequest
+ */
+export declare type ExportCostBasisRequest = Message<"ntx.v1.ExportCostBasisRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.ExportCostBasisRequest.
+ * Use `create(ExportCostBasisRequestSchema)` to create a new message.
+ */
+export declare const ExportCostBasisRequestSchema: GenMessage<ExportCostBasisRequest>;
+
+/**
+ * ExportCostBasisResponse is every open holding at its effective cost, in
+ * the column layout of the Mero Share WACC report.
+ *
+ * @generated from message ntx.v1.ExportCostBasisResponse
+ */
+export declare type ExportCostBasisResponse = Message<"ntx.v1.ExportCostBasisResponse"> & {
+  /**
+   * @generated from field: string filename = 1;
+   */
+  filename: string;
+
+  /**
+   * @generated from field: bytes csv_data = 2;
+   */
+  csvData: Uint8Array;
+};
+
+/**
+ * Describes the message ntx.v1.ExportCostBasisResponse.
+ * Use `create(ExportCostBasisResponseSchema)` to create a new message.
+ */
+export declare const ExportCostBasisResponseSchema: GenMessage<ExportCostBasisResponse>;
+
+/**
+ * BonusExpectation is the bonus a holding should receive from one announced
+ * bonus issue. NEPSE data has no book-closure date, so eligibility is the
+ * quantity held when the issue was announced.
+ *
+ * @generated from message ntx.v1.BonusExpectation
+ */
+export declare type BonusExpectation = Message<"ntx.v1.BonusExpectation"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: string fiscal_year = 2;
+   */
+  fiscalYear: string;
+
+  /**
+   * @generated from field: double bonus_percentage = 3;
+   */
+  bonusPercentage: number;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string announced_on = 4;
+   */
+  announcedOn: string;
+
+  /**
+   * held on announced_on
+   *
+   * @generated from field: int64 eligible_quantity = 5;
+   */
+  eligibleQuantity: bigint;
+
+  /**
+   * whole shares credited to the demat account
+   *
+   * @generated from field: int64 expected_units = 6;
+   */
+  expectedUnits: bigint;
+
+  /**
+   * settled in cash, not shares
+   *
+   * @generated from field: double fractional_units = 7;
+   */
+  fractionalUnits: number;
+};
+
+/**
+ * Describes the message ntx.v1.BonusExpectation.
+ * Use `create(BonusExpectationSchema)` to create a new message.
+ */
+export declare const BonusExpectationSchema: GenMessage<BonusExpectation>;
+
+/**
+ * @generated from message ntx.v1.GetBonusExpectationsRequest
+ */
+export declare type GetBonusExpectationsRequest = Message<"ntx.v1.GetBonusExpectationsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * announcements in the last N days, default 365
+   *
+   * @generated from field: int32 days = 2;
+   */
+  days: number;
+};
+
+/**
+ * Describes the message ntx.v1.GetBonusExpectationsRequest.
+ * Use `create(GetBonusExpectationsRequestSchema)` to create a new message.
+ */
+export declare const GetBonusExpectationsRequestSchema: GenMessage<GetBonusExpectationsRequest>;
+
+/**
+ * @generated from message ntx.v1.GetBonusExpectationsResponse
+ */
+export declare type GetBonusExpectationsResponse = Message<"ntx.v1.GetBonusExpectationsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.BonusExpectation expectations = 1;
+   */
+  expectations: BonusExpectation[];
+};
+
+/**
+ * Describes the message ntx.v1.GetBonusExpectationsResponse.
+ * Use `create(GetBonusExpectationsResponseSchema)` to create a new message.
+ */
+export declare const GetBonusExpectationsResponseSchema: GenMessage<GetBonusExpectationsResponse>;
+
+/**
+ * IncomeHolding is a holding's dividend income if the company repeats its
+ * latest cash dividend, which is declared as a percent of the Rs. 100 face
+ * value.
+ *
+ * @generated from message ntx.v1.IncomeHolding
+ */
+export declare type IncomeHolding = Message<"ntx.v1.IncomeHolding"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 quantity = 2;
+   */
+  quantity: bigint;
+
+  /**
+   * of the dividend used; empty if none
+   *
+   * @generated from field: string fiscal_year = 3;
+   */
+  fiscalYear: string;
+
+  /**
+   * @generated from field: double dividend_per_share = 4;
+   */
+  dividendPerShare: number;
+
+  /**
+   * percent of average cost
+   *
+   * @generated from field: double yield_on_cost = 5;
+   */
+  yieldOnCost: number;
+
+  /**
+   * percent of current price
+   *
+   * @generated from field: double current_yield = 6;
+   */
+  currentYield: number;
+
+  /**
+   * @generated from field: double annual_income = 7;
+   */
+  annualIncome: number;
+};
+
+/**
+ * Describes the message ntx.v1.IncomeHolding.
+ * Use `create(IncomeHoldingSchema)` to create a new message.
+ */
+export declare const IncomeHoldingSchema: GenMessage<IncomeHolding>;
+
+/**
+ * @generated from message ntx.v1.GetIncomeSummaryRequest
+ */
+export declare type GetIncomeSummaryRequest = Message<"ntx.v1.GetIncomeSummaryRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.GetIncomeSummaryRequest.
+ * Use `create(GetIncomeSummaryRequestSchema)` to create a new message.
+ */
+export declare const GetIncomeSummaryRequestSchema: GenMessage<GetIncomeSummaryRequest>;
+
+/**
+ * @generated from message ntx.v1.GetIncomeSummaryResponse
+ */
+export declare type GetIncomeSummaryResponse = Message<"ntx.v1.GetIncomeSummaryResponse"> & {
+  /**
+   * highest income first
+   *
+   * @generated from field: repeated ntx.v1.IncomeHolding holdings = 1;
+   */
+  holdings: IncomeHolding[];
+
+  /**
+   * @generated from field: double annual_income = 2;
+   */
+  annualIncome: number;
+
+  /**
+   * @generated from field: double yield_on_cost = 3;
+   */
+  yieldOnCost: number;
+
+  /**
+   * @generated from field: double current_yield = 4;
+   */
+  currentYield: number;
+};
+
+/**
+ * Describes the message ntx.v1.GetIncomeSummaryResponse.
+ * Use `create(GetIncomeSummaryResponseSchema)` to create a new message.
+ */
+export declare const GetIncomeSummaryResponseSchema: GenMessage<GetIncomeSummaryResponse>;
+
+/**
+ * BondTerms are the coupon terms of a debenture or bond held in a
+ * portfolio. A holding with terms is valued as fixed income: its clean
+ * price plus accrued interest.
+ *
+ * @generated from message ntx.v1.BondTerms
+ */
+export declare type BondTerms = Message<"ntx.v1.BondTerms"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: double face_value = 2;
+   */
+  faceValue: number;
+
+  /**
+   * annual percent of face value
+   *
+   * @generated from field: double coupon_rate = 3;
+   */
+  couponRate: number;
+
+  /**
+   * @generated from field: int64 coupons_per_year = 4;
+   */
+  couponsPerYear: bigint;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string maturity_date = 5;
+   */
+  maturityDate: string;
+
+  /**
+   * @generated from field: string set_at = 6;
+   */
+  setAt: string;
+};
+
+/**
+ * Describes the message ntx.v1.BondTerms.
+ * Use `create(BondTermsSchema)` to create a new message.
+ */
+export declare const BondTermsSchema: GenMessage<BondTerms>;
+
+/**
+ * @generated from message ntx.v1.SetBondTermsRequest
+ */
+export declare type SetBondTermsRequest = Message<"ntx.v1.SetBondTermsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+
+  /**
+   * default 1000, the usual NEPSE debenture
+   *
+   * @generated from field: double face_value = 3;
+   */
+  faceValue: number;
+
+  /**
+   * @generated from field: double coupon_rate = 4;
+   */
+  couponRate: number;
+
+  /**
+   * 1, 2, 4 or 12; default 2
+   *
+   * @generated from field: int64 coupons_per_year = 5;
+   */
+  couponsPerYear: bigint;
+
+  /**
+   * @generated from field: string maturity_date = 6;
+   */
+  maturityDate: string;
+};
+
+/**
+ * Describes the message ntx.v1.SetBondTermsRequest.
+ * Use `create(SetBondTermsRequestSchema)` to create a new message.
+ */
+export declare const SetBondTermsRequestSchema: GenMessage<SetBondTermsRequest>;
+
+/**
+ * @generated from message ntx.v1.SetBondTermsResponse
+ */
+export declare type SetBondTermsResponse = Message<"ntx.v1.SetBondTermsResponse"> & {
+  /**
+   * @generated from field: ntx.v1.BondTerms terms = 1;
+   */
+  terms?: BondTerms;
+};
+
+/**
+ * Describes the message ntx.v1.SetBondTermsResponse.
+ * Use `create(SetBondTermsResponseSchema)` to create a new message.
+ */
+export declare const SetBondTermsResponseSchema: GenMessage<SetBondTermsResponse>;
+
+/**
+ * @generated from message ntx.v1.ClearBondTermsRequest
+ */
+export declare type ClearBondTermsRequest = Message<"ntx.v1.ClearBondTermsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: string stock_symbol = 2;
+   */
+  stockSymbol: string;
+};
+
+/**
+ * Describes the message ntx.v1.ClearBondTermsRequest.
+ * Use `create(ClearBondTermsRequestSchema)` to create a new message.
+ */
+export declare const ClearBondTermsRequestSchema: GenMessage<ClearBondTermsRequest>;
+
+/**
+ * @generated from message ntx.v1.ClearBondTermsResponse
+ */
+export declare type ClearBondTermsResponse = Message<"ntx.v1.ClearBondTermsResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ClearBondTermsResponse.
+ * Use `create(ClearBondTermsResponseSchema)` to create a new message.
+ */
+export declare const ClearBondTermsResponseSchema: GenMessage<ClearBondTermsResponse>;
+
+/**
+ * BondSchedule is where a bond holding stands in its coupon cycle. Coupons
+ * are assumed to fall on the maturity date's day of the month.
+ *
+ * @generated from message ntx.v1.BondSchedule
+ */
+export declare type BondSchedule = Message<"ntx.v1.BondSchedule"> & {
+  /**
+   * @generated from field: ntx.v1.BondTerms terms = 1;
+   */
+  terms?: BondTerms;
+
+  /**
+   * @generated from field: int64 quantity = 2;
+   */
+  quantity: bigint;
+
+  /**
+   * for the whole holding
+   *
+   * @generated from field: double accrued_interest = 3;
+   */
+  accruedInterest: number;
+
+  /**
+   * @generated from field: string last_coupon_on = 4;
+   */
+  lastCouponOn: string;
+
+  /**
+   * empty once matured
+   *
+   * @generated from field: string next_coupon_on = 5;
+   */
+  nextCouponOn: string;
+
+  /**
+   * for the whole holding
+   *
+   * @generated from field: double next_coupon_amount = 6;
+   */
+  nextCouponAmount: number;
+
+  /**
+   * negative once matured
+   *
+   * @generated from field: int32 days_to_maturity = 7;
+   */
+  daysToMaturity: number;
+
+  /**
+   * face value of the holding
+   *
+   * @generated from field: double redemption_value = 8;
+   */
+  redemptionValue: number;
+};
+
+/**
+ * Describes the message ntx.v1.BondSchedule.
+ * Use `create(BondScheduleSchema)` to create a new message.
+ */
+export declare const BondScheduleSchema: GenMessage<BondSchedule>;
+
+/**
+ * @generated from message ntx.v1.GetBondScheduleRequest
+ */
+export declare type GetBondScheduleRequest = Message<"ntx.v1.GetBondScheduleRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.GetBondScheduleRequest.
+ * Use `create(GetBondScheduleRequestSchema)` to create a new message.
+ */
+export declare const GetBondScheduleRequestSchema: GenMessage<GetBondScheduleRequest>;
+
+/**
+ * @generated from message ntx.v1.GetBondScheduleResponse
+ */
+export declare type GetBondScheduleResponse = Message<"ntx.v1.GetBondScheduleResponse"> & {
+  /**
+   * soonest maturity first
+   *
+   * @generated from field: repeated ntx.v1.BondSchedule bonds = 1;
+   */
+  bonds: BondSchedule[];
+};
+
+/**
+ * Describes the message ntx.v1.GetBondScheduleResponse.
+ * Use `create(GetBondScheduleResponseSchema)` to create a new message.
+ */
+export declare const GetBondScheduleResponseSchema: GenMessage<GetBondScheduleResponse>;
+
+/**
+ * HoldingGroup is a user-defined bucket such as "Speculative" or "Banking",
+ * used in place of the exchange sector for allocation. Members are whole
+ * sectors or single symbols; a symbol member wins over its sector's group.
+ *
+ * @generated from message ntx.v1.HoldingGroup
+ */
+export declare type HoldingGroup = Message<"ntx.v1.HoldingGroup"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string sectors = 3;
+   */
+  sectors: string[];
+
+  /**
+   * @generated from field: repeated string symbols = 4;
+   */
+  symbols: string[];
+
+  /**
+   * Concentration limit; the summary warns when the group goes over it.
+   *
+   * @generated from field: optional double max_weight_percent = 5;
+   */
+  maxWeightPercent?: number;
+};
+
+/**
+ * Describes the message ntx.v1.HoldingGroup.
+ * Use `create(HoldingGroupSchema)` to create a new message.
+ */
+export declare const HoldingGroupSchema: GenMessage<HoldingGroup>;
+
+/**
+ * @generated from message ntx.v1.CreateHoldingGroupRequest
+ */
+export declare type CreateHoldingGroupRequest = Message<"ntx.v1.CreateHoldingGroupRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string sectors = 2;
+   */
+  sectors: string[];
+
+  /**
+   * @generated from field: repeated string symbols = 3;
+   */
+  symbols: string[];
+
+  /**
+   * @generated from field: optional double max_weight_percent = 4;
+   */
+  maxWeightPercent?: number;
+};
+
+/**
+ * Describes the message ntx.v1.CreateHoldingGroupRequest.
+ * Use `create(CreateHoldingGroupRequestSchema)` to create a new message.
+ */
+export declare const CreateHoldingGroupRequestSchema: GenMessage<CreateHoldingGroupRequest>;
+
+/**
+ * @generated from message ntx.v1.CreateHoldingGroupResponse
+ */
+export declare type CreateHoldingGroupResponse = Message<"ntx.v1.CreateHoldingGroupResponse"> & {
+  /**
+   * @generated from field: ntx.v1.HoldingGroup group = 1;
+   */
+  group?: HoldingGroup;
+};
+
+/**
+ * Describes the message ntx.v1.CreateHoldingGroupResponse.
+ * Use `create(CreateHoldingGroupResponseSchema)` to create a new message.
+ */
+export declare const CreateHoldingGroupResponseSchema: GenMessage<CreateHoldingGroupResponse>;
+
+/**
+ * @generated from message ntx.v1.ListHoldingGroupsRequest
+ */
+export declare type ListHoldingGroupsRequest = Message<"ntx.v1.ListHoldingGroupsRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.ListHoldingGroupsRequest.
+ * Use `create(ListHoldingGroupsRequestSchema)` to create a new message.
+ */
+export declare const ListHoldingGroupsRequestSchema: GenMessage<ListHoldingGroupsRequest>;
+
+/**
+ * @generated from message ntx.v1.ListHoldingGroupsResponse
+ */
+export declare type ListHoldingGroupsResponse = Message<"ntx.v1.ListHoldingGroupsResponse"> & {
+  /**
+   * @generated from field: repeated ntx.v1.HoldingGroup groups = 1;
+   */
+  groups: HoldingGroup[];
+};
+
+/**
+ * Describes the message ntx.v1.ListHoldingGroupsResponse.
+ * Use `create(ListHoldingGroupsResponseSchema)` to create a new message.
+ */
+export declare const ListHoldingGroupsResponseSchema: GenMessage<ListHoldingGroupsResponse>;
+
+/**
+ * UpdateHoldingGroupRequest replaces the group's name, members and limit.
+ *
+ * @generated from message ntx.v1.UpdateHoldingGroupRequest
+ */
+export declare type UpdateHoldingGroupRequest = Message<"ntx.v1.UpdateHoldingGroupRequest"> & {
+  /**
+   * @generated from field: int64 group_id = 1;
+   */
+  groupId: bigint;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string sectors = 3;
+   */
+  sectors: string[];
+
+  /**
+   * @generated from field: repeated string symbols = 4;
+   */
+  symbols: string[];
+
+  /**
+   * @generated from field: optional double max_weight_percent = 5;
+   */
+  maxWeightPercent?: number;
+};
+
+/**
+ * Describes the message ntx.v1.UpdateHoldingGroupRequest.
+ * Use `create(UpdateHoldingGroupRequestSchema)` to create a new message.
+ */
+export declare const UpdateHoldingGroupRequestSchema: GenMessage<UpdateHoldingGroupRequest>;
+
+/**
+ * @generated from message ntx.v1.UpdateHoldingGroupResponse
+ */
+export declare type UpdateHoldingGroupResponse = Message<"ntx.v1.UpdateHoldingGroupResponse"> & {
+  /**
+   * @generated from field: ntx.v1.HoldingGroup group = 1;
+   */
+  group?: HoldingGroup;
+};
+
+/**
+ * Describes the message ntx.v1.UpdateHoldingGroupResponse.
+ * Use `create(UpdateHoldingGroupResponseSchema)` to create a new message.
+ */
+export declare const UpdateHoldingGroupResponseSchema: GenMessage<UpdateHoldingGroupResponse>;
+
+/**
+ * @generated from message ntx.v1.DeleteHoldingGroupRequest
+ */
+export declare type DeleteHoldingGroupRequest = Message<"ntx.v1.DeleteHoldingGroupRequest"> & {
+  /**
+   * @generated from field: int64 group_id = 1;
+   */
+  groupId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.DeleteHoldingGroupRequest.
+ * Use `create(DeleteHoldingGroupRequestSchema)` to create a new message.
+ */
+export declare const DeleteHoldingGroupRequestSchema: GenMessage<DeleteHoldingGroupRequest>;
+
+/**
+ * @generated from message ntx.v1.DeleteHoldingGroupResponse
+ */
+export declare type DeleteHoldingGroupResponse = Message<"ntx.v1.DeleteHoldingGroupResponse"> & {
+};
+
+/**
+ * Describes the message ntx.v1.DeleteHoldingGroupResponse.
+ * Use `create(DeleteHoldingGroupResponseSchema)` to create a new message.
+ */
+export declare const DeleteHoldingGroupResponseSchema: GenMessage<DeleteHoldingGroupResponse>;
+
+/**
+ * GroupAllocation is one slice of a portfolio's allocation. Holdings outside
+ * every group fall back to a slice for their sector.
+ *
+ * @generated from message ntx.v1.GroupAllocation
+ */
+export declare type GroupAllocation = Message<"ntx.v1.GroupAllocation"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * unset for a sector slice
+   *
+   * @generated from field: optional int64 group_id = 2;
+   */
+  groupId?: bigint;
+
+  /**
+   * @generated from field: double value = 3;
+   */
+  value: number;
+
+  /**
+   * @generated from field: double weight_percent = 4;
+   */
+  weightPercent: number;
+
+  /**
+   * @generated from field: repeated string symbols = 5;
+   */
+  symbols: string[];
+
+  /**
+   * @generated from field: optional double max_weight_percent = 6;
+   */
+  maxWeightPercent?: number;
+
+  /**
+   * @generated from field: bool over_limit = 7;
+   */
+  overLimit: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.GroupAllocation.
+ * Use `create(GroupAllocationSchema)` to create a new message.
+ */
+export declare const GroupAllocationSchema: GenMessage<GroupAllocation>;
+
+/**
+ * @generated from message ntx.v1.GroupHoldingsRequest
+ */
+export declare type GroupHoldingsRequest = Message<"ntx.v1.GroupHoldingsRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.GroupHoldingsRequest.
+ * Use `create(GroupHoldingsRequestSchema)` to create a new message.
+ */
+export declare const GroupHoldingsRequestSchema: GenMessage<GroupHoldingsRequest>;
+
+/**
+ * @generated from message ntx.v1.GroupHoldingsResponse
+ */
+export declare type GroupHoldingsResponse = Message<"ntx.v1.GroupHoldingsResponse"> & {
+  /**
+   * largest first
+   *
+   * @generated from field: repeated ntx.v1.GroupAllocation groups = 1;
+   */
+  groups: GroupAllocation[];
+};
+
+/**
+ * Describes the message ntx.v1.GroupHoldingsResponse.
+ * Use `create(GroupHoldingsResponseSchema)` to create a new message.
+ */
+export declare const GroupHoldingsResponseSchema: GenMessage<GroupHoldingsResponse>;
+
+/**
+ * @generated from message ntx.v1.TimelineEvent
+ */
+export declare type TimelineEvent = Message<"ntx.v1.TimelineEvent"> & {
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string date = 1;
+   */
+  date: string;
+
+  /**
+   * @generated from field: ntx.v1.TimelineEventKind kind = 2;
+   */
+  kind: TimelineEventKind;
+
+  /**
+   * empty for portfolio-wide alerts
+   *
+   * @generated from field: string stock_symbol = 3;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: string title = 4;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string detail = 5;
+   */
+  detail: string;
+
+  /**
+   * transaction, alert or note id; 0 for corporate actions
+   *
+   * @generated from field: int64 ref_id = 6;
+   */
+  refId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.TimelineEvent.
+ * Use `create(TimelineEventSchema)` to create a new message.
+ */
+export declare const TimelineEventSchema: GenMessage<TimelineEvent>;
+
+/**
+ * @generated from message ntx.v1.GetTimelineRequest
+ */
+export declare type GetTimelineRequest = Message<"ntx.v1.GetTimelineRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * @generated from field: optional string stock_symbol = 2;
+   */
+  stockSymbol?: string;
+
+  /**
+   * YYYY-MM; defaults to the latest month with events
+   *
+   * @generated from field: string month = 3;
+   */
+  month: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetTimelineRequest.
+ * Use `create(GetTimelineRequestSchema)` to create a new message.
+ */
+export declare const GetTimelineRequestSchema: GenMessage<GetTimelineRequest>;
+
+/**
+ * @generated from message ntx.v1.GetTimelineResponse
+ */
+export declare type GetTimelineResponse = Message<"ntx.v1.GetTimelineResponse"> & {
+  /**
+   * @generated from field: string month = 1;
+   */
+  month: string;
+
+  /**
+   * oldest first
+   *
+   * @generated from field: repeated ntx.v1.TimelineEvent events = 2;
+   */
+  events: TimelineEvent[];
+
+  /**
+   * nearest earlier month with events
+   *
+   * @generated from field: string previous_month = 3;
+   */
+  previousMonth: string;
+
+  /**
+   * nearest later month with events
+   *
+   * @generated from field: string next_month = 4;
+   */
+  nextMonth: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetTimelineResponse.
+ * Use `create(GetTimelineResponseSchema)` to create a new message.
+ */
+export declare const GetTimelineResponseSchema: GenMessage<GetTimelineResponse>;
+
+/**
+ * @generated from message ntx.v1.FiscalYearSummary
+ */
+export declare type FiscalYearSummary = Message<"ntx.v1.FiscalYearSummary"> & {
+  /**
+   * 1 Shrawan
+   *
+   * @generated from field: string start_date = 1;
+   */
+  startDate: string;
+
+  /**
+   * today for the current year
+   *
+   * @generated from field: string end_date = 2;
+   */
+  endDate: string;
+
+  /**
+   * at end_date
+   *
+   * @generated from field: double value = 3;
+   */
+  value: number;
+
+  /**
+   * cost of the lots open at end_date
+   *
+   * @generated from field: double cost = 4;
+   */
+  cost: number;
+
+  /**
+   * purchases less sale proceeds
+   *
+   * @generated from field: double net_contributions = 5;
+   */
+  netContributions: number;
+
+  /**
+   * @generated from field: double realized_gain = 6;
+   */
+  realizedGain: number;
+
+  /**
+   * at end_date
+   *
+   * @generated from field: double unrealized_gain = 7;
+   */
+  unrealizedGain: number;
+
+  /**
+   * cash dividends announced while held
+   *
+   * @generated from field: double dividend_income = 8;
+   */
+  dividendIncome: number;
+
+  /**
+   * CGT withheld on the year's sales
+   *
+   * @generated from field: double estimated_tax = 9;
+   */
+  estimatedTax: number;
+};
+
+/**
+ * Describes the message ntx.v1.FiscalYearSummary.
+ * Use `create(FiscalYearSummarySchema)` to create a new message.
+ */
+export declare const FiscalYearSummarySchema: GenMessage<FiscalYearSummary>;
+
+/**
+ * @generated from message ntx.v1.GetYearComparisonRequest
+ */
+export declare type GetYearComparisonRequest = Message<"ntx.v1.GetYearComparisonRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+};
+
+/**
+ * Describes the message ntx.v1.GetYearComparisonRequest.
+ * Use `create(GetYearComparisonRequestSchema)` to create a new message.
+ */
+export declare const GetYearComparisonRequestSchema: GenMessage<GetYearComparisonRequest>;
+
+/**
+ * @generated from message ntx.v1.GetYearComparisonResponse
+ */
+export declare type GetYearComparisonResponse = Message<"ntx.v1.GetYearComparisonResponse"> & {
+  /**
+   * oldest first
+   *
+   * @generated from field: repeated ntx.v1.FiscalYearSummary years = 1;
+   */
+  years: FiscalYearSummary[];
+};
+
+/**
+ * Describes the message ntx.v1.GetYearComparisonResponse.
+ * Use `create(GetYearComparisonResponseSchema)` to create a new message.
+ */
+export declare const GetYearComparisonResponseSchema: GenMessage<GetYearComparisonResponse>;
+
+/**
+ * @generated from message ntx.v1.MonthlyGrowth
+ */
+export declare type MonthlyGrowth = Message<"ntx.v1.MonthlyGrowth"> & {
+  /**
+   * YYYY-MM
+   *
+   * @generated from field: string month = 1;
+   */
+  month: string;
+
+  /**
+   * @generated from field: double start_value = 2;
+   */
+  startValue: number;
+
+  /**
+   * @generated from field: double end_value = 3;
+   */
+  endValue: number;
+
+  /**
+   * purchases less sale proceeds
+   *
+   * @generated from field: double contributions = 4;
+   */
+  contributions: number;
+
+  /**
+   * change in value not put in or taken out
+   *
+   * @generated from field: double market_growth = 5;
+   */
+  marketGrowth: number;
+};
+
+/**
+ * Describes the message ntx.v1.MonthlyGrowth.
+ * Use `create(MonthlyGrowthSchema)` to create a new message.
+ */
+export declare const MonthlyGrowthSchema: GenMessage<MonthlyGrowth>;
+
+/**
+ * @generated from message ntx.v1.GetGrowthBreakdownRequest
+ */
+export declare type GetGrowthBreakdownRequest = Message<"ntx.v1.GetGrowthBreakdownRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * most recent months to return; 0 for 12
+   *
+   * @generated from field: int32 months = 2;
+   */
+  months: number;
+};
+
+/**
+ * Describes the message ntx.v1.GetGrowthBreakdownRequest.
+ * Use `create(GetGrowthBreakdownRequestSchema)` to create a new message.
+ */
+export declare const GetGrowthBreakdownRequestSchema: GenMessage<GetGrowthBreakdownRequest>;
+
+/**
+ * @generated from message ntx.v1.GetGrowthBreakdownResponse
+ */
+export declare type GetGrowthBreakdownResponse = Message<"ntx.v1.GetGrowthBreakdownResponse"> & {
+  /**
+   * oldest first
+   *
+   * @generated from field: repeated ntx.v1.MonthlyGrowth months = 1;
+   */
+  months: MonthlyGrowth[];
+
+  /**
+   * @generated from field: double total_contributions = 2;
+   */
+  totalContributions: number;
+
+  /**
+   * @generated from field: double total_market_growth = 3;
+   */
+  totalMarketGrowth: number;
+};
+
+/**
+ * Describes the message ntx.v1.GetGrowthBreakdownResponse.
+ * Use `create(GetGrowthBreakdownResponseSchema)` to create a new message.
+ */
+export declare const GetGrowthBreakdownResponseSchema: GenMessage<GetGrowthBreakdownResponse>;
+
+/**
+ * RealizedGain is the part of a sale matched against one lot.
+ *
+ * @generated from message ntx.v1.RealizedGain
+ */
+export declare type RealizedGain = Message<"ntx.v1.RealizedGain"> & {
+  /**
+   * @generated from field: string stock_symbol = 1;
+   */
+  stockSymbol: string;
+
+  /**
+   * @generated from field: int64 quantity = 2;
+   */
+  quantity: bigint;
+
+  /**
+   * @generated from field: string acquired_date = 3;
+   */
+  acquiredDate: string;
+
+  /**
+   * @generated from field: string sold_date = 4;
+   */
+  soldDate: string;
+
+  /**
+   * @generated from field: double unit_cost = 5;
+   */
+  unitCost: number;
+
+  /**
+   * @generated from field: double sale_price = 6;
+   */
+  salePrice: number;
+
+  /**
+   * before fees
+   *
+   * @generated from field: double gain = 7;
+   */
+  gain: number;
+
+  /**
+   * @generated from field: int32 holding_days = 8;
+   */
+  holdingDays: number;
+
+  /**
+   * @generated from field: bool long_term = 9;
+   */
+  longTerm: boolean;
+
+  /**
+   * CGT withheld; 0 on a loss
+   *
+   * @generated from field: double estimated_tax = 10;
+   */
+  estimatedTax: number;
+
+  /**
+   * @generated from field: string fiscal_year_start = 11;
+   */
+  fiscalYearStart: string;
+};
+
+/**
+ * Describes the message ntx.v1.RealizedGain.
+ * Use `create(RealizedGainSchema)` to create a new message.
+ */
+export declare const RealizedGainSchema: GenMessage<RealizedGain>;
+
+/**
+ * @generated from message ntx.v1.GetTaxReportRequest
+ */
+export declare type GetTaxReportRequest = Message<"ntx.v1.GetTaxReportRequest"> & {
+  /**
+   * @generated from field: int64 portfolio_id = 1;
+   */
+  portfolioId: bigint;
+
+  /**
+   * Any date in the fiscal year to report, YYYY-MM-DD; empty for every year
+   *
+   * @generated from field: string fiscal_year = 2;
+   */
+  fiscalYear: string;
+};
+
+/**
+ * Describes the message ntx.v1.GetTaxReportRequest.
+ * Use `create(GetTaxReportRequestSchema)` to create a new message.
+ */
+export declare const GetTaxReportRequestSchema: GenMessage<GetTaxReportRequest>;
+
+/**
+ * @generated from message ntx.v1.GetTaxReportResponse
+ */
+export declare type GetTaxReportResponse = Message<"ntx.v1.GetTaxReportResponse"> & {
+  /**
+   * oldest sale first
+   *
+   * @generated from field: repeated ntx.v1.RealizedGain gains = 1;
+   */
+  gains: RealizedGain[];
+
+  /**
+   * Per fiscal year, oldest first. Rights renunciation proceeds count as
+   * short-term gain here without a line in gains.
+   *
+   * @generated from field: repeated ntx.v1.TaxSummary years = 2;
+   */
+  years: TaxSummary[];
+
+  /**
+   * fiscal_year_start is empty
+   *
+   * @generated from field: ntx.v1.TaxSummary total = 3;
+   */
+  total?: TaxSummary;
+};
+
+/**
+ * Describes the message ntx.v1.GetTaxReportResponse.
+ * Use `create(GetTaxReportResponseSchema)` to create a new message.
+ */
+export declare const GetTaxReportResponseSchema: GenMessage<GetTaxReportResponse>;
+
+/**
+ * @generated from enum ntx.v1.TransactionType
+ */
+export enum TransactionType {
+  /**
+   * @generated from enum value: TRANSACTION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TRANSACTION_TYPE_BUY = 1;
+   */
+  BUY = 1,
+
+  /**
+   * @generated from enum value: TRANSACTION_TYPE_SELL = 2;
+   */
+  SELL = 2,
+}
+
+/**
+ * Describes the enum ntx.v1.TransactionType.
+ */
+export declare const TransactionTypeSchema: GenEnum<TransactionType>;
+
+/**
+ * @generated from enum ntx.v1.HoldingSortField
+ */
+export enum HoldingSortField {
+  /**
+   * sorts by symbol
+   *
+   * @generated from enum value: HOLDING_SORT_FIELD_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_SYMBOL = 1;
+   */
+  SYMBOL = 1,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_VALUE = 2;
+   */
+  VALUE = 2,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_PNL = 3;
+   */
+  PNL = 3,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_PNL_PERCENT = 4;
+   */
+  PNL_PERCENT = 4,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_DAY_CHANGE = 5;
+   */
+  DAY_CHANGE = 5,
+
+  /**
+   * @generated from enum value: HOLDING_SORT_FIELD_WEIGHT = 6;
+   */
+  WEIGHT = 6,
+}
+
+/**
+ * Describes the enum ntx.v1.HoldingSortField.
+ */
+export declare const HoldingSortFieldSchema: GenEnum<HoldingSortField>;
+
+/**
+ * @generated from enum ntx.v1.ConflictStrategy
+ */
+export enum ConflictStrategy {
+  /**
+   * @generated from enum value: CONFLICT_STRATEGY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CONFLICT_STRATEGY_SKIP = 1;
+   */
+  SKIP = 1,
+
+  /**
+   * @generated from enum value: CONFLICT_STRATEGY_REPLACE = 2;
+   */
+  REPLACE = 2,
+
+  /**
+   * @generated from enum value: CONFLICT_STRATEGY_KEEP_BOTH = 3;
+   */
+  KEEP_BOTH = 3,
+}
+
+/**
+ * Describes the enum ntx.v1.ConflictStrategy.
+ */
+export declare const ConflictStrategySchema: GenEnum<ConflictStrategy>;
+
+/**
+ * @generated from enum ntx.v1.HistoryInterval
+ */
+export enum HistoryInterval {
+  /**
+   * daily
+   *
+   * @generated from enum value: HISTORY_INTERVAL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: HISTORY_INTERVAL_DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * @generated from enum value: HISTORY_INTERVAL_WEEKLY = 2;
+   */
+  WEEKLY = 2,
+
+  /**
+   * @generated from enum value: HISTORY_INTERVAL_MONTHLY = 3;
+   */
+  MONTHLY = 3,
+}
+
+/**
+ * Describes the enum ntx.v1.HistoryInterval.
+ */
+export declare const HistoryIntervalSchema: GenEnum<HistoryInterval>;
+
+/**
+ * CostSource is where a holding's average cost came from. When several
+ * sources are recorded, manual entry wins over the WACC report, which wins
+ * over the cost computed from transactions.
+ *
+ * @generated from enum ntx.v1.CostSource
+ */
+export enum CostSource {
+  /**
+   * @generated from enum value: COST_SOURCE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * open lots, sells taking the oldest first
+   *
+   * @generated from enum value: COST_SOURCE_TRANSACTIONS = 1;
+   */
+  TRANSACTIONS = 1,
+
+  /**
+   * CDSC weighted average cost report
+   *
+   * @generated from enum value: COST_SOURCE_WACC = 2;
+   */
+  WACC = 2,
+
+  /**
+   * @generated from enum value: COST_SOURCE_MANUAL = 3;
+   */
+  MANUAL = 3,
+}
+
+/**
+ * Describes the enum ntx.v1.CostSource.
+ */
+export declare const CostSourceSchema: GenEnum<CostSource>;
+
+/**
+ * @generated from enum ntx.v1.TimelineEventKind
+ */
+export enum TimelineEventKind {
+  /**
+   * @generated from enum value: TIMELINE_EVENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TIMELINE_EVENT_KIND_TRANSACTION = 1;
+   */
+  TRANSACTION = 1,
+
+  /**
+   * @generated from enum value: TIMELINE_EVENT_KIND_DIVIDEND = 2;
+   */
+  DIVIDEND = 2,
+
+  /**
+   * bonus and right issues
+   *
+   * @generated from enum value: TIMELINE_EVENT_KIND_CORPORATE_ACTION = 3;
+   */
+  CORPORATE_ACTION = 3,
+
+  /**
+   * @generated from enum value: TIMELINE_EVENT_KIND_ALERT = 4;
+   */
+  ALERT = 4,
+
+  /**
+   * @generated from enum value: TIMELINE_EVENT_KIND_NOTE = 5;
+   */
+  NOTE = 5,
+}
+
+/**
+ * Describes the enum ntx.v1.TimelineEventKind.
+ */
+export declare const TimelineEventKindSchema: GenEnum<TimelineEventKind>;
+
+/**
+ * @generated from service ntx.v1.PortfolioService
+ */
+export declare const PortfolioService: GenService<{
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListPortfolios
+   */
+  listPortfolios: {
+    methodKind: "unary";
+    input: typeof ListPortfoliosRequestSchema;
+    output: typeof ListPortfoliosResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CreatePortfolio
+   */
+  createPortfolio: {
+    methodKind: "unary";
+    input: typeof CreatePortfolioRequestSchema;
+    output: typeof CreatePortfolioResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeletePortfolio
+   */
+  deletePortfolio: {
+    methodKind: "unary";
+    input: typeof DeletePortfolioRequestSchema;
+    output: typeof DeletePortfolioResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.AddTransaction
+   */
+  addTransaction: {
+    methodKind: "unary";
+    input: typeof AddTransactionRequestSchema;
+    output: typeof AddTransactionResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListTransactions
+   */
+  listTransactions: {
+    methodKind: "unary";
+    input: typeof ListTransactionsRequestSchema;
+    output: typeof ListTransactionsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeleteTransaction
+   */
+  deleteTransaction: {
+    methodKind: "unary";
+    input: typeof DeleteTransactionRequestSchema;
+    output: typeof DeleteTransactionResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetPortfolioSummary
+   */
+  getPortfolioSummary: {
+    methodKind: "unary";
+    input: typeof GetPortfolioSummaryRequestSchema;
+    output: typeof GetPortfolioSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListHoldings
+   */
+  listHoldings: {
+    methodKind: "unary";
+    input: typeof ListHoldingsRequestSchema;
+    output: typeof ListHoldingsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetPortfolioHistory
+   */
+  getPortfolioHistory: {
+    methodKind: "unary";
+    input: typeof GetPortfolioHistoryRequestSchema;
+    output: typeof GetPortfolioHistoryResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetConsolidatedSummary
+   */
+  getConsolidatedSummary: {
+    methodKind: "unary";
+    input: typeof GetConsolidatedSummaryRequestSchema;
+    output: typeof GetConsolidatedSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListLots
+   */
+  listLots: {
+    methodKind: "unary";
+    input: typeof ListLotsRequestSchema;
+    output: typeof ListLotsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ImportTransactions
+   */
+  importTransactions: {
+    methodKind: "unary";
+    input: typeof ImportTransactionsRequestSchema;
+    output: typeof ImportTransactionsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ExportTransactions
+   */
+  exportTransactions: {
+    methodKind: "unary";
+    input: typeof ExportTransactionsRequestSchema;
+    output: typeof ExportTransactionsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetAttribution
+   */
+  getAttribution: {
+    methodKind: "unary";
+    input: typeof GetAttributionRequestSchema;
+    output: typeof GetAttributionResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ProjectPortfolio
+   */
+  projectPortfolio: {
+    methodKind: "unary";
+    input: typeof ProjectPortfolioRequestSchema;
+    output: typeof ProjectPortfolioResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.RunScenario
+   */
+  runScenario: {
+    methodKind: "unary";
+    input: typeof RunScenarioRequestSchema;
+    output: typeof RunScenarioResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CalculatePositionSize
+   */
+  calculatePositionSize: {
+    methodKind: "unary";
+    input: typeof CalculatePositionSizeRequestSchema;
+    output: typeof CalculatePositionSizeResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CreateTag
+   */
+  createTag: {
+    methodKind: "unary";
+    input: typeof CreateTagRequestSchema;
+    output: typeof CreateTagResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListTags
+   */
+  listTags: {
+    methodKind: "unary";
+    input: typeof ListTagsRequestSchema;
+    output: typeof ListTagsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.RenameTag
+   */
+  renameTag: {
+    methodKind: "unary";
+    input: typeof RenameTagRequestSchema;
+    output: typeof RenameTagResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeleteTag
+   */
+  deleteTag: {
+    methodKind: "unary";
+    input: typeof DeleteTagRequestSchema;
+    output: typeof DeleteTagResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.SetTransactionTags
+   */
+  setTransactionTags: {
+    methodKind: "unary";
+    input: typeof SetTransactionTagsRequestSchema;
+    output: typeof SetTransactionTagsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetTagPerformance
+   */
+  getTagPerformance: {
+    methodKind: "unary";
+    input: typeof GetTagPerformanceRequestSchema;
+    output: typeof GetTagPerformanceResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CreateBrokerAccount
+   */
+  createBrokerAccount: {
+    methodKind: "unary";
+    input: typeof CreateBrokerAccountRequestSchema;
+    output: typeof CreateBrokerAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListBrokerAccounts
+   */
+  listBrokerAccounts: {
+    methodKind: "unary";
+    input: typeof ListBrokerAccountsRequestSchema;
+    output: typeof ListBrokerAccountsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeleteBrokerAccount
+   */
+  deleteBrokerAccount: {
+    methodKind: "unary";
+    input: typeof DeleteBrokerAccountRequestSchema;
+    output: typeof DeleteBrokerAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.SetTransactionBroker
+   */
+  setTransactionBroker: {
+    methodKind: "unary";
+    input: typeof SetTransactionBrokerRequestSchema;
+    output: typeof SetTransactionBrokerResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetBrokerCommissions
+   */
+  getBrokerCommissions: {
+    methodKind: "unary";
+    input: typeof GetBrokerCommissionsRequestSchema;
+    output: typeof GetBrokerCommissionsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CreateProfile
+   */
+  createProfile: {
+    methodKind: "unary";
+    input: typeof CreateProfileRequestSchema;
+    output: typeof CreateProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListProfiles
+   */
+  listProfiles: {
+    methodKind: "unary";
+    input: typeof ListProfilesRequestSchema;
+    output: typeof ListProfilesResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeleteProfile
+   */
+  deleteProfile: {
+    methodKind: "unary";
+    input: typeof DeleteProfileRequestSchema;
+    output: typeof DeleteProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.SetPortfolioProfile
+   */
+  setPortfolioProfile: {
+    methodKind: "unary";
+    input: typeof SetPortfolioProfileRequestSchema;
+    output: typeof SetPortfolioProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.SetHoldingCost
+   */
+  setHoldingCost: {
+    methodKind: "unary";
+    input: typeof SetHoldingCostRequestSchema;
+    output: typeof SetHoldingCostResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ClearHoldingCost
+   */
+  clearHoldingCost: {
+    methodKind: "unary";
+    input: typeof ClearHoldingCostRequestSchema;
+    output: typeof ClearHoldingCostResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetCostReconciliation
+   */
+  getCostReconciliation: {
+    methodKind: "unary";
+    input: typeof GetCostReconciliationRequestSchema;
+    output: typeof GetCostReconciliationResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ExportCostBasis
+   */
+  exportCostBasis: {
+    methodKind: "unary";
+    input: typeof ExportCostBasisRequestSchema;
+    output: typeof ExportCostBasisResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetBonusExpectations
+   */
+  getBonusExpectations: {
+    methodKind: "unary";
+    input: typeof GetBonusExpectationsRequestSchema;
+    output: typeof GetBonusExpectationsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetIncomeSummary
+   */
+  getIncomeSummary: {
+    methodKind: "unary";
+    input: typeof GetIncomeSummaryRequestSchema;
+    output: typeof GetIncomeSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.SetBondTerms
+   */
+  setBondTerms: {
+    methodKind: "unary";
+    input: typeof SetBondTermsRequestSchema;
+    output: typeof SetBondTermsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ClearBondTerms
+   */
+  clearBondTerms: {
+    methodKind: "unary";
+    input: typeof ClearBondTermsRequestSchema;
+    output: typeof ClearBondTermsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetBondSchedule
+   */
+  getBondSchedule: {
+    methodKind: "unary";
+    input: typeof GetBondScheduleRequestSchema;
+    output: typeof GetBondScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.CreateHoldingGroup
+   */
+  createHoldingGroup: {
+    methodKind: "unary";
+    input: typeof CreateHoldingGroupRequestSchema;
+    output: typeof CreateHoldingGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.ListHoldingGroups
+   */
+  listHoldingGroups: {
+    methodKind: "unary";
+    input: typeof ListHoldingGroupsRequestSchema;
+    output: typeof ListHoldingGroupsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.UpdateHoldingGroup
+   */
+  updateHoldingGroup: {
+    methodKind: "unary";
+    input: typeof UpdateHoldingGroupRequestSchema;
+    output: typeof UpdateHoldingGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.DeleteHoldingGroup
+   */
+  deleteHoldingGroup: {
+    methodKind: "unary";
+    input: typeof DeleteHoldingGroupRequestSchema;
+    output: typeof DeleteHoldingGroupResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GroupHoldings
+   */
+  groupHoldings: {
+    methodKind: "unary";
+    input: typeof GroupHoldingsRequestSchema;
+    output: typeof GroupHoldingsResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetTimeline
+   */
+  getTimeline: {
+    methodKind: "unary";
+    input: typeof GetTimelineRequestSchema;
+    output: typeof GetTimelineResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetYearComparison
+   */
+  getYearComparison: {
+    methodKind: "unary";
+    input: typeof GetYearComparisonRequestSchema;
+    output: typeof GetYearComparisonResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetGrowthBreakdown
+   */
+  getGrowthBreakdown: {
+    methodKind: "unary";
+    input: typeof GetGrowthBreakdownRequestSchema;
+    output: typeof GetGrowthBreakdownResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PortfolioService.GetTaxReport
+   */
+  getTaxReport: {
+    methodKind: "unary";
+    input: typeof GetTaxReportRequestSchema;
+    output: typeof GetTaxReportResponseSchema;
   },
 }>;
 
@@ -3,12 +3,13 @@
 /* eslint-disable */
 
 import { enumDesc, fileDesc, messageDesc, serviceDesc, tsEnum } from "@bufbuild/protobuf/codegenv1";
+import { file_ntx_v1_common } from "./common_pb";
 
 /**
  * Describes the file ntx/v1/portfolio.proto.
  */
 export const file_ntx_v1_portfolio = /*@__PURE__*/
-  fileDesc("ChZudHgvdjEvcG9ydGZvbGlvLnByb3RvEgZudHgudjEiOQoJUG9ydGZvbGlvEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCSIXChVMaXN0UG9ydGZvbGlvc1JlcXVlc3QiPwoWTGlzdFBvcnRmb2xpb3NSZXNwb25zZRIlCgpwb3J0Zm9saW9zGAEgAygLMhEubnR4LnYxLlBvcnRmb2xpbyImChZDcmVhdGVQb3J0Zm9saW9SZXF1ZXN0EgwKBG5hbWUYASABKAkiPwoXQ3JlYXRlUG9ydGZvbGlvUmVzcG9uc2USJAoJcG9ydGZvbGlvGAEgASgLMhEubnR4LnYxLlBvcnRmb2xpbyK4AQoLVHJhbnNhY3Rpb24SCgoCaWQYASABKAMSFAoMcG9ydGZvbGlvX2lkGAIgASgDEhQKDHN0b2NrX3N5bWJvbBgDIAEoCRIxChB0cmFuc2FjdGlvbl90eXBlGAQgASgOMhcubnR4LnYxLlRyYW5zYWN0aW9uVHlwZRIQCghxdWFudGl0eRgFIAEoAxISCgp1bml0X3ByaWNlGAYgASgBEhgKEHRyYW5zYWN0aW9uX2RhdGUYByABKAkitgEKFUFkZFRyYW5zYWN0aW9uUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSFAoMc3RvY2tfc3ltYm9sGAIgASgJEjEKEHRyYW5zYWN0aW9uX3R5cGUYAyABKA4yFy5udHgudjEuVHJhbnNhY3Rpb25UeXBlEhAKCHF1YW50aXR5GAQgASgDEhIKCnVuaXRfcHJpY2UYBSABKAESGAoQdHJhbnNhY3Rpb25fZGF0ZRgGIAEoCSJCChZBZGRUcmFuc2FjdGlvblJlc3BvbnNlEigKC3RyYW5zYWN0aW9uGAEgASgLMhMubnR4LnYxLlRyYW5zYWN0aW9uIlsKF0xpc3RUcmFuc2FjdGlvbnNSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIZCgxzdG9ja19zeW1ib2wYAiABKAlIAIgBAUIPCg1fc3RvY2tfc3ltYm9sIkUKGExpc3RUcmFuc2FjdGlvbnNSZXNwb25zZRIpCgx0cmFuc2FjdGlvbnMYASADKAsyEy5udHgudjEuVHJhbnNhY3Rpb24iMgoYRGVsZXRlVHJhbnNhY3Rpb25SZXF1ZXN0EhYKDnRyYW5zYWN0aW9uX2lkGAEgASgDIhsKGURlbGV0ZVRyYW5zYWN0aW9uUmVzcG9uc2Ui7AEKB0hvbGRpbmcSFAoMc3RvY2tfc3ltYm9sGAEgASgJEhAKCHF1YW50aXR5GAIgASgDEhUKDWF2Z19idXlfcHJpY2UYAyABKAESFQoNY3VycmVudF9wcmljZRgEIAEoARITCgt0b3RhbF92YWx1ZRgFIAEoARITCgtwcm9maXRfbG9zcxgGIAEoARIbChNwcm9maXRfbG9zc19wZXJjZW50GAcgASgBEg4KBnNlY3RvchgIIAEoCRIaChJkYXlfY2hhbmdlX3BlcmNlbnQYCSABKAESGAoQZGF5X2NoYW5nZV92YWx1ZRgKIAEoASKaAgoQUG9ydGZvbGlvU3VtbWFyeRIUCgxwb3J0Zm9saW9faWQYASABKAMSFgoOcG9ydGZvbGlvX25hbWUYAiABKAkSIQoIaG9sZGluZ3MYAyADKAsyDy5udHgudjEuSG9sZGluZxIWCg50b3RhbF9pbnZlc3RlZBgEIAEoARIbChN0b3RhbF9jdXJyZW50X3ZhbHVlGAUgASgBEhkKEXRvdGFsX3Byb2ZpdF9sb3NzGAYgASgBEiEKGXRvdGFsX3Byb2ZpdF9sb3NzX3BlcmNlbnQYByABKAESGgoScHJvamVjdGVkX2RpdmlkZW5kGAggASgBEiYKC2hlYWx0aF90aXBzGAkgAygLMhEubnR4LnYxLkhlYWx0aFRpcCI6CglIZWFsdGhUaXASDgoGc3ltYm9sGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSDAoEdHlwZRgDIAEoCSIyChpHZXRQb3J0Zm9saW9TdW1tYXJ5UmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMiSAobR2V0UG9ydGZvbGlvU3VtbWFyeVJlc3BvbnNlEikKB3N1bW1hcnkYASABKAsyGC5udHgudjEuUG9ydGZvbGlvU3VtbWFyeSpoCg9UcmFuc2FjdGlvblR5cGUSIAocVFJBTlNBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEhgKFFRSQU5TQUNUSU9OX1RZUEVfQlVZEAESGQoVVFJBTlNBQ1RJT05fVFlQRV9TRUxMEAIymQQKEFBvcnRmb2xpb1NlcnZpY2USTwoOTGlzdFBvcnRmb2xpb3MSHS5udHgudjEuTGlzdFBvcnRmb2xpb3NSZXF1ZXN0Gh4ubnR4LnYxLkxpc3RQb3J0Zm9saW9zUmVzcG9uc2USUgoPQ3JlYXRlUG9ydGZvbGlvEh4ubnR4LnYxLkNyZWF0ZVBvcnRmb2xpb1JlcXVlc3QaHy5udHgudjEuQ3JlYXRlUG9ydGZvbGlvUmVzcG9uc2USTwoOQWRkVHJhbnNhY3Rpb24SHS5udHgudjEuQWRkVHJhbnNhY3Rpb25SZXF1ZXN0Gh4ubnR4LnYxLkFkZFRyYW5zYWN0aW9uUmVzcG9uc2USVQoQTGlzdFRyYW5zYWN0aW9ucxIfLm50eC52MS5MaXN0VHJhbnNhY3Rpb25zUmVxdWVzdBogLm50eC52MS5MaXN0VHJhbnNhY3Rpb25zUmVzcG9uc2USWAoRRGVsZXRlVHJhbnNhY3Rpb24SIC5udHgudjEuRGVsZXRlVHJhbnNhY3Rpb25SZXF1ZXN0GiEubnR4LnYxLkRlbGV0ZVRyYW5zYWN0aW9uUmVzcG9uc2USXgoTR2V0UG9ydGZvbGlvU3VtbWFyeRIiLm50eC52MS5HZXRQb3J0Zm9saW9TdW1tYXJ5UmVxdWVzdBojLm50eC52MS5HZXRQb3J0Zm9saW9TdW1tYXJ5UmVzcG9uc2VCMFouZ2l0aHViLmNvbS92b2lkYXJjaGl2ZS9udHgvZ2VuL2dvL250eC92MTtudHh2MWIGcHJvdG8z");
+  fileDesc("ChZudHgvdjEvcG9ydGZvbGlvLnByb3RvEgZudHgudjEicAoJUG9ydGZvbGlvEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkSEgoKY3JlYXRlZF9hdBgDIAEoCRIXCgpwcm9maWxlX2lkGAQgASgDSACIAQESDQoFcGFwZXIYBSABKAhCDQoLX3Byb2ZpbGVfaWQiFwoVTGlzdFBvcnRmb2xpb3NSZXF1ZXN0Ij8KFkxpc3RQb3J0Zm9saW9zUmVzcG9uc2USJQoKcG9ydGZvbGlvcxgBIAMoCzIRLm50eC52MS5Qb3J0Zm9saW8iXQoWQ3JlYXRlUG9ydGZvbGlvUmVxdWVzdBIMCgRuYW1lGAEgASgJEhcKCnByb2ZpbGVfaWQYAiABKANIAIgBARINCgVwYXBlchgDIAEoCEINCgtfcHJvZmlsZV9pZCI/ChdDcmVhdGVQb3J0Zm9saW9SZXNwb25zZRIkCglwb3J0Zm9saW8YASABKAsyES5udHgudjEuUG9ydGZvbGlvIi4KFkRlbGV0ZVBvcnRmb2xpb1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDIhkKF0RlbGV0ZVBvcnRmb2xpb1Jlc3BvbnNlIpsCCgtUcmFuc2FjdGlvbhIKCgJpZBgBIAEoAxIUCgxwb3J0Zm9saW9faWQYAiABKAMSFAoMc3RvY2tfc3ltYm9sGAMgASgJEjEKEHRyYW5zYWN0aW9uX3R5cGUYBCABKA4yFy5udHgudjEuVHJhbnNhY3Rpb25UeXBlEhAKCHF1YW50aXR5GAUgASgDEhIKCnVuaXRfcHJpY2UYBiABKAESGAoQdHJhbnNhY3Rpb25fZGF0ZRgHIAEoCRIQCghpbnRyYWRheRgIIAEoCBIZCgR0YWdzGAkgAygLMgsubnR4LnYxLlRhZxIeChFicm9rZXJfYWNjb3VudF9pZBgKIAEoA0gAiAEBQhQKEl9icm9rZXJfYWNjb3VudF9pZCLsAQoVQWRkVHJhbnNhY3Rpb25SZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIUCgxzdG9ja19zeW1ib2wYAiABKAkSMQoQdHJhbnNhY3Rpb25fdHlwZRgDIAEoDjIXLm50eC52MS5UcmFuc2FjdGlvblR5cGUSEAoIcXVhbnRpdHkYBCABKAMSEgoKdW5pdF9wcmljZRgFIAEoARIYChB0cmFuc2FjdGlvbl9kYXRlGAYgASgJEh4KEWJyb2tlcl9hY2NvdW50X2lkGAcgASgDSACIAQFCFAoSX2Jyb2tlcl9hY2NvdW50X2lkIkIKFkFkZFRyYW5zYWN0aW9uUmVzcG9uc2USKAoLdHJhbnNhY3Rpb24YASABKAsyEy5udHgudjEuVHJhbnNhY3Rpb24i0AEKF0xpc3RUcmFuc2FjdGlvbnNSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIZCgxzdG9ja19zeW1ib2wYAiABKAlIAIgBARITCgZ0YWdfaWQYAyABKANIAYgBARIeChFicm9rZXJfYWNjb3VudF9pZBgEIAEoA0gCiAEBEg0KBWxpbWl0GAUgASgFEg4KBm9mZnNldBgGIAEoBUIPCg1fc3RvY2tfc3ltYm9sQgkKB190YWdfaWRCFAoSX2Jyb2tlcl9hY2NvdW50X2lkIloKGExpc3RUcmFuc2FjdGlvbnNSZXNwb25zZRIpCgx0cmFuc2FjdGlvbnMYASADKAsyEy5udHgudjEuVHJhbnNhY3Rpb24SEwoLdG90YWxfY291bnQYAiABKAUiMgoYRGVsZXRlVHJhbnNhY3Rpb25SZXF1ZXN0EhYKDnRyYW5zYWN0aW9uX2lkGAEgASgDIhsKGURlbGV0ZVRyYW5zYWN0aW9uUmVzcG9uc2UiywMKB0hvbGRpbmcSFAoMc3RvY2tfc3ltYm9sGAEgASgJEhAKCHF1YW50aXR5GAIgASgDEhUKDWF2Z19idXlfcHJpY2UYAyABKAESFQoNY3VycmVudF9wcmljZRgEIAEoARITCgt0b3RhbF92YWx1ZRgFIAEoARITCgtwcm9maXRfbG9zcxgGIAEoARIbChNwcm9maXRfbG9zc19wZXJjZW50GAcgASgBEg4KBnNlY3RvchgIIAEoCRIaChJkYXlfY2hhbmdlX3BlcmNlbnQYCSABKAESGAoQZGF5X2NoYW5nZV92YWx1ZRgKIAEoARIWCg53ZWlnaHRfcGVyY2VudBgLIAEoARInCgtjb3N0X3NvdXJjZRgMIAEoDjISLm50eC52MS5Db3N0U291cmNlEi8KD2luc3RydW1lbnRfdHlwZRgNIAEoDjIWLm50eC52MS5JbnN0cnVtZW50VHlwZRIYChBhY2NydWVkX2ludGVyZXN0GA4gASgBEi0KDmxpc3Rpbmdfc3RhdHVzGA8gASgOMhUubnR4LnYxLkxpc3RpbmdTdGF0dXMSEwoLZGVsaXN0ZWRfb24YECABKAkSDQoFZ3JvdXAYESABKAki0AIKEFBvcnRmb2xpb1N1bW1hcnkSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhYKDnBvcnRmb2xpb19uYW1lGAIgASgJEiEKCGhvbGRpbmdzGAMgAygLMg8ubnR4LnYxLkhvbGRpbmcSFgoOdG90YWxfaW52ZXN0ZWQYBCABKAESGwoTdG90YWxfY3VycmVudF92YWx1ZRgFIAEoARIZChF0b3RhbF9wcm9maXRfbG9zcxgGIAEoARIhChl0b3RhbF9wcm9maXRfbG9zc19wZXJjZW50GAcgASgBEhoKEnByb2plY3RlZF9kaXZpZGVuZBgIIAEoARImCgtoZWFsdGhfdGlwcxgJIAMoCzIRLm50eC52MS5IZWFsdGhUaXASGAoQZGF5X2NoYW5nZV92YWx1ZRgKIAEoARIaChJkYXlfY2hhbmdlX3BlcmNlbnQYCyABKAEiOgoJSGVhbHRoVGlwEg4KBnN5bWJvbBgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEgwKBHR5cGUYAyABKAkiQQoaR2V0UG9ydGZvbGlvU3VtbWFyeVJlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEg0KBWFzX29mGAIgASgJIkgKG0dldFBvcnRmb2xpb1N1bW1hcnlSZXNwb25zZRIpCgdzdW1tYXJ5GAEgASgLMhgubnR4LnYxLlBvcnRmb2xpb1N1bW1hcnkiiQIKE0xpc3RIb2xkaW5nc1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEikKB3NvcnRfYnkYAiABKA4yGC5udHgudjEuSG9sZGluZ1NvcnRGaWVsZBISCgpkZXNjZW5kaW5nGAMgASgIEhMKBnNlY3RvchgEIAEoCUgAiAEBEhYKCW1pbl92YWx1ZRgFIAEoAUgBiAEBEhQKDG9ubHlfZ2FpbmVycxgGIAEoCBITCgtvbmx5X2xvc2VycxgHIAEoCBINCgVsaW1pdBgIIAEoBRIOCgZvZmZzZXQYCSABKAUSDQoFYXNfb2YYCiABKAlCCQoHX3NlY3RvckIMCgpfbWluX3ZhbHVlIk4KFExpc3RIb2xkaW5nc1Jlc3BvbnNlEiEKCGhvbGRpbmdzGAEgAygLMg8ubnR4LnYxLkhvbGRpbmcSEwoLdG90YWxfY291bnQYAiABKAUitAEKA0xvdBIUCgxzdG9ja19zeW1ib2wYASABKAkSEAoIcXVhbnRpdHkYAiABKAMSEgoKdW5pdF9wcmljZRgDIAEoARIVCg1hY3F1aXJlZF9kYXRlGAQgASgJEhQKDGhvbGRpbmdfZGF5cxgFIAEoBRIWCg5sb25nX3Rlcm1fZGF0ZRgGIAEoCRIZChFkYXlzX3RvX2xvbmdfdGVybRgHIAEoBRIRCglsb25nX3Rlcm0YCCABKAgiUwoPTGlzdExvdHNSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIZCgxzdG9ja19zeW1ib2wYAiABKAlIAIgBAUIPCg1fc3RvY2tfc3ltYm9sImYKEExpc3RMb3RzUmVzcG9uc2USGQoEbG90cxgBIAMoCzILLm50eC52MS5Mb3QSGgoSbG9uZ190ZXJtX3F1YW50aXR5GAIgASgDEhsKE3Nob3J0X3Rlcm1fcXVhbnRpdHkYAyABKAMimgEKDkltcG9ydENvbmZsaWN0EgwKBGxpbmUYASABKAUSJQoIZXhpc3RpbmcYAiABKAsyEy5udHgudjEuVHJhbnNhY3Rpb24SJQoIaW1wb3J0ZWQYAyABKAsyEy5udHgudjEuVHJhbnNhY3Rpb24SLAoKcmVzb2x1dGlvbhgEIAEoDjIYLm50eC52MS5Db25mbGljdFN0cmF0ZWd5IngKGUltcG9ydFRyYW5zYWN0aW9uc1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhAKCGNzdl9kYXRhGAIgASgMEjMKEWNvbmZsaWN0X3N0cmF0ZWd5GAMgASgOMhgubnR4LnYxLkNvbmZsaWN0U3RyYXRlZ3kifAoaSW1wb3J0VHJhbnNhY3Rpb25zUmVzcG9uc2USEAoIaW1wb3J0ZWQYASABKAUSDwoHc2tpcHBlZBgCIAEoBRIQCghyZXBsYWNlZBgDIAEoBRIpCgljb25mbGljdHMYBCADKAsyFi5udHgudjEuSW1wb3J0Q29uZmxpY3QiMQoZRXhwb3J0VHJhbnNhY3Rpb25zUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMiQAoaRXhwb3J0VHJhbnNhY3Rpb25zUmVzcG9uc2USEAoIZmlsZW5hbWUYASABKAkSEAoIY3N2X2RhdGEYAiABKAwicAoVUG9ydGZvbGlvSGlzdG9yeVBvaW50EgwKBGRhdGUYASABKAkSDQoFdmFsdWUYAiABKAESDAoEY29zdBgDIAEoARIUCgxyZWFsaXplZF9wbmwYBCABKAESFgoOdW5yZWFsaXplZF9wbmwYBSABKAEigQEKGkdldFBvcnRmb2xpb0hpc3RvcnlSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIRCglmcm9tX2RhdGUYAiABKAkSDwoHdG9fZGF0ZRgDIAEoCRIpCghpbnRlcnZhbBgEIAEoDjIXLm50eC52MS5IaXN0b3J5SW50ZXJ2YWwiTAobR2V0UG9ydGZvbGlvSGlzdG9yeVJlc3BvbnNlEi0KBnBvaW50cxgBIAMoCzIdLm50eC52MS5Qb3J0Zm9saW9IaXN0b3J5UG9pbnQi7AEKElBvcnRmb2xpb0JyZWFrZG93bhIUCgxwb3J0Zm9saW9faWQYASABKAMSFgoOcG9ydGZvbGlvX25hbWUYAiABKAkSFgoOdG90YWxfaW52ZXN0ZWQYAyABKAESGwoTdG90YWxfY3VycmVudF92YWx1ZRgEIAEoARIZChF0b3RhbF9wcm9maXRfbG9zcxgFIAEoARIYChBkYXlfY2hhbmdlX3ZhbHVlGAYgASgBEhYKDndlaWdodF9wZXJjZW50GAcgASgBEhcKCnByb2ZpbGVfaWQYCCABKANIAIgBAUINCgtfcHJvZmlsZV9pZCKIAQoKVGF4U3VtbWFyeRIZChFmaXNjYWxfeWVhcl9zdGFydBgBIAEoCRIXCg9zaG9ydF90ZXJtX2dhaW4YAiABKAESFgoObG9uZ190ZXJtX2dhaW4YAyABKAESFQoNZXN0aW1hdGVkX3RheBgEIAEoARIXCg9maXNjYWxfeWVhcl9lbmQYBSABKAkilgIKE0NvbnNvbGlkYXRlZFN1bW1hcnkSLgoKcG9ydGZvbGlvcxgBIAMoCzIaLm50eC52MS5Qb3J0Zm9saW9CcmVha2Rvd24SIQoIaG9sZGluZ3MYAiADKAsyDy5udHgudjEuSG9sZGluZxIWCg50b3RhbF9pbnZlc3RlZBgDIAEoARIbChN0b3RhbF9jdXJyZW50X3ZhbHVlGAQgASgBEhkKEXRvdGFsX3Byb2ZpdF9sb3NzGAUgASgBEiEKGXRvdGFsX3Byb2ZpdF9sb3NzX3BlcmNlbnQYBiABKAESGAoQZGF5X2NoYW5nZV92YWx1ZRgHIAEoARIfCgN0YXgYCCABKAsyEi5udHgudjEuVGF4U3VtbWFyeSJHCh1HZXRDb25zb2xpZGF0ZWRTdW1tYXJ5UmVxdWVzdBIXCgpwcm9maWxlX2lkGAEgASgDSACIAQFCDQoLX3Byb2ZpbGVfaWQiTgoeR2V0Q29uc29saWRhdGVkU3VtbWFyeVJlc3BvbnNlEiwKB3N1bW1hcnkYASABKAsyGy5udHgudjEuQ29uc29saWRhdGVkU3VtbWFyeSLzAQoSSG9sZGluZ0F0dHJpYnV0aW9uEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRIWCg5zdGFydF9xdWFudGl0eRgCIAEoAxIUCgxlbmRfcXVhbnRpdHkYAyABKAMSEwoLc3RhcnRfdmFsdWUYBCABKAESEQoJZW5kX3ZhbHVlGAUgASgBEhAKCG5ldF9mbG93GAYgASgBEhQKDHByaWNlX2VmZmVjdBgHIAEoARIYChBuZXdfbW9uZXlfZWZmZWN0GAggASgBEhEKCXRvdGFsX3BubBgJIAEoARIcChRjb250cmlidXRpb25fcGVyY2VudBgKIAEoASJRChVHZXRBdHRyaWJ1dGlvblJlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhEKCWZyb21fZGF0ZRgCIAEoCRIPCgd0b19kYXRlGAMgASgJIqsBChZHZXRBdHRyaWJ1dGlvblJlc3BvbnNlEiwKCGhvbGRpbmdzGAEgAygLMhoubnR4LnYxLkhvbGRpbmdBdHRyaWJ1dGlvbhITCgtzdGFydF92YWx1ZRgCIAEoARIRCgllbmRfdmFsdWUYAyABKAESEAoIbmV0X2Zsb3cYBCABKAESEQoJdG90YWxfcG5sGAUgASgBEhYKDnJldHVybl9wZXJjZW50GAYgASgBIncKF1Byb2plY3RQb3J0Zm9saW9SZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxITCgtzaW11bGF0aW9ucxgCIAEoBRIVCg1ob3Jpem9uX3llYXJzGAMgAygFEhEKBHNlZWQYBCABKARIAIgBAUIHCgVfc2VlZCKEAQoOUHJvamVjdGlvbkJhbmQSFQoNaG9yaXpvbl95ZWFycxgBIAEoBRIKCgJwNRgCIAEoARILCgNwMjUYAyABKAESCwoDcDUwGAQgASgBEgsKA3A3NRgFIAEoARILCgNwOTUYBiABKAESGwoTcHJvYmFiaWxpdHlfb2ZfbG9zcxgHIAEoASKIAQoYUHJvamVjdFBvcnRmb2xpb1Jlc3BvbnNlEhUKDWN1cnJlbnRfdmFsdWUYASABKAESJQoFYmFuZHMYAiADKAsyFi5udHgudjEuUHJvamVjdGlvbkJhbmQSFAoMaGlzdG9yeV9kYXlzGAMgASgFEhgKEGV4Y2x1ZGVkX3N5bWJvbHMYBCADKAkiNQoLU2VjdG9yU2hvY2sSDgoGc2VjdG9yGAEgASgJEhYKDmNoYW5nZV9wZXJjZW50GAIgASgBIpIBChJSdW5TY2VuYXJpb1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEiEKFGluZGV4X2NoYW5nZV9wZXJjZW50GAIgASgBSACIAQESKgoNc2VjdG9yX3Nob2NrcxgDIAMoCzITLm50eC52MS5TZWN0b3JTaG9ja0IXChVfaW5kZXhfY2hhbmdlX3BlcmNlbnQimwEKD1NjZW5hcmlvSG9sZGluZxIUCgxzdG9ja19zeW1ib2wYASABKAkSDgoGc2VjdG9yGAIgASgJEhUKDWN1cnJlbnRfdmFsdWUYAyABKAESFwoPcHJvamVjdGVkX3ZhbHVlGAQgASgBEhYKDmNoYW5nZV9wZXJjZW50GAUgASgBEhEKBGJldGEYBiABKAFIAIgBAUIHCgVfYmV0YSK9AQoTUnVuU2NlbmFyaW9SZXNwb25zZRIpCghob2xkaW5ncxgBIAMoCzIXLm50eC52MS5TY2VuYXJpb0hvbGRpbmcSFQoNY3VycmVudF92YWx1ZRgCIAEoARIXCg9wcm9qZWN0ZWRfdmFsdWUYAyABKAESFAoMY2hhbmdlX3ZhbHVlGAQgASgBEhYKDmNoYW5nZV9wZXJjZW50GAUgASgBEh0KFXByb2plY3RlZF9wcm9maXRfbG9zcxgGIAEoASKfAQocQ2FsY3VsYXRlUG9zaXRpb25TaXplUmVxdWVzdBIUCgxhY2NvdW50X3NpemUYASABKAESFAoMcmlza19wZXJjZW50GAIgASgBEhMKC2VudHJ5X3ByaWNlGAMgASgBEhIKCnN0b3BfcHJpY2UYBCABKAESFAoMcG9ydGZvbGlvX2lkGAUgASgDEhQKDHN0b2NrX3N5bWJvbBgGIAEoCSK8AgodQ2FsY3VsYXRlUG9zaXRpb25TaXplUmVzcG9uc2USEAoIcXVhbnRpdHkYASABKAMSEwoLcmlza19hbW91bnQYAiABKAESFgoOcmlza19wZXJfc2hhcmUYAyABKAESFgoOcG9zaXRpb25fdmFsdWUYBCABKAESEgoKY29tbWlzc2lvbhgFIAEoARIRCglzZWJvbl9mZWUYBiABKAESEQoJZHBfY2hhcmdlGAcgASgBEhIKCnRvdGFsX2Nvc3QYCCABKAESFAoMbG9zc19hdF9zdG9wGAkgASgBEhcKD2FjY291bnRfcGVyY2VudBgKIAEoARIZChFjYXBwZWRfYnlfYWNjb3VudBgLIAEoCBIsCgVkcmFmdBgMIAEoCzIdLm50eC52MS5BZGRUcmFuc2FjdGlvblJlcXVlc3QiHwoDVGFnEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkiIAoQQ3JlYXRlVGFnUmVxdWVzdBIMCgRuYW1lGAEgASgJIi0KEUNyZWF0ZVRhZ1Jlc3BvbnNlEhgKA3RhZxgBIAEoCzILLm50eC52MS5UYWciEQoPTGlzdFRhZ3NSZXF1ZXN0Ii0KEExpc3RUYWdzUmVzcG9uc2USGQoEdGFncxgBIAMoCzILLm50eC52MS5UYWciMAoQUmVuYW1lVGFnUmVxdWVzdBIOCgZ0YWdfaWQYASABKAMSDAoEbmFtZRgCIAEoCSItChFSZW5hbWVUYWdSZXNwb25zZRIYCgN0YWcYASABKAsyCy5udHgudjEuVGFnIiIKEERlbGV0ZVRhZ1JlcXVlc3QSDgoGdGFnX2lkGAEgASgDIhMKEURlbGV0ZVRhZ1Jlc3BvbnNlIkQKGVNldFRyYW5zYWN0aW9uVGFnc1JlcXVlc3QSFgoOdHJhbnNhY3Rpb25faWQYASABKAMSDwoHdGFnX2lkcxgCIAMoAyI3ChpTZXRUcmFuc2FjdGlvblRhZ3NSZXNwb25zZRIZCgR0YWdzGAEgAygLMgsubnR4LnYxLlRhZyLwAQoOVGFnUGVyZm9ybWFuY2USGAoDdGFnGAEgASgLMgsubnR4LnYxLlRhZxITCgt0cmFkZV9jb3VudBgCIAEoBRIVCg1yZWFsaXplZF9nYWluGAMgASgBEhcKD3Nob3J0X3Rlcm1fZ2FpbhgEIAEoARIWCg5sb25nX3Rlcm1fZ2FpbhgFIAEoARIVCg1lc3RpbWF0ZWRfdGF4GAYgASgBEhEKCW9wZW5fY29zdBgHIAEoARISCgpvcGVuX3ZhbHVlGAggASgBEhYKDnVucmVhbGl6ZWRfcG5sGAkgASgBEhEKCXRvdGFsX3BubBgKIAEoASJ0ChhHZXRUYWdQZXJmb3JtYW5jZVJlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhMKBnRhZ19pZBgCIAEoA0gAiAEBEhEKCWZyb21fZGF0ZRgDIAEoCRIPCgd0b19kYXRlGAQgASgJQgkKB190YWdfaWQiQQoZR2V0VGFnUGVyZm9ybWFuY2VSZXNwb25zZRIkCgR0YWdzGAEgAygLMhYubnR4LnYxLlRhZ1BlcmZvcm1hbmNlIlMKDUJyb2tlckFjY291bnQSCgoCaWQYASABKAMSFQoNYnJva2VyX251bWJlchgCIAEoBRIRCgljbGllbnRfaWQYAyABKAkSDAoEbmFtZRgEIAEoCSJUChpDcmVhdGVCcm9rZXJBY2NvdW50UmVxdWVzdBIVCg1icm9rZXJfbnVtYmVyGAEgASgFEhEKCWNsaWVudF9pZBgCIAEoCRIMCgRuYW1lGAMgASgJIkUKG0NyZWF0ZUJyb2tlckFjY291bnRSZXNwb25zZRImCgdhY2NvdW50GAEgASgLMhUubnR4LnYxLkJyb2tlckFjY291bnQiGwoZTGlzdEJyb2tlckFjY291bnRzUmVxdWVzdCJFChpMaXN0QnJva2VyQWNjb3VudHNSZXNwb25zZRInCghhY2NvdW50cxgBIAMoCzIVLm50eC52MS5Ccm9rZXJBY2NvdW50IjAKGkRlbGV0ZUJyb2tlckFjY291bnRSZXF1ZXN0EhIKCmFjY291bnRfaWQYASABKAMiHQobRGVsZXRlQnJva2VyQWNjb3VudFJlc3BvbnNlImsKG1NldFRyYW5zYWN0aW9uQnJva2VyUmVxdWVzdBIWCg50cmFuc2FjdGlvbl9pZBgBIAEoAxIeChFicm9rZXJfYWNjb3VudF9pZBgCIAEoA0gAiAEBQhQKEl9icm9rZXJfYWNjb3VudF9pZCIeChxTZXRUcmFuc2FjdGlvbkJyb2tlclJlc3BvbnNlIscBChBCcm9rZXJDb21taXNzaW9uEiYKB2FjY291bnQYASABKAsyFS5udHgudjEuQnJva2VyQWNjb3VudBITCgt0cmFkZV9jb3VudBgCIAEoBRISCgpidXlfYW1vdW50GAMgASgBEhMKC3NlbGxfYW1vdW50GAQgASgBEhIKCmNvbW1pc3Npb24YBSABKAESEQoJc2Vib25fZmVlGAYgASgBEhIKCmRwX2NoYXJnZXMYByABKAESEgoKdG90YWxfZmVlcxgIIAEoASJtChtHZXRCcm9rZXJDb21taXNzaW9uc1JlcXVlc3QSGQoMcG9ydGZvbGlvX2lkGAEgASgDSACIAQESEQoJZnJvbV9kYXRlGAIgASgJEg8KB3RvX2RhdGUYAyABKAlCDwoNX3BvcnRmb2xpb19pZCJJChxHZXRCcm9rZXJDb21taXNzaW9uc1Jlc3BvbnNlEikKB2Jyb2tlcnMYASADKAsyGC5udHgudjEuQnJva2VyQ29tbWlzc2lvbiJqCgdQcm9maWxlEgoKAmlkGAEgASgDEgwKBG5hbWUYAiABKAkSDAoEYm9pZBgDIAEoCRIUCgxyZWxhdGlvbnNoaXAYBCABKAkSDQoFbWlub3IYBSABKAgSEgoKY3JlYXRlZF9hdBgGIAEoCSJXChRDcmVhdGVQcm9maWxlUmVxdWVzdBIMCgRuYW1lGAEgASgJEgwKBGJvaWQYAiABKAkSFAoMcmVsYXRpb25zaGlwGAMgASgJEg0KBW1pbm9yGAQgASgIIjkKFUNyZWF0ZVByb2ZpbGVSZXNwb25zZRIgCgdwcm9maWxlGAEgASgLMg8ubnR4LnYxLlByb2ZpbGUiFQoTTGlzdFByb2ZpbGVzUmVxdWVzdCI5ChRMaXN0UHJvZmlsZXNSZXNwb25zZRIhCghwcm9maWxlcxgBIAMoCzIPLm50eC52MS5Qcm9maWxlIioKFERlbGV0ZVByb2ZpbGVSZXF1ZXN0EhIKCnByb2ZpbGVfaWQYASABKAMiFwoVRGVsZXRlUHJvZmlsZVJlc3BvbnNlIloKGlNldFBvcnRmb2xpb1Byb2ZpbGVSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIXCgpwcm9maWxlX2lkGAIgASgDSACIAQFCDQoLX3Byb2ZpbGVfaWQiHQobU2V0UG9ydGZvbGlvUHJvZmlsZVJlc3BvbnNlIl8KCUNvc3RFbnRyeRIiCgZzb3VyY2UYASABKA4yEi5udHgudjEuQ29zdFNvdXJjZRIQCghhdmdfY29zdBgCIAEoARIMCgRub3RlGAMgASgJEg4KBnNldF9hdBgEIAEoCSKHAQoVU2V0SG9sZGluZ0Nvc3RSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIUCgxzdG9ja19zeW1ib2wYAiABKAkSIgoGc291cmNlGAMgASgOMhIubnR4LnYxLkNvc3RTb3VyY2USEAoIYXZnX2Nvc3QYBCABKAESDAoEbm90ZRgFIAEoCSI6ChZTZXRIb2xkaW5nQ29zdFJlc3BvbnNlEiAKBWVudHJ5GAEgASgLMhEubnR4LnYxLkNvc3RFbnRyeSJpChdDbGVhckhvbGRpbmdDb3N0UmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSFAoMc3RvY2tfc3ltYm9sGAIgASgJEiIKBnNvdXJjZRgDIAEoDjISLm50eC52MS5Db3N0U291cmNlIhoKGENsZWFySG9sZGluZ0Nvc3RSZXNwb25zZSK4AQoSQ29zdFJlY29uY2lsaWF0aW9uEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRIQCghxdWFudGl0eRgCIAEoAxIsChBlZmZlY3RpdmVfc291cmNlGAMgASgOMhIubnR4LnYxLkNvc3RTb3VyY2USFgoOZWZmZWN0aXZlX2Nvc3QYBCABKAESIgoHZW50cmllcxgFIAMoCzIRLm50eC52MS5Db3N0RW50cnkSEAoIY29uZmxpY3QYBiABKAgiTAocR2V0Q29zdFJlY29uY2lsaWF0aW9uUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSFgoOY29uZmxpY3RzX29ubHkYAiABKAgiTQodR2V0Q29zdFJlY29uY2lsaWF0aW9uUmVzcG9uc2USLAoIaG9sZGluZ3MYASADKAsyGi5udHgudjEuQ29zdFJlY29uY2lsaWF0aW9uIi4KFkV4cG9ydENvc3RCYXNpc1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDIj0KF0V4cG9ydENvc3RCYXNpc1Jlc3BvbnNlEhAKCGZpbGVuYW1lGAEgASgJEhAKCGNzdl9kYXRhGAIgASgMIroBChBCb251c0V4cGVjdGF0aW9uEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRITCgtmaXNjYWxfeWVhchgCIAEoCRIYChBib251c19wZXJjZW50YWdlGAMgASgBEhQKDGFubm91bmNlZF9vbhgEIAEoCRIZChFlbGlnaWJsZV9xdWFudGl0eRgFIAEoAxIWCg5leHBlY3RlZF91bml0cxgGIAEoAxIYChBmcmFjdGlvbmFsX3VuaXRzGAcgASgBIkEKG0dldEJvbnVzRXhwZWN0YXRpb25zUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSDAoEZGF5cxgCIAEoBSJOChxHZXRCb251c0V4cGVjdGF0aW9uc1Jlc3BvbnNlEi4KDGV4cGVjdGF0aW9ucxgBIAMoCzIYLm50eC52MS5Cb251c0V4cGVjdGF0aW9uIq0BCg1JbmNvbWVIb2xkaW5nEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRIQCghxdWFudGl0eRgCIAEoAxITCgtmaXNjYWxfeWVhchgDIAEoCRIaChJkaXZpZGVuZF9wZXJfc2hhcmUYBCABKAESFQoNeWllbGRfb25fY29zdBgFIAEoARIVCg1jdXJyZW50X3lpZWxkGAYgASgBEhUKDWFubnVhbF9pbmNvbWUYByABKAEiLwoXR2V0SW5jb21lU3VtbWFyeVJlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDIogBChhHZXRJbmNvbWVTdW1tYXJ5UmVzcG9uc2USJwoIaG9sZGluZ3MYASADKAsyFS5udHgudjEuSW5jb21lSG9sZGluZxIVCg1hbm51YWxfaW5jb21lGAIgASgBEhUKDXlpZWxkX29uX2Nvc3QYAyABKAESFQoNY3VycmVudF95aWVsZBgEIAEoASKLAQoJQm9uZFRlcm1zEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRISCgpmYWNlX3ZhbHVlGAIgASgBEhMKC2NvdXBvbl9yYXRlGAMgASgBEhgKEGNvdXBvbnNfcGVyX3llYXIYBCABKAMSFQoNbWF0dXJpdHlfZGF0ZRgFIAEoCRIOCgZzZXRfYXQYBiABKAkimwEKE1NldEJvbmRUZXJtc1JlcXVlc3QSFAoMcG9ydGZvbGlvX2lkGAEgASgDEhQKDHN0b2NrX3N5bWJvbBgCIAEoCRISCgpmYWNlX3ZhbHVlGAMgASgBEhMKC2NvdXBvbl9yYXRlGAQgASgBEhgKEGNvdXBvbnNfcGVyX3llYXIYBSABKAMSFQoNbWF0dXJpdHlfZGF0ZRgGIAEoCSI4ChRTZXRCb25kVGVybXNSZXNwb25zZRIgCgV0ZXJtcxgBIAEoCzIRLm50eC52MS5Cb25kVGVybXMiQwoVQ2xlYXJCb25kVGVybXNSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIUCgxzdG9ja19zeW1ib2wYAiABKAkiGAoWQ2xlYXJCb25kVGVybXNSZXNwb25zZSLcAQoMQm9uZFNjaGVkdWxlEiAKBXRlcm1zGAEgASgLMhEubnR4LnYxLkJvbmRUZXJtcxIQCghxdWFudGl0eRgCIAEoAxIYChBhY2NydWVkX2ludGVyZXN0GAMgASgBEhYKDmxhc3RfY291cG9uX29uGAQgASgJEhYKDm5leHRfY291cG9uX29uGAUgASgJEhoKEm5leHRfY291cG9uX2Ftb3VudBgGIAEoARIYChBkYXlzX3RvX21hdHVyaXR5GAcgASgFEhgKEHJlZGVtcHRpb25fdmFsdWUYCCABKAEiLgoWR2V0Qm9uZFNjaGVkdWxlUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMiPgoXR2V0Qm9uZFNjaGVkdWxlUmVzcG9uc2USIwoFYm9uZHMYASADKAsyFC5udHgudjEuQm9uZFNjaGVkdWxlIoIBCgxIb2xkaW5nR3JvdXASCgoCaWQYASABKAMSDAoEbmFtZRgCIAEoCRIPCgdzZWN0b3JzGAMgAygJEg8KB3N5bWJvbHMYBCADKAkSHwoSbWF4X3dlaWdodF9wZXJjZW50GAUgASgBSACIAQFCFQoTX21heF93ZWlnaHRfcGVyY2VudCKDAQoZQ3JlYXRlSG9sZGluZ0dyb3VwUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB3NlY3RvcnMYAiADKAkSDwoHc3ltYm9scxgDIAMoCRIfChJtYXhfd2VpZ2h0X3BlcmNlbnQYBCABKAFIAIgBAUIVChNfbWF4X3dlaWdodF9wZXJjZW50IkEKGkNyZWF0ZUhvbGRpbmdHcm91cFJlc3BvbnNlEiMKBWdyb3VwGAEgASgLMhQubnR4LnYxLkhvbGRpbmdHcm91cCIaChhMaXN0SG9sZGluZ0dyb3Vwc1JlcXVlc3QiQQoZTGlzdEhvbGRpbmdHcm91cHNSZXNwb25zZRIkCgZncm91cHMYASADKAsyFC5udHgudjEuSG9sZGluZ0dyb3VwIpUBChlVcGRhdGVIb2xkaW5nR3JvdXBSZXF1ZXN0EhAKCGdyb3VwX2lkGAEgASgDEgwKBG5hbWUYAiABKAkSDwoHc2VjdG9ycxgDIAMoCRIPCgdzeW1ib2xzGAQgAygJEh8KEm1heF93ZWlnaHRfcGVyY2VudBgFIAEoAUgAiAEBQhUKE19tYXhfd2VpZ2h0X3BlcmNlbnQiQQoaVXBkYXRlSG9sZGluZ0dyb3VwUmVzcG9uc2USIwoFZ3JvdXAYASABKAsyFC5udHgudjEuSG9sZGluZ0dyb3VwIi0KGURlbGV0ZUhvbGRpbmdHcm91cFJlcXVlc3QSEAoIZ3JvdXBfaWQYASABKAMiHAoaRGVsZXRlSG9sZGluZ0dyb3VwUmVzcG9uc2UixwEKD0dyb3VwQWxsb2NhdGlvbhIMCgRuYW1lGAEgASgJEhUKCGdyb3VwX2lkGAIgASgDSACIAQESDQoFdmFsdWUYAyABKAESFgoOd2VpZ2h0X3BlcmNlbnQYBCABKAESDwoHc3ltYm9scxgFIAMoCRIfChJtYXhfd2VpZ2h0X3BlcmNlbnQYBiABKAFIAYgBARISCgpvdmVyX2xpbWl0GAcgASgIQgsKCV9ncm91cF9pZEIVChNfbWF4X3dlaWdodF9wZXJjZW50IiwKFEdyb3VwSG9sZGluZ3NSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAyJAChVHcm91cEhvbGRpbmdzUmVzcG9uc2USJwoGZ3JvdXBzGAEgAygLMhcubnR4LnYxLkdyb3VwQWxsb2NhdGlvbiKLAQoNVGltZWxpbmVFdmVudBIMCgRkYXRlGAEgASgJEicKBGtpbmQYAiABKA4yGS5udHgudjEuVGltZWxpbmVFdmVudEtpbmQSFAoMc3RvY2tfc3ltYm9sGAMgASgJEg0KBXRpdGxlGAQgASgJEg4KBmRldGFpbBgFIAEoCRIOCgZyZWZfaWQYBiABKAMiZQoSR2V0VGltZWxpbmVSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxIZCgxzdG9ja19zeW1ib2wYAiABKAlIAIgBARINCgVtb250aBgDIAEoCUIPCg1fc3RvY2tfc3ltYm9sIncKE0dldFRpbWVsaW5lUmVzcG9uc2USDQoFbW9udGgYASABKAkSJQoGZXZlbnRzGAIgAygLMhUubnR4LnYxLlRpbWVsaW5lRXZlbnQSFgoOcHJldmlvdXNfbW9udGgYAyABKAkSEgoKbmV4dF9tb250aBgEIAEoCSLRAQoRRmlzY2FsWWVhclN1bW1hcnkSEgoKc3RhcnRfZGF0ZRgBIAEoCRIQCghlbmRfZGF0ZRgCIAEoCRINCgV2YWx1ZRgDIAEoARIMCgRjb3N0GAQgASgBEhkKEW5ldF9jb250cmlidXRpb25zGAUgASgBEhUKDXJlYWxpemVkX2dhaW4YBiABKAESFwoPdW5yZWFsaXplZF9nYWluGAcgASgBEhcKD2RpdmlkZW5kX2luY29tZRgIIAEoARIVCg1lc3RpbWF0ZWRfdGF4GAkgASgBIjAKGEdldFllYXJDb21wYXJpc29uUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMiRQoZR2V0WWVhckNvbXBhcmlzb25SZXNwb25zZRIoCgV5ZWFycxgBIAMoCzIZLm50eC52MS5GaXNjYWxZZWFyU3VtbWFyeSJ0Cg1Nb250aGx5R3Jvd3RoEg0KBW1vbnRoGAEgASgJEhMKC3N0YXJ0X3ZhbHVlGAIgASgBEhEKCWVuZF92YWx1ZRgDIAEoARIVCg1jb250cmlidXRpb25zGAQgASgBEhUKDW1hcmtldF9ncm93dGgYBSABKAEiQQoZR2V0R3Jvd3RoQnJlYWtkb3duUmVxdWVzdBIUCgxwb3J0Zm9saW9faWQYASABKAMSDgoGbW9udGhzGAIgASgFIn0KGkdldEdyb3d0aEJyZWFrZG93blJlc3BvbnNlEiUKBm1vbnRocxgBIAMoCzIVLm50eC52MS5Nb250aGx5R3Jvd3RoEhsKE3RvdGFsX2NvbnRyaWJ1dGlvbnMYAiABKAESGwoTdG90YWxfbWFya2V0X2dyb3d0aBgDIAEoASLwAQoMUmVhbGl6ZWRHYWluEhQKDHN0b2NrX3N5bWJvbBgBIAEoCRIQCghxdWFudGl0eRgCIAEoAxIVCg1hY3F1aXJlZF9kYXRlGAMgASgJEhEKCXNvbGRfZGF0ZRgEIAEoCRIRCgl1bml0X2Nvc3QYBSABKAESEgoKc2FsZV9wcmljZRgGIAEoARIMCgRnYWluGAcgASgBEhQKDGhvbGRpbmdfZGF5cxgIIAEoBRIRCglsb25nX3Rlcm0YCSABKAgSFQoNZXN0aW1hdGVkX3RheBgKIAEoARIZChFmaXNjYWxfeWVhcl9zdGFydBgLIAEoCSJAChNHZXRUYXhSZXBvcnRSZXF1ZXN0EhQKDHBvcnRmb2xpb19pZBgBIAEoAxITCgtmaXNjYWxfeWVhchgCIAEoCSKBAQoUR2V0VGF4UmVwb3J0UmVzcG9uc2USIwoFZ2FpbnMYASADKAsyFC5udHgudjEuUmVhbGl6ZWRHYWluEiEKBXllYXJzGAIgAygLMhIubnR4LnYxLlRheFN1bW1hcnkSIQoFdG90YWwYAyABKAsyEi5udHgudjEuVGF4U3VtbWFyeSpoCg9UcmFuc2FjdGlvblR5cGUSIAocVFJBTlNBQ1RJT05fVFlQRV9VTlNQRUNJRklFRBAAEhgKFFRSQU5TQUNUSU9OX1RZUEVfQlVZEAESGQoVVFJBTlNBQ1RJT05fVFlQRV9TRUxMEAIq9QEKEEhvbGRpbmdTb3J0RmllbGQSIgoeSE9MRElOR19TT1JUX0ZJRUxEX1VOU1BFQ0lGSUVEEAASHQoZSE9MRElOR19TT1JUX0ZJRUxEX1NZTUJPTBABEhwKGEhPTERJTkdfU09SVF9GSUVMRF9WQUxVRRACEhoKFkhPTERJTkdfU09SVF9GSUVMRF9QTkwQAxIiCh5IT0xESU5HX1NPUlRfRklFTERfUE5MX1BFUkNFTlQQBBIhCh1IT0xESU5HX1NPUlRfRklFTERfREFZX0NIQU5HRRAFEh0KGUhPTERJTkdfU09SVF9GSUVMRF9XRUlHSFQQBiqRAQoQQ29uZmxpY3RTdHJhdGVneRIhCh1DT05GTElDVF9TVFJBVEVHWV9VTlNQRUNJRklFRBAAEhoKFkNPTkZMSUNUX1NUUkFURUdZX1NLSVAQARIdChlDT05GTElDVF9TVFJBVEVHWV9SRVBMQUNFEAISHwobQ09ORkxJQ1RfU1RSQVRFR1lfS0VFUF9CT1RIEAMqigEKD0hpc3RvcnlJbnRlcnZhbBIgChxISVNUT1JZX0lOVEVSVkFMX1VOU1BFQ0lGSUVEEAASGgoWSElTVE9SWV9JTlRFUlZBTF9EQUlMWRABEhsKF0hJU1RPUllfSU5URVJWQUxfV0VFS0xZEAISHAoYSElTVE9SWV9JTlRFUlZBTF9NT05USExZEAMqdQoKQ29zdFNvdXJjZRIbChdDT1NUX1NPVVJDRV9VTlNQRUNJRklFRBAAEhwKGENPU1RfU09VUkNFX1RSQU5TQUNUSU9OUxABEhQKEENPU1RfU09VUkNFX1dBQ0MQAhIWChJDT1NUX1NPVVJDRV9NQU5VQUwQAyrmAQoRVGltZWxpbmVFdmVudEtpbmQSIwofVElNRUxJTkVfRVZFTlRfS0lORF9VTlNQRUNJRklFRBAAEiMKH1RJTUVMSU5FX0VWRU5UX0tJTkRfVFJBTlNBQ1RJT04QARIgChxUSU1FTElORV9FVkVOVF9LSU5EX0RJVklERU5EEAISKAokVElNRUxJTkVfRVZFTlRfS0lORF9DT1JQT1JBVEVfQUNUSU9OEAMSHQoZVElNRUxJTkVfRVZFTlRfS0lORF9BTEVSVBAEEhwKGFRJTUVMSU5FX0VWRU5UX0tJTkRfTk9URRAFMsshChBQb3J0Zm9saW9TZXJ2aWNlEk8KDkxpc3RQb3J0Zm9saW9zEh0ubnR4LnYxLkxpc3RQb3J0Zm9saW9zUmVxdWVzdBoeLm50eC52MS5MaXN0UG9ydGZvbGlvc1Jlc3BvbnNlElIKD0NyZWF0ZVBvcnRmb2xpbxIeLm50eC52MS5DcmVhdGVQb3J0Zm9saW9SZXF1ZXN0Gh8ubnR4LnYxLkNyZWF0ZVBvcnRmb2xpb1Jlc3BvbnNlElIKD0RlbGV0ZVBvcnRmb2xpbxIeLm50eC52MS5EZWxldGVQb3J0Zm9saW9SZXF1ZXN0Gh8ubnR4LnYxLkRlbGV0ZVBvcnRmb2xpb1Jlc3BvbnNlEk8KDkFkZFRyYW5zYWN0aW9uEh0ubnR4LnYxLkFkZFRyYW5zYWN0aW9uUmVxdWVzdBoeLm50eC52MS5BZGRUcmFuc2FjdGlvblJlc3BvbnNlElUKEExpc3RUcmFuc2FjdGlvbnMSHy5udHgudjEuTGlzdFRyYW5zYWN0aW9uc1JlcXVlc3QaIC5udHgudjEuTGlzdFRyYW5zYWN0aW9uc1Jlc3BvbnNlElgKEURlbGV0ZVRyYW5zYWN0aW9uEiAubnR4LnYxLkRlbGV0ZVRyYW5zYWN0aW9uUmVxdWVzdBohLm50eC52MS5EZWxldGVUcmFuc2FjdGlvblJlc3BvbnNlEl4KE0dldFBvcnRmb2xpb1N1bW1hcnkSIi5udHgudjEuR2V0UG9ydGZvbGlvU3VtbWFyeVJlcXVlc3QaIy5udHgudjEuR2V0UG9ydGZvbGlvU3VtbWFyeVJlc3BvbnNlEkkKDExpc3RIb2xkaW5ncxIbLm50eC52MS5MaXN0SG9sZGluZ3NSZXF1ZXN0GhwubnR4LnYxLkxpc3RIb2xkaW5nc1Jlc3BvbnNlEl4KE0dldFBvcnRmb2xpb0hpc3RvcnkSIi5udHgudjEuR2V0UG9ydGZvbGlvSGlzdG9yeVJlcXVlc3QaIy5udHgudjEuR2V0UG9ydGZvbGlvSGlzdG9yeVJlc3BvbnNlEmcKFkdldENvbnNvbGlkYXRlZFN1bW1hcnkSJS5udHgudjEuR2V0Q29uc29saWRhdGVkU3VtbWFyeVJlcXVlc3QaJi5udHgudjEuR2V0Q29uc29saWRhdGVkU3VtbWFyeVJlc3BvbnNlEj0KCExpc3RMb3RzEhcubnR4LnYxLkxpc3RMb3RzUmVxdWVzdBoYLm50eC52MS5MaXN0TG90c1Jlc3BvbnNlElsKEkltcG9ydFRyYW5zYWN0aW9ucxIhLm50eC52MS5JbXBvcnRUcmFuc2FjdGlvbnNSZXF1ZXN0GiIubnR4LnYxLkltcG9ydFRyYW5zYWN0aW9uc1Jlc3BvbnNlElsKEkV4cG9ydFRyYW5zYWN0aW9ucxIhLm50eC52MS5FeHBvcnRUcmFuc2FjdGlvbnNSZXF1ZXN0GiIubnR4LnYxLkV4cG9ydFRyYW5zYWN0aW9uc1Jlc3BvbnNlEk8KDkdldEF0dHJpYnV0aW9uEh0ubnR4LnYxLkdldEF0dHJpYnV0aW9uUmVxdWVzdBoeLm50eC52MS5HZXRBdHRyaWJ1dGlvblJlc3BvbnNlElUKEFByb2plY3RQb3J0Zm9saW8SHy5udHgudjEuUHJvamVjdFBvcnRmb2xpb1JlcXVlc3QaIC5udHgudjEuUHJvamVjdFBvcnRmb2xpb1Jlc3BvbnNlEkYKC1J1blNjZW5hcmlvEhoubnR4LnYxLlJ1blNjZW5hcmlvUmVxdWVzdBobLm50eC52MS5SdW5TY2VuYXJpb1Jlc3BvbnNlEmQKFUNhbGN1bGF0ZVBvc2l0aW9uU2l6ZRIkLm50eC52MS5DYWxjdWxhdGVQb3NpdGlvblNpemVSZXF1ZXN0GiUubnR4LnYxLkNhbGN1bGF0ZVBvc2l0aW9uU2l6ZVJlc3BvbnNlEkAKCUNyZWF0ZVRhZxIYLm50eC52MS5DcmVhdGVUYWdSZXF1ZXN0GhkubnR4LnYxLkNyZWF0ZVRhZ1Jlc3BvbnNlEj0KCExpc3RUYWdzEhcubnR4LnYxLkxpc3RUYWdzUmVxdWVzdBoYLm50eC52MS5MaXN0VGFnc1Jlc3BvbnNlEkAKCVJlbmFtZVRhZxIYLm50eC52MS5SZW5hbWVUYWdSZXF1ZXN0GhkubnR4LnYxLlJlbmFtZVRhZ1Jlc3BvbnNlEkAKCURlbGV0ZVRhZxIYLm50eC52MS5EZWxldGVUYWdSZXF1ZXN0GhkubnR4LnYxLkRlbGV0ZVRhZ1Jlc3BvbnNlElsKElNldFRyYW5zYWN0aW9uVGFncxIhLm50eC52MS5TZXRUcmFuc2FjdGlvblRhZ3NSZXF1ZXN0GiIubnR4LnYxLlNldFRyYW5zYWN0aW9uVGFnc1Jlc3BvbnNlElgKEUdldFRhZ1BlcmZvcm1hbmNlEiAubnR4LnYxLkdldFRhZ1BlcmZvcm1hbmNlUmVxdWVzdBohLm50eC52MS5HZXRUYWdQZXJmb3JtYW5jZVJlc3BvbnNlEl4KE0NyZWF0ZUJyb2tlckFjY291bnQSIi5udHgudjEuQ3JlYXRlQnJva2VyQWNjb3VudFJlcXVlc3QaIy5udHgudjEuQ3JlYXRlQnJva2VyQWNjb3VudFJlc3BvbnNlElsKEkxpc3RCcm9rZXJBY2NvdW50cxIhLm50eC52MS5MaXN0QnJva2VyQWNjb3VudHNSZXF1ZXN0GiIubnR4LnYxLkxpc3RCcm9rZXJBY2NvdW50c1Jlc3BvbnNlEl4KE0RlbGV0ZUJyb2tlckFjY291bnQSIi5udHgudjEuRGVsZXRlQnJva2VyQWNjb3VudFJlcXVlc3QaIy5udHgudjEuRGVsZXRlQnJva2VyQWNjb3VudFJlc3BvbnNlEmEKFFNldFRyYW5zYWN0aW9uQnJva2VyEiMubnR4LnYxLlNldFRyYW5zYWN0aW9uQnJva2VyUmVxdWVzdBokLm50eC52MS5TZXRUcmFuc2FjdGlvbkJyb2tlclJlc3BvbnNlEmEKFEdldEJyb2tlckNvbW1pc3Npb25zEiMubnR4LnYxLkdldEJyb2tlckNvbW1pc3Npb25zUmVxdWVzdBokLm50eC52MS5HZXRCcm9rZXJDb21taXNzaW9uc1Jlc3BvbnNlEkwKDUNyZWF0ZVByb2ZpbGUSHC5udHgudjEuQ3JlYXRlUHJvZmlsZVJlcXVlc3QaHS5udHgudjEuQ3JlYXRlUHJvZmlsZVJlc3BvbnNlEkkKDExpc3RQcm9maWxlcxIbLm50eC52MS5MaXN0UHJvZmlsZXNSZXF1ZXN0GhwubnR4LnYxLkxpc3RQcm9maWxlc1Jlc3BvbnNlEkwKDURlbGV0ZVByb2ZpbGUSHC5udHgudjEuRGVsZXRlUHJvZmlsZVJlcXVlc3QaHS5udHgudjEuRGVsZXRlUHJvZmlsZVJlc3BvbnNlEl4KE1NldFBvcnRmb2xpb1Byb2ZpbGUSIi5udHgudjEuU2V0UG9ydGZvbGlvUHJvZmlsZVJlcXVlc3QaIy5udHgudjEuU2V0UG9ydGZvbGlvUHJvZmlsZVJlc3BvbnNlEk8KDlNldEhvbGRpbmdDb3N0Eh0ubnR4LnYxLlNldEhvbGRpbmdDb3N0UmVxdWVzdBoeLm50eC52MS5TZXRIb2xkaW5nQ29zdFJlc3BvbnNlElUKEENsZWFySG9sZGluZ0Nvc3QSHy5udHgudjEuQ2xlYXJIb2xkaW5nQ29zdFJlcXVlc3QaIC5udHgudjEuQ2xlYXJIb2xkaW5nQ29zdFJlc3BvbnNlEmQKFUdldENvc3RSZWNvbmNpbGlhdGlvbhIkLm50eC52MS5HZXRDb3N0UmVjb25jaWxpYXRpb25SZXF1ZXN0GiUubnR4LnYxLkdldENvc3RSZWNvbmNpbGlhdGlvblJlc3BvbnNlElIKD0V4cG9ydENvc3RCYXNpcxIeLm50eC52MS5FeHBvcnRDb3N0QmFzaXNSZXF1ZXN0Gh8ubnR4LnYxLkV4cG9ydENvc3RCYXNpc1Jlc3BvbnNlEmEKFEdldEJvbnVzRXhwZWN0YXRpb25zEiMubnR4LnYxLkdldEJvbnVzRXhwZWN0YXRpb25zUmVxdWVzdBokLm50eC52MS5HZXRCb251c0V4cGVjdGF0aW9uc1Jlc3BvbnNlElUKEEdldEluY29tZVN1bW1hcnkSHy5udHgudjEuR2V0SW5jb21lU3VtbWFyeVJlcXVlc3QaIC5udHgudjEuR2V0SW5jb21lU3VtbWFyeVJlc3BvbnNlEkkKDFNldEJvbmRUZXJtcxIbLm50eC52MS5TZXRCb25kVGVybXNSZXF1ZXN0GhwubnR4LnYxLlNldEJvbmRUZXJtc1Jlc3BvbnNlEk8KDkNsZWFyQm9uZFRlcm1zEh0ubnR4LnYxLkNsZWFyQm9uZFRlcm1zUmVxdWVzdBoeLm50eC52MS5DbGVhckJvbmRUZXJtc1Jlc3BvbnNlElIKD0dldEJvbmRTY2hlZHVsZRIeLm50eC52MS5HZXRCb25kU2NoZWR1bGVSZXF1ZXN0Gh8ubnR4LnYxLkdldEJvbmRTY2hlZHVsZVJlc3BvbnNlElsKEkNyZWF0ZUhvbGRpbmdHcm91cBIhLm50eC52MS5DcmVhdGVIb2xkaW5nR3JvdXBSZXF1ZXN0GiIubnR4LnYxLkNyZWF0ZUhvbGRpbmdHcm91cFJlc3BvbnNlElgKEUxpc3RIb2xkaW5nR3JvdXBzEiAubnR4LnYxLkxpc3RIb2xkaW5nR3JvdXBzUmVxdWVzdBohLm50eC52MS5MaXN0SG9sZGluZ0dyb3Vwc1Jlc3BvbnNlElsKElVwZGF0ZUhvbGRpbmdHcm91cBIhLm50eC52MS5VcGRhdGVIb2xkaW5nR3JvdXBSZXF1ZXN0GiIubnR4LnYxLlVwZGF0ZUhvbGRpbmdHcm91cFJlc3BvbnNlElsKEkRlbGV0ZUhvbGRpbmdHcm91cBIhLm50eC52MS5EZWxldGVIb2xkaW5nR3JvdXBSZXF1ZXN0GiIubnR4LnYxLkRlbGV0ZUhvbGRpbmdHcm91cFJlc3BvbnNlEkwKDUdyb3VwSG9sZGluZ3MSHC5udHgudjEuR3JvdXBIb2xkaW5nc1JlcXVlc3QaHS5udHgudjEuR3JvdXBIb2xkaW5nc1Jlc3BvbnNlEkYKC0dldFRpbWVsaW5lEhoubnR4LnYxLkdldFRpbWVsaW5lUmVxdWVzdBobLm50eC52MS5HZXRUaW1lbGluZVJlc3BvbnNlElgKEUdldFllYXJDb21wYXJpc29uEiAubnR4LnYxLkdldFllYXJDb21wYXJpc29uUmVxdWVzdBohLm50eC52MS5HZXRZZWFyQ29tcGFyaXNvblJlc3BvbnNlElsKEkdldEdyb3d0aEJyZWFrZG93bhIhLm50eC52MS5HZXRHcm93dGhCcmVha2Rvd25SZXF1ZXN0GiIubnR4LnYxLkdldEdyb3d0aEJyZWFrZG93blJlc3BvbnNlEkkKDEdldFRheFJlcG9ydBIbLm50eC52MS5HZXRUYXhSZXBvcnRSZXF1ZXN0GhwubnR4LnYxLkdldFRheFJlcG9ydFJlc3BvbnNlQjBaLmdpdGh1Yi5jb20vdm9pZGFyY2hpdmUvbnR4L2dlbi9nby9udHgvdjE7bnR4djFiBnByb3RvMw", [file_ntx_v1_common]);
 
 /**
  * Describes the message ntx.v1.Portfolio.
@@ -45,89 +46,894 @@ export const CreatePortfolioRequestSchema = /*@__PURE__*/
 export const CreatePortfolioResponseSchema = /*@__PURE__*/
   messageDesc(file_ntx_v1_portfolio, 4);
 
+/**
+ * Describes the message ntx.v1.DeletePortfolioRequest.
+ * Use `create(DeletePortfolioRequestSchema)` to create a new message.
+ */
+export const DeletePortfolioRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 5);
+
+/**
+ * Describes the message ntx.v1.DeletePortfolioResponse.
+ * Use `create(DeletePortfolioResponseSchema)` to create a new message.
+ */
+export const DeletePortfolioResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 6);
+
 /**
  * Describes the message ntx.v1.Transaction.
  * Use `create(TransactionSchema)` to create a new message.
  */
 export const TransactionSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 5);
+  messageDesc(file_ntx_v1_portfolio, 7);
 
 /**
  * Describes the message ntx.v1.AddTransactionRequest.
  * Use `create(AddTransactionRequestSchema)` to create a new message.
  */
 export const AddTransactionRequestSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 6);
+  messageDesc(file_ntx_v1_portfolio, 8);
 
 /**
  * Describes the message ntx.v1.AddTransactionResponse.
  * Use `create(AddTransactionResponseSchema)` to create a new message.
  */
 export const AddTransactionResponseSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 7);
+  messageDesc(file_ntx_v1_portfolio, 9);
 
 /**
  * Describes the message ntx.v1.ListTransactionsRequest.
  * Use `create(ListTransactionsRequestSchema)` to create a new message.
  */
 export const ListTransactionsRequestSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 8);
+  messageDesc(file_ntx_v1_portfolio, 10);
 
 /**
  * Describes the message ntx.v1.ListTransactionsResponse.
  * Use `create(ListTransactionsResponseSchema)` to create a new message.
  */
 export const ListTransactionsResponseSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 9);
+  messageDesc(file_ntx_v1_portfolio, 11);
 
 /**
  * Describes the message ntx.v1.DeleteTransactionRequest.
  * Use `create(DeleteTransactionRequestSchema)` to create a new message.
  */
 export const DeleteTransactionRequestSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 10);
+  messageDesc(file_ntx_v1_portfolio, 12);
 
 /**
  * Describes the message ntx.v1.DeleteTransactionResponse.
  * Use `create(DeleteTransactionResponseSchema)` to create a new message.
  */
 export const DeleteTransactionResponseSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 11);
+  messageDesc(file_ntx_v1_portfolio, 13);
 
 /**
  * Describes the message ntx.v1.Holding.
  * Use `create(HoldingSchema)` to create a new message.
  */
 export const HoldingSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 12);
+  messageDesc(file_ntx_v1_portfolio, 14);
 
 /**
  * Describes the message ntx.v1.PortfolioSummary.
  * Use `create(PortfolioSummarySchema)` to create a new message.
  */
 export const PortfolioSummarySchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 13);
+  messageDesc(file_ntx_v1_portfolio, 15);
 
 /**
  * Describes the message ntx.v1.HealthTip.
  * Use `create(HealthTipSchema)` to create a new message.
  */
 export const HealthTipSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 14);
+  messageDesc(file_ntx_v1_portfolio, 16);
 
 /**
  * Describes the message ntx.v1.GetPortfolioSummaryRequest.
  * Use `create(GetPortfolioSummaryRequestSchema)` to create a new message.
  */
 export const GetPortfolioSummaryRequestSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 15);
+  messageDesc(file_ntx_v1_portfolio, 17);
 
 /**
  * Describes the message ntx.v1.GetPortfolioSummaryResponse.
  * Use `create(GetPortfolioSummaryResponseSchema)` to create a new message.
  */
 export const GetPortfolioSummaryResponseSchema = /*@__PURE__*/
-  messageDesc(file_ntx_v1_portfolio, 16);
+  messageDesc(file_ntx_v1_portfolio, 18);
+
+/**
+ * Describes the message ntx.v1.ListHoldingsRequest.
+ * Use `create(ListHoldingsRequestSchema)` to create a new message.
+ */
+export const ListHoldingsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 19);
+
+/**
+ * Describes the message ntx.v1.ListHoldingsResponse.
+ * Use `create(ListHoldingsResponseSchema)` to create a new message.
+ */
+export const ListHoldingsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 20);
+
+/**
+ * Describes the message ntx.v1.Lot.
+ * Use `create(LotSchema)` to create a new message.
+ */
+export const LotSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 21);
+
+/**
+ * Describes the message ntx.v1.ListLotsRequest.
+ * Use `create(ListLotsRequestSchema)` to create a new message.
+ */
+export const ListLotsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 22);
+
+/**
+ * Describes the message ntx.v1.ListLotsResponse.
+ * Use `create(ListLotsResponseSchema)` to create a new message.
+ */
+export const ListLotsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 23);
+
+/**
+ * Describes the message ntx.v1.ImportConflict.
+ * Use `create(ImportConflictSchema)` to create a new message.
+ */
+export const ImportConflictSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 24);
+
+/**
+ * Describes the message ntx.v1.ImportTransactionsRequest.
+ * Use `create(ImportTransactionsRequestSchema)` to create a new message.
+ */
+export const ImportTransactionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 25);
+
+/**
+ * Describes the message ntx.v1.ImportTransactionsResponse.
+ * Use `create(ImportTransactionsResponseSchema)` to create a new message.
+ */
+export const ImportTransactionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 26);
+
+/**
+ * Describes the message ntx.v1.ExportTransactionsRequest.
+ * Use `create(ExportTransactionsRequestSchema)` to create a new message.
+ */
+export const ExportTransactionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 27);
+
+/**
+ * Describes the message ntx.v1.ExportTransactionsResponse.
+ * Use `create(ExportTransactionsResponseSchema)` to create a new message.
+ */
+export const ExportTransactionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 28);
+
+/**
+ * Describes the message ntx.v1.PortfolioHistoryPoint.
+ * Use `create(PortfolioHistoryPointSchema)` to create a new message.
+ */
+export const PortfolioHistoryPointSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 29);
+
+/**
+ * Describes the message ntx.v1.GetPortfolioHistoryRequest.
+ * Use `create(GetPortfolioHistoryRequestSchema)` to create a new message.
+ */
+export const GetPortfolioHistoryRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 30);
+
+/**
+ * Describes the message ntx.v1.GetPortfolioHistoryResponse.
+ * Use `create(GetPortfolioHistoryResponseSchema)` to create a new message.
+ */
+export const GetPortfolioHistoryResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 31);
+
+/**
+ * Describes the message ntx.v1.PortfolioBreakdown.
+ * Use `create(PortfolioBreakdownSchema)` to create a new message.
+ */
+export const PortfolioBreakdownSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 32);
+
+/**
+ * Describes the message ntx.v1.TaxSummary.
+ * Use `create(TaxSummarySchema)` to create a new message.
+ */
+export const TaxSummarySchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 33);
+
+/**
+ * Describes the message ntx.v1.ConsolidatedSummary.
+ * Use `create(ConsolidatedSummarySchema)` to create a new message.
+ */
+export const ConsolidatedSummarySchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 34);
+
+/**
+ * Describes the message ntx.v1.GetConsolidatedSummaryRequest.
+ * Use `create(GetConsolidatedSummaryRequestSchema)` to create a new message.
+ */
+export const GetConsolidatedSummaryRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 35);
+
+/**
+ * Describes the message ntx.v1.GetConsolidatedSummaryResponse.
+ * Use `create(GetConsolidatedSummaryResponseSchema)` to create a new message.
+ */
+export const GetConsolidatedSummaryResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 36);
+
+/**
+ * Describes the message ntx.v1.HoldingAttribution.
+ * Use `create(HoldingAttributionSchema)` to create a new message.
+ */
+export const HoldingAttributionSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 37);
+
+/**
+ * Describes the message ntx.v1.GetAttributionRequest.
+ * Use `create(GetAttributionRequestSchema)` to create a new message.
+ */
+export const GetAttributionRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 38);
+
+/**
+ * Describes the message ntx.v1.GetAttributionResponse.
+ * Use `create(GetAttributionResponseSchema)` to create a new message.
+ */
+export const GetAttributionResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 39);
+
+/**
+ * Describes the message ntx.v1.ProjectPortfolioRequest.
+ * Use `create(ProjectPortfolioRequestSchema)` to create a new message.
+ */
+export const ProjectPortfolioRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 40);
+
+/**
+ * Describes the message ntx.v1.ProjectionBand.
+ * Use `create(ProjectionBandSchema)` to create a new message.
+ */
+export const ProjectionBandSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 41);
+
+/**
+ * Describes the message ntx.v1.ProjectPortfolioResponse.
+ * Use `create(ProjectPortfolioResponseSchema)` to create a new message.
+ */
+export const ProjectPortfolioResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 42);
+
+/**
+ * Describes the message ntx.v1.SectorShock.
+ * Use `create(SectorShockSchema)` to create a new message.
+ */
+export const SectorShockSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 43);
+
+/**
+ * Describes the message ntx.v1.RunScenarioRequest.
+ * Use `create(RunScenarioRequestSchema)` to create a new message.
+ */
+export const RunScenarioRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 44);
+
+/**
+ * Describes the message ntx.v1.ScenarioHolding.
+ * Use `create(ScenarioHoldingSchema)` to create a new message.
+ */
+export const ScenarioHoldingSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 45);
+
+/**
+ * Describes the message ntx.v1.RunScenarioResponse.
+ * Use `create(RunScenarioResponseSchema)` to create a new message.
+ */
+export const RunScenarioResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 46);
+
+/**
+ * Describes the message ntx.v1.CalculatePositionSizeRequest.
+ * Use `create(CalculatePositionSizeRequestSchema)` to create a new message.
+ */
+export const CalculatePositionSizeRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 47);
+
+/**
+ * Describes the message ntx.v1.CalculatePositionSizeResponse.
+ * Use `create(CalculatePositionSizeResponseSchema)` to create a new message.
+ */
+export const CalculatePositionSizeResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 48);
+
+/**
+ * Describes the message ntx.v1.Tag.
+ * Use `create(TagSchema)` to create a new message.
+ */
+export const TagSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 49);
+
+/**
+ * Describes the message ntx.v1.CreateTagRequest.
+ * Use `create(CreateTagRequestSchema)` to create a new message.
+ */
+export const CreateTagRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 50);
+
+/**
+ * Describes the message ntx.v1.CreateTagResponse.
+ * Use `create(CreateTagResponseSchema)` to create a new message.
+ */
+export const CreateTagResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 51);
+
+/**
+ * Describes the message ntx.v1.ListTagsRequest.
+ * Use `create(ListTagsRequestSchema)` to create a new message.
+ */
+export const ListTagsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 52);
+
+/**
+ * Describes the message ntx.v1.ListTagsResponse.
+ * Use `create(ListTagsResponseSchema)` to create a new message.
+ */
+export const ListTagsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 53);
+
+/**
+ * Describes the message ntx.v1.RenameTagRequest.
+ * Use `create(RenameTagRequestSchema)` to create a new message.
+ */
+export const RenameTagRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 54);
+
+/**
+ * Describes the message ntx.v1.RenameTagResponse.
+ * Use `create(RenameTagResponseSchema)` to create a new message.
+ */
+export const RenameTagResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 55);
+
+/**
+ * Describes the message ntx.v1.DeleteTagRequest.
+ * Use `create(DeleteTagRequestSchema)` to create a new message.
+ */
+export const DeleteTagRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 56);
+
+/**
+ * Describes the message ntx.v1.DeleteTagResponse.
+ * Use `create(DeleteTagResponseSchema)` to create a new message.
+ */
+export const DeleteTagResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 57);
+
+/**
+ * Describes the message ntx.v1.SetTransactionTagsRequest.
+ * Use `create(SetTransactionTagsRequestSchema)` to create a new message.
+ */
+export const SetTransactionTagsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 58);
+
+/**
+ * Describes the message ntx.v1.SetTransactionTagsResponse.
+ * Use `create(SetTransactionTagsResponseSchema)` to create a new message.
+ */
+export const SetTransactionTagsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 59);
+
+/**
+ * Describes the message ntx.v1.TagPerformance.
+ * Use `create(TagPerformanceSchema)` to create a new message.
+ */
+export const TagPerformanceSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 60);
+
+/**
+ * Describes the message ntx.v1.GetTagPerformanceRequest.
+ * Use `create(GetTagPerformanceRequestSchema)` to create a new message.
+ */
+export const GetTagPerformanceRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 61);
+
+/**
+ * Describes the message ntx.v1.GetTagPerformanceResponse.
+ * Use `create(GetTagPerformanceResponseSchema)` to create a new message.
+ */
+export const GetTagPerformanceResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 62);
+
+/**
+ * Describes the message ntx.v1.BrokerAccount.
+ * Use `create(BrokerAccountSchema)` to create a new message.
+ */
+export const BrokerAccountSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 63);
+
+/**
+ * Describes the message ntx.v1.CreateBrokerAccountRequest.
+ * Use `create(CreateBrokerAccountRequestSchema)` to create a new message.
+ */
+export const CreateBrokerAccountRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 64);
+
+/**
+ * Describes the message ntx.v1.CreateBrokerAccountResponse.
+ * Use `create(CreateBrokerAccountResponseSchema)` to create a new message.
+ */
+export const CreateBrokerAccountResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 65);
+
+/**
+ * Describes the message ntx.v1.ListBrokerAccountsRequest.
+ * Use `create(ListBrokerAccountsRequestSchema)` to create a new message.
+ */
+export const ListBrokerAccountsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 66);
+
+/**
+ * Describes the message ntx.v1.ListBrokerAccountsResponse.
+ * Use `create(ListBrokerAccountsResponseSchema)` to create a new message.
+ */
+export const ListBrokerAccountsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 67);
+
+/**
+ * Describes the message ntx.v1.DeleteBrokerAccountRequest.
+ * Use `create(DeleteBrokerAccountRequestSchema)` to create a new message.
+ */
+export const DeleteBrokerAccountRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 68);
+
+/**
+ * Describes the message ntx.v1.DeleteBrokerAccountResponse.
+ * Use `create(DeleteBrokerAccountResponseSchema)` to create a new message.
+ */
+export const DeleteBrokerAccountResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 69);
+
+/**
+ * Describes the message ntx.v1.SetTransactionBrokerRequest.
+ * Use `create(SetTransactionBrokerRequestSchema)` to create a new message.
+ */
+export const SetTransactionBrokerRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 70);
+
+/**
+ * Describes the message ntx.v1.SetTransactionBrokerResponse.
+ * Use `create(SetTransactionBrokerResponseSchema)` to create a new message.
+ */
+export const SetTransactionBrokerResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 71);
+
+/**
+ * Describes the message ntx.v1.BrokerCommission.
+ * Use `create(BrokerCommissionSchema)` to create a new message.
+ */
+export const BrokerCommissionSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 72);
+
+/**
+ * Describes the message ntx.v1.GetBrokerCommissionsRequest.
+ * Use `create(GetBrokerCommissionsRequestSchema)` to create a new message.
+ */
+export const GetBrokerCommissionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 73);
+
+/**
+ * Describes the message ntx.v1.GetBrokerCommissionsResponse.
+ * Use `create(GetBrokerCommissionsResponseSchema)` to create a new message.
+ */
+export const GetBrokerCommissionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 74);
+
+/**
+ * Describes the message ntx.v1.Profile.
+ * Use `create(ProfileSchema)` to create a new message.
+ */
+export const ProfileSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 75);
+
+/**
+ * Describes the message ntx.v1.CreateProfileRequest.
+ * Use `create(CreateProfileRequestSchema)` to create a new message.
+ */
+export const CreateProfileRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 76);
+
+/**
+ * Describes the message ntx.v1.CreateProfileResponse.
+ * Use `create(CreateProfileResponseSchema)` to create a new message.
+ */
+export const CreateProfileResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 77);
+
+/**
+ * Describes the message ntx.v1.ListProfilesRequest.
+ * Use `create(ListProfilesRequestSchema)` to create a new message.
+ */
+export const ListProfilesRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 78);
+
+/**
+ * Describes the message ntx.v1.ListProfilesResponse.
+ * Use `create(ListProfilesResponseSchema)` to create a new message.
+ */
+export const ListProfilesResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 79);
+
+/**
+ * Describes the message ntx.v1.DeleteProfileRequest.
+ * Use `create(DeleteProfileRequestSchema)` to create a new message.
+ */
+export const DeleteProfileRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 80);
+
+/**
+ * Describes the message ntx.v1.DeleteProfileResponse.
+ * Use `create(DeleteProfileResponseSchema)` to create a new message.
+ */
+export const DeleteProfileResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 81);
+
+/**
+ * Describes the message ntx.v1.SetPortfolioProfileRequest.
+ * Use `create(SetPortfolioProfileRequestSchema)` to create a new message.
+ */
+export const SetPortfolioProfileRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 82);
+
+/**
+ * Describes the message ntx.v1.SetPortfolioProfileResponse.
+ * Use `create(SetPortfolioProfileResponseSchema)` to create a new message.
+ */
+export const SetPortfolioProfileResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 83);
+
+/**
+ * Describes the message ntx.v1.CostEntry.
+ * Use `create(CostEntrySchema)` to create a new message.
+ */
+export const CostEntrySchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 84);
+
+/**
+ * Describes the message ntx.v1.SetHoldingCostRequest.
+ * Use `create(SetHoldingCostRequestSchema)` to create a new message.
+ */
+export const SetHoldingCostRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 85);
+
+/**
+ * Describes the message ntx.v1.SetHoldingCostResponse.
+ * Use `create(SetHoldingCostResponseSchema)` to create a new message.
+ */
+export const SetHoldingCostResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 86);
+
+/**
+ * Describes the message ntx.v1.ClearHoldingCostRequest.
+ * Use `create(ClearHoldingCostRequestSchema)` to create a new message.
+ */
+export const ClearHoldingCostRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 87);
+
+/**
+ * Describes the message ntx.v1.ClearHoldingCostResponse.
+ * Use `create(ClearHoldingCostResponseSchema)` to create a new message.
+ */
+export const ClearHoldingCostResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 88);
+
+/**
+ * Describes the message ntx.v1.CostReconciliation.
+ * Use `create(CostReconciliationSchema)` to create a new message.
+ */
+export const CostReconciliationSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 89);
+
+/**
+ * Describes the message ntx.v1.GetCostReconciliationRequest.
+ * Use `create(GetCostReconciliationRequestSchema)` to create a new message.
+ */
+export const GetCostReconciliationRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 90);
+
+/**
+ * Describes the message ntx.v1.GetCostReconciliationResponse.
+ * Use `create(GetCostReconciliationResponseSchema)` to create a new message.
+ */
+export const GetCostReconciliationResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 91);
+
+/**
+ * Describes the message ntx.v1.ExportCostBasisRequest.
+ * Use `create(ExportCostBasisRequestSchema)` to create a new message.
+ */
+export const ExportCostBasisRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 92);
+
+/**
+ * Describes the message ntx.v1.ExportCostBasisResponse.
+ * Use `create(ExportCostBasisResponseSchema)` to create a new message.
+ */
+export const ExportCostBasisResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 93);
+
+/**
+ * Describes the message ntx.v1.BonusExpectation.
+ * Use `create(BonusExpectationSchema)` to create a new message.
+ */
+export const BonusExpectationSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 94);
+
+/**
+ * Describes the message ntx.v1.GetBonusExpectationsRequest.
+ * Use `create(GetBonusExpectationsRequestSchema)` to create a new message.
+ */
+export const GetBonusExpectationsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 95);
+
+/**
+ * Describes the message ntx.v1.GetBonusExpectationsResponse.
+ * Use `create(GetBonusExpectationsResponseSchema)` to create a new message.
+ */
+export const GetBonusExpectationsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 96);
+
+/**
+ * Describes the message ntx.v1.IncomeHolding.
+ * Use `create(IncomeHoldingSchema)` to create a new message.
+ */
+export const IncomeHoldingSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 97);
+
+/**
+ * Describes the message ntx.v1.GetIncomeSummaryRequest.
+ * Use `create(GetIncomeSummaryRequestSchema)` to create a new message.
+ */
+export const GetIncomeSummaryRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 98);
+
+/**
+ * Describes the message ntx.v1.GetIncomeSummaryResponse.
+ * Use `create(GetIncomeSummaryResponseSchema)` to create a new message.
+ */
+export const GetIncomeSummaryResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 99);
+
+/**
+ * Describes the message ntx.v1.BondTerms.
+ * Use `create(BondTermsSchema)` to create a new message.
+ */
+export const BondTermsSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 100);
+
+/**
+ * Describes the message ntx.v1.SetBondTermsRequest.
+ * Use `create(SetBondTermsRequestSchema)` to create a new message.
+ */
+export const SetBondTermsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 101);
+
+/**
+ * Describes the message ntx.v1.SetBondTermsResponse.
+ * Use `create(SetBondTermsResponseSchema)` to create a new message.
+ */
+export const SetBondTermsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 102);
+
+/**
+ * Describes the message ntx.v1.ClearBondTermsRequest.
+ * Use `create(ClearBondTermsRequestSchema)` to create a new message.
+ */
+export const ClearBondTermsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 103);
+
+/**
+ * Describes the message ntx.v1.ClearBondTermsResponse.
+ * Use `create(ClearBondTermsResponseSchema)` to create a new message.
+ */
+export const ClearBondTermsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 104);
+
+/**
+ * Describes the message ntx.v1.BondSchedule.
+ * Use `create(BondScheduleSchema)` to create a new message.
+ */
+export const BondScheduleSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 105);
+
+/**
+ * Describes the message ntx.v1.GetBondScheduleRequest.
+ * Use `create(GetBondScheduleRequestSchema)` to create a new message.
+ */
+export const GetBondScheduleRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 106);
+
+/**
+ * Describes the message ntx.v1.GetBondScheduleResponse.
+ * Use `create(GetBondScheduleResponseSchema)` to create a new message.
+ */
+export const GetBondScheduleResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 107);
+
+/**
+ * Describes the message ntx.v1.HoldingGroup.
+ * Use `create(HoldingGroupSchema)` to create a new message.
+ */
+export const HoldingGroupSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 108);
+
+/**
+ * Describes the message ntx.v1.CreateHoldingGroupRequest.
+ * Use `create(CreateHoldingGroupRequestSchema)` to create a new message.
+ */
+export const CreateHoldingGroupRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 109);
+
+/**
+ * Describes the message ntx.v1.CreateHoldingGroupResponse.
+ * Use `create(CreateHoldingGroupResponseSchema)` to create a new message.
+ */
+export const CreateHoldingGroupResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 110);
+
+/**
+ * Describes the message ntx.v1.ListHoldingGroupsRequest.
+ * Use `create(ListHoldingGroupsRequestSchema)` to create a new message.
+ */
+export const ListHoldingGroupsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 111);
+
+/**
+ * Describes the message ntx.v1.ListHoldingGroupsResponse.
+ * Use `create(ListHoldingGroupsResponseSchema)` to create a new message.
+ */
+export const ListHoldingGroupsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 112);
+
+/**
+ * Describes the message ntx.v1.UpdateHoldingGroupRequest.
+ * Use `create(UpdateHoldingGroupRequestSchema)` to create a new message.
+ */
+export const UpdateHoldingGroupRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 113);
+
+/**
+ * Describes the message ntx.v1.UpdateHoldingGroupResponse.
+ * Use `create(UpdateHoldingGroupResponseSchema)` to create a new message.
+ */
+export const UpdateHoldingGroupResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 114);
+
+/**
+ * Describes the message ntx.v1.DeleteHoldingGroupRequest.
+ * Use `create(DeleteHoldingGroupRequestSchema)` to create a new message.
+ */
+export const DeleteHoldingGroupRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 115);
+
+/**
+ * Describes the message ntx.v1.DeleteHoldingGroupResponse.
+ * Use `create(DeleteHoldingGroupResponseSchema)` to create a new message.
+ */
+export const DeleteHoldingGroupResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 116);
+
+/**
+ * Describes the message ntx.v1.GroupAllocation.
+ * Use `create(GroupAllocationSchema)` to create a new message.
+ */
+export const GroupAllocationSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 117);
+
+/**
+ * Describes the message ntx.v1.GroupHoldingsRequest.
+ * Use `create(GroupHoldingsRequestSchema)` to create a new message.
+ */
+export const GroupHoldingsRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 118);
+
+/**
+ * Describes the message ntx.v1.GroupHoldingsResponse.
+ * Use `create(GroupHoldingsResponseSchema)` to create a new message.
+ */
+export const GroupHoldingsResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 119);
+
+/**
+ * Describes the message ntx.v1.TimelineEvent.
+ * Use `create(TimelineEventSchema)` to create a new message.
+ */
+export const TimelineEventSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 120);
+
+/**
+ * Describes the message ntx.v1.GetTimelineRequest.
+ * Use `create(GetTimelineRequestSchema)` to create a new message.
+ */
+export const GetTimelineRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 121);
+
+/**
+ * Describes the message ntx.v1.GetTimelineResponse.
+ * Use `create(GetTimelineResponseSchema)` to create a new message.
+ */
+export const GetTimelineResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 122);
+
+/**
+ * Describes the message ntx.v1.FiscalYearSummary.
+ * Use `create(FiscalYearSummarySchema)` to create a new message.
+ */
+export const FiscalYearSummarySchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 123);
+
+/**
+ * Describes the message ntx.v1.GetYearComparisonRequest.
+ * Use `create(GetYearComparisonRequestSchema)` to create a new message.
+ */
+export const GetYearComparisonRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 124);
+
+/**
+ * Describes the message ntx.v1.GetYearComparisonResponse.
+ * Use `create(GetYearComparisonResponseSchema)` to create a new message.
+ */
+export const GetYearComparisonResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 125);
+
+/**
+ * Describes the message ntx.v1.MonthlyGrowth.
+ * Use `create(MonthlyGrowthSchema)` to create a new message.
+ */
+export const MonthlyGrowthSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 126);
+
+/**
+ * Describes the message ntx.v1.GetGrowthBreakdownRequest.
+ * Use `create(GetGrowthBreakdownRequestSchema)` to create a new message.
+ */
+export const GetGrowthBreakdownRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 127);
+
+/**
+ * Describes the message ntx.v1.GetGrowthBreakdownResponse.
+ * Use `create(GetGrowthBreakdownResponseSchema)` to create a new message.
+ */
+export const GetGrowthBreakdownResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 128);
+
+/**
+ * Describes the message ntx.v1.RealizedGain.
+ * Use `create(RealizedGainSchema)` to create a new message.
+ */
+export const RealizedGainSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 129);
+
+/**
+ * Describes the message ntx.v1.GetTaxReportRequest.
+ * Use `create(GetTaxReportRequestSchema)` to create a new message.
+ */
+export const GetTaxReportRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 130);
+
+/**
+ * Describes the message ntx.v1.GetTaxReportResponse.
+ * Use `create(GetTaxReportResponseSchema)` to create a new message.
+ */
+export const GetTaxReportResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_portfolio, 131);
 
 /**
  * Describes the enum ntx.v1.TransactionType.
@@ -141,6 +947,70 @@ export const TransactionTypeSchema = /*@__PURE__*/
 export const TransactionType = /*@__PURE__*/
   tsEnum(TransactionTypeSchema);
 
+/**
+ * Describes the enum ntx.v1.HoldingSortField.
+ */
+export const HoldingSortFieldSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_portfolio, 1);
+
+/**
+ * @generated from enum ntx.v1.HoldingSortField
+ */
+export const HoldingSortField = /*@__PURE__*/
+  tsEnum(HoldingSortFieldSchema);
+
+/**
+ * Describes the enum ntx.v1.ConflictStrategy.
+ */
+export const ConflictStrategySchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_portfolio, 2);
+
+/**
+ * @generated from enum ntx.v1.ConflictStrategy
+ */
+export const ConflictStrategy = /*@__PURE__*/
+  tsEnum(ConflictStrategySchema);
+
+/**
+ * Describes the enum ntx.v1.HistoryInterval.
+ */
+export const HistoryIntervalSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_portfolio, 3);
+
+/**
+ * @generated from enum ntx.v1.HistoryInterval
+ */
+export const HistoryInterval = /*@__PURE__*/
+  tsEnum(HistoryIntervalSchema);
+
+/**
+ * Describes the enum ntx.v1.CostSource.
+ */
+export const CostSourceSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_portfolio, 4);
+
+/**
+ * CostSource is where a holding's average cost came from. When several
+ * sources are recorded, manual entry wins over the WACC report, which wins
+ * over the cost computed from transactions.
+ *
+ * @generated from enum ntx.v1.CostSource
+ */
+export const CostSource = /*@__PURE__*/
+  tsEnum(CostSourceSchema);
+
+/**
+ * Describes the enum ntx.v1.TimelineEventKind.
+ */
+export const TimelineEventKindSchema = /*@__PURE__*/
+  enumDesc(file_ntx_v1_portfolio, 5);
+
+/**
+ * @generated from enum ntx.v1.TimelineEventKind
+ */
+export const TimelineEventKind = /*@__PURE__*/
+  tsEnum(TimelineEventKindSchema);
+
 /**
  * @generated from service ntx.v1.PortfolioService
  */
@@ -112,6 +112,196 @@ export declare type ListLatestPricesResponse = Message<"ntx.v1.ListLatestPricesR
  */
 export declare const ListLatestPricesResponseSchema: GenMessage<ListLatestPricesResponse>;
 
+/**
+ * @generated from message ntx.v1.SyncPricesRequest
+ */
+export declare type SyncPricesRequest = Message<"ntx.v1.SyncPricesRequest"> & {
+  /**
+   * empty syncs every listed company
+   *
+   * @generated from field: repeated string symbols = 1;
+   */
+  symbols: string[];
+};
+
+/**
+ * Describes the message ntx.v1.SyncPricesRequest.
+ * Use `create(SyncPricesRequestSchema)` to create a new message.
+ */
+export declare const SyncPricesRequestSchema: GenMessage<SyncPricesRequest>;
+
+/**
+ * @generated from message ntx.v1.SymbolSyncResult
+ */
+export declare type SymbolSyncResult = Message<"ntx.v1.SymbolSyncResult"> & {
+  /**
+   * @generated from field: string symbol = 1;
+   */
+  symbol: string;
+
+  /**
+   * @generated from field: bool updated = 2;
+   */
+  updated: boolean;
+
+  /**
+   * why the symbol was not updated
+   *
+   * @generated from field: string error = 3;
+   */
+  error: string;
+
+  /**
+   * the stored price already matched, so nothing was written
+   *
+   * @generated from field: bool unchanged = 4;
+   */
+  unchanged: boolean;
+};
+
+/**
+ * Describes the message ntx.v1.SymbolSyncResult.
+ * Use `create(SymbolSyncResultSchema)` to create a new message.
+ */
+export declare const SymbolSyncResultSchema: GenMessage<SymbolSyncResult>;
+
+/**
+ * @generated from message ntx.v1.SyncPricesResponse
+ */
+export declare type SyncPricesResponse = Message<"ntx.v1.SyncPricesResponse"> & {
+  /**
+   * @generated from field: string business_date = 1;
+   */
+  businessDate: string;
+
+  /**
+   * @generated from field: repeated ntx.v1.SymbolSyncResult results = 2;
+   */
+  results: SymbolSyncResult[];
+};
+
+/**
+ * Describes the message ntx.v1.SyncPricesResponse.
+ * Use `create(SyncPricesResponseSchema)` to create a new message.
+ */
+export declare const SyncPricesResponseSchema: GenMessage<SyncPricesResponse>;
+
+/**
+ * @generated from message ntx.v1.GetBackfillStatusRequest
+ */
+export declare type GetBackfillStatusRequest = Message<"ntx.v1.GetBackfillStatusRequest"> & {
+};
+
+/**
+ * Describes the message ntx.v1.GetBackfillStatusRequest.
+ * Use `create(GetBackfillStatusRequestSchema)` to create a new message.
+ */
+export declare const GetBackfillStatusRequestSchema: GenMessage<GetBackfillStatusRequest>;
+
+/**
+ * Progress of an `ntx backfill` run. Phases run in order: companies,
+ * fundamentals, prices, ownership, corporate-actions.
+ *
+ * @generated from message ntx.v1.BackfillRun
+ */
+export declare type BackfillRun = Message<"ntx.v1.BackfillRun"> & {
+  /**
+   * @generated from field: int64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * running, succeeded or failed
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string phase = 3;
+   */
+  phase: string;
+
+  /**
+   * last company finished
+   *
+   * @generated from field: string symbol = 4;
+   */
+  symbol: string;
+
+  /**
+   * within the phase
+   *
+   * @generated from field: int64 companies_done = 5;
+   */
+  companiesDone: bigint;
+
+  /**
+   * @generated from field: int64 companies_total = 6;
+   */
+  companiesTotal: bigint;
+
+  /**
+   * across the whole run
+   *
+   * @generated from field: int64 rows_fetched = 7;
+   */
+  rowsFetched: bigint;
+
+  /**
+   * @generated from field: string started_at = 8;
+   */
+  startedAt: string;
+
+  /**
+   * empty while running
+   *
+   * @generated from field: string finished_at = 9;
+   */
+  finishedAt: string;
+
+  /**
+   * @generated from field: string updated_at = 10;
+   */
+  updatedAt: string;
+
+  /**
+   * for the current phase; 0 when unknown
+   *
+   * @generated from field: int64 eta_seconds = 11;
+   */
+  etaSeconds: bigint;
+
+  /**
+   * @generated from field: string error = 12;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message ntx.v1.BackfillRun.
+ * Use `create(BackfillRunSchema)` to create a new message.
+ */
+export declare const BackfillRunSchema: GenMessage<BackfillRun>;
+
+/**
+ * @generated from message ntx.v1.GetBackfillStatusResponse
+ */
+export declare type GetBackfillStatusResponse = Message<"ntx.v1.GetBackfillStatusResponse"> & {
+  /**
+   * unset if no backfill has run
+   *
+   * @generated from field: ntx.v1.BackfillRun run = 1;
+   */
+  run?: BackfillRun;
+};
+
+/**
+ * Describes the message ntx.v1.GetBackfillStatusResponse.
+ * Use `create(GetBackfillStatusResponseSchema)` to create a new message.
+ */
+export declare const GetBackfillStatusResponseSchema: GenMessage<GetBackfillStatusResponse>;
+
 /**
  * @generated from service ntx.v1.PriceService
  */
@@ -140,5 +330,21 @@ export declare const PriceService: GenService<{
     input: typeof ListLatestPricesRequestSchema;
     output: typeof ListLatestPricesResponseSchema;
   },
+  /**
+   * @generated from rpc ntx.v1.PriceService.SyncPrices
+   */
+  syncPrices: {
+    methodKind: "unary";
+    input: typeof SyncPricesRequestSchema;
+    output: typeof SyncPricesResponseSchema;
+  },
+  /**
+   * @generated from rpc ntx.v1.PriceService.GetBackfillStatus
+   */
+  getBackfillStatus: {
+    methodKind: "unary";
+    input: typeof GetBackfillStatusRequestSchema;
+    output: typeof GetBackfillStatusResponseSchema;
+  },
 }>;
 
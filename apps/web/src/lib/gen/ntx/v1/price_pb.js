@@ -9,7 +9,7 @@ import { file_ntx_v1_common } from "./common_pb";
  * Describes the file ntx/v1/price.proto.
  */
 export const file_ntx_v1_price = /*@__PURE__*/
-  fileDesc("ChJudHgvdjEvcHJpY2UucHJvdG8SBm50eC52MSIhCg9HZXRQcmljZVJlcXVlc3QSDgoGc3ltYm9sGAEgASgJIjAKEEdldFByaWNlUmVzcG9uc2USHAoFcHJpY2UYASABKAsyDS5udHgudjEuUHJpY2UiRAoWR2V0UHJpY2VIaXN0b3J5UmVxdWVzdBIOCgZzeW1ib2wYASABKAkSEQoEZGF5cxgCIAEoBUgAiAEBQgcKBV9kYXlzIjgKF0dldFByaWNlSGlzdG9yeVJlc3BvbnNlEh0KBnByaWNlcxgBIAMoCzINLm50eC52MS5QcmljZSIZChdMaXN0TGF0ZXN0UHJpY2VzUmVxdWVzdCI5ChhMaXN0TGF0ZXN0UHJpY2VzUmVzcG9uc2USHQoGcHJpY2VzGAEgAygLMg0ubnR4LnYxLlByaWNlMvgBCgxQcmljZVNlcnZpY2USPQoIR2V0UHJpY2USFy5udHgudjEuR2V0UHJpY2VSZXF1ZXN0GhgubnR4LnYxLkdldFByaWNlUmVzcG9uc2USUgoPR2V0UHJpY2VIaXN0b3J5Eh4ubnR4LnYxLkdldFByaWNlSGlzdG9yeVJlcXVlc3QaHy5udHgudjEuR2V0UHJpY2VIaXN0b3J5UmVzcG9uc2USVQoQTGlzdExhdGVzdFByaWNlcxIfLm50eC52MS5MaXN0TGF0ZXN0UHJpY2VzUmVxdWVzdBogLm50eC52MS5MaXN0TGF0ZXN0UHJpY2VzUmVzcG9uc2VCMFouZ2l0aHViLmNvbS92b2lkYXJjaGl2ZS9udHgvZ2VuL2dvL250eC92MTtudHh2MWIGcHJvdG8z", [file_ntx_v1_common]);
+  fileDesc("ChJudHgvdjEvcHJpY2UucHJvdG8SBm50eC52MSIhCg9HZXRQcmljZVJlcXVlc3QSDgoGc3ltYm9sGAEgASgJIjAKEEdldFByaWNlUmVzcG9uc2USHAoFcHJpY2UYASABKAsyDS5udHgudjEuUHJpY2UiRAoWR2V0UHJpY2VIaXN0b3J5UmVxdWVzdBIOCgZzeW1ib2wYASABKAkSEQoEZGF5cxgCIAEoBUgAiAEBQgcKBV9kYXlzIjgKF0dldFByaWNlSGlzdG9yeVJlc3BvbnNlEh0KBnByaWNlcxgBIAMoCzINLm50eC52MS5QcmljZSIZChdMaXN0TGF0ZXN0UHJpY2VzUmVxdWVzdCI5ChhMaXN0TGF0ZXN0UHJpY2VzUmVzcG9uc2USHQoGcHJpY2VzGAEgAygLMg0ubnR4LnYxLlByaWNlIiQKEVN5bmNQcmljZXNSZXF1ZXN0Eg8KB3N5bWJvbHMYASADKAkiVQoQU3ltYm9sU3luY1Jlc3VsdBIOCgZzeW1ib2wYASABKAkSDwoHdXBkYXRlZBgCIAEoCBINCgVlcnJvchgDIAEoCRIRCgl1bmNoYW5nZWQYBCABKAgiVgoSU3luY1ByaWNlc1Jlc3BvbnNlEhUKDWJ1c2luZXNzX2RhdGUYASABKAkSKQoHcmVzdWx0cxgCIAMoCzIYLm50eC52MS5TeW1ib2xTeW5jUmVzdWx0IhoKGEdldEJhY2tmaWxsU3RhdHVzUmVxdWVzdCLwAQoLQmFja2ZpbGxSdW4SCgoCaWQYASABKAMSDgoGc3RhdHVzGAIgASgJEg0KBXBoYXNlGAMgASgJEg4KBnN5bWJvbBgEIAEoCRIWCg5jb21wYW5pZXNfZG9uZRgFIAEoAxIXCg9jb21wYW5pZXNfdG90YWwYBiABKAMSFAoMcm93c19mZXRjaGVkGAcgASgDEhIKCnN0YXJ0ZWRfYXQYCCABKAkSEwoLZmluaXNoZWRfYXQYCSABKAkSEgoKdXBkYXRlZF9hdBgKIAEoCRITCgtldGFfc2Vjb25kcxgLIAEoAxINCgVlcnJvchgMIAEoCSI9ChlHZXRCYWNrZmlsbFN0YXR1c1Jlc3BvbnNlEiAKA3J1bhgBIAEoCzITLm50eC52MS5CYWNrZmlsbFJ1bjKXAwoMUHJpY2VTZXJ2aWNlEj0KCEdldFByaWNlEhcubnR4LnYxLkdldFByaWNlUmVxdWVzdBoYLm50eC52MS5HZXRQcmljZVJlc3BvbnNlElIKD0dldFByaWNlSGlzdG9yeRIeLm50eC52MS5HZXRQcmljZUhpc3RvcnlSZXF1ZXN0Gh8ubnR4LnYxLkdldFByaWNlSGlzdG9yeVJlc3BvbnNlElUKEExpc3RMYXRlc3RQcmljZXMSHy5udHgudjEuTGlzdExhdGVzdFByaWNlc1JlcXVlc3QaIC5udHgudjEuTGlzdExhdGVzdFByaWNlc1Jlc3BvbnNlEkMKClN5bmNQcmljZXMSGS5udHgudjEuU3luY1ByaWNlc1JlcXVlc3QaGi5udHgudjEuU3luY1ByaWNlc1Jlc3BvbnNlElgKEUdldEJhY2tmaWxsU3RhdHVzEiAubnR4LnYxLkdldEJhY2tmaWxsU3RhdHVzUmVxdWVzdBohLm50eC52MS5HZXRCYWNrZmlsbFN0YXR1c1Jlc3BvbnNlQjBaLmdpdGh1Yi5jb20vdm9pZGFyY2hpdmUvbnR4L2dlbi9nby9udHgvdjE7bnR4djFiBnByb3RvMw", [file_ntx_v1_common]);
 
 /**
  * Describes the message ntx.v1.GetPriceRequest.
@@ -53,6 +53,48 @@ export const ListLatestPricesRequestSchema = /*@__PURE__*/
 export const ListLatestPricesResponseSchema = /*@__PURE__*/
   messageDesc(file_ntx_v1_price, 5);
 
+/**
+ * Describes the message ntx.v1.SyncPricesRequest.
+ * Use `create(SyncPricesRequestSchema)` to create a new message.
+ */
+export const SyncPricesRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 6);
+
+/**
+ * Describes the message ntx.v1.SymbolSyncResult.
+ * Use `create(SymbolSyncResultSchema)` to create a new message.
+ */
+export const SymbolSyncResultSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 7);
+
+/**
+ * Describes the message ntx.v1.SyncPricesResponse.
+ * Use `create(SyncPricesResponseSchema)` to create a new message.
+ */
+export const SyncPricesResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 8);
+
+/**
+ * Describes the message ntx.v1.GetBackfillStatusRequest.
+ * Use `create(GetBackfillStatusRequestSchema)` to create a new message.
+ */
+export const GetBackfillStatusRequestSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 9);
+
+/**
+ * Describes the message ntx.v1.BackfillRun.
+ * Use `create(BackfillRunSchema)` to create a new message.
+ */
+export const BackfillRunSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 10);
+
+/**
+ * Describes the message ntx.v1.GetBackfillStatusResponse.
+ * Use `create(GetBackfillStatusResponseSchema)` to create a new message.
+ */
+export const GetBackfillStatusResponseSchema = /*@__PURE__*/
+  messageDesc(file_ntx_v1_price, 11);
+
 /**
  * @generated from service ntx.v1.PriceService
  */